@@ -5,12 +5,39 @@ fn main() {
         .build_client(true)
         .compile(
             &[
+                "../avalanchego-conformance/rpcpb/address.proto",
+                "../avalanchego-conformance/rpcpb/bench.proto",
+                "../avalanchego-conformance/rpcpb/cert.proto",
+                "../avalanchego-conformance/rpcpb/codec.proto",
+                "../avalanchego-conformance/rpcpb/config.proto",
+                "../avalanchego-conformance/rpcpb/coreth.proto",
+                "../avalanchego-conformance/rpcpb/diff.proto",
+                "../avalanchego-conformance/rpcpb/errors.proto",
+                "../avalanchego-conformance/rpcpb/formatting.proto",
+                "../avalanchego-conformance/rpcpb/fuzz.proto",
+                "../avalanchego-conformance/rpcpb/genesis.proto",
+                "../avalanchego-conformance/rpcpb/hash.proto",
+                "../avalanchego-conformance/rpcpb/ids.proto",
+                "../avalanchego-conformance/rpcpb/json.proto",
+                "../avalanchego-conformance/rpcpb/json_api.proto",
                 "../avalanchego-conformance/rpcpb/key.proto",
                 "../avalanchego-conformance/rpcpb/message.proto",
+                "../avalanchego-conformance/rpcpb/network.proto",
                 "../avalanchego-conformance/rpcpb/packer.proto",
                 "../avalanchego-conformance/rpcpb/ping.proto",
+                "../avalanchego-conformance/rpcpb/proof.proto",
+                "../avalanchego-conformance/rpcpb/report.proto",
+                "../avalanchego-conformance/rpcpb/reward.proto",
+                "../avalanchego-conformance/rpcpb/sorting.proto",
+                "../avalanchego-conformance/rpcpb/stress.proto",
+                "../avalanchego-conformance/rpcpb/tx.proto",
+                "../avalanchego-conformance/rpcpb/upgrade.proto",
+                "../avalanchego-conformance/rpcpb/validators.proto",
+                "../avalanchego-conformance/rpcpb/vector.proto",
+                "../avalanchego-conformance/rpcpb/warp.proto",
+                "../avalanchego-conformance/rpcpb/watch.proto",
             ],
-            &["../avalanchego-conformance/rpcpb"],
+            &["../avalanchego-conformance"],
         )
         .unwrap();
 }
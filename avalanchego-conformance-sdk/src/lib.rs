@@ -10,23 +10,97 @@ pub mod rpcpb {
     tonic::include_proto!("rpcpb");
 }
 pub use rpcpb::{
-    key_service_client::KeyServiceClient, message_service_client::MessageServiceClient,
+    address_service_client::AddressServiceClient, bench_service_client::BenchServiceClient,
+    cert_service_client::CertServiceClient, codec_service_client::CodecServiceClient,
+    coreth_service_client::CorethServiceClient, formatting_service_client::FormattingServiceClient,
+    fuzz_service_client::FuzzServiceClient, genesis_service_client::GenesisServiceClient,
+    hash_service_client::HashServiceClient, id_service_client::IdServiceClient,
+    json_api_service_client::JsonApiServiceClient, key_service_client::KeyServiceClient,
+    message_service_client::MessageServiceClient, network_service_client::NetworkServiceClient,
     packer_service_client::PackerServiceClient, ping_service_client::PingServiceClient,
-    AcceptedFrontierRequest, AcceptedFrontierResponse, AcceptedRequest, AcceptedResponse,
-    AcceptedStateSummaryRequest, AcceptedStateSummaryResponse, AncestorsRequest, AncestorsResponse,
-    AppGossipRequest, AppGossipResponse, AppRequestRequest, AppRequestResponse, AppResponseRequest,
-    AppResponseResponse, BlsSignatureRequest, BlsSignatureResponse, BuildVertexRequest,
-    BuildVertexResponse, CertificateToNodeIdRequest, CertificateToNodeIdResponse, ChainAddresses,
-    ChitsRequest, ChitsResponse, GetAcceptedFrontierRequest, GetAcceptedFrontierResponse,
-    GetAcceptedRequest, GetAcceptedResponse, GetAcceptedStateSummaryRequest,
-    GetAcceptedStateSummaryResponse, GetAncestorsRequest, GetAncestorsResponse, GetRequest,
-    GetResponse, GetStateSummaryFrontierRequest, GetStateSummaryFrontierResponse, Peer,
-    PeerlistRequest, PeerlistResponse, PingRequest, PingResponse, PingServiceRequest,
-    PingServiceResponse, PongRequest, PongResponse, PullQueryRequest, PullQueryResponse,
-    PushQueryRequest, PushQueryResponse, PutRequest, PutResponse, Secp256k1Info,
-    Secp256k1InfoRequest, Secp256k1InfoResponse, Secp256k1RecoverHashPublicKeyRequest,
-    Secp256k1RecoverHashPublicKeyResponse, StateSummaryFrontierRequest,
-    StateSummaryFrontierResponse, VersionRequest, VersionResponse,
+    proof_service_client::ProofServiceClient, report_service_client::ReportServiceClient,
+    reward_service_client::RewardServiceClient, sorting_service_client::SortingServiceClient,
+    tx_service_client::TxServiceClient, vector_service_client::VectorServiceClient,
+    warp_service_client::WarpServiceClient, AcceptedFrontierRequest, AcceptedFrontierResponse,
+    AcceptedRequest, AcceptedResponse, AcceptedStateSummaryRequest, AcceptedStateSummaryResponse,
+    AddDelegatorTxRequest, AddDelegatorTxResponse, AddPermissionlessValidatorTxRequest,
+    AddPermissionlessValidatorTxResponse, AddSubnetValidatorTxRequest,
+    AddSubnetValidatorTxResponse, AddValidatorTxRequest, AddValidatorTxResponse, AddressErrorClass,
+    AddressMatrixEntry, AncestorsRequest, AncestorsResponse, ApiFieldBinding,
+    ApiFieldBindingStatus, AppGossipRequest, AppGossipResponse, AppRequestRequest,
+    AppRequestResponse, AppResponseRequest, AppResponseResponse, AvmBaseTxRequest,
+    AvmBaseTxResponse, AvmCreateAssetTxRequest, AvmCreateAssetTxResponse, AvmExportTxRequest,
+    AvmExportTxResponse, AvmImportTxRequest, AvmImportTxResponse, AvmOperation,
+    AvmOperationTxRequest, AvmOperationTxResponse, BaseTx, BatchVerifyItem, BatchVerifyRequest,
+    BatchVerifyResponse, BatchVerifyResult, BenchRequest, BenchResponse, BenchStats,
+    BlsAggregatePublicKeysRequest, BlsAggregatePublicKeysResponse, BlsAggregateSignaturesRequest,
+    BlsAggregateSignaturesResponse, BlsAggregateVerifyRequest, BlsAggregateVerifyResponse,
+    BlsKeyFromSeedRequest, BlsKeyFromSeedResponse, BlsPublicKeyEncodingRequest,
+    BlsPublicKeyEncodingResponse, BlsSignatureRequest, BlsSignatureResponse, BuildBlockRequest,
+    BuildBlockResponse, BuildGenesisRequest, BuildGenesisResponse, BuildPlatformBlockRequest,
+    BuildPlatformBlockResponse, BuildVertexRequest, BuildVertexResponse, CapabilitiesRequest,
+    CapabilitiesResponse, Cb58DecodeRequest, Cb58DecodeResponse, Cb58EncodeRequest,
+    Cb58EncodeResponse, Cb58ErrorClass, CertificateToNodeIdRequest, CertificateToNodeIdResponse,
+    ChainAddresses, ChainIdsRequest, ChainIdsResponse, ChangeProofRequest, ChangeProofResponse,
+    ChitsRequest, ChitsResponse, CodecInterfaceValue, CodecPrimitive, CodecRegisteredType,
+    CodecStructType, CodecType, CodecValue, CodecValues, CodecVersion, CreateChainTxRequest,
+    CreateChainTxResponse, CreateSubnetTxRequest, CreateSubnetTxResponse, Credential,
+    CredentialSigners, CurrentStaker, DelegationRewardRequest, DelegationRewardResponse,
+    DialPeerRequest, DivergentCase, EthKeyfileDecryptRequest, EthKeyfileDecryptResponse,
+    EthKeyfileEncryptRequest, EthKeyfileEncryptResponse, EthPersonalSignRequest,
+    EthPersonalSignResponse, EthTxRequest, EthTxResponse, EthTypedDataRequest,
+    EthTypedDataResponse, EvmInput, EvmOutput, ExportTxRequest, ExportTxResponse,
+    FormatAddressRequest, FormatAddressResponse, FuzzInput, FuzzRequest, FuzzResponse,
+    FuzzSessionReportRequest, FuzzSessionReportResponse, GenerateRequest, GenerateResponse,
+    GenesisAllocation, GenesisLockedAmount, GenesisStaker, GetAcceptedFrontierRequest,
+    GetAcceptedFrontierResponse, GetAcceptedRequest, GetAcceptedResponse,
+    GetAcceptedStateSummaryRequest, GetAcceptedStateSummaryResponse, GetAncestorsRequest,
+    GetAncestorsResponse, GetRequest, GetResponse, GetStateSummaryFrontierRequest,
+    GetStateSummaryFrontierResponse, HandshakeTimestampsRequest, HandshakeTimestampsResponse,
+    HashFunction, HashRange, HashRequest, HashResponse, IdBitsRequest, IdBitsResponse,
+    IdFromBytesRequest, IdFromBytesResponse, IdKind, IdParseRequest, IdParseResponse,
+    ImportTxRequest, ImportTxResponse, InitialState, KeystoreExportUserRequest,
+    KeystoreExportUserResponse, KeystoreImportUserRequest, KeystoreImportUserResponse,
+    LedgerPathRequest, LedgerPathResponse, LedgerSignRequest, LedgerSignResponse, Listener,
+    ListenersRequest, ListenersResponse, MerkleOp, MerkleRootRequest, MerkleRootResponse,
+    MethodReport, MinimizeStep, OutputOwners, PackPrimitivesRequest, PackPrimitivesResponse,
+    PackRequest, PackResponse, PackerByteSlices, PackerIp, PackerOp, ParseAddressRequest,
+    ParseAddressResponse, ParseApiRequestRequest, ParseApiRequestResponse, ParseGenesisRequest,
+    ParseGenesisResponse, ParseMessageRequest, ParseMessageResponse, ParseVertexRequest,
+    ParseVertexResponse, Peer, PeerDeviation, PeerEvent, PeerScriptMessage, PeerTranscriptRequest,
+    PeerTranscriptResponse, PeerlistRequest, PeerlistResponse, PingRequest, PingResponse,
+    PingServiceRequest, PingServiceResponse, PlatformBlockFork, PlatformBlockType,
+    PlatformGetCurrentValidatorsRequest, PlatformGetCurrentValidatorsResponse,
+    PlatformGetTxRequest, PlatformGetTxResponse, PlatformGetUtxosRequest, PlatformGetUtxosResponse,
+    PongRequest, PongResponse, ProofOfPossession, ProofOfPossessionVerifyRequest,
+    ProofOfPossessionVerifyResponse, PullQueryRequest, PullQueryResponse, PushQueryRequest,
+    PushQueryResponse, PutRequest, PutResponse, RangeProofRequest, RangeProofResponse,
+    ReadFrameRequest, ReadFrameResponse, ReportDivergenceRequest, ReportRequest, ReportResponse,
+    ReportVariantRequest, RewardConfig, Secp256k1DeriveKeysRequest, Secp256k1DeriveKeysResponse,
+    Secp256k1DerivedKey, Secp256k1Info, Secp256k1InfoRequest, Secp256k1InfoResponse,
+    Secp256k1KeyFromSeedRequest, Secp256k1KeyFromSeedResponse, Secp256k1KmsSignatureRequest,
+    Secp256k1KmsSignatureResponse, Secp256k1PublicKeyRequest, Secp256k1PublicKeyResponse,
+    Secp256k1RecoverHashPublicKeyRequest, Secp256k1RecoverHashPublicKeyResponse,
+    Secp256k1SignRequest, Secp256k1SignResponse, Secp256k1VerifyRequest, Secp256k1VerifyResponse,
+    SecpMintOperation, SecpOutput, SecpTransferOutput, ShortIdFromPublicKeyRequest,
+    ShortIdFromPublicKeyResponse, ShutdownRequest, ShutdownResponse, SignedIpRequest,
+    SignedIpResponse, SignedTxRequest, SignedTxResponse, SortAddressesRequest,
+    SortAddressesResponse, SortIdsRequest, SortIdsResponse, SortMismatch,
+    SortTransferableInputsRequest, SortTransferableInputsResponse, SortTransferableOutputsRequest,
+    SortTransferableOutputsResponse, StakerKind, StakerValidator, StakingCertificateRequest,
+    StakingCertificateResponse, StakingConfig, StakingKeyFromSeedRequest,
+    StakingKeyFromSeedResponse, StakingLimitsRequest, StakingLimitsResponse, StakingRewardRequest,
+    StakingRewardResponse, StartFuzzSessionRequest, StartFuzzSessionResponse, StartPeerRequest,
+    StartPeerResponse, StateSummaryFrontierRequest, StateSummaryFrontierResponse, StaticFeeConfig,
+    StaticFeeRequest, StaticFeeResponse, TransferableInput, TransferableInputsRequest,
+    TransferableInputsResponse, TransferableOutput, TransferableOutputsRequest,
+    TransferableOutputsResponse, TxChain, UnsignedExportTxRequest, UnsignedExportTxResponse,
+    UnsignedImportTxRequest, UnsignedImportTxResponse, UtxoId, UtxoRequest, UtxoResponse, Vector,
+    VectorSpec, VersionRequest, VersionResponse, Vertex, WarpAddressedCallPayloadRequest,
+    WarpAddressedCallPayloadResponse, WarpHashPayloadRequest, WarpHashPayloadResponse,
+    WarpSignedMessageRequest, WarpSignedMessageResponse, WarpUnsignedMessage,
+    WarpUnsignedMessageRequest, WarpUnsignedMessageResponse, WarpValidator,
+    WarpVerifySignatureRequest, WarpVerifySignatureResponse, WriteFrameRequest, WriteFrameResponse,
 };
 
 pub struct Client<T> {
@@ -40,8 +114,31 @@ pub struct GrpcClient<T> {
     pub key_service_client: Mutex<KeyServiceClient<T>>,
     pub packer_service_client: Mutex<PackerServiceClient<T>>,
     pub message_service_client: Mutex<MessageServiceClient<T>>,
+    pub tx_service_client: Mutex<TxServiceClient<T>>,
+    pub coreth_service_client: Mutex<CorethServiceClient<T>>,
+    pub codec_service_client: Mutex<CodecServiceClient<T>>,
+    pub warp_service_client: Mutex<WarpServiceClient<T>>,
+    pub sorting_service_client: Mutex<SortingServiceClient<T>>,
+    pub cert_service_client: Mutex<CertServiceClient<T>>,
+    pub address_service_client: Mutex<AddressServiceClient<T>>,
+    pub formatting_service_client: Mutex<FormattingServiceClient<T>>,
+    pub id_service_client: Mutex<IdServiceClient<T>>,
+    pub hash_service_client: Mutex<HashServiceClient<T>>,
+    pub genesis_service_client: Mutex<GenesisServiceClient<T>>,
+    pub report_service_client: Mutex<ReportServiceClient<T>>,
+    pub vector_service_client: Mutex<VectorServiceClient<T>>,
+    pub fuzz_service_client: Mutex<FuzzServiceClient<T>>,
+    pub network_service_client: Mutex<NetworkServiceClient<T>>,
+    pub bench_service_client: Mutex<BenchServiceClient<T>>,
+    pub proof_service_client: Mutex<ProofServiceClient<T>>,
+    pub json_api_service_client: Mutex<JsonApiServiceClient<T>>,
+    pub reward_service_client: Mutex<RewardServiceClient<T>>,
 }
 
+/// Maximum size of the messages sent to and received from the server, which
+/// matches the server default (see "--max-recv-msg-size").
+pub const MAX_MSG_SIZE: usize = 64 * 1024 * 1024;
+
 impl Client<Channel> {
     /// Creates a new avalanchego-conformance client.
     ///
@@ -54,12 +151,55 @@ impl Client<Channel> {
         let ping_client = PingServiceClient::connect(ep.clone()).await.unwrap();
         let key_client = KeyServiceClient::connect(ep.clone()).await.unwrap();
         let packer_client = PackerServiceClient::connect(ep.clone()).await.unwrap();
-        let message_client = MessageServiceClient::connect(ep.clone()).await.unwrap();
+        // large Ancestors containers and app payloads exceed the 4 MiB default
+        let message_client = MessageServiceClient::connect(ep.clone())
+            .await
+            .unwrap()
+            .max_decoding_message_size(MAX_MSG_SIZE)
+            .max_encoding_message_size(MAX_MSG_SIZE);
+        let tx_client = TxServiceClient::connect(ep.clone()).await.unwrap();
+        let coreth_client = CorethServiceClient::connect(ep.clone()).await.unwrap();
+        let codec_client = CodecServiceClient::connect(ep.clone()).await.unwrap();
+        let warp_client = WarpServiceClient::connect(ep.clone()).await.unwrap();
+        let sorting_client = SortingServiceClient::connect(ep.clone()).await.unwrap();
+        let cert_client = CertServiceClient::connect(ep.clone()).await.unwrap();
+        let address_client = AddressServiceClient::connect(ep.clone()).await.unwrap();
+        let formatting_client = FormattingServiceClient::connect(ep.clone()).await.unwrap();
+        let id_client = IdServiceClient::connect(ep.clone()).await.unwrap();
+        let hash_client = HashServiceClient::connect(ep.clone()).await.unwrap();
+        let genesis_client = GenesisServiceClient::connect(ep.clone()).await.unwrap();
+        let report_client = ReportServiceClient::connect(ep.clone()).await.unwrap();
+        let vector_client = VectorServiceClient::connect(ep.clone()).await.unwrap();
+        let fuzz_client = FuzzServiceClient::connect(ep.clone()).await.unwrap();
+        let network_client = NetworkServiceClient::connect(ep.clone()).await.unwrap();
+        let bench_client = BenchServiceClient::connect(ep.clone()).await.unwrap();
+        let proof_client = ProofServiceClient::connect(ep.clone()).await.unwrap();
+        let json_api_client = JsonApiServiceClient::connect(ep.clone()).await.unwrap();
+        let reward_client = RewardServiceClient::connect(ep.clone()).await.unwrap();
         let grpc_client = GrpcClient {
             ping_service_client: Mutex::new(ping_client),
             key_service_client: Mutex::new(key_client),
             packer_service_client: Mutex::new(packer_client),
             message_service_client: Mutex::new(message_client),
+            tx_service_client: Mutex::new(tx_client),
+            coreth_service_client: Mutex::new(coreth_client),
+            codec_service_client: Mutex::new(codec_client),
+            warp_service_client: Mutex::new(warp_client),
+            sorting_service_client: Mutex::new(sorting_client),
+            cert_service_client: Mutex::new(cert_client),
+            address_service_client: Mutex::new(address_client),
+            formatting_service_client: Mutex::new(formatting_client),
+            id_service_client: Mutex::new(id_client),
+            hash_service_client: Mutex::new(hash_client),
+            genesis_service_client: Mutex::new(genesis_client),
+            report_service_client: Mutex::new(report_client),
+            vector_service_client: Mutex::new(vector_client),
+            fuzz_service_client: Mutex::new(fuzz_client),
+            network_service_client: Mutex::new(network_client),
+            bench_service_client: Mutex::new(bench_client),
+            proof_service_client: Mutex::new(proof_client),
+            json_api_service_client: Mutex::new(json_api_client),
+            reward_service_client: Mutex::new(reward_client),
         };
         Self {
             rpc_endpoint: String::from(rpc_endpoint),
@@ -78,6 +218,39 @@ impl Client<Channel> {
         Ok(resp.into_inner())
     }
 
+    /// Reports what the avalanchego-conformance server can validate.
+    pub async fn capabilities(&self) -> io::Result<CapabilitiesResponse> {
+        let mut ping_client = self.grpc_client.ping_service_client.lock().await;
+        let req = tonic::Request::new(CapabilitiesRequest {});
+        let resp = ping_client
+            .capabilities(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed capabilities '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn shutdown(&self, req: ShutdownRequest) -> io::Result<ShutdownResponse> {
+        let mut cli = self.grpc_client.ping_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .shutdown(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed shutdown '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    /// Lists the gRPC listeners of the avalanchego-conformance process, one
+    /// per instance of a "server multi" process.
+    pub async fn listeners(&self) -> io::Result<ListenersResponse> {
+        let mut ping_client = self.grpc_client.ping_service_client.lock().await;
+        let req = tonic::Request::new(ListenersRequest {});
+        let resp = ping_client
+            .listeners(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed listeners '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
     pub async fn certificate_to_node_id(
         &self,
         req: CertificateToNodeIdRequest,
@@ -93,6 +266,16 @@ impl Client<Channel> {
         Ok(resp.into_inner())
     }
 
+    pub async fn signed_ip(&self, req: SignedIpRequest) -> io::Result<SignedIpResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .signed_ip(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed signed_ip '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
     pub async fn secp256k1_recover_hash_public_key(
         &self,
         req: Secp256k1RecoverHashPublicKeyRequest,
@@ -124,6 +307,136 @@ impl Client<Channel> {
         Ok(resp.into_inner())
     }
 
+    pub async fn secp256k1_sign(
+        &self,
+        req: Secp256k1SignRequest,
+    ) -> io::Result<Secp256k1SignResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .secp256k1_sign(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed secp256k1_sign '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn secp256k1_verify(
+        &self,
+        req: Secp256k1VerifyRequest,
+    ) -> io::Result<Secp256k1VerifyResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.secp256k1_verify(req).await.map_err(|e| {
+            Error::new(ErrorKind::Other, format!("failed secp256k1_verify '{}'", e))
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn secp256k1_public_key(
+        &self,
+        req: Secp256k1PublicKeyRequest,
+    ) -> io::Result<Secp256k1PublicKeyResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.secp256k1_public_key(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed secp256k1_public_key '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn secp256k1_derive_keys(
+        &self,
+        req: Secp256k1DeriveKeysRequest,
+    ) -> io::Result<Secp256k1DeriveKeysResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.secp256k1_derive_keys(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed secp256k1_derive_keys '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn secp256k1_kms_signature(
+        &self,
+        req: Secp256k1KmsSignatureRequest,
+    ) -> io::Result<Secp256k1KmsSignatureResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.secp256k1_kms_signature(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed secp256k1_kms_signature '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn keystore_import_user(
+        &self,
+        req: KeystoreImportUserRequest,
+    ) -> io::Result<KeystoreImportUserResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.keystore_import_user(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed keystore_import_user '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn keystore_export_user(
+        &self,
+        req: KeystoreExportUserRequest,
+    ) -> io::Result<KeystoreExportUserResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.keystore_export_user(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed keystore_export_user '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn eth_keyfile_decrypt(
+        &self,
+        req: EthKeyfileDecryptRequest,
+    ) -> io::Result<EthKeyfileDecryptResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.eth_keyfile_decrypt(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed eth_keyfile_decrypt '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn eth_keyfile_encrypt(
+        &self,
+        req: EthKeyfileEncryptRequest,
+    ) -> io::Result<EthKeyfileEncryptResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.eth_keyfile_encrypt(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed eth_keyfile_encrypt '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
     pub async fn bls_signature(
         &self,
         req: BlsSignatureRequest,
@@ -137,6 +450,131 @@ impl Client<Channel> {
         Ok(resp.into_inner())
     }
 
+    pub async fn bls_aggregate_public_keys(
+        &self,
+        req: BlsAggregatePublicKeysRequest,
+    ) -> io::Result<BlsAggregatePublicKeysResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.bls_aggregate_public_keys(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed bls_aggregate_public_keys '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn bls_aggregate_signatures(
+        &self,
+        req: BlsAggregateSignaturesRequest,
+    ) -> io::Result<BlsAggregateSignaturesResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.bls_aggregate_signatures(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed bls_aggregate_signatures '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn bls_aggregate_verify(
+        &self,
+        req: BlsAggregateVerifyRequest,
+    ) -> io::Result<BlsAggregateVerifyResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.bls_aggregate_verify(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed bls_aggregate_verify '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn bls_public_key_encoding(
+        &self,
+        req: BlsPublicKeyEncodingRequest,
+    ) -> io::Result<BlsPublicKeyEncodingResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.bls_public_key_encoding(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed bls_public_key_encoding '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn ledger_path(&self, req: LedgerPathRequest) -> io::Result<LedgerPathResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .ledger_path(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed ledger_path '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn ledger_sign(&self, req: LedgerSignRequest) -> io::Result<LedgerSignResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .ledger_sign(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed ledger_sign '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn secp256k1_key_from_seed(
+        &self,
+        req: Secp256k1KeyFromSeedRequest,
+    ) -> io::Result<Secp256k1KeyFromSeedResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.secp256k1_key_from_seed(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed secp256k1_key_from_seed '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn bls_key_from_seed(
+        &self,
+        req: BlsKeyFromSeedRequest,
+    ) -> io::Result<BlsKeyFromSeedResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.bls_key_from_seed(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed bls_key_from_seed '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn staking_key_from_seed(
+        &self,
+        req: StakingKeyFromSeedRequest,
+    ) -> io::Result<StakingKeyFromSeedResponse> {
+        let mut cli = self.grpc_client.key_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.staking_key_from_seed(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed staking_key_from_seed '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
     pub async fn build_vertex(&self, req: BuildVertexRequest) -> io::Result<BuildVertexResponse> {
         let mut cli = self.grpc_client.packer_service_client.lock().await;
         let req = tonic::Request::new(req);
@@ -147,6 +585,54 @@ impl Client<Channel> {
         Ok(resp.into_inner())
     }
 
+    pub async fn parse_vertex(&self, req: ParseVertexRequest) -> io::Result<ParseVertexResponse> {
+        let mut cli = self.grpc_client.packer_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .parse_vertex(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed parse_vertex '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn build_block(&self, req: BuildBlockRequest) -> io::Result<BuildBlockResponse> {
+        let mut cli = self.grpc_client.packer_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .build_block(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed build_block '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn build_platform_block(
+        &self,
+        req: BuildPlatformBlockRequest,
+    ) -> io::Result<BuildPlatformBlockResponse> {
+        let mut cli = self.grpc_client.packer_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.build_platform_block(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed build_platform_block '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn pack_primitives(
+        &self,
+        req: PackPrimitivesRequest,
+    ) -> io::Result<PackPrimitivesResponse> {
+        let mut cli = self.grpc_client.packer_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .pack_primitives(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed pack_primitives '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
     pub async fn accepted_frontier(
         &self,
         req: AcceptedFrontierRequest,
@@ -399,6 +885,916 @@ impl Client<Channel> {
             .map_err(|e| Error::new(ErrorKind::Other, format!("failed version '{}'", e)))?;
         Ok(resp.into_inner())
     }
+
+    pub async fn batch_verify(&self, req: BatchVerifyRequest) -> io::Result<BatchVerifyResponse> {
+        let mut cli = self.grpc_client.message_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .batch_verify(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed batch_verify '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn write_frame(&self, req: WriteFrameRequest) -> io::Result<WriteFrameResponse> {
+        let mut cli = self.grpc_client.message_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .write_frame(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed write_frame '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn read_frame(&self, req: ReadFrameRequest) -> io::Result<ReadFrameResponse> {
+        let mut cli = self.grpc_client.message_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .read_frame(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed read_frame '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn parse_message(
+        &self,
+        req: ParseMessageRequest,
+    ) -> io::Result<ParseMessageResponse> {
+        let mut cli = self.grpc_client.message_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .parse_message(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed parse_message '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn add_permissionless_validator_tx(
+        &self,
+        req: AddPermissionlessValidatorTxRequest,
+    ) -> io::Result<AddPermissionlessValidatorTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .add_permissionless_validator_tx(req)
+            .await
+            .map_err(|e| {
+                Error::new(
+                    ErrorKind::Other,
+                    format!("failed add_permissionless_validator_tx '{}'", e),
+                )
+            })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn add_validator_tx(
+        &self,
+        req: AddValidatorTxRequest,
+    ) -> io::Result<AddValidatorTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.add_validator_tx(req).await.map_err(|e| {
+            Error::new(ErrorKind::Other, format!("failed add_validator_tx '{}'", e))
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn add_delegator_tx(
+        &self,
+        req: AddDelegatorTxRequest,
+    ) -> io::Result<AddDelegatorTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.add_delegator_tx(req).await.map_err(|e| {
+            Error::new(ErrorKind::Other, format!("failed add_delegator_tx '{}'", e))
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn add_subnet_validator_tx(
+        &self,
+        req: AddSubnetValidatorTxRequest,
+    ) -> io::Result<AddSubnetValidatorTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.add_subnet_validator_tx(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed add_subnet_validator_tx '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn create_subnet_tx(
+        &self,
+        req: CreateSubnetTxRequest,
+    ) -> io::Result<CreateSubnetTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.create_subnet_tx(req).await.map_err(|e| {
+            Error::new(ErrorKind::Other, format!("failed create_subnet_tx '{}'", e))
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn create_chain_tx(
+        &self,
+        req: CreateChainTxRequest,
+    ) -> io::Result<CreateChainTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .create_chain_tx(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed create_chain_tx '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn chain_ids(&self, req: ChainIdsRequest) -> io::Result<ChainIdsResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .chain_ids(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed chain_ids '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn import_tx(&self, req: ImportTxRequest) -> io::Result<ImportTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .import_tx(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed import_tx '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn export_tx(&self, req: ExportTxRequest) -> io::Result<ExportTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .export_tx(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed export_tx '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn proof_of_possession_verify(
+        &self,
+        req: ProofOfPossessionVerifyRequest,
+    ) -> io::Result<ProofOfPossessionVerifyResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.proof_of_possession_verify(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed proof_of_possession_verify '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn avm_base_tx(&self, req: AvmBaseTxRequest) -> io::Result<AvmBaseTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .avm_base_tx(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed avm_base_tx '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn avm_create_asset_tx(
+        &self,
+        req: AvmCreateAssetTxRequest,
+    ) -> io::Result<AvmCreateAssetTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.avm_create_asset_tx(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed avm_create_asset_tx '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn avm_operation_tx(
+        &self,
+        req: AvmOperationTxRequest,
+    ) -> io::Result<AvmOperationTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.avm_operation_tx(req).await.map_err(|e| {
+            Error::new(ErrorKind::Other, format!("failed avm_operation_tx '{}'", e))
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn avm_import_tx(&self, req: AvmImportTxRequest) -> io::Result<AvmImportTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .avm_import_tx(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed avm_import_tx '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn avm_export_tx(&self, req: AvmExportTxRequest) -> io::Result<AvmExportTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .avm_export_tx(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed avm_export_tx '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn signed_tx(&self, req: SignedTxRequest) -> io::Result<SignedTxResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .signed_tx(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed signed_tx '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn utxo(&self, req: UtxoRequest) -> io::Result<UtxoResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .utxo(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed utxo '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn transferable_outputs(
+        &self,
+        req: TransferableOutputsRequest,
+    ) -> io::Result<TransferableOutputsResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.transferable_outputs(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed transferable_outputs '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn transferable_inputs(
+        &self,
+        req: TransferableInputsRequest,
+    ) -> io::Result<TransferableInputsResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.transferable_inputs(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed transferable_inputs '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn static_fee(&self, req: StaticFeeRequest) -> io::Result<StaticFeeResponse> {
+        let mut cli = self.grpc_client.tx_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .static_fee(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed static_fee '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn unsigned_import_tx(
+        &self,
+        req: UnsignedImportTxRequest,
+    ) -> io::Result<UnsignedImportTxResponse> {
+        let mut cli = self.grpc_client.coreth_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.unsigned_import_tx(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed unsigned_import_tx '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn unsigned_export_tx(
+        &self,
+        req: UnsignedExportTxRequest,
+    ) -> io::Result<UnsignedExportTxResponse> {
+        let mut cli = self.grpc_client.coreth_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.unsigned_export_tx(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed unsigned_export_tx '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn eth_tx(&self, req: EthTxRequest) -> io::Result<EthTxResponse> {
+        let mut cli = self.grpc_client.coreth_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .eth_tx(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed eth_tx '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn eth_personal_sign(
+        &self,
+        req: EthPersonalSignRequest,
+    ) -> io::Result<EthPersonalSignResponse> {
+        let mut cli = self.grpc_client.coreth_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.eth_personal_sign(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed eth_personal_sign '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn eth_typed_data(
+        &self,
+        req: EthTypedDataRequest,
+    ) -> io::Result<EthTypedDataResponse> {
+        let mut cli = self.grpc_client.coreth_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .eth_typed_data(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed eth_typed_data '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn pack(&self, req: PackRequest) -> io::Result<PackResponse> {
+        let mut cli = self.grpc_client.codec_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .pack(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed pack '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn warp_unsigned_message(
+        &self,
+        req: WarpUnsignedMessageRequest,
+    ) -> io::Result<WarpUnsignedMessageResponse> {
+        let mut cli = self.grpc_client.warp_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.warp_unsigned_message(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed warp_unsigned_message '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn warp_signed_message(
+        &self,
+        req: WarpSignedMessageRequest,
+    ) -> io::Result<WarpSignedMessageResponse> {
+        let mut cli = self.grpc_client.warp_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.warp_signed_message(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed warp_signed_message '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn warp_addressed_call_payload(
+        &self,
+        req: WarpAddressedCallPayloadRequest,
+    ) -> io::Result<WarpAddressedCallPayloadResponse> {
+        let mut cli = self.grpc_client.warp_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.warp_addressed_call_payload(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed warp_addressed_call_payload '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn warp_hash_payload(
+        &self,
+        req: WarpHashPayloadRequest,
+    ) -> io::Result<WarpHashPayloadResponse> {
+        let mut cli = self.grpc_client.warp_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.warp_hash_payload(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed warp_hash_payload '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn warp_verify_signature(
+        &self,
+        req: WarpVerifySignatureRequest,
+    ) -> io::Result<WarpVerifySignatureResponse> {
+        let mut cli = self.grpc_client.warp_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.warp_verify_signature(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed warp_verify_signature '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn sort_transferable_outputs(
+        &self,
+        req: SortTransferableOutputsRequest,
+    ) -> io::Result<SortTransferableOutputsResponse> {
+        let mut cli = self.grpc_client.sorting_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.sort_transferable_outputs(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed sort_transferable_outputs '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn sort_transferable_inputs(
+        &self,
+        req: SortTransferableInputsRequest,
+    ) -> io::Result<SortTransferableInputsResponse> {
+        let mut cli = self.grpc_client.sorting_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.sort_transferable_inputs(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed sort_transferable_inputs '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn sort_ids(&self, req: SortIdsRequest) -> io::Result<SortIdsResponse> {
+        let mut cli = self.grpc_client.sorting_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .sort_ids(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed sort_ids '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn sort_addresses(
+        &self,
+        req: SortAddressesRequest,
+    ) -> io::Result<SortAddressesResponse> {
+        let mut cli = self.grpc_client.sorting_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .sort_addresses(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed sort_addresses '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn staking_certificate(
+        &self,
+        req: StakingCertificateRequest,
+    ) -> io::Result<StakingCertificateResponse> {
+        let mut cli = self.grpc_client.cert_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.staking_certificate(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed staking_certificate '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn format_address(
+        &self,
+        req: FormatAddressRequest,
+    ) -> io::Result<FormatAddressResponse> {
+        let mut cli = self.grpc_client.address_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .format_address(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed format_address '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn parse_address(
+        &self,
+        req: ParseAddressRequest,
+    ) -> io::Result<ParseAddressResponse> {
+        let mut cli = self.grpc_client.address_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .parse_address(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed parse_address '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn cb58_encode(&self, req: Cb58EncodeRequest) -> io::Result<Cb58EncodeResponse> {
+        let mut cli = self.grpc_client.formatting_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .cb58_encode(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed cb58_encode '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn cb58_decode(&self, req: Cb58DecodeRequest) -> io::Result<Cb58DecodeResponse> {
+        let mut cli = self.grpc_client.formatting_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .cb58_decode(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed cb58_decode '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn id_from_bytes(&self, req: IdFromBytesRequest) -> io::Result<IdFromBytesResponse> {
+        let mut cli = self.grpc_client.id_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .id_from_bytes(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed id_from_bytes '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn id_parse(&self, req: IdParseRequest) -> io::Result<IdParseResponse> {
+        let mut cli = self.grpc_client.id_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .id_parse(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed id_parse '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn short_id_from_public_key(
+        &self,
+        req: ShortIdFromPublicKeyRequest,
+    ) -> io::Result<ShortIdFromPublicKeyResponse> {
+        let mut cli = self.grpc_client.id_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.short_id_from_public_key(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed short_id_from_public_key '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn id_bits(&self, req: IdBitsRequest) -> io::Result<IdBitsResponse> {
+        let mut cli = self.grpc_client.id_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .id_bits(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed id_bits '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn hash(&self, req: HashRequest) -> io::Result<HashResponse> {
+        let mut cli = self.grpc_client.hash_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .hash(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed hash '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn parse_genesis(
+        &self,
+        req: ParseGenesisRequest,
+    ) -> io::Result<ParseGenesisResponse> {
+        let mut cli = self.grpc_client.genesis_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .parse_genesis(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed parse_genesis '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn build_genesis(
+        &self,
+        req: BuildGenesisRequest,
+    ) -> io::Result<BuildGenesisResponse> {
+        let mut cli = self.grpc_client.genesis_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .build_genesis(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed build_genesis '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn report(&self, req: ReportRequest) -> io::Result<ReportResponse> {
+        let mut cli = self.grpc_client.report_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .report(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed report '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn generate(&self, req: GenerateRequest) -> io::Result<GenerateResponse> {
+        let mut cli = self.grpc_client.vector_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .generate(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed generate '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn fuzz(&self, req: FuzzRequest) -> io::Result<FuzzResponse> {
+        let mut cli = self.grpc_client.fuzz_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .fuzz(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed fuzz '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn start_fuzz_session(
+        &self,
+        req: StartFuzzSessionRequest,
+    ) -> io::Result<StartFuzzSessionResponse> {
+        let mut cli = self.grpc_client.fuzz_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.start_fuzz_session(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed start_fuzz_session '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn report_divergence(
+        &self,
+        req: ReportDivergenceRequest,
+    ) -> io::Result<MinimizeStep> {
+        let mut cli = self.grpc_client.fuzz_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.report_divergence(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed report_divergence '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn report_variant(&self, req: ReportVariantRequest) -> io::Result<MinimizeStep> {
+        let mut cli = self.grpc_client.fuzz_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .report_variant(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed report_variant '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn fuzz_session_report(
+        &self,
+        req: FuzzSessionReportRequest,
+    ) -> io::Result<FuzzSessionReportResponse> {
+        let mut cli = self.grpc_client.fuzz_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.fuzz_session_report(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed fuzz_session_report '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn start_peer(&self, req: StartPeerRequest) -> io::Result<StartPeerResponse> {
+        let mut cli = self.grpc_client.network_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .start_peer(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed start_peer '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn peer_transcript(
+        &self,
+        req: PeerTranscriptRequest,
+    ) -> io::Result<PeerTranscriptResponse> {
+        let mut cli = self.grpc_client.network_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .peer_transcript(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed peer_transcript '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn dial_peer(&self, req: DialPeerRequest) -> io::Result<PeerTranscriptResponse> {
+        let mut cli = self.grpc_client.network_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .dial_peer(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed dial_peer '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn handshake_timestamps(
+        &self,
+        req: HandshakeTimestampsRequest,
+    ) -> io::Result<HandshakeTimestampsResponse> {
+        let mut cli = self.grpc_client.network_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.handshake_timestamps(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed handshake_timestamps '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn bench(&self, req: BenchRequest) -> io::Result<BenchResponse> {
+        let mut cli = self.grpc_client.bench_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .bench(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed bench '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn merkle_root(&self, req: MerkleRootRequest) -> io::Result<MerkleRootResponse> {
+        let mut cli = self.grpc_client.proof_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .merkle_root(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed merkle_root '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn range_proof(&self, req: RangeProofRequest) -> io::Result<RangeProofResponse> {
+        let mut cli = self.grpc_client.proof_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .range_proof(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed range_proof '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn change_proof(&self, req: ChangeProofRequest) -> io::Result<ChangeProofResponse> {
+        let mut cli = self.grpc_client.proof_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .change_proof(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed change_proof '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn platform_get_tx(
+        &self,
+        req: PlatformGetTxRequest,
+    ) -> io::Result<PlatformGetTxResponse> {
+        let mut cli = self.grpc_client.json_api_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .platform_get_tx(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed platform_get_tx '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn platform_get_utxos(
+        &self,
+        req: PlatformGetUtxosRequest,
+    ) -> io::Result<PlatformGetUtxosResponse> {
+        let mut cli = self.grpc_client.json_api_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.platform_get_utxos(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed platform_get_utxos '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn platform_get_current_validators(
+        &self,
+        req: PlatformGetCurrentValidatorsRequest,
+    ) -> io::Result<PlatformGetCurrentValidatorsResponse> {
+        let mut cli = self.grpc_client.json_api_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .platform_get_current_validators(req)
+            .await
+            .map_err(|e| {
+                Error::new(
+                    ErrorKind::Other,
+                    format!("failed platform_get_current_validators '{}'", e),
+                )
+            })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn parse_api_request(
+        &self,
+        req: ParseApiRequestRequest,
+    ) -> io::Result<ParseApiRequestResponse> {
+        let mut cli = self.grpc_client.json_api_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.parse_api_request(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed parse_api_request '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn staking_reward(
+        &self,
+        req: StakingRewardRequest,
+    ) -> io::Result<StakingRewardResponse> {
+        let mut cli = self.grpc_client.reward_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .staking_reward(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed staking_reward '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn delegation_reward(
+        &self,
+        req: DelegationRewardRequest,
+    ) -> io::Result<DelegationRewardResponse> {
+        let mut cli = self.grpc_client.reward_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli.delegation_reward(req).await.map_err(|e| {
+            Error::new(
+                ErrorKind::Other,
+                format!("failed delegation_reward '{}'", e),
+            )
+        })?;
+        Ok(resp.into_inner())
+    }
+
+    pub async fn staking_limits(
+        &self,
+        req: StakingLimitsRequest,
+    ) -> io::Result<StakingLimitsResponse> {
+        let mut cli = self.grpc_client.reward_service_client.lock().await;
+        let req = tonic::Request::new(req);
+        let resp = cli
+            .staking_limits(req)
+            .await
+            .map_err(|e| Error::new(ErrorKind::Other, format!("failed staking_limits '{}'", e)))?;
+        Ok(resp.into_inner())
+    }
 }
 
 pub struct CertificateToNodeIdArgs {
@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultRetryBackoff is the default Config.RetryBackoff.
+const DefaultRetryBackoff = 100 * time.Millisecond
+
+// WithTimeout bounds a unary call, including its retries, overriding
+// Config.CallTimeout. Zero means no timeout.
+func WithTimeout(d time.Duration) grpc.CallOption {
+	return timeoutOption{timeout: d}
+}
+
+// WithRetries sets the number of times a unary call failing with
+// UNAVAILABLE (e.g., while the server restarts) is retried, overriding
+// Config.Retries.
+func WithRetries(n int) grpc.CallOption {
+	return retriesOption{retries: n}
+}
+
+type timeoutOption struct {
+	grpc.EmptyCallOption
+	timeout time.Duration
+}
+
+type retriesOption struct {
+	grpc.EmptyCallOption
+	retries int
+}
+
+// unaryInterceptor applies the timeout and retries of the call options, or
+// of the config by default.
+func (c *client) unaryInterceptor(
+	ctx context.Context,
+	method string,
+	req interface{},
+	reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	timeout, retries := c.cfg.CallTimeout, c.cfg.Retries
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case timeoutOption:
+			timeout = o.timeout
+		case retriesOption:
+			retries = o.retries
+		}
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	backoff := c.cfg.RetryBackoff
+	if backoff == 0 {
+		backoff = DefaultRetryBackoff
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil || attempt >= retries || status.Code(err) != codes.Unavailable {
+			return err
+		}
+		zap.L().Warn("retrying call",
+			zap.String("method", method),
+			zap.Int("attempt", attempt+1),
+			zap.Error(err),
+		)
+		t := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C:
+		}
+	}
+}
@@ -6,6 +6,11 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
 	"sync"
 	"time"
 
@@ -14,17 +19,64 @@ import (
 	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
+var ErrInvalidTLSConfig = errors.New("invalid TLS config")
+
+// DefaultMaxMsgSize is the default Config.MaxMsgSize, which matches the
+// server default.
+const DefaultMaxMsgSize = 64 * 1024 * 1024
+
 type Config struct {
-	LogLevel    string
+	LogLevel string
+	// Endpoint is the server address (e.g., "localhost:9090"), or its unix
+	// socket (e.g., "unix:///tmp/conformance.sock").
 	Endpoint    string
 	DialTimeout time.Duration
+
+	// TLSCAFile dials the server over TLS, verifying its certificate
+	// against the PEM CA certificate. Empty means plaintext.
+	TLSCAFile string
+	// TLSCertFile and TLSKeyFile are the client certificate presented to
+	// servers requiring mutual TLS.
+	TLSCertFile string
+	TLSKeyFile  string
+	// TLSServerName overrides the server name the certificate is verified
+	// against, the endpoint host by default.
+	TLSServerName string
+
+	// AuthToken is sent as a bearer token with every request, for servers
+	// started with "--auth-token".
+	AuthToken string
+
+	// CallTimeout bounds every unary call, including its retries, unless
+	// overridden with WithTimeout. Zero means no timeout.
+	CallTimeout time.Duration
+	// Retries is the number of times unary calls failing with UNAVAILABLE
+	// are retried, unless overridden with WithRetries, waiting RetryBackoff
+	// in between. Zero RetryBackoff means DefaultRetryBackoff.
+	Retries      int
+	RetryBackoff time.Duration
+
+	// MaxMsgSize bounds the size of the messages sent to and received from
+	// the server. Zero means DefaultMaxMsgSize.
+	MaxMsgSize int
 }
 
 type Client interface {
+	Services
+
 	PingService(ctx context.Context) (*rpcpb.PingServiceResponse, error)
+	// Generate returns the response of the verification request without
+	// comparison, with its canonical bytes (see VectorService).
+	Generate(ctx context.Context, req proto.Message) (proto.Message, error)
+	// Invoke calls the unary method of the request type, with its
+	// comparison.
+	Invoke(ctx context.Context, req proto.Message, opts ...grpc.CallOption) (proto.Message, error)
 	Close() error
 }
 
@@ -33,7 +85,8 @@ type client struct {
 
 	conn *grpc.ClientConn
 
-	pingc rpcpb.PingServiceClient
+	pingc   rpcpb.PingServiceClient
+	vectorc rpcpb.VectorServiceClient
 
 	closed    chan struct{}
 	closeOnce sync.Once
@@ -48,25 +101,86 @@ func New(cfg Config) (Client, error) {
 	}
 	_ = zap.ReplaceGlobals(logger)
 
+	creds, err := newCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	color.Outf("{{blue}}dialing endpoint %q{{/}}\n", cfg.Endpoint)
+	maxMsgSize := cfg.MaxMsgSize
+	if maxMsgSize == 0 {
+		maxMsgSize = DefaultMaxMsgSize
+	}
+	c := &client{
+		cfg:    cfg,
+		closed: make(chan struct{}),
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.DialTimeout)
-	conn, err := grpc.DialContext(
-		ctx,
-		cfg.Endpoint,
+	opts := []grpc.DialOption{
 		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(c.unaryInterceptor),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxMsgSize),
+			grpc.MaxCallSendMsgSize(maxMsgSize),
+		),
+	}
+	if cfg.AuthToken != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(cfg.AuthToken)))
+	}
+	conn, err := grpc.DialContext(ctx, cfg.Endpoint, opts...)
 	cancel()
 	if err != nil {
 		return nil, err
 	}
 
-	return &client{
-		cfg:    cfg,
-		conn:   conn,
-		pingc:  rpcpb.NewPingServiceClient(conn),
-		closed: make(chan struct{}),
-	}, nil
+	c.conn = conn
+	c.pingc = rpcpb.NewPingServiceClient(conn)
+	c.vectorc = rpcpb.NewVectorServiceClient(conn)
+	return c, nil
+}
+
+// newCredentials returns the transport credentials to dial the server with.
+func newCredentials(cfg Config) (credentials.TransportCredentials, error) {
+	if cfg.TLSCAFile == "" {
+		if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" || cfg.TLSServerName != "" {
+			return nil, fmt.Errorf("%w: TLS options set without a CA", ErrInvalidTLSConfig)
+		}
+		return insecure.NewCredentials(), nil
+	}
+	pem, err := os.ReadFile(cfg.TLSCAFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("%w: no certificates in %q", ErrInvalidTLSConfig, cfg.TLSCAFile)
+	}
+	tlsCfg := &tls.Config{
+		RootCAs:    pool,
+		ServerName: cfg.TLSServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// bearerToken sends the auth token with every request. It does not require
+// TLS, so that it can also be used on trusted networks without it.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (bearerToken) RequireTransportSecurity() bool {
+	return false
 }
 
 func (c *client) PingService(ctx context.Context) (*rpcpb.PingServiceResponse, error) {
@@ -77,6 +191,18 @@ func (c *client) PingService(ctx context.Context) (*rpcpb.PingServiceResponse, e
 	return c.pingc.PingService(ctx, &rpcpb.PingServiceRequest{})
 }
 
+func (c *client) Generate(ctx context.Context, req proto.Message) (proto.Message, error) {
+	anyReq, err := anypb.New(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.vectorc.Generate(ctx, &rpcpb.GenerateRequest{Request: anyReq})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response.UnmarshalNew()
+}
+
 func (c *client) Close() error {
 	c.closeOnce.Do(func() {
 		close(c.closed)
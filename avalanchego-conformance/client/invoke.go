@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+var ErrUnknownRequest = errors.New("no unary method for request")
+
+var (
+	unaryMethodsOnce sync.Once
+	unaryMethods     map[protoreflect.FullName]protoreflect.MethodDescriptor
+)
+
+// Method returns the unary method of the server taking the request type.
+func Method(req proto.Message) (protoreflect.MethodDescriptor, error) {
+	unaryMethodsOnce.Do(func() {
+		unaryMethods = make(map[protoreflect.FullName]protoreflect.MethodDescriptor)
+		protoregistry.GlobalFiles.RangeFilesByPackage("rpcpb", func(fd protoreflect.FileDescriptor) bool {
+			services := fd.Services()
+			for i := 0; i < services.Len(); i++ {
+				methods := services.Get(i).Methods()
+				for j := 0; j < methods.Len(); j++ {
+					md := methods.Get(j)
+					if !md.IsStreamingClient() && !md.IsStreamingServer() {
+						unaryMethods[md.Input().FullName()] = md
+					}
+				}
+			}
+			return true
+		})
+	})
+
+	name := req.ProtoReflect().Descriptor().FullName()
+	md, ok := unaryMethods[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownRequest, name)
+	}
+	return md, nil
+}
+
+// Invoke calls the unary method of the request type (see Method), so that
+// requests can be sent without knowing their service (e.g., when read from
+// files).
+func (c *client) Invoke(ctx context.Context, req proto.Message, opts ...grpc.CallOption) (proto.Message, error) {
+	md, err := Method(req)
+	if err != nil {
+		return nil, err
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	resp := mt.New().Interface()
+	method := fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name())
+	if err := c.conn.Invoke(ctx, method, req, resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
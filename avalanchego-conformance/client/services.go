@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package client
+
+import (
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Services returns the typed clients of every service of the server, whose
+// unary calls accept the call options of this package (e.g.,
+//
+//	resp, err := cli.Key().Secp256K1Info(ctx, req, client.WithTimeout(time.Second), client.WithRetries(3))
+//
+// ). Services not served (see "--enabled-services") fail with
+// UNIMPLEMENTED.
+type Services interface {
+	Address() rpcpb.AddressServiceClient
+	Bench() rpcpb.BenchServiceClient
+	Cert() rpcpb.CertServiceClient
+	Codec() rpcpb.CodecServiceClient
+	Config() rpcpb.ConfigServiceClient
+	Coreth() rpcpb.CorethServiceClient
+	Formatting() rpcpb.FormattingServiceClient
+	Fuzz() rpcpb.FuzzServiceClient
+	Genesis() rpcpb.GenesisServiceClient
+	Hash() rpcpb.HashServiceClient
+	Id() rpcpb.IdServiceClient
+	Json() rpcpb.JsonServiceClient
+	JsonApi() rpcpb.JsonApiServiceClient
+	Key() rpcpb.KeyServiceClient
+	Message() rpcpb.MessageServiceClient
+	Network() rpcpb.NetworkServiceClient
+	Packer() rpcpb.PackerServiceClient
+	Ping() rpcpb.PingServiceClient
+	Proof() rpcpb.ProofServiceClient
+	Report() rpcpb.ReportServiceClient
+	Reward() rpcpb.RewardServiceClient
+	Sorting() rpcpb.SortingServiceClient
+	Stress() rpcpb.StressServiceClient
+	Tx() rpcpb.TxServiceClient
+	Validators() rpcpb.ValidatorsServiceClient
+	Vector() rpcpb.VectorServiceClient
+	Warp() rpcpb.WarpServiceClient
+	Watch() rpcpb.WatchServiceClient
+	// Health is the standard "grpc.health.v1.Health" service.
+	Health() grpc_health_v1.HealthClient
+}
+
+func (c *client) Address() rpcpb.AddressServiceClient {
+	return rpcpb.NewAddressServiceClient(c.conn)
+}
+
+func (c *client) Bench() rpcpb.BenchServiceClient {
+	return rpcpb.NewBenchServiceClient(c.conn)
+}
+
+func (c *client) Cert() rpcpb.CertServiceClient {
+	return rpcpb.NewCertServiceClient(c.conn)
+}
+
+func (c *client) Codec() rpcpb.CodecServiceClient {
+	return rpcpb.NewCodecServiceClient(c.conn)
+}
+
+func (c *client) Config() rpcpb.ConfigServiceClient {
+	return rpcpb.NewConfigServiceClient(c.conn)
+}
+
+func (c *client) Coreth() rpcpb.CorethServiceClient {
+	return rpcpb.NewCorethServiceClient(c.conn)
+}
+
+func (c *client) Formatting() rpcpb.FormattingServiceClient {
+	return rpcpb.NewFormattingServiceClient(c.conn)
+}
+
+func (c *client) Fuzz() rpcpb.FuzzServiceClient {
+	return rpcpb.NewFuzzServiceClient(c.conn)
+}
+
+func (c *client) Genesis() rpcpb.GenesisServiceClient {
+	return rpcpb.NewGenesisServiceClient(c.conn)
+}
+
+func (c *client) Hash() rpcpb.HashServiceClient {
+	return rpcpb.NewHashServiceClient(c.conn)
+}
+
+func (c *client) Id() rpcpb.IdServiceClient {
+	return rpcpb.NewIdServiceClient(c.conn)
+}
+
+func (c *client) Json() rpcpb.JsonServiceClient {
+	return rpcpb.NewJsonServiceClient(c.conn)
+}
+
+func (c *client) JsonApi() rpcpb.JsonApiServiceClient {
+	return rpcpb.NewJsonApiServiceClient(c.conn)
+}
+
+func (c *client) Key() rpcpb.KeyServiceClient {
+	return rpcpb.NewKeyServiceClient(c.conn)
+}
+
+func (c *client) Message() rpcpb.MessageServiceClient {
+	return rpcpb.NewMessageServiceClient(c.conn)
+}
+
+func (c *client) Network() rpcpb.NetworkServiceClient {
+	return rpcpb.NewNetworkServiceClient(c.conn)
+}
+
+func (c *client) Packer() rpcpb.PackerServiceClient {
+	return rpcpb.NewPackerServiceClient(c.conn)
+}
+
+func (c *client) Ping() rpcpb.PingServiceClient {
+	return rpcpb.NewPingServiceClient(c.conn)
+}
+
+func (c *client) Proof() rpcpb.ProofServiceClient {
+	return rpcpb.NewProofServiceClient(c.conn)
+}
+
+func (c *client) Report() rpcpb.ReportServiceClient {
+	return rpcpb.NewReportServiceClient(c.conn)
+}
+
+func (c *client) Reward() rpcpb.RewardServiceClient {
+	return rpcpb.NewRewardServiceClient(c.conn)
+}
+
+func (c *client) Sorting() rpcpb.SortingServiceClient {
+	return rpcpb.NewSortingServiceClient(c.conn)
+}
+
+func (c *client) Stress() rpcpb.StressServiceClient {
+	return rpcpb.NewStressServiceClient(c.conn)
+}
+
+func (c *client) Tx() rpcpb.TxServiceClient {
+	return rpcpb.NewTxServiceClient(c.conn)
+}
+
+func (c *client) Validators() rpcpb.ValidatorsServiceClient {
+	return rpcpb.NewValidatorsServiceClient(c.conn)
+}
+
+func (c *client) Vector() rpcpb.VectorServiceClient {
+	return rpcpb.NewVectorServiceClient(c.conn)
+}
+
+func (c *client) Warp() rpcpb.WarpServiceClient {
+	return rpcpb.NewWarpServiceClient(c.conn)
+}
+
+func (c *client) Watch() rpcpb.WatchServiceClient {
+	return rpcpb.NewWatchServiceClient(c.conn)
+}
+
+func (c *client) Health() grpc_health_v1.HealthClient {
+	return grpc_health_v1.NewHealthClient(c.conn)
+}
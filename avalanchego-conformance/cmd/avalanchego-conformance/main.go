@@ -8,6 +8,8 @@ import (
 	"os"
 
 	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/cmd/avalanchego-conformance/server"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/cmd/avalanchego-conformance/vectors"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/summary"
 	"github.com/spf13/cobra"
 )
 
@@ -24,13 +26,16 @@ func init() {
 func init() {
 	rootCmd.AddCommand(
 		server.NewCommand(),
+		vectors.NewCommand(),
+		vectors.NewReplayCommand(),
+		vectors.NewVerifyCommand(),
 	)
 }
 
 func main() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "avalanchego-conformance failed %v\n", err)
-		os.Exit(1)
+		os.Exit(summary.ExitCode(err))
 	}
-	os.Exit(0)
+	os.Exit(summary.ExitPass)
 }
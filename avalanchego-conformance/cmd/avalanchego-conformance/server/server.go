@@ -5,6 +5,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
@@ -22,10 +23,41 @@ func init() {
 }
 
 var (
-	logLevel    string
-	port        uint16
-	gwPort      uint16
-	dialTimeout time.Duration
+	logLevel     string
+	port         uint16
+	gwPort       uint16
+	dialTimeout  time.Duration
+	fakeTime     int64
+	metricsPort  uint16
+	secpCache    int
+	quotas       map[string]int64
+	enabledSvcs  []string
+	disabledSvcs []string
+	noReflection bool
+	tlsCert      string
+	tlsKey       string
+	tlsCA        string
+	grpcSocket   string
+	portFile     string
+	drainTimeout time.Duration
+	shutdownTok  string
+	authToken    string
+	slowReqs     time.Duration
+	logRequests  bool
+	lenientInput bool
+	reportDir    string
+
+	maxRecvMsgSize   int
+	maxSendMsgSize   int
+	maxStreams       uint32
+	keepaliveTime    time.Duration
+	keepaliveTimeout time.Duration
+	keepaliveMinTime time.Duration
+	keepaliveNoRPCs  bool
+
+	maxMsgTimeout time.Duration
+
+	instances int
 )
 
 func NewCommand() *cobra.Command {
@@ -36,14 +68,85 @@ func NewCommand() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringVar(&logLevel, "log-level", logutil.DefaultLogLevel, "log level")
-	cmd.PersistentFlags().Uint16Var(&port, "port", 9090, "server port")
-	cmd.PersistentFlags().Uint16Var(&gwPort, "grpc-gateway-port", 9091, "grpc-gateway server port")
-	cmd.PersistentFlags().DurationVar(&dialTimeout, "dial-timeout", 10*time.Second, "server dial timeout")
+	cmd.PersistentFlags().Uint16Var(&port, "port", 9090, "server port (0 for an ephemeral port)")
+	cmd.PersistentFlags().Uint16Var(&gwPort, "grpc-gateway-port", 9091, "grpc-gateway server port (0 to disable)")
+	cmd.PersistentFlags().StringVar(&portFile, "port-file", "", "file to write the served ports to as JSON once ready (also printed to stdout)")
+	cmd.PersistentFlags().StringVar(&grpcSocket, "grpc-socket", "", "unix socket path to serve gRPC on instead of --port")
+	cmd.PersistentFlags().DurationVar(&dialTimeout, "dial-timeout", server.DefaultDialTimeout, "server dial timeout")
+	cmd.PersistentFlags().DurationVar(&drainTimeout, "drain-timeout", server.DefaultDrainTimeout, "maximum time to wait for in-flight requests on shutdown")
+	cmd.PersistentFlags().StringVar(&shutdownTok, "shutdown-token", "", "token enabling the Shutdown RPC for requests carrying it (disabled if empty)")
+	cmd.PersistentFlags().Int64Var(&fakeTime, "fake-time-unix", 0, "fixed server clock in unix seconds for timestamp-sensitive checks (0 to use the wall clock)")
+	cmd.PersistentFlags().IntVar(&secpCache, "secp-cache-size", server.DefaultSecpCacheSize, "size of the secp256k1 public key recovery cache")
+	cmd.PersistentFlags().Uint16Var(&metricsPort, "metrics-port", 0, "prometheus metrics port (0 to disable)")
+	cmd.PersistentFlags().StringToInt64Var(&quotas, "service-quota-bytes", nil, "maximum total request bytes per run for a service (e.g., PackerService=104857600)")
+
+	cmd.PersistentFlags().StringSliceVar(&enabledSvcs, "enabled-services", nil, "services to serve (e.g., KeyService,PackerService), all if empty; PingService is always served")
+	cmd.PersistentFlags().StringSliceVar(&disabledSvcs, "disabled-services", nil, "services not to serve (e.g., StressService)")
+	cmd.PersistentFlags().BoolVar(&noReflection, "disable-reflection", false, "disable the gRPC server reflection service (used by grpcurl and evans)")
+	cmd.PersistentFlags().StringVar(&tlsCert, "tls-cert", "", "PEM certificate file to serve the gRPC server and gateway over TLS")
+	cmd.PersistentFlags().StringVar(&tlsKey, "tls-key", "", "PEM private key file of --tls-cert")
+	cmd.PersistentFlags().StringVar(&tlsCA, "tls-ca", "", "PEM CA certificate file to require and verify client certificates against (mutual TLS)")
+	cmd.PersistentFlags().DurationVar(&slowReqs, "slow-request-threshold", 0, "log requests taking at least this long as warnings (0 to disable)")
+	cmd.PersistentFlags().BoolVar(&logRequests, "log-requests", false, "log every request and its outcome at the info level")
+	cmd.PersistentFlags().BoolVar(&lenientInput, "lenient-input", false, "zero-pad wrong-length IDs, truncate out-of-range ports and default unset output owners instead of rejecting them")
+	cmd.PersistentFlags().StringVar(&reportDir, "report-dir", "", "directory to append every conformance check to (report.jsonl)")
+	cmd.PersistentFlags().StringVar(&authToken, "auth-token", "", "bearer token requests must carry in the \"authorization\" metadata (disabled if empty)")
+	cmd.PersistentFlags().DurationVar(&maxMsgTimeout, "max-message-timeout", server.DefaultMaxMessageTimeout, "maximum deadline of the p2p messages checked and parsed")
+
+	cmd.PersistentFlags().IntVar(&maxRecvMsgSize, "max-recv-msg-size", server.DefaultMaxMsgSize, "maximum size in bytes of the messages the server receives")
+	cmd.PersistentFlags().IntVar(&maxSendMsgSize, "max-send-msg-size", server.DefaultMaxMsgSize, "maximum size in bytes of the messages the server sends")
+	cmd.PersistentFlags().Uint32Var(&maxStreams, "max-concurrent-streams", 0, "maximum concurrent streams (in-flight requests) per connection (0 for unlimited)")
+	cmd.PersistentFlags().DurationVar(&keepaliveTime, "keepalive-time", 0, "idle time after which the server pings clients (0 for the gRPC default of 2h)")
+	cmd.PersistentFlags().DurationVar(&keepaliveTimeout, "keepalive-timeout", 0, "time to wait for a keepalive ping ack before closing the connection (0 for the gRPC default of 20s)")
+	cmd.PersistentFlags().DurationVar(&keepaliveMinTime, "keepalive-min-time", 0, "minimum interval clients may send keepalive pings at (0 for the gRPC default of 5m)")
+	cmd.PersistentFlags().BoolVar(&keepaliveNoRPCs, "keepalive-permit-without-stream", false, "allow client keepalive pings without in-flight requests")
 
+	cmd.AddCommand(newMultiCommand())
+	return cmd
+}
+
+func newMultiCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi [options]",
+		Short: "Start isolated servers on ephemeral ports in one process.",
+		RunE:  multiFunc,
+	}
+	cmd.Flags().IntVar(&instances, "instances", 1, "number of isolated gRPC servers to start")
 	return cmd
 }
 
 func serverFunc(cmd *cobra.Command, args []string) (err error) {
+	cfg, err := newConfig()
+	if err != nil {
+		return err
+	}
+	s, err := server.New(cfg)
+	if err != nil {
+		return err
+	}
+	return run(s)
+}
+
+func multiFunc(cmd *cobra.Command, args []string) (err error) {
+	for _, flag := range []string{"port", "grpc-gateway-port", "grpc-socket", "metrics-port"} {
+		if cmd.Flags().Changed(flag) {
+			return fmt.Errorf("--%s is not supported with multiple instances, which serve gRPC on ephemeral ports", flag)
+		}
+	}
+	cfg, err := newConfig()
+	if err != nil {
+		return err
+	}
+	cfg.Port = 0
+	cfg.GwPort = 0
+	s, err := server.NewMulti(cfg, instances)
+	if err != nil {
+		return err
+	}
+	return run(s)
+}
+
+func newConfig() (server.Config, error) {
 	lcfg := logutil.GetDefaultZapLoggerConfig()
 	lcfg.Level = zap.NewAtomicLevelAt(logutil.ConvertToZapLevel(logLevel))
 	logger, err := lcfg.Build()
@@ -52,15 +155,61 @@ func serverFunc(cmd *cobra.Command, args []string) (err error) {
 	}
 	_ = zap.ReplaceGlobals(logger)
 
-	s, err := server.New(server.Config{
+	cfg := server.Config{
 		Port:        port,
 		GwPort:      gwPort,
 		DialTimeout: dialTimeout,
-	})
-	if err != nil {
-		return err
+		MetricsPort: metricsPort,
+		GRPCSocket:  grpcSocket,
+		PortsOutput: os.Stdout,
+		PortFile:    portFile,
+
+		DrainTimeout:  drainTimeout,
+		ShutdownToken: shutdownTok,
+
+		SecpCacheSize:        secpCache,
+		SlowRequestThreshold: slowReqs,
+		ReportDir:            reportDir,
+		LogRequests:          logRequests,
+		LenientInput:         lenientInput,
+
+		EnabledServices:   enabledSvcs,
+		DisabledServices:  disabledSvcs,
+		DisableReflection: noReflection,
+
+		TLSCertFile: tlsCert,
+		TLSKeyFile:  tlsKey,
+		TLSCAFile:   tlsCA,
+
+		AuthToken: authToken,
+
+		MaxMessageTimeout: maxMsgTimeout,
+
+		MaxRecvMsgSize:               maxRecvMsgSize,
+		MaxSendMsgSize:               maxSendMsgSize,
+		MaxConcurrentStreams:         maxStreams,
+		KeepaliveTime:                keepaliveTime,
+		KeepaliveTimeout:             keepaliveTimeout,
+		KeepaliveMinTime:             keepaliveMinTime,
+		KeepalivePermitWithoutStream: keepaliveNoRPCs,
+	}
+	if fakeTime != 0 {
+		cfg.FakeTime = time.Unix(fakeTime, 0)
 	}
+	if len(quotas) > 0 {
+		cfg.ServiceQuotas = make(map[string]uint64, len(quotas))
+		for svc, quota := range quotas {
+			if quota < 0 {
+				return server.Config{}, fmt.Errorf("invalid quota %d for %s", quota, svc)
+			}
+			cfg.ServiceQuotas[svc] = uint64(quota)
+		}
+	}
+	return cfg, nil
+}
 
+// run runs the server until it stops or a signal is received.
+func run(s server.Server) (err error) {
 	rootCtx, rootCancel := context.WithCancel(context.Background())
 	errc := make(chan error)
 	go func() {
@@ -0,0 +1,192 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vectors
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/client"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/color"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/summary"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	corpusDir   string
+	summaryFile string
+)
+
+// NewReplayCommand returns the command replaying a corpus of vectors, as
+// written by "vectors generate".
+func NewReplayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [options]",
+		Short: "Re-verify a corpus of golden test vectors against the linked avalanchego version.",
+		Long: `Re-verify a corpus of golden test vectors (as written by "vectors generate")
+against the avalanchego version linked into the server, to catch upstream
+serialization changes. Every "*.json" vector under the corpus directory is
+executed again, and fails if its response differs from the recorded one.`,
+		Args: cobra.NoArgs,
+		RunE: replayFunc,
+	}
+	cmd.Flags().StringVar(&corpusDir, "corpus", "", "directory of the vectors to replay")
+	cmd.Flags().StringVar(&summaryFile, "summary-file", "", "file to write the JSON compatibility report to")
+	addServerFlags(cmd)
+	_ = cmd.MarkFlagRequired("corpus")
+	return cmd
+}
+
+func replayFunc(cmd *cobra.Command, args []string) error {
+	s := summary.New("replay")
+	err := replay(cmd.Context(), s)
+	err = s.Finish(err)
+	if werr := s.WriteFile(summaryFile); werr != nil && err == nil {
+		err = werr
+	}
+	return err
+}
+
+func replay(ctx context.Context, s *summary.Summary) error {
+	files, err := corpusFiles(corpusDir)
+	if err != nil {
+		return err
+	}
+
+	cli, closer, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	caps, err := cli.Ping().Capabilities(ctx, &rpcpb.CapabilitiesRequest{})
+	if err != nil {
+		return err
+	}
+	s.AvalanchegoVersion = caps.AvalanchegoVersion
+
+	for _, f := range files {
+		name, err := filepath.Rel(corpusDir, f)
+		if err != nil {
+			return err
+		}
+		start := time.Now()
+		msg, diffs := replayVector(ctx, cli, f)
+		s.Add(summary.Check{
+			Name:       name,
+			Success:    msg == "",
+			Message:    msg,
+			DurationMs: uint64(time.Since(start).Milliseconds()),
+		})
+		if msg != "" {
+			color.Outf("{{red}}%s: %s{{/}}\n", name, msg)
+			for _, d := range diffs {
+				color.Outf("%s\n", d)
+			}
+		}
+	}
+	color.Outf("{{green}}replayed %d vectors against avalanchego %s:{{/}} %d passed, %d failed\n",
+		s.Total, s.AvalanchegoVersion, s.Passed, s.Failed)
+	return nil
+}
+
+// corpusFiles returns the vector files under the directory, in lexical
+// order.
+func corpusFiles(dir string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.HasSuffix(p, ".json") {
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no vectors in %q", dir)
+	}
+	return files, nil
+}
+
+// replayVector executes the vector again, and returns why its response
+// differs from the recorded one, or "" if it does not, with the rendered
+// diffs of its bytes fields.
+func replayVector(ctx context.Context, cli client.Client, p string) (string, []string) {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return err.Error(), nil
+	}
+	v := &rpcpb.Vector{}
+	if err := protojson.Unmarshal(b, v); err != nil {
+		return fmt.Sprintf("failed to parse vector (%v)", err), nil
+	}
+	if v.Request == nil || v.Response == nil {
+		return "vector has no request or no recorded response", nil
+	}
+	req, err := v.Request.UnmarshalNew()
+	if err != nil {
+		return fmt.Sprintf("failed to unmarshal request (%v)", err), nil
+	}
+	recorded, err := v.Response.UnmarshalNew()
+	if err != nil {
+		return fmt.Sprintf("failed to unmarshal recorded response (%v)", err), nil
+	}
+	resp, err := cli.Generate(ctx, req)
+	if err != nil {
+		return fmt.Sprintf("failed to execute request (%v)", err), nil
+	}
+	return responseDiff(recorded, resp)
+}
+
+// responseDiff summarizes the fields of the response that differ from the
+// recorded one, or returns "" if none does. Bytes fields are located
+// where they first diverge, and rendered as colorized byte diffs.
+func responseDiff(recorded proto.Message, resp proto.Message) (string, []string) {
+	rec, cur := recorded.ProtoReflect(), resp.ProtoReflect()
+	if rec.Descriptor().FullName() != cur.Descriptor().FullName() {
+		return fmt.Sprintf("recorded %s, received %s", rec.Descriptor().FullName(), cur.Descriptor().FullName()), nil
+	}
+
+	var msgs, rendered []string
+	fields := rec.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() == protoreflect.BytesKind && !fd.IsList() {
+			recBytes, curBytes := rec.Get(fd).Bytes(), cur.Get(fd).Bytes()
+			if d, ok := diff.Locate(recBytes, curBytes, 0, nil); ok {
+				msgs = append(msgs, fmt.Sprintf("%s: %s", fd.Name(), d))
+				rendered = append(rendered, fmt.Sprintf("%s:\n%s", fd.Name(), diff.Window(d, diff.Options{Color: true})))
+			}
+			continue
+		}
+		if !proto.Equal(withField(rec, fd), withField(cur, fd)) {
+			msgs = append(msgs, fmt.Sprintf("%s differs", fd.Name()))
+		}
+	}
+	return strings.Join(msgs, "; "), rendered
+}
+
+// withField returns a message with only the field of m set, so that a
+// single field can be compared with proto.Equal.
+func withField(m protoreflect.Message, fd protoreflect.FieldDescriptor) proto.Message {
+	f := m.New()
+	if m.Has(fd) {
+		f.Set(fd, m.Get(fd))
+	}
+	return f.Interface()
+}
@@ -0,0 +1,220 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vectors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/client"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/color"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/logutil"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/server"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	logLevel    string
+	endpoint    string
+	dialTimeout time.Duration
+	fakeTime    int64
+	specFile    string
+	outDir      string
+)
+
+var errInvalidSpec = errors.New("invalid vector spec")
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vectors",
+		Short: "Golden test vector commands.",
+	}
+	cmd.AddCommand(newGenerateCommand())
+	return cmd
+}
+
+func newGenerateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate [options]",
+		Short: "Generate golden test vectors (canonical bytes, without comparison) from a spec.",
+		Long: `Generate golden test vectors from a spec, a YAML (or JSON) file listing
+verification requests in the protobuf JSON mapping:
+
+  vectors:
+    - id: hash256-hello
+      request:
+        "@type": type.googleapis.com/rpcpb.HashRequest
+        function: HASH_FUNCTION_HASH256
+        data: aGVsbG8=
+
+Each vector is written to "<out>/<id>.json" with the response of the
+request, which holds the canonical bytes. Unless --endpoint is set, the
+requests are executed by an in-process server.`,
+		Args: cobra.NoArgs,
+		RunE: generateFunc,
+	}
+	cmd.Flags().StringVar(&specFile, "spec", "", "vector spec file (YAML or JSON)")
+	cmd.Flags().StringVar(&outDir, "out", "vectors", "directory to write the vectors to")
+	addServerFlags(cmd)
+	_ = cmd.MarkFlagRequired("spec")
+	return cmd
+}
+
+func generateFunc(cmd *cobra.Command, args []string) error {
+	spec, err := readSpec(specFile)
+	if err != nil {
+		return err
+	}
+
+	cli, closer, err := connect(cmd.Context())
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return err
+	}
+	for _, v := range spec.Vectors {
+		req, err := v.Request.UnmarshalNew()
+		if err != nil {
+			return fmt.Errorf("vector %q: %w", v.Id, err)
+		}
+		resp, err := cli.Generate(cmd.Context(), req)
+		if err != nil {
+			return fmt.Errorf("vector %q: %w", v.Id, err)
+		}
+		v.Response, err = anypb.New(resp)
+		if err != nil {
+			return err
+		}
+		if err := writeVector(filepath.Join(outDir, v.Id+".json"), v); err != nil {
+			return err
+		}
+	}
+	color.Outf("{{green}}generated %d vectors in %q{{/}}\n", len(spec.Vectors), outDir)
+	return nil
+}
+
+// addServerFlags adds the flags of the server the vectors are executed by.
+func addServerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&logLevel, "log-level", logutil.DefaultLogLevel, "log level")
+	cmd.Flags().StringVar(&endpoint, "endpoint", "", "gRPC endpoint of a running server (in-process server if empty)")
+	cmd.Flags().DurationVar(&dialTimeout, "dial-timeout", server.DefaultDialTimeout, "server dial timeout")
+	cmd.Flags().Int64Var(&fakeTime, "fake-time-unix", 0, "fixed clock of the in-process server in unix seconds (0 to use the wall clock)")
+}
+
+// connect returns a client of the server at --endpoint, or of an in-process
+// server if unset, and the function closing them.
+func connect(ctx context.Context) (client.Client, func(), error) {
+	lcfg := logutil.GetDefaultZapLoggerConfig()
+	lcfg.Level = zap.NewAtomicLevelAt(logutil.ConvertToZapLevel(logLevel))
+	logger, err := lcfg.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+	_ = zap.ReplaceGlobals(logger)
+
+	ctx, cancel := context.WithCancel(ctx)
+	stop := cancel
+	ep := endpoint
+	if ep == "" {
+		cfg := server.Config{}
+		if fakeTime != 0 {
+			cfg.FakeTime = time.Unix(fakeTime, 0)
+		}
+		ports, errc, err := server.Serve(ctx, cfg)
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+		stop = func() {
+			cancel()
+			<-errc
+		}
+		ep = ports.Endpoint()
+	}
+	cli, err := client.New(client.Config{
+		LogLevel:    logLevel,
+		Endpoint:    ep,
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		stop()
+		return nil, nil, err
+	}
+	return cli, func() {
+		_ = cli.Close()
+		stop()
+	}, nil
+}
+
+// readSpec reads the vector spec.
+func readSpec(p string) (*rpcpb.VectorSpec, error) {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	spec := &rpcpb.VectorSpec{}
+	if err := unmarshalYAML(b, spec); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidSpec, err)
+	}
+
+	ids := make(map[string]bool, len(spec.Vectors))
+	for i, v := range spec.Vectors {
+		switch {
+		case v.Id == "" || v.Id != filepath.Base(v.Id) || strings.HasPrefix(v.Id, "."):
+			return nil, fmt.Errorf("%w: vector %d has invalid id %q", errInvalidSpec, i, v.Id)
+		case ids[v.Id]:
+			return nil, fmt.Errorf("%w: duplicate vector id %q", errInvalidSpec, v.Id)
+		case v.Request == nil:
+			return nil, fmt.Errorf("%w: vector %q has no request", errInvalidSpec, v.Id)
+		}
+		ids[v.Id] = true
+	}
+	return spec, nil
+}
+
+// unmarshalYAML parses the YAML (or JSON) message, converting YAML to JSON
+// so that requests are parsed with the protobuf JSON mapping.
+func unmarshalYAML(b []byte, m proto.Message) error {
+	var doc interface{}
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return err
+	}
+	js, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(js, m)
+}
+
+// writeVector writes the vector as indented JSON. The output of protojson
+// is deliberately unstable, so it is re-indented for the vectors to be
+// byte-for-byte reproducible.
+func writeVector(p string, v *rpcpb.Vector) error {
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, b, "", "  "); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	return os.WriteFile(p, buf.Bytes(), 0o644)
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vectors
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/client"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/color"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/summary"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// NewVerifyCommand returns the command verifying a single vector against
+// the server.
+func NewVerifyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify a single artifact (e.g., produced by the Rust SDK) against avalanchego.",
+	}
+	cmd.AddCommand(
+		newVerifyServiceCommand("message", "rpcpb.MessageService", "p2p message"),
+		newVerifyServiceCommand("key", "rpcpb.KeyService", "key, signature or certificate"),
+		newVerifyServiceCommand("tx", "rpcpb.TxService", "transaction"),
+	)
+	return cmd
+}
+
+func newVerifyServiceCommand(use string, service protoreflect.FullName, artifact string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [vector file]",
+		Short: fmt.Sprintf("Verify a %s vector (%s request).", artifact, service),
+		Long: fmt.Sprintf(`Verify a %s against avalanchego, from a YAML (or JSON) vector file
+in the format of "vectors generate" (read from stdin if the file is
+omitted or "-"):
+
+  id: my-artifact
+  request:
+    "@type": type.googleapis.com/rpcpb.<Request>
+    ...
+
+The request must be of a %s method, with the bytes produced by
+the Rust SDK in its expected fields. The recorded response, if any, is
+ignored. Exits with 1 if avalanchego does not produce the same bytes.`, artifact, service),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p := "-"
+			if len(args) > 0 {
+				p = args[0]
+			}
+			s := summary.New("verify " + use)
+			err := verify(cmd.Context(), s, cmd.InOrStdin(), p, service)
+			err = s.Finish(err)
+			if werr := s.WriteFile(summaryFile); werr != nil && err == nil {
+				err = werr
+			}
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&summaryFile, "summary-file", "", "file to write the JSON summary to")
+	addServerFlags(cmd)
+	return cmd
+}
+
+func verify(ctx context.Context, s *summary.Summary, stdin io.Reader, p string, service protoreflect.FullName) error {
+	v, err := readVector(stdin, p)
+	if err != nil {
+		return err
+	}
+	req, err := v.Request.UnmarshalNew()
+	if err != nil {
+		return fmt.Errorf("vector %q: %w", v.Id, err)
+	}
+	md, err := client.Method(req)
+	if err != nil {
+		return fmt.Errorf("vector %q: %w", v.Id, err)
+	}
+	if md.Parent().FullName() != service {
+		return fmt.Errorf("%w: vector %q is a %s request, not of %s", errInvalidSpec, v.Id, md.Parent().FullName(), service)
+	}
+
+	cli, closer, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	start := time.Now()
+	resp, err := cli.Invoke(ctx, req)
+	if err != nil {
+		return fmt.Errorf("vector %q: %w", v.Id, err)
+	}
+	msg, success := summary.Result(resp)
+	s.Add(summary.Check{
+		Name:       v.Id,
+		Success:    success,
+		Message:    msg,
+		DurationMs: uint64(time.Since(start).Milliseconds()),
+	})
+	if success {
+		color.Outf("{{green}}PASS{{/}} %s (%s)\n", v.Id, md.Name())
+	} else {
+		color.Outf("{{red}}FAIL{{/}} %s (%s): %s\n", v.Id, md.Name(), msg)
+		if d := diff.Of(resp); d != nil {
+			color.Outf("%s\n", diff.Render(d, diff.Options{Color: true}))
+		}
+	}
+	return nil
+}
+
+// readVector reads a single vector from the file, or from stdin if "-".
+func readVector(stdin io.Reader, p string) (*rpcpb.Vector, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if p == "-" {
+		p = "stdin"
+		b, err = io.ReadAll(stdin)
+	} else {
+		b, err = os.ReadFile(p)
+	}
+	if err != nil {
+		return nil, err
+	}
+	v := &rpcpb.Vector{}
+	if err := unmarshalYAML(b, v); err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", errInvalidSpec, p, err)
+	}
+	if v.Request == nil {
+		return nil, fmt.Errorf("%w: %s has no request", errInvalidSpec, p)
+	}
+	if v.Id == "" {
+		v.Id = p
+	}
+	return v, nil
+}
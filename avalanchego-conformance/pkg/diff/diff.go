@@ -0,0 +1,223 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package diff implements expected-vs-received byte diff rendering.
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	formatter "github.com/onsi/ginkgo/v2/formatter"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Field is a named byte range [Start, End) of a serialized value.
+type Field struct {
+	Name  string
+	Start int
+	End   int
+}
+
+// Annotator parses the serialized bytes into fields, to annotate the diff
+// rows. Fields that cannot be parsed are omitted.
+type Annotator func(b []byte) []Field
+
+// FramedMessage annotates a length-prefixed message, as written to peers.
+// ref. "network/peer.writeMessages"
+func FramedMessage(b []byte) []Field {
+	const prefixLen = 4
+	if len(b) < prefixLen {
+		return nil
+	}
+	return []Field{
+		{Name: "length prefix", Start: 0, End: prefixLen},
+		{Name: "message", Start: prefixLen, End: len(b)},
+	}
+}
+
+type Options struct {
+	// Colorize the output for terminals.
+	Color bool
+	// Number of bytes per row. Defaults to 16.
+	Width int
+	// Number of equal rows shown around each differing row.
+	Context int
+	// Optional annotator of the expected bytes.
+	Annotate Annotator
+	// Offset of the first byte, when rendering windows of larger values.
+	Offset int
+	// Optional summary rendered instead of the computed one (e.g., of the
+	// larger values).
+	Summary string
+}
+
+// Equal is the message rendered when there is no difference.
+const Equal = "no difference"
+
+// Bytes renders the rows where the expected and received bytes differ, as
+// hex dumps with offsets. Differing bytes are highlighted when colorized.
+//
+// e.g.,
+//
+//	expected 6 bytes, received 6 bytes, first difference at offset 0x5, 1 differing bytes
+//	00000000 - 00 00 00 02 01 02  | length prefix, message
+//	         + 00 00 00 02 01 03
+func Bytes(expected []byte, received []byte, opts Options) string {
+	if opts.Width <= 0 {
+		opts.Width = 16
+	}
+
+	n := len(expected)
+	if len(received) > n {
+		n = len(received)
+	}
+	first, differing := -1, 0
+	for i := 0; i < n; i++ {
+		if byteAt(expected, i) != byteAt(received, i) {
+			if first < 0 {
+				first = i
+			}
+			differing++
+		}
+	}
+	if first < 0 {
+		return Equal
+	}
+
+	var fields []Field
+	if opts.Annotate != nil {
+		fields = opts.Annotate(expected)
+	}
+
+	rows := (n + opts.Width - 1) / opts.Width
+	shown := make([]bool, rows)
+	for row := 0; row < rows; row++ {
+		if !rowDiffers(expected, received, row*opts.Width, opts.Width) {
+			continue
+		}
+		for r := row - opts.Context; r <= row+opts.Context; r++ {
+			if r >= 0 && r < rows {
+				shown[r] = true
+			}
+		}
+	}
+
+	sb := strings.Builder{}
+	if opts.Summary != "" {
+		sb.WriteString(opts.Summary + "\n")
+	} else {
+		sb.WriteString(fmt.Sprintf("expected %d bytes, received %d bytes, first difference at offset 0x%x, %d differing bytes\n",
+			len(expected), len(received), opts.Offset+first, differing))
+	}
+	for row := 0; row < rows; row++ {
+		if !shown[row] {
+			if row > 0 && shown[row-1] {
+				sb.WriteString("...\n")
+			}
+			continue
+		}
+		start := row * opts.Width
+		sb.WriteString(fmt.Sprintf("%08x - ", opts.Offset+start))
+		line := hexRow(expected, received, start, opts.Width, "{{red}}")
+		if names := fieldNames(fields, start, start+opts.Width); names != "" {
+			line += "  | " + names
+		}
+		sb.WriteString(strings.TrimRight(line, " "))
+		sb.WriteString("\n")
+		sb.WriteString(strings.Repeat(" ", 8) + " + ")
+		sb.WriteString(strings.TrimRight(hexRow(received, expected, start, opts.Width, "{{green}}"), " "))
+		sb.WriteString("\n")
+	}
+
+	out := strings.TrimSuffix(sb.String(), "\n")
+	mode := formatter.ColorModeNone
+	if opts.Color {
+		mode = formatter.ColorModeTerminal
+	}
+	// escape for the formatter, which formats before styling
+	return formatter.New(mode).F(strings.ReplaceAll(out, "%", "%%"))
+}
+
+// Window renders the windows of the divergence under its summary, with
+// all the rows of the windows shown.
+func Window(d Divergence, opts Options) string {
+	return renderWindow(d.String(), d.ExpectedWindow, d.ReceivedWindow, d.WindowStart, opts)
+}
+
+// Render renders the windows of a diff reported by the server under its
+// summary, as Window does.
+func Render(d *rpcpb.Diff, opts Options) string {
+	return renderWindow(d.Summary, d.ExpectedWindow, d.ReceivedWindow, int(d.WindowOffset), opts)
+}
+
+// Of returns the "diff" field of a check response, nil if it has none.
+func Of(resp proto.Message) *rpcpb.Diff {
+	r := resp.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName("diff")
+	if fd == nil || fd.Kind() != protoreflect.MessageKind || !r.Has(fd) {
+		return nil
+	}
+	d, _ := r.Get(fd).Message().Interface().(*rpcpb.Diff)
+	return d
+}
+
+// renderWindow renders windows with Bytes. Annotations are dropped, since
+// windows cannot be parsed on their own.
+func renderWindow(summary string, expected []byte, received []byte, offset int, opts Options) string {
+	if opts.Width <= 0 {
+		opts.Width = 16
+	}
+	opts.Annotate = nil
+	opts.Offset = offset
+	opts.Summary = summary
+	opts.Context = (len(expected)+len(received))/opts.Width + 1
+	return Bytes(expected, received, opts)
+}
+
+// byteAt returns -1 past the end of b.
+func byteAt(b []byte, i int) int {
+	if i >= len(b) {
+		return -1
+	}
+	return int(b[i])
+}
+
+func rowDiffers(a []byte, b []byte, start int, width int) bool {
+	for i := start; i < start+width; i++ {
+		if byteAt(a, i) != byteAt(b, i) {
+			return true
+		}
+	}
+	return false
+}
+
+// hexRow renders the row of b, styling the bytes that differ from other.
+// Bytes past the end of b are rendered as blanks.
+func hexRow(b []byte, other []byte, start int, width int, style string) string {
+	cells := make([]string, 0, width)
+	for i := start; i < start+width; i++ {
+		switch {
+		case i >= len(b):
+			cells = append(cells, "  ")
+		case byteAt(b, i) != byteAt(other, i):
+			cells = append(cells, fmt.Sprintf("%s%02x{{/}}", style, b[i]))
+		default:
+			cells = append(cells, fmt.Sprintf("%02x", b[i]))
+		}
+	}
+	return strings.Join(cells, " ")
+}
+
+// fieldNames returns the names of the fields overlapping [start, end).
+func fieldNames(fields []Field, start int, end int) string {
+	names := []string{}
+	for _, f := range fields {
+		if f.Start < end && f.End > start {
+			names = append(names, f.Name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DefaultWindow is the default number of bytes of each side in a
+// Divergence window.
+const DefaultWindow = 64
+
+// Divergence locates the first difference between expected and received
+// bytes.
+type Divergence struct {
+	ExpectedLen int
+	ReceivedLen int
+	// Offset of the first differing byte. When one side is a prefix of the
+	// other, this is the length of the shorter side.
+	Offset int
+
+	// Bytes of each side starting at WindowStart, around Offset.
+	WindowStart    int
+	ExpectedWindow []byte
+	ReceivedWindow []byte
+
+	// Path of the field at Offset (e.g., "app_gossip.app_bytes"), if known.
+	Path string
+}
+
+// PathFunc returns the path of the field at the offset of the serialized
+// bytes, or "" if the bytes cannot be parsed.
+type PathFunc func(b []byte, offset int) string
+
+// Locate returns the divergence of the received bytes from the expected
+// ones, or false if they are equal. Windows are of the given size, starting
+// a quarter of it before the first difference, so that the preceding bytes
+// give context. The path is resolved against the expected bytes.
+func Locate(expected []byte, received []byte, window int, path PathFunc) (Divergence, bool) {
+	n := len(expected)
+	if len(received) < n {
+		n = len(received)
+	}
+	offset := n
+	for i := 0; i < n; i++ {
+		if expected[i] != received[i] {
+			offset = i
+			break
+		}
+	}
+	if offset == n && len(expected) == len(received) {
+		return Divergence{}, false
+	}
+
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	start := offset - window/4
+	if start < 0 {
+		start = 0
+	}
+	d := Divergence{
+		ExpectedLen:    len(expected),
+		ReceivedLen:    len(received),
+		Offset:         offset,
+		WindowStart:    start,
+		ExpectedWindow: windowOf(expected, start, window),
+		ReceivedWindow: windowOf(received, start, window),
+	}
+	if path != nil {
+		at := offset
+		if at == len(expected) && at > 0 {
+			// received has trailing bytes; report the last expected field
+			at--
+		}
+		d.Path = path(expected, at)
+	}
+	return d, true
+}
+
+func windowOf(b []byte, start int, window int) []byte {
+	if start >= len(b) {
+		return nil
+	}
+	end := start + window
+	if end > len(b) {
+		end = len(b)
+	}
+	return b[start:end]
+}
+
+// String summarizes the divergence.
+//
+// e.g.,
+//
+//	expected 40 bytes, received 41 bytes, first difference at offset 0x25 (app_gossip.app_bytes)
+func (d Divergence) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("expected %d bytes, received %d bytes, ", d.ExpectedLen, d.ReceivedLen))
+	switch {
+	case d.Offset == d.ExpectedLen:
+		sb.WriteString(fmt.Sprintf("received %d trailing bytes at offset 0x%x", d.ReceivedLen-d.ExpectedLen, d.Offset))
+	case d.Offset == d.ReceivedLen:
+		sb.WriteString(fmt.Sprintf("received is truncated at offset 0x%x", d.Offset))
+	default:
+		sb.WriteString(fmt.Sprintf("first difference at offset 0x%x", d.Offset))
+	}
+	if d.Path != "" {
+		sb.WriteString(fmt.Sprintf(" (%s)", d.Path))
+	}
+	return sb.String()
+}
+
+// AnnotatorPath resolves paths from the fields of an annotator.
+func AnnotatorPath(annotate Annotator) PathFunc {
+	return func(b []byte, offset int) string {
+		return fieldNames(annotate(b), offset, offset+1)
+	}
+}
+
+// FramedProtoPath resolves paths in a length-prefixed protobuf message of
+// the descriptor, as written to peers.
+// ref. "network/peer.writeMessages"
+func FramedProtoPath(md protoreflect.MessageDescriptor) PathFunc {
+	return func(b []byte, offset int) string {
+		const prefixLen = 4
+		if offset < prefixLen {
+			return "length prefix"
+		}
+		if len(b) < prefixLen {
+			return ""
+		}
+		return ProtoPath(md)(b[prefixLen:], offset-prefixLen)
+	}
+}
+
+// ProtoPath resolves paths in a protobuf message of the descriptor, by
+// walking its wire format. Repeated fields are indexed by occurrence (e.g.,
+// "ancestors.containers[3]"). Paths end at the innermost field that could
+// be decoded.
+func ProtoPath(md protoreflect.MessageDescriptor) PathFunc {
+	return func(b []byte, offset int) string {
+		return protoPath(md, b, offset)
+	}
+}
+
+func protoPath(md protoreflect.MessageDescriptor, b []byte, offset int) string {
+	occurrences := make(map[protowire.Number]int)
+	pos := 0
+	for pos < len(b) {
+		num, typ, tagLen := protowire.ConsumeTag(b[pos:])
+		if tagLen < 0 {
+			return ""
+		}
+		valueLen := protowire.ConsumeFieldValue(num, typ, b[pos+tagLen:])
+		if valueLen < 0 {
+			return ""
+		}
+		end := pos + tagLen + valueLen
+		if offset >= end {
+			occurrences[num]++
+			pos = end
+			continue
+		}
+
+		fd := md.Fields().ByNumber(num)
+		if fd == nil {
+			return fmt.Sprintf("<unknown field %d>", num)
+		}
+		name := string(fd.Name())
+		if fd.IsList() {
+			name = fmt.Sprintf("%s[%d]", name, occurrences[num])
+		}
+		if fd.Message() == nil || typ != protowire.BytesType || fd.IsMap() {
+			return name
+		}
+
+		// descend into the embedded message past its tag and length
+		_, prefixLen := protowire.ConsumeVarint(b[pos+tagLen:])
+		valueStart := pos + tagLen + prefixLen
+		if offset < valueStart {
+			return name
+		}
+		if inner := protoPath(fd.Message(), b[valueStart:end], offset-valueStart); inner != "" {
+			return name + "." + inner
+		}
+		return name
+	}
+	return ""
+}
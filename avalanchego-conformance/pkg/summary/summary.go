@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package summary implements machine-readable summaries and exit codes of
+// CLI runs, so CI pipelines can gate on results without parsing logs.
+package summary
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Exit codes of CLI runs.
+const (
+	// ExitPass is returned when all checks passed.
+	ExitPass = 0
+	// ExitFailures is returned when the run completed with failed checks.
+	ExitFailures = 1
+	// ExitInfraError is returned when the run could not complete (e.g.,
+	// invalid flags or an unreachable server).
+	ExitInfraError = 2
+)
+
+// ErrFailures is returned by runs that completed with failed checks.
+var ErrFailures = errors.New("checks failed")
+
+// ExitCode returns the exit code of a run that returned err.
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return ExitPass
+	case errors.Is(err, ErrFailures):
+		return ExitFailures
+	default:
+		return ExitInfraError
+	}
+}
+
+// Result returns the "message" and "success" fields of a check response.
+// Responses without a success field are successful.
+func Result(resp proto.Message) (string, bool) {
+	r := resp.ProtoReflect()
+	fields := r.Descriptor().Fields()
+
+	var (
+		msg     string
+		success = true
+	)
+	if fd := fields.ByName("message"); fd != nil && fd.Kind() == protoreflect.StringKind {
+		msg = r.Get(fd).String()
+	}
+	if fd := fields.ByName("success"); fd != nil && fd.Kind() == protoreflect.BoolKind {
+		success = r.Get(fd).Bool()
+	}
+	return msg, success
+}
+
+type Check struct {
+	Name       string `json:"name"`
+	Success    bool   `json:"success"`
+	Message    string `json:"message,omitempty"`
+	DurationMs uint64 `json:"duration_ms"`
+}
+
+type Summary struct {
+	Command string `json:"command"`
+	// avalanchego version the checks ran against, if known.
+	AvalanchegoVersion string `json:"avalanchego_version,omitempty"`
+
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+
+	Total  int `json:"total"`
+	Passed int `json:"passed"`
+	Failed int `json:"failed"`
+
+	// Set when the run could not complete.
+	InfraError string `json:"infra_error,omitempty"`
+	ExitCode   int    `json:"exit_code"`
+
+	Checks []Check `json:"checks"`
+}
+
+func New(command string) *Summary {
+	return &Summary{
+		Command:   command,
+		StartTime: time.Now(),
+		Checks:    []Check{},
+	}
+}
+
+// Add records the result of a check.
+func (s *Summary) Add(c Check) {
+	s.Checks = append(s.Checks, c)
+	s.Total++
+	if c.Success {
+		s.Passed++
+	} else {
+		s.Failed++
+	}
+}
+
+// Finish completes the summary given the error the run returned (if any),
+// and returns the error of the run, which is ErrFailures if the run
+// completed with failed checks.
+func (s *Summary) Finish(err error) error {
+	s.EndTime = time.Now()
+	if err == nil && s.Failed > 0 {
+		err = fmt.Errorf("%w (%d of %d)", ErrFailures, s.Failed, s.Total)
+	}
+	if err != nil && !errors.Is(err, ErrFailures) {
+		s.InfraError = err.Error()
+	}
+	s.ExitCode = ExitCode(err)
+	return err
+}
+
+// WriteFile writes the summary as JSON to the file, if non-empty.
+func (s *Summary) WriteFile(file string) error {
+	if file == "" {
+		return nil
+	}
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, b, 0o644)
+}
@@ -0,0 +1,716 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/address.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AddressErrorClass classifies the errors of parsing an address.
+type AddressErrorClass int32
+
+const (
+	// The address is accepted.
+	AddressErrorClass_ADDRESS_ERROR_CLASS_UNSPECIFIED AddressErrorClass = 0
+	// No "-" between the chain alias and the bech32 address.
+	AddressErrorClass_ADDRESS_ERROR_CLASS_NO_SEPARATOR AddressErrorClass = 1
+	// ref. "bech32.ErrMixedCase"
+	AddressErrorClass_ADDRESS_ERROR_CLASS_MIXED_CASE AddressErrorClass = 2
+	// ref. "bech32.ErrInvalidLength"
+	AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_LENGTH AddressErrorClass = 3
+	// Character out of the US-ASCII range [33, 126].
+	// ref. "bech32.ErrInvalidCharacter"
+	AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_CHARACTER AddressErrorClass = 4
+	// Missing "1" separator, empty HRP or too short checksum.
+	// ref. "bech32.ErrInvalidSeparatorIndex"
+	AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_SEPARATOR_INDEX AddressErrorClass = 5
+	// Data character out of the bech32 charset.
+	// ref. "bech32.ErrNonCharsetChar"
+	AddressErrorClass_ADDRESS_ERROR_CLASS_NON_CHARSET_CHAR AddressErrorClass = 6
+	// ref. "bech32.ErrInvalidChecksum"
+	AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_CHECKSUM AddressErrorClass = 7
+	// The data cannot be converted from 5-bit to 8-bit groups.
+	AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_BITS AddressErrorClass = 8
+	// The address is not 20 bytes.
+	AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_ADDRESS_LENGTH AddressErrorClass = 9
+	// The HRP is not of the network.
+	AddressErrorClass_ADDRESS_ERROR_CLASS_HRP_MISMATCH AddressErrorClass = 10
+	// The chain alias is not of the chain.
+	AddressErrorClass_ADDRESS_ERROR_CLASS_CHAIN_ALIAS_MISMATCH AddressErrorClass = 11
+	AddressErrorClass_ADDRESS_ERROR_CLASS_OTHER                AddressErrorClass = 12
+)
+
+// Enum value maps for AddressErrorClass.
+var (
+	AddressErrorClass_name = map[int32]string{
+		0:  "ADDRESS_ERROR_CLASS_UNSPECIFIED",
+		1:  "ADDRESS_ERROR_CLASS_NO_SEPARATOR",
+		2:  "ADDRESS_ERROR_CLASS_MIXED_CASE",
+		3:  "ADDRESS_ERROR_CLASS_INVALID_LENGTH",
+		4:  "ADDRESS_ERROR_CLASS_INVALID_CHARACTER",
+		5:  "ADDRESS_ERROR_CLASS_INVALID_SEPARATOR_INDEX",
+		6:  "ADDRESS_ERROR_CLASS_NON_CHARSET_CHAR",
+		7:  "ADDRESS_ERROR_CLASS_INVALID_CHECKSUM",
+		8:  "ADDRESS_ERROR_CLASS_INVALID_BITS",
+		9:  "ADDRESS_ERROR_CLASS_INVALID_ADDRESS_LENGTH",
+		10: "ADDRESS_ERROR_CLASS_HRP_MISMATCH",
+		11: "ADDRESS_ERROR_CLASS_CHAIN_ALIAS_MISMATCH",
+		12: "ADDRESS_ERROR_CLASS_OTHER",
+	}
+	AddressErrorClass_value = map[string]int32{
+		"ADDRESS_ERROR_CLASS_UNSPECIFIED":             0,
+		"ADDRESS_ERROR_CLASS_NO_SEPARATOR":            1,
+		"ADDRESS_ERROR_CLASS_MIXED_CASE":              2,
+		"ADDRESS_ERROR_CLASS_INVALID_LENGTH":          3,
+		"ADDRESS_ERROR_CLASS_INVALID_CHARACTER":       4,
+		"ADDRESS_ERROR_CLASS_INVALID_SEPARATOR_INDEX": 5,
+		"ADDRESS_ERROR_CLASS_NON_CHARSET_CHAR":        6,
+		"ADDRESS_ERROR_CLASS_INVALID_CHECKSUM":        7,
+		"ADDRESS_ERROR_CLASS_INVALID_BITS":            8,
+		"ADDRESS_ERROR_CLASS_INVALID_ADDRESS_LENGTH":  9,
+		"ADDRESS_ERROR_CLASS_HRP_MISMATCH":            10,
+		"ADDRESS_ERROR_CLASS_CHAIN_ALIAS_MISMATCH":    11,
+		"ADDRESS_ERROR_CLASS_OTHER":                   12,
+	}
+)
+
+func (x AddressErrorClass) Enum() *AddressErrorClass {
+	p := new(AddressErrorClass)
+	*p = x
+	return p
+}
+
+func (x AddressErrorClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddressErrorClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_address_proto_enumTypes[0].Descriptor()
+}
+
+func (AddressErrorClass) Type() protoreflect.EnumType {
+	return &file_rpcpb_address_proto_enumTypes[0]
+}
+
+func (x AddressErrorClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddressErrorClass.Descriptor instead.
+func (AddressErrorClass) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_address_proto_rawDescGZIP(), []int{0}
+}
+
+type FormatAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// e.g., "X" or "P"
+	ChainAlias string `protobuf:"bytes,1,opt,name=chain_alias,json=chainAlias,proto3" json:"chain_alias,omitempty"`
+	// HRP of the address. If empty, the HRP of the network is used
+	// (e.g., "custom" for networks without one).
+	Hrp              string `protobuf:"bytes,2,opt,name=hrp,proto3" json:"hrp,omitempty"`
+	NetworkId        uint32 `protobuf:"varint,3,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Address          []byte `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	FormattedAddress string `protobuf:"bytes,5,opt,name=formatted_address,json=formattedAddress,proto3" json:"formatted_address,omitempty"`
+}
+
+func (x *FormatAddressRequest) Reset() {
+	*x = FormatAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_address_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormatAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatAddressRequest) ProtoMessage() {}
+
+func (x *FormatAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_address_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatAddressRequest.ProtoReflect.Descriptor instead.
+func (*FormatAddressRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_address_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FormatAddressRequest) GetChainAlias() string {
+	if x != nil {
+		return x.ChainAlias
+	}
+	return ""
+}
+
+func (x *FormatAddressRequest) GetHrp() string {
+	if x != nil {
+		return x.Hrp
+	}
+	return ""
+}
+
+func (x *FormatAddressRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *FormatAddressRequest) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *FormatAddressRequest) GetFormattedAddress() string {
+	if x != nil {
+		return x.FormattedAddress
+	}
+	return ""
+}
+
+type FormatAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedFormattedAddress string `protobuf:"bytes,1,opt,name=expected_formatted_address,json=expectedFormattedAddress,proto3" json:"expected_formatted_address,omitempty"`
+	Message                  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success                  bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *FormatAddressResponse) Reset() {
+	*x = FormatAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_address_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormatAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatAddressResponse) ProtoMessage() {}
+
+func (x *FormatAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_address_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatAddressResponse.ProtoReflect.Descriptor instead.
+func (*FormatAddressResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_address_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FormatAddressResponse) GetExpectedFormattedAddress() string {
+	if x != nil {
+		return x.ExpectedFormattedAddress
+	}
+	return ""
+}
+
+func (x *FormatAddressResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FormatAddressResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FormatAddressResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type ParseAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FormattedAddress string `protobuf:"bytes,1,opt,name=formatted_address,json=formattedAddress,proto3" json:"formatted_address,omitempty"`
+	// If set, the address must also be a 20-byte address of the chain alias on
+	// the network, as chain APIs parse addresses.
+	Local      bool   `protobuf:"varint,2,opt,name=local,proto3" json:"local,omitempty"`
+	ChainAlias string `protobuf:"bytes,3,opt,name=chain_alias,json=chainAlias,proto3" json:"chain_alias,omitempty"`
+	NetworkId  uint32 `protobuf:"varint,4,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// Parsed parts of the address, or the class of the error the client
+	// rejects it with.
+	ParsedChainAlias string            `protobuf:"bytes,5,opt,name=parsed_chain_alias,json=parsedChainAlias,proto3" json:"parsed_chain_alias,omitempty"`
+	ParsedHrp        string            `protobuf:"bytes,6,opt,name=parsed_hrp,json=parsedHrp,proto3" json:"parsed_hrp,omitempty"`
+	ParsedAddress    []byte            `protobuf:"bytes,7,opt,name=parsed_address,json=parsedAddress,proto3" json:"parsed_address,omitempty"`
+	ErrorClass       AddressErrorClass `protobuf:"varint,8,opt,name=error_class,json=errorClass,proto3,enum=rpcpb.AddressErrorClass" json:"error_class,omitempty"`
+}
+
+func (x *ParseAddressRequest) Reset() {
+	*x = ParseAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_address_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseAddressRequest) ProtoMessage() {}
+
+func (x *ParseAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_address_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseAddressRequest.ProtoReflect.Descriptor instead.
+func (*ParseAddressRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_address_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ParseAddressRequest) GetFormattedAddress() string {
+	if x != nil {
+		return x.FormattedAddress
+	}
+	return ""
+}
+
+func (x *ParseAddressRequest) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+func (x *ParseAddressRequest) GetChainAlias() string {
+	if x != nil {
+		return x.ChainAlias
+	}
+	return ""
+}
+
+func (x *ParseAddressRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *ParseAddressRequest) GetParsedChainAlias() string {
+	if x != nil {
+		return x.ParsedChainAlias
+	}
+	return ""
+}
+
+func (x *ParseAddressRequest) GetParsedHrp() string {
+	if x != nil {
+		return x.ParsedHrp
+	}
+	return ""
+}
+
+func (x *ParseAddressRequest) GetParsedAddress() []byte {
+	if x != nil {
+		return x.ParsedAddress
+	}
+	return nil
+}
+
+func (x *ParseAddressRequest) GetErrorClass() AddressErrorClass {
+	if x != nil {
+		return x.ErrorClass
+	}
+	return AddressErrorClass_ADDRESS_ERROR_CLASS_UNSPECIFIED
+}
+
+type ParseAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedChainAlias string            `protobuf:"bytes,1,opt,name=expected_chain_alias,json=expectedChainAlias,proto3" json:"expected_chain_alias,omitempty"`
+	ExpectedHrp        string            `protobuf:"bytes,2,opt,name=expected_hrp,json=expectedHrp,proto3" json:"expected_hrp,omitempty"`
+	ExpectedAddress    []byte            `protobuf:"bytes,3,opt,name=expected_address,json=expectedAddress,proto3" json:"expected_address,omitempty"`
+	ExpectedErrorClass AddressErrorClass `protobuf:"varint,4,opt,name=expected_error_class,json=expectedErrorClass,proto3,enum=rpcpb.AddressErrorClass" json:"expected_error_class,omitempty"`
+	// Error avalanchego rejects the address with.
+	ExpectedError string `protobuf:"bytes,5,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	Message       string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,8,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *ParseAddressResponse) Reset() {
+	*x = ParseAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_address_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseAddressResponse) ProtoMessage() {}
+
+func (x *ParseAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_address_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseAddressResponse.ProtoReflect.Descriptor instead.
+func (*ParseAddressResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_address_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ParseAddressResponse) GetExpectedChainAlias() string {
+	if x != nil {
+		return x.ExpectedChainAlias
+	}
+	return ""
+}
+
+func (x *ParseAddressResponse) GetExpectedHrp() string {
+	if x != nil {
+		return x.ExpectedHrp
+	}
+	return ""
+}
+
+func (x *ParseAddressResponse) GetExpectedAddress() []byte {
+	if x != nil {
+		return x.ExpectedAddress
+	}
+	return nil
+}
+
+func (x *ParseAddressResponse) GetExpectedErrorClass() AddressErrorClass {
+	if x != nil {
+		return x.ExpectedErrorClass
+	}
+	return AddressErrorClass_ADDRESS_ERROR_CLASS_UNSPECIFIED
+}
+
+func (x *ParseAddressResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *ParseAddressResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ParseAddressResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ParseAddressResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_address_proto protoreflect.FileDescriptor
+
+var file_rpcpb_address_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x22, 0xaf, 0x01, 0x0a,
+	0x14, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x72, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x68, 0x72, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb7,
+	0x01, 0x0a, 0x15, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xc7, 0x02, 0x0a, 0x13, 0x50, 0x61, 0x72,
+	0x73, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2b, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x5f, 0x68, 0x72, 0x70, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x48, 0x72, 0x70,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x22, 0xeb, 0x02, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x73, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x72, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x72, 0x70,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4a, 0x0a, 0x14, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x2a, 0xa3, 0x04, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53,
+	0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x41,
+	0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41,
+	0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x5f, 0x53, 0x45, 0x50, 0x41, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10,
+	0x01, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x4d, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x43,
+	0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x03, 0x12, 0x29, 0x0a,
+	0x25, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43,
+	0x4c, 0x41, 0x53, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x41,
+	0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x10, 0x04, 0x12, 0x2f, 0x0a, 0x2b, 0x41, 0x44, 0x44, 0x52,
+	0x45, 0x53, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x45, 0x50, 0x41, 0x52, 0x41, 0x54, 0x4f,
+	0x52, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x05, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x44, 0x44,
+	0x52, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x48, 0x41,
+	0x52, 0x10, 0x06, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x10, 0x07, 0x12, 0x24, 0x0a,
+	0x20, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43,
+	0x4c, 0x41, 0x53, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x42, 0x49, 0x54,
+	0x53, 0x10, 0x08, 0x12, 0x2e, 0x0a, 0x2a, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54,
+	0x48, 0x10, 0x09, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x48, 0x52, 0x50, 0x5f, 0x4d,
+	0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0a, 0x12, 0x2c, 0x0a, 0x28, 0x41, 0x44, 0x44,
+	0x52, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x41, 0x4c, 0x49, 0x41, 0x53, 0x5f, 0x4d, 0x49, 0x53,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0b, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x44, 0x44, 0x52, 0x45,
+	0x53, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x4f,
+	0x54, 0x48, 0x45, 0x52, 0x10, 0x0c, 0x32, 0xa9, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x72, 0x73,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x68, 0x65, 0x2d, 0x72, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_address_proto_rawDescOnce sync.Once
+	file_rpcpb_address_proto_rawDescData = file_rpcpb_address_proto_rawDesc
+)
+
+func file_rpcpb_address_proto_rawDescGZIP() []byte {
+	file_rpcpb_address_proto_rawDescOnce.Do(func() {
+		file_rpcpb_address_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_address_proto_rawDescData)
+	})
+	return file_rpcpb_address_proto_rawDescData
+}
+
+var file_rpcpb_address_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpcpb_address_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpcpb_address_proto_goTypes = []interface{}{
+	(AddressErrorClass)(0),        // 0: rpcpb.AddressErrorClass
+	(*FormatAddressRequest)(nil),  // 1: rpcpb.FormatAddressRequest
+	(*FormatAddressResponse)(nil), // 2: rpcpb.FormatAddressResponse
+	(*ParseAddressRequest)(nil),   // 3: rpcpb.ParseAddressRequest
+	(*ParseAddressResponse)(nil),  // 4: rpcpb.ParseAddressResponse
+}
+var file_rpcpb_address_proto_depIdxs = []int32{
+	0, // 0: rpcpb.ParseAddressRequest.error_class:type_name -> rpcpb.AddressErrorClass
+	0, // 1: rpcpb.ParseAddressResponse.expected_error_class:type_name -> rpcpb.AddressErrorClass
+	1, // 2: rpcpb.AddressService.FormatAddress:input_type -> rpcpb.FormatAddressRequest
+	3, // 3: rpcpb.AddressService.ParseAddress:input_type -> rpcpb.ParseAddressRequest
+	2, // 4: rpcpb.AddressService.FormatAddress:output_type -> rpcpb.FormatAddressResponse
+	4, // 5: rpcpb.AddressService.ParseAddress:output_type -> rpcpb.ParseAddressResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_address_proto_init() }
+func file_rpcpb_address_proto_init() {
+	if File_rpcpb_address_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_address_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_address_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_address_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_address_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_address_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_address_proto_goTypes,
+		DependencyIndexes: file_rpcpb_address_proto_depIdxs,
+		EnumInfos:         file_rpcpb_address_proto_enumTypes,
+		MessageInfos:      file_rpcpb_address_proto_msgTypes,
+	}.Build()
+	File_rpcpb_address_proto = out.File
+	file_rpcpb_address_proto_rawDesc = nil
+	file_rpcpb_address_proto_goTypes = nil
+	file_rpcpb_address_proto_depIdxs = nil
+}
@@ -0,0 +1,154 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/address.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AddressService_FormatAddress_FullMethodName = "/rpcpb.AddressService/FormatAddress"
+	AddressService_ParseAddress_FullMethodName  = "/rpcpb.AddressService/ParseAddress"
+)
+
+// AddressServiceClient is the client API for AddressService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AddressServiceClient interface {
+	// ref. "address.Format"
+	FormatAddress(ctx context.Context, in *FormatAddressRequest, opts ...grpc.CallOption) (*FormatAddressResponse, error)
+	// Parses an address, or reports the class of the error avalanchego
+	// rejects it with.
+	// ref. "address.Parse", "avax.AddressManager.ParseLocalAddress"
+	ParseAddress(ctx context.Context, in *ParseAddressRequest, opts ...grpc.CallOption) (*ParseAddressResponse, error)
+}
+
+type addressServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAddressServiceClient(cc grpc.ClientConnInterface) AddressServiceClient {
+	return &addressServiceClient{cc}
+}
+
+func (c *addressServiceClient) FormatAddress(ctx context.Context, in *FormatAddressRequest, opts ...grpc.CallOption) (*FormatAddressResponse, error) {
+	out := new(FormatAddressResponse)
+	err := c.cc.Invoke(ctx, AddressService_FormatAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressServiceClient) ParseAddress(ctx context.Context, in *ParseAddressRequest, opts ...grpc.CallOption) (*ParseAddressResponse, error) {
+	out := new(ParseAddressResponse)
+	err := c.cc.Invoke(ctx, AddressService_ParseAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddressServiceServer is the server API for AddressService service.
+// All implementations must embed UnimplementedAddressServiceServer
+// for forward compatibility
+type AddressServiceServer interface {
+	// ref. "address.Format"
+	FormatAddress(context.Context, *FormatAddressRequest) (*FormatAddressResponse, error)
+	// Parses an address, or reports the class of the error avalanchego
+	// rejects it with.
+	// ref. "address.Parse", "avax.AddressManager.ParseLocalAddress"
+	ParseAddress(context.Context, *ParseAddressRequest) (*ParseAddressResponse, error)
+	mustEmbedUnimplementedAddressServiceServer()
+}
+
+// UnimplementedAddressServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAddressServiceServer struct {
+}
+
+func (UnimplementedAddressServiceServer) FormatAddress(context.Context, *FormatAddressRequest) (*FormatAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FormatAddress not implemented")
+}
+func (UnimplementedAddressServiceServer) ParseAddress(context.Context, *ParseAddressRequest) (*ParseAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseAddress not implemented")
+}
+func (UnimplementedAddressServiceServer) mustEmbedUnimplementedAddressServiceServer() {}
+
+// UnsafeAddressServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AddressServiceServer will
+// result in compilation errors.
+type UnsafeAddressServiceServer interface {
+	mustEmbedUnimplementedAddressServiceServer()
+}
+
+func RegisterAddressServiceServer(s grpc.ServiceRegistrar, srv AddressServiceServer) {
+	s.RegisterService(&AddressService_ServiceDesc, srv)
+}
+
+func _AddressService_FormatAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).FormatAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressService_FormatAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).FormatAddress(ctx, req.(*FormatAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressService_ParseAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).ParseAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressService_ParseAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).ParseAddress(ctx, req.(*ParseAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AddressService_ServiceDesc is the grpc.ServiceDesc for AddressService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AddressService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.AddressService",
+	HandlerType: (*AddressServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FormatAddress",
+			Handler:    _AddressService_FormatAddress_Handler,
+		},
+		{
+			MethodName: "ParseAddress",
+			Handler:    _AddressService_ParseAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/address.proto",
+}
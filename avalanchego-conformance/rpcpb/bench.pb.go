@@ -0,0 +1,460 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/bench.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BenchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Op of the messages (as in message transcripts): "app_gossip",
+	// "app_request", "app_response", "put", "push_query" or "ancestors".
+	Op string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	// Size of the random payload of each message: the app bytes, or the
+	// container (a single one for "ancestors").
+	PayloadSize uint32 `protobuf:"varint,2,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	// Number of messages, 1000 if zero. At most 100000, and 1 GiB of payloads.
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Compression of the messages, none if unspecified.
+	CompressionType CompressionType `protobuf:"varint,4,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+}
+
+func (x *BenchRequest) Reset() {
+	*x = BenchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_bench_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchRequest) ProtoMessage() {}
+
+func (x *BenchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_bench_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchRequest.ProtoReflect.Descriptor instead.
+func (*BenchRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_bench_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BenchRequest) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *BenchRequest) GetPayloadSize() uint32 {
+	if x != nil {
+		return x.PayloadSize
+	}
+	return 0
+}
+
+func (x *BenchRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BenchRequest) GetCompressionType() CompressionType {
+	if x != nil {
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
+// BenchStats are the timing statistics of an operation on each message.
+type BenchStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time spent on all messages, in nanoseconds.
+	TotalNs uint64 `protobuf:"varint,1,opt,name=total_ns,json=totalNs,proto3" json:"total_ns,omitempty"`
+	// Time spent per message, in nanoseconds.
+	MinNs  uint64  `protobuf:"varint,2,opt,name=min_ns,json=minNs,proto3" json:"min_ns,omitempty"`
+	MaxNs  uint64  `protobuf:"varint,3,opt,name=max_ns,json=maxNs,proto3" json:"max_ns,omitempty"`
+	MeanNs float64 `protobuf:"fixed64,4,opt,name=mean_ns,json=meanNs,proto3" json:"mean_ns,omitempty"`
+	P50Ns  uint64  `protobuf:"varint,5,opt,name=p50_ns,json=p50Ns,proto3" json:"p50_ns,omitempty"`
+	P90Ns  uint64  `protobuf:"varint,6,opt,name=p90_ns,json=p90Ns,proto3" json:"p90_ns,omitempty"`
+	P99Ns  uint64  `protobuf:"varint,7,opt,name=p99_ns,json=p99Ns,proto3" json:"p99_ns,omitempty"`
+	// Throughput over the total time, of messages and of serialized bytes.
+	MsgsPerSec  float64 `protobuf:"fixed64,8,opt,name=msgs_per_sec,json=msgsPerSec,proto3" json:"msgs_per_sec,omitempty"`
+	BytesPerSec float64 `protobuf:"fixed64,9,opt,name=bytes_per_sec,json=bytesPerSec,proto3" json:"bytes_per_sec,omitempty"`
+}
+
+func (x *BenchStats) Reset() {
+	*x = BenchStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_bench_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchStats) ProtoMessage() {}
+
+func (x *BenchStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_bench_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchStats.ProtoReflect.Descriptor instead.
+func (*BenchStats) Descriptor() ([]byte, []int) {
+	return file_rpcpb_bench_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BenchStats) GetTotalNs() uint64 {
+	if x != nil {
+		return x.TotalNs
+	}
+	return 0
+}
+
+func (x *BenchStats) GetMinNs() uint64 {
+	if x != nil {
+		return x.MinNs
+	}
+	return 0
+}
+
+func (x *BenchStats) GetMaxNs() uint64 {
+	if x != nil {
+		return x.MaxNs
+	}
+	return 0
+}
+
+func (x *BenchStats) GetMeanNs() float64 {
+	if x != nil {
+		return x.MeanNs
+	}
+	return 0
+}
+
+func (x *BenchStats) GetP50Ns() uint64 {
+	if x != nil {
+		return x.P50Ns
+	}
+	return 0
+}
+
+func (x *BenchStats) GetP90Ns() uint64 {
+	if x != nil {
+		return x.P90Ns
+	}
+	return 0
+}
+
+func (x *BenchStats) GetP99Ns() uint64 {
+	if x != nil {
+		return x.P99Ns
+	}
+	return 0
+}
+
+func (x *BenchStats) GetMsgsPerSec() float64 {
+	if x != nil {
+		return x.MsgsPerSec
+	}
+	return 0
+}
+
+func (x *BenchStats) GetBytesPerSec() float64 {
+	if x != nil {
+		return x.BytesPerSec
+	}
+	return 0
+}
+
+type BenchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op    string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Size of each serialized message, without the length prefix.
+	SerializedSize uint32 `protobuf:"varint,3,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
+	// Building of each message by the message creator of avalanchego (i.e.,
+	// encoding and compression).
+	Serialize *BenchStats `protobuf:"bytes,4,opt,name=serialize,proto3" json:"serialize,omitempty"`
+	// Parsing of each serialized message, as an inbound message (i.e.,
+	// decompression and decoding).
+	Parse *BenchStats `protobuf:"bytes,5,opt,name=parse,proto3" json:"parse,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BenchResponse) Reset() {
+	*x = BenchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_bench_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchResponse) ProtoMessage() {}
+
+func (x *BenchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_bench_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchResponse.ProtoReflect.Descriptor instead.
+func (*BenchResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_bench_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BenchResponse) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *BenchResponse) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BenchResponse) GetSerializedSize() uint32 {
+	if x != nil {
+		return x.SerializedSize
+	}
+	return 0
+}
+
+func (x *BenchResponse) GetSerialize() *BenchStats {
+	if x != nil {
+		return x.Serialize
+	}
+	return nil
+}
+
+func (x *BenchResponse) GetParse() *BenchStats {
+	if x != nil {
+		return x.Parse
+	}
+	return nil
+}
+
+func (x *BenchResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_bench_proto protoreflect.FileDescriptor
+
+var file_rpcpb_bench_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x1a, 0x13, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x9a, 0x01, 0x0a, 0x0c, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf9, 0x01, 0x0a,
+	0x0a, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x5f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x4e, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6d,
+	0x61, 0x78, 0x4e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x65, 0x61, 0x6e, 0x4e, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x70, 0x35, 0x30, 0x5f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70,
+	0x35, 0x30, 0x4e, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x39, 0x30, 0x5f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x39, 0x30, 0x4e, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x70,
+	0x39, 0x39, 0x5f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x39, 0x39,
+	0x4e, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x73, 0x50, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x22, 0xe6, 0x01, 0x0a, 0x0d, 0x42, 0x65, 0x6e,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x09, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x61,
+	0x72, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x70, 0x61,
+	0x72, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x32, 0x44, 0x0a, 0x0c, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x34, 0x0a, 0x05, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61,
+	0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_rpcpb_bench_proto_rawDescOnce sync.Once
+	file_rpcpb_bench_proto_rawDescData = file_rpcpb_bench_proto_rawDesc
+)
+
+func file_rpcpb_bench_proto_rawDescGZIP() []byte {
+	file_rpcpb_bench_proto_rawDescOnce.Do(func() {
+		file_rpcpb_bench_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_bench_proto_rawDescData)
+	})
+	return file_rpcpb_bench_proto_rawDescData
+}
+
+var file_rpcpb_bench_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpcpb_bench_proto_goTypes = []interface{}{
+	(*BenchRequest)(nil),  // 0: rpcpb.BenchRequest
+	(*BenchStats)(nil),    // 1: rpcpb.BenchStats
+	(*BenchResponse)(nil), // 2: rpcpb.BenchResponse
+	(CompressionType)(0),  // 3: rpcpb.CompressionType
+}
+var file_rpcpb_bench_proto_depIdxs = []int32{
+	3, // 0: rpcpb.BenchRequest.compression_type:type_name -> rpcpb.CompressionType
+	1, // 1: rpcpb.BenchResponse.serialize:type_name -> rpcpb.BenchStats
+	1, // 2: rpcpb.BenchResponse.parse:type_name -> rpcpb.BenchStats
+	0, // 3: rpcpb.BenchService.Bench:input_type -> rpcpb.BenchRequest
+	2, // 4: rpcpb.BenchService.Bench:output_type -> rpcpb.BenchResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_bench_proto_init() }
+func file_rpcpb_bench_proto_init() {
+	if File_rpcpb_bench_proto != nil {
+		return
+	}
+	file_rpcpb_message_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_bench_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_bench_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_bench_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_bench_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_bench_proto_goTypes,
+		DependencyIndexes: file_rpcpb_bench_proto_depIdxs,
+		MessageInfos:      file_rpcpb_bench_proto_msgTypes,
+	}.Build()
+	File_rpcpb_bench_proto = out.File
+	file_rpcpb_bench_proto_rawDesc = nil
+	file_rpcpb_bench_proto_goTypes = nil
+	file_rpcpb_bench_proto_depIdxs = nil
+}
@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/bench.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BenchService_Bench_FullMethodName = "/rpcpb.BenchService/Bench"
+)
+
+// BenchServiceClient is the client API for BenchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BenchServiceClient interface {
+	// Bench serializes and parses messages of the op and payload size, and
+	// returns timing statistics of both.
+	Bench(ctx context.Context, in *BenchRequest, opts ...grpc.CallOption) (*BenchResponse, error)
+}
+
+type benchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBenchServiceClient(cc grpc.ClientConnInterface) BenchServiceClient {
+	return &benchServiceClient{cc}
+}
+
+func (c *benchServiceClient) Bench(ctx context.Context, in *BenchRequest, opts ...grpc.CallOption) (*BenchResponse, error) {
+	out := new(BenchResponse)
+	err := c.cc.Invoke(ctx, BenchService_Bench_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BenchServiceServer is the server API for BenchService service.
+// All implementations must embed UnimplementedBenchServiceServer
+// for forward compatibility
+type BenchServiceServer interface {
+	// Bench serializes and parses messages of the op and payload size, and
+	// returns timing statistics of both.
+	Bench(context.Context, *BenchRequest) (*BenchResponse, error)
+	mustEmbedUnimplementedBenchServiceServer()
+}
+
+// UnimplementedBenchServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBenchServiceServer struct {
+}
+
+func (UnimplementedBenchServiceServer) Bench(context.Context, *BenchRequest) (*BenchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bench not implemented")
+}
+func (UnimplementedBenchServiceServer) mustEmbedUnimplementedBenchServiceServer() {}
+
+// UnsafeBenchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BenchServiceServer will
+// result in compilation errors.
+type UnsafeBenchServiceServer interface {
+	mustEmbedUnimplementedBenchServiceServer()
+}
+
+func RegisterBenchServiceServer(s grpc.ServiceRegistrar, srv BenchServiceServer) {
+	s.RegisterService(&BenchService_ServiceDesc, srv)
+}
+
+func _BenchService_Bench_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BenchServiceServer).Bench(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BenchService_Bench_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BenchServiceServer).Bench(ctx, req.(*BenchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BenchService_ServiceDesc is the grpc.ServiceDesc for BenchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BenchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.BenchService",
+	HandlerType: (*BenchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Bench",
+			Handler:    _BenchService_Bench_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/bench.proto",
+}
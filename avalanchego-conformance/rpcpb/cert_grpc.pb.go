@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/cert.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CertService_StakingCertificate_FullMethodName = "/rpcpb.CertService/StakingCertificate"
+)
+
+// CertServiceClient is the client API for CertService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CertServiceClient interface {
+	// Parses a staking certificate (and key), derives its node ID, and reports
+	// the reasons a peer would refuse it.
+	// ref. "staking.LoadTLSCertFromBytes", "peer.TLSConfig", "ids.NodeIDFromCert"
+	StakingCertificate(ctx context.Context, in *StakingCertificateRequest, opts ...grpc.CallOption) (*StakingCertificateResponse, error)
+}
+
+type certServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCertServiceClient(cc grpc.ClientConnInterface) CertServiceClient {
+	return &certServiceClient{cc}
+}
+
+func (c *certServiceClient) StakingCertificate(ctx context.Context, in *StakingCertificateRequest, opts ...grpc.CallOption) (*StakingCertificateResponse, error) {
+	out := new(StakingCertificateResponse)
+	err := c.cc.Invoke(ctx, CertService_StakingCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CertServiceServer is the server API for CertService service.
+// All implementations must embed UnimplementedCertServiceServer
+// for forward compatibility
+type CertServiceServer interface {
+	// Parses a staking certificate (and key), derives its node ID, and reports
+	// the reasons a peer would refuse it.
+	// ref. "staking.LoadTLSCertFromBytes", "peer.TLSConfig", "ids.NodeIDFromCert"
+	StakingCertificate(context.Context, *StakingCertificateRequest) (*StakingCertificateResponse, error)
+	mustEmbedUnimplementedCertServiceServer()
+}
+
+// UnimplementedCertServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCertServiceServer struct {
+}
+
+func (UnimplementedCertServiceServer) StakingCertificate(context.Context, *StakingCertificateRequest) (*StakingCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingCertificate not implemented")
+}
+func (UnimplementedCertServiceServer) mustEmbedUnimplementedCertServiceServer() {}
+
+// UnsafeCertServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CertServiceServer will
+// result in compilation errors.
+type UnsafeCertServiceServer interface {
+	mustEmbedUnimplementedCertServiceServer()
+}
+
+func RegisterCertServiceServer(s grpc.ServiceRegistrar, srv CertServiceServer) {
+	s.RegisterService(&CertService_ServiceDesc, srv)
+}
+
+func _CertService_StakingCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StakingCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertServiceServer).StakingCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertService_StakingCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertServiceServer).StakingCertificate(ctx, req.(*StakingCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CertService_ServiceDesc is the grpc.ServiceDesc for CertService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CertService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.CertService",
+	HandlerType: (*CertServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StakingCertificate",
+			Handler:    _CertService_StakingCertificate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/cert.proto",
+}
@@ -0,0 +1,1065 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/codec.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CodecPrimitive int32
+
+const (
+	CodecPrimitive_CODEC_PRIMITIVE_UNSPECIFIED CodecPrimitive = 0
+	CodecPrimitive_CODEC_PRIMITIVE_BOOL        CodecPrimitive = 1
+	CodecPrimitive_CODEC_PRIMITIVE_UINT8       CodecPrimitive = 2
+	CodecPrimitive_CODEC_PRIMITIVE_UINT16      CodecPrimitive = 3
+	CodecPrimitive_CODEC_PRIMITIVE_UINT32      CodecPrimitive = 4
+	CodecPrimitive_CODEC_PRIMITIVE_UINT64      CodecPrimitive = 5
+	CodecPrimitive_CODEC_PRIMITIVE_INT8        CodecPrimitive = 6
+	CodecPrimitive_CODEC_PRIMITIVE_INT16       CodecPrimitive = 7
+	CodecPrimitive_CODEC_PRIMITIVE_INT32       CodecPrimitive = 8
+	CodecPrimitive_CODEC_PRIMITIVE_INT64       CodecPrimitive = 9
+	CodecPrimitive_CODEC_PRIMITIVE_STRING      CodecPrimitive = 10
+	// Variable-length "[]byte".
+	CodecPrimitive_CODEC_PRIMITIVE_BYTES CodecPrimitive = 11
+)
+
+// Enum value maps for CodecPrimitive.
+var (
+	CodecPrimitive_name = map[int32]string{
+		0:  "CODEC_PRIMITIVE_UNSPECIFIED",
+		1:  "CODEC_PRIMITIVE_BOOL",
+		2:  "CODEC_PRIMITIVE_UINT8",
+		3:  "CODEC_PRIMITIVE_UINT16",
+		4:  "CODEC_PRIMITIVE_UINT32",
+		5:  "CODEC_PRIMITIVE_UINT64",
+		6:  "CODEC_PRIMITIVE_INT8",
+		7:  "CODEC_PRIMITIVE_INT16",
+		8:  "CODEC_PRIMITIVE_INT32",
+		9:  "CODEC_PRIMITIVE_INT64",
+		10: "CODEC_PRIMITIVE_STRING",
+		11: "CODEC_PRIMITIVE_BYTES",
+	}
+	CodecPrimitive_value = map[string]int32{
+		"CODEC_PRIMITIVE_UNSPECIFIED": 0,
+		"CODEC_PRIMITIVE_BOOL":        1,
+		"CODEC_PRIMITIVE_UINT8":       2,
+		"CODEC_PRIMITIVE_UINT16":      3,
+		"CODEC_PRIMITIVE_UINT32":      4,
+		"CODEC_PRIMITIVE_UINT64":      5,
+		"CODEC_PRIMITIVE_INT8":        6,
+		"CODEC_PRIMITIVE_INT16":       7,
+		"CODEC_PRIMITIVE_INT32":       8,
+		"CODEC_PRIMITIVE_INT64":       9,
+		"CODEC_PRIMITIVE_STRING":      10,
+		"CODEC_PRIMITIVE_BYTES":       11,
+	}
+)
+
+func (x CodecPrimitive) Enum() *CodecPrimitive {
+	p := new(CodecPrimitive)
+	*p = x
+	return p
+}
+
+func (x CodecPrimitive) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CodecPrimitive) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_codec_proto_enumTypes[0].Descriptor()
+}
+
+func (CodecPrimitive) Type() protoreflect.EnumType {
+	return &file_rpcpb_codec_proto_enumTypes[0]
+}
+
+func (x CodecPrimitive) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CodecPrimitive.Descriptor instead.
+func (CodecPrimitive) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_codec_proto_rawDescGZIP(), []int{0}
+}
+
+// CodecType describes a Go type packed by the codec.
+type CodecType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//
+	//	*CodecType_Primitive
+	//	*CodecType_FixedBytesLen
+	//	*CodecType_SliceOf
+	//	*CodecType_StructType
+	//	*CodecType_Interface
+	Kind isCodecType_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *CodecType) Reset() {
+	*x = CodecType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_codec_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodecType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodecType) ProtoMessage() {}
+
+func (x *CodecType) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_codec_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodecType.ProtoReflect.Descriptor instead.
+func (*CodecType) Descriptor() ([]byte, []int) {
+	return file_rpcpb_codec_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *CodecType) GetKind() isCodecType_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *CodecType) GetPrimitive() CodecPrimitive {
+	if x, ok := x.GetKind().(*CodecType_Primitive); ok {
+		return x.Primitive
+	}
+	return CodecPrimitive_CODEC_PRIMITIVE_UNSPECIFIED
+}
+
+func (x *CodecType) GetFixedBytesLen() uint32 {
+	if x, ok := x.GetKind().(*CodecType_FixedBytesLen); ok {
+		return x.FixedBytesLen
+	}
+	return 0
+}
+
+func (x *CodecType) GetSliceOf() *CodecType {
+	if x, ok := x.GetKind().(*CodecType_SliceOf); ok {
+		return x.SliceOf
+	}
+	return nil
+}
+
+func (x *CodecType) GetStructType() *CodecStructType {
+	if x, ok := x.GetKind().(*CodecType_StructType); ok {
+		return x.StructType
+	}
+	return nil
+}
+
+func (x *CodecType) GetInterface() bool {
+	if x, ok := x.GetKind().(*CodecType_Interface); ok {
+		return x.Interface
+	}
+	return false
+}
+
+type isCodecType_Kind interface {
+	isCodecType_Kind()
+}
+
+type CodecType_Primitive struct {
+	Primitive CodecPrimitive `protobuf:"varint,1,opt,name=primitive,proto3,enum=rpcpb.CodecPrimitive,oneof"`
+}
+
+type CodecType_FixedBytesLen struct {
+	// Length of a "[N]byte" array.
+	FixedBytesLen uint32 `protobuf:"varint,2,opt,name=fixed_bytes_len,json=fixedBytesLen,proto3,oneof"`
+}
+
+type CodecType_SliceOf struct {
+	// Element type of a slice.
+	SliceOf *CodecType `protobuf:"bytes,3,opt,name=slice_of,json=sliceOf,proto3,oneof"`
+}
+
+type CodecType_StructType struct {
+	StructType *CodecStructType `protobuf:"bytes,4,opt,name=struct_type,json=structType,proto3,oneof"`
+}
+
+type CodecType_Interface struct {
+	// Interface whose values are of registered types, packed with their
+	// type ID.
+	Interface bool `protobuf:"varint,5,opt,name=interface,proto3,oneof"`
+}
+
+func (*CodecType_Primitive) isCodecType_Kind() {}
+
+func (*CodecType_FixedBytesLen) isCodecType_Kind() {}
+
+func (*CodecType_SliceOf) isCodecType_Kind() {}
+
+func (*CodecType_StructType) isCodecType_Kind() {}
+
+func (*CodecType_Interface) isCodecType_Kind() {}
+
+// CodecStructType lists the serialized fields of a struct, in order.
+type CodecStructType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*CodecType `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *CodecStructType) Reset() {
+	*x = CodecStructType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_codec_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodecStructType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodecStructType) ProtoMessage() {}
+
+func (x *CodecStructType) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_codec_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodecStructType.ProtoReflect.Descriptor instead.
+func (*CodecStructType) Descriptor() ([]byte, []int) {
+	return file_rpcpb_codec_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CodecStructType) GetFields() []*CodecType {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// CodecRegisteredType is a struct type registered with the codec, as a
+// pointer (e.g., "&secp256k1fx.TransferOutput{}").
+type CodecRegisteredType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type IDs skipped before registering this type.
+	// ref. "linearcodec.Codec.SkipRegistrations"
+	Skip       uint32           `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	StructType *CodecStructType `protobuf:"bytes,2,opt,name=struct_type,json=structType,proto3" json:"struct_type,omitempty"`
+}
+
+func (x *CodecRegisteredType) Reset() {
+	*x = CodecRegisteredType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_codec_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodecRegisteredType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodecRegisteredType) ProtoMessage() {}
+
+func (x *CodecRegisteredType) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_codec_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodecRegisteredType.ProtoReflect.Descriptor instead.
+func (*CodecRegisteredType) Descriptor() ([]byte, []int) {
+	return file_rpcpb_codec_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CodecRegisteredType) GetSkip() uint32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *CodecRegisteredType) GetStructType() *CodecStructType {
+	if x != nil {
+		return x.StructType
+	}
+	return nil
+}
+
+// CodecValue is a value of a CodecType.
+type CodecValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//
+	//	*CodecValue_BoolValue
+	//	*CodecValue_UintValue
+	//	*CodecValue_IntValue
+	//	*CodecValue_StringValue
+	//	*CodecValue_BytesValue
+	//	*CodecValue_Values
+	//	*CodecValue_InterfaceValue
+	Value isCodecValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *CodecValue) Reset() {
+	*x = CodecValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_codec_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodecValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodecValue) ProtoMessage() {}
+
+func (x *CodecValue) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_codec_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodecValue.ProtoReflect.Descriptor instead.
+func (*CodecValue) Descriptor() ([]byte, []int) {
+	return file_rpcpb_codec_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *CodecValue) GetValue() isCodecValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *CodecValue) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*CodecValue_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *CodecValue) GetUintValue() uint64 {
+	if x, ok := x.GetValue().(*CodecValue_UintValue); ok {
+		return x.UintValue
+	}
+	return 0
+}
+
+func (x *CodecValue) GetIntValue() int64 {
+	if x, ok := x.GetValue().(*CodecValue_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (x *CodecValue) GetStringValue() string {
+	if x, ok := x.GetValue().(*CodecValue_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *CodecValue) GetBytesValue() []byte {
+	if x, ok := x.GetValue().(*CodecValue_BytesValue); ok {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *CodecValue) GetValues() *CodecValues {
+	if x, ok := x.GetValue().(*CodecValue_Values); ok {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *CodecValue) GetInterfaceValue() *CodecInterfaceValue {
+	if x, ok := x.GetValue().(*CodecValue_InterfaceValue); ok {
+		return x.InterfaceValue
+	}
+	return nil
+}
+
+type isCodecValue_Value interface {
+	isCodecValue_Value()
+}
+
+type CodecValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,1,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type CodecValue_UintValue struct {
+	// Value of unsigned integers.
+	UintValue uint64 `protobuf:"varint,2,opt,name=uint_value,json=uintValue,proto3,oneof"`
+}
+
+type CodecValue_IntValue struct {
+	// Value of signed integers.
+	IntValue int64 `protobuf:"zigzag64,3,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type CodecValue_StringValue struct {
+	StringValue string `protobuf:"bytes,4,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type CodecValue_BytesValue struct {
+	// Value of variable and fixed-length bytes.
+	BytesValue []byte `protobuf:"bytes,5,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+type CodecValue_Values struct {
+	// Elements of a slice, or fields of a struct.
+	Values *CodecValues `protobuf:"bytes,6,opt,name=values,proto3,oneof"`
+}
+
+type CodecValue_InterfaceValue struct {
+	InterfaceValue *CodecInterfaceValue `protobuf:"bytes,7,opt,name=interface_value,json=interfaceValue,proto3,oneof"`
+}
+
+func (*CodecValue_BoolValue) isCodecValue_Value() {}
+
+func (*CodecValue_UintValue) isCodecValue_Value() {}
+
+func (*CodecValue_IntValue) isCodecValue_Value() {}
+
+func (*CodecValue_StringValue) isCodecValue_Value() {}
+
+func (*CodecValue_BytesValue) isCodecValue_Value() {}
+
+func (*CodecValue_Values) isCodecValue_Value() {}
+
+func (*CodecValue_InterfaceValue) isCodecValue_Value() {}
+
+type CodecValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*CodecValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *CodecValues) Reset() {
+	*x = CodecValues{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_codec_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodecValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodecValues) ProtoMessage() {}
+
+func (x *CodecValues) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_codec_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodecValues.ProtoReflect.Descriptor instead.
+func (*CodecValues) Descriptor() ([]byte, []int) {
+	return file_rpcpb_codec_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CodecValues) GetValues() []*CodecValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type CodecInterfaceValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type ID of the registered type of the value.
+	TypeId uint32       `protobuf:"varint,1,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	Fields *CodecValues `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *CodecInterfaceValue) Reset() {
+	*x = CodecInterfaceValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_codec_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodecInterfaceValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodecInterfaceValue) ProtoMessage() {}
+
+func (x *CodecInterfaceValue) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_codec_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodecInterfaceValue.ProtoReflect.Descriptor instead.
+func (*CodecInterfaceValue) Descriptor() ([]byte, []int) {
+	return file_rpcpb_codec_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CodecInterfaceValue) GetTypeId() uint32 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *CodecInterfaceValue) GetFields() *CodecValues {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type PackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CodecVersion uint32 `protobuf:"varint,1,opt,name=codec_version,json=codecVersion,proto3" json:"codec_version,omitempty"`
+	// Types registered in order, assigned consecutive type IDs from 0.
+	RegisteredTypes []*CodecRegisteredType `protobuf:"bytes,2,rep,name=registered_types,json=registeredTypes,proto3" json:"registered_types,omitempty"`
+	Type            *CodecType             `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Value           *CodecValue            `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// Packed bytes, prefixed with the codec version.
+	PackedBytes []byte `protobuf:"bytes,5,opt,name=packed_bytes,json=packedBytes,proto3" json:"packed_bytes,omitempty"`
+}
+
+func (x *PackRequest) Reset() {
+	*x = PackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_codec_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackRequest) ProtoMessage() {}
+
+func (x *PackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_codec_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackRequest.ProtoReflect.Descriptor instead.
+func (*PackRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_codec_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PackRequest) GetCodecVersion() uint32 {
+	if x != nil {
+		return x.CodecVersion
+	}
+	return 0
+}
+
+func (x *PackRequest) GetRegisteredTypes() []*CodecRegisteredType {
+	if x != nil {
+		return x.RegisteredTypes
+	}
+	return nil
+}
+
+func (x *PackRequest) GetType() *CodecType {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *PackRequest) GetValue() *CodecValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *PackRequest) GetPackedBytes() []byte {
+	if x != nil {
+		return x.PackedBytes
+	}
+	return nil
+}
+
+type PackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedPackedBytes []byte `protobuf:"bytes,1,opt,name=expected_packed_bytes,json=expectedPackedBytes,proto3" json:"expected_packed_bytes,omitempty"`
+	Message             string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success             bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *PackResponse) Reset() {
+	*x = PackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_codec_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackResponse) ProtoMessage() {}
+
+func (x *PackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_codec_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackResponse.ProtoReflect.Descriptor instead.
+func (*PackResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_codec_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PackResponse) GetExpectedPackedBytes() []byte {
+	if x != nil {
+		return x.ExpectedPackedBytes
+	}
+	return nil
+}
+
+func (x *PackResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PackResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PackResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *PackResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_codec_proto protoreflect.FileDescriptor
+
+var file_rpcpb_codec_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x1a, 0x10, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2f, 0x64, 0x69, 0x66, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe, 0x01, 0x0a,
+	0x09, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x72,
+	0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x50, 0x72, 0x69, 0x6d, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x48, 0x00, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0d, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x65, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x73,
+	0x6c, 0x69, 0x63, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x54, 0x79, 0x70, 0x65, 0x48,
+	0x00, 0x52, 0x07, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x4f, 0x66, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x3b, 0x0a,
+	0x0f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x28, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x13, 0x43, 0x6f,
+	0x64, 0x65, 0x63, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x37, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb3,
+	0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f,
+	0x0a, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x00, 0x52, 0x09, 0x75, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x12, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23,
+	0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x64, 0x65, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x48, 0x00, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x38, 0x0a, 0x0b, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x64, 0x65,
+	0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x5a,
+	0x0a, 0x13, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x0b, 0x50,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x64, 0x65, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x45, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x64,
+	0x65, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x2a, 0xdc, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x50, 0x52, 0x49,
+	0x4d, 0x49, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x50, 0x52,
+	0x49, 0x4d, 0x49, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x19,
+	0x0a, 0x15, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x49, 0x54, 0x49, 0x56,
+	0x45, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x38, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x44,
+	0x45, 0x43, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x49, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x55, 0x49, 0x4e,
+	0x54, 0x31, 0x36, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x50,
+	0x52, 0x49, 0x4d, 0x49, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10,
+	0x04, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x49,
+	0x54, 0x49, 0x56, 0x45, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x05, 0x12, 0x18, 0x0a,
+	0x14, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x49, 0x54, 0x49, 0x56, 0x45,
+	0x5f, 0x49, 0x4e, 0x54, 0x38, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x44, 0x45, 0x43,
+	0x5f, 0x50, 0x52, 0x49, 0x4d, 0x49, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x31, 0x36,
+	0x10, 0x07, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x50, 0x52, 0x49, 0x4d,
+	0x49, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x08, 0x12, 0x19, 0x0a,
+	0x15, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x49, 0x54, 0x49, 0x56, 0x45,
+	0x5f, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x09, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x44, 0x45,
+	0x43, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x49, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49,
+	0x4e, 0x47, 0x10, 0x0a, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x5f, 0x50, 0x52,
+	0x49, 0x4d, 0x49, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x0b, 0x32,
+	0x41, 0x0a, 0x0c, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x31, 0x0a, 0x04, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x68, 0x65, 0x2d, 0x72, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_codec_proto_rawDescOnce sync.Once
+	file_rpcpb_codec_proto_rawDescData = file_rpcpb_codec_proto_rawDesc
+)
+
+func file_rpcpb_codec_proto_rawDescGZIP() []byte {
+	file_rpcpb_codec_proto_rawDescOnce.Do(func() {
+		file_rpcpb_codec_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_codec_proto_rawDescData)
+	})
+	return file_rpcpb_codec_proto_rawDescData
+}
+
+var file_rpcpb_codec_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpcpb_codec_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_rpcpb_codec_proto_goTypes = []interface{}{
+	(CodecPrimitive)(0),         // 0: rpcpb.CodecPrimitive
+	(*CodecType)(nil),           // 1: rpcpb.CodecType
+	(*CodecStructType)(nil),     // 2: rpcpb.CodecStructType
+	(*CodecRegisteredType)(nil), // 3: rpcpb.CodecRegisteredType
+	(*CodecValue)(nil),          // 4: rpcpb.CodecValue
+	(*CodecValues)(nil),         // 5: rpcpb.CodecValues
+	(*CodecInterfaceValue)(nil), // 6: rpcpb.CodecInterfaceValue
+	(*PackRequest)(nil),         // 7: rpcpb.PackRequest
+	(*PackResponse)(nil),        // 8: rpcpb.PackResponse
+	(*Diff)(nil),                // 9: rpcpb.Diff
+}
+var file_rpcpb_codec_proto_depIdxs = []int32{
+	0,  // 0: rpcpb.CodecType.primitive:type_name -> rpcpb.CodecPrimitive
+	1,  // 1: rpcpb.CodecType.slice_of:type_name -> rpcpb.CodecType
+	2,  // 2: rpcpb.CodecType.struct_type:type_name -> rpcpb.CodecStructType
+	1,  // 3: rpcpb.CodecStructType.fields:type_name -> rpcpb.CodecType
+	2,  // 4: rpcpb.CodecRegisteredType.struct_type:type_name -> rpcpb.CodecStructType
+	5,  // 5: rpcpb.CodecValue.values:type_name -> rpcpb.CodecValues
+	6,  // 6: rpcpb.CodecValue.interface_value:type_name -> rpcpb.CodecInterfaceValue
+	4,  // 7: rpcpb.CodecValues.values:type_name -> rpcpb.CodecValue
+	5,  // 8: rpcpb.CodecInterfaceValue.fields:type_name -> rpcpb.CodecValues
+	3,  // 9: rpcpb.PackRequest.registered_types:type_name -> rpcpb.CodecRegisteredType
+	1,  // 10: rpcpb.PackRequest.type:type_name -> rpcpb.CodecType
+	4,  // 11: rpcpb.PackRequest.value:type_name -> rpcpb.CodecValue
+	9,  // 12: rpcpb.PackResponse.diff:type_name -> rpcpb.Diff
+	7,  // 13: rpcpb.CodecService.Pack:input_type -> rpcpb.PackRequest
+	8,  // 14: rpcpb.CodecService.Pack:output_type -> rpcpb.PackResponse
+	14, // [14:15] is the sub-list for method output_type
+	13, // [13:14] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_codec_proto_init() }
+func file_rpcpb_codec_proto_init() {
+	if File_rpcpb_codec_proto != nil {
+		return
+	}
+	file_rpcpb_diff_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_codec_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodecType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_codec_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodecStructType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_codec_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodecRegisteredType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_codec_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodecValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_codec_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodecValues); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_codec_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodecInterfaceValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_codec_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_codec_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpcpb_codec_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*CodecType_Primitive)(nil),
+		(*CodecType_FixedBytesLen)(nil),
+		(*CodecType_SliceOf)(nil),
+		(*CodecType_StructType)(nil),
+		(*CodecType_Interface)(nil),
+	}
+	file_rpcpb_codec_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*CodecValue_BoolValue)(nil),
+		(*CodecValue_UintValue)(nil),
+		(*CodecValue_IntValue)(nil),
+		(*CodecValue_StringValue)(nil),
+		(*CodecValue_BytesValue)(nil),
+		(*CodecValue_Values)(nil),
+		(*CodecValue_InterfaceValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_codec_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_codec_proto_goTypes,
+		DependencyIndexes: file_rpcpb_codec_proto_depIdxs,
+		EnumInfos:         file_rpcpb_codec_proto_enumTypes,
+		MessageInfos:      file_rpcpb_codec_proto_msgTypes,
+	}.Build()
+	File_rpcpb_codec_proto = out.File
+	file_rpcpb_codec_proto_rawDesc = nil
+	file_rpcpb_codec_proto_goTypes = nil
+	file_rpcpb_codec_proto_depIdxs = nil
+}
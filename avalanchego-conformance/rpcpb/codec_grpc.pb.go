@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/codec.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CodecService_Pack_FullMethodName = "/rpcpb.CodecService/Pack"
+)
+
+// CodecServiceClient is the client API for CodecService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CodecServiceClient interface {
+	// Packs a value of the described type with avalanchego "linearcodec".
+	Pack(ctx context.Context, in *PackRequest, opts ...grpc.CallOption) (*PackResponse, error)
+}
+
+type codecServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCodecServiceClient(cc grpc.ClientConnInterface) CodecServiceClient {
+	return &codecServiceClient{cc}
+}
+
+func (c *codecServiceClient) Pack(ctx context.Context, in *PackRequest, opts ...grpc.CallOption) (*PackResponse, error) {
+	out := new(PackResponse)
+	err := c.cc.Invoke(ctx, CodecService_Pack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CodecServiceServer is the server API for CodecService service.
+// All implementations must embed UnimplementedCodecServiceServer
+// for forward compatibility
+type CodecServiceServer interface {
+	// Packs a value of the described type with avalanchego "linearcodec".
+	Pack(context.Context, *PackRequest) (*PackResponse, error)
+	mustEmbedUnimplementedCodecServiceServer()
+}
+
+// UnimplementedCodecServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCodecServiceServer struct {
+}
+
+func (UnimplementedCodecServiceServer) Pack(context.Context, *PackRequest) (*PackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pack not implemented")
+}
+func (UnimplementedCodecServiceServer) mustEmbedUnimplementedCodecServiceServer() {}
+
+// UnsafeCodecServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CodecServiceServer will
+// result in compilation errors.
+type UnsafeCodecServiceServer interface {
+	mustEmbedUnimplementedCodecServiceServer()
+}
+
+func RegisterCodecServiceServer(s grpc.ServiceRegistrar, srv CodecServiceServer) {
+	s.RegisterService(&CodecService_ServiceDesc, srv)
+}
+
+func _CodecService_Pack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodecServiceServer).Pack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodecService_Pack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodecServiceServer).Pack(ctx, req.(*PackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CodecService_ServiceDesc is the grpc.ServiceDesc for CodecService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CodecService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.CodecService",
+	HandlerType: (*CodecServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pack",
+			Handler:    _CodecService_Pack_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/codec.proto",
+}
@@ -0,0 +1,737 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/config.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubnetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Contents of a subnet config file (e.g., "[subnet-config-dir]/[subnetID].json").
+	ConfigFile []byte `protobuf:"bytes,1,opt,name=config_file,json=configFile,proto3" json:"config_file,omitempty"`
+	// Optional. Resolved config (with defaults filled in) as the caller
+	// understands it, to be semantically compared with the avalanchego one.
+	ResolvedJson string `protobuf:"bytes,2,opt,name=resolved_json,json=resolvedJson,proto3" json:"resolved_json,omitempty"`
+}
+
+func (x *SubnetConfigRequest) Reset() {
+	*x = SubnetConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubnetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetConfigRequest) ProtoMessage() {}
+
+func (x *SubnetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubnetConfigRequest.ProtoReflect.Descriptor instead.
+func (*SubnetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubnetConfigRequest) GetConfigFile() []byte {
+	if x != nil {
+		return x.ConfigFile
+	}
+	return nil
+}
+
+func (x *SubnetConfigRequest) GetResolvedJson() string {
+	if x != nil {
+		return x.ResolvedJson
+	}
+	return ""
+}
+
+type SubnetConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Config resolved by avalanchego, with defaults filled in.
+	ExpectedResolvedJson string `protobuf:"bytes,1,opt,name=expected_resolved_json,json=expectedResolvedJson,proto3" json:"expected_resolved_json,omitempty"`
+	// Keys in the config file that avalanchego silently ignores.
+	UnknownKeys []string `protobuf:"bytes,2,rep,name=unknown_keys,json=unknownKeys,proto3" json:"unknown_keys,omitempty"`
+	Message     string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success     bool     `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *SubnetConfigResponse) Reset() {
+	*x = SubnetConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubnetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetConfigResponse) ProtoMessage() {}
+
+func (x *SubnetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubnetConfigResponse.ProtoReflect.Descriptor instead.
+func (*SubnetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubnetConfigResponse) GetExpectedResolvedJson() string {
+	if x != nil {
+		return x.ExpectedResolvedJson
+	}
+	return ""
+}
+
+func (x *SubnetConfigResponse) GetUnknownKeys() []string {
+	if x != nil {
+		return x.UnknownKeys
+	}
+	return nil
+}
+
+func (x *SubnetConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubnetConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SubnetConfigResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type ChainConfigContentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JSON map from chain ID or alias to its config and upgrade blobs, as
+	// passed (base64-encoded) via "--chain-config-content".
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *ChainConfigContentRequest) Reset() {
+	*x = ChainConfigContentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainConfigContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainConfigContentRequest) ProtoMessage() {}
+
+func (x *ChainConfigContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainConfigContentRequest.ProtoReflect.Descriptor instead.
+func (*ChainConfigContentRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChainConfigContentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ChainConfigContentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Chain IDs or aliases parsed from the content.
+	Chains []string `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+	// Keys in the content that avalanchego silently ignores.
+	UnknownKeys []string `protobuf:"bytes,2,rep,name=unknown_keys,json=unknownKeys,proto3" json:"unknown_keys,omitempty"`
+	Message     string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success     bool     `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *ChainConfigContentResponse) Reset() {
+	*x = ChainConfigContentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainConfigContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainConfigContentResponse) ProtoMessage() {}
+
+func (x *ChainConfigContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainConfigContentResponse.ProtoReflect.Descriptor instead.
+func (*ChainConfigContentResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChainConfigContentResponse) GetChains() []string {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+func (x *ChainConfigContentResponse) GetUnknownKeys() []string {
+	if x != nil {
+		return x.UnknownKeys
+	}
+	return nil
+}
+
+func (x *ChainConfigContentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChainConfigContentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ChainConfigContentResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BootstrapBeaconsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Comma-separated IPs as passed via "--bootstrap-ips"
+	// (e.g., "1.2.3.4:9651,[2001:db8::1]:9651").
+	BootstrapIps string `protobuf:"bytes,1,opt,name=bootstrap_ips,json=bootstrapIps,proto3" json:"bootstrap_ips,omitempty"`
+	// Comma-separated node IDs as passed via "--bootstrap-ids"
+	// (e.g., "NodeID-...,NodeID-...").
+	BootstrapIds string `protobuf:"bytes,2,opt,name=bootstrap_ids,json=bootstrapIds,proto3" json:"bootstrap_ids,omitempty"`
+	// Beacons the flag values were generated from, in the same order.
+	Beacons []*BootstrapBeacon `protobuf:"bytes,3,rep,name=beacons,proto3" json:"beacons,omitempty"`
+}
+
+func (x *BootstrapBeaconsRequest) Reset() {
+	*x = BootstrapBeaconsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootstrapBeaconsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapBeaconsRequest) ProtoMessage() {}
+
+func (x *BootstrapBeaconsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapBeaconsRequest.ProtoReflect.Descriptor instead.
+func (*BootstrapBeaconsRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BootstrapBeaconsRequest) GetBootstrapIps() string {
+	if x != nil {
+		return x.BootstrapIps
+	}
+	return ""
+}
+
+func (x *BootstrapBeaconsRequest) GetBootstrapIds() string {
+	if x != nil {
+		return x.BootstrapIds
+	}
+	return ""
+}
+
+func (x *BootstrapBeaconsRequest) GetBeacons() []*BootstrapBeacon {
+	if x != nil {
+		return x.Beacons
+	}
+	return nil
+}
+
+type BootstrapBeacon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 4-byte IPv4 or 16-byte IPv6 address.
+	IpAddr []byte `protobuf:"bytes,1,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
+	IpPort uint32 `protobuf:"varint,2,opt,name=ip_port,json=ipPort,proto3" json:"ip_port,omitempty"`
+	NodeId []byte `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *BootstrapBeacon) Reset() {
+	*x = BootstrapBeacon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootstrapBeacon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapBeacon) ProtoMessage() {}
+
+func (x *BootstrapBeacon) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapBeacon.ProtoReflect.Descriptor instead.
+func (*BootstrapBeacon) Descriptor() ([]byte, []int) {
+	return file_rpcpb_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BootstrapBeacon) GetIpAddr() []byte {
+	if x != nil {
+		return x.IpAddr
+	}
+	return nil
+}
+
+func (x *BootstrapBeacon) GetIpPort() uint32 {
+	if x != nil {
+		return x.IpPort
+	}
+	return 0
+}
+
+func (x *BootstrapBeacon) GetNodeId() []byte {
+	if x != nil {
+		return x.NodeId
+	}
+	return nil
+}
+
+type BootstrapBeaconsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Beacons as parsed by avalanchego.
+	ExpectedBeacons []*BootstrapBeacon `protobuf:"bytes,1,rep,name=expected_beacons,json=expectedBeacons,proto3" json:"expected_beacons,omitempty"`
+	Message         string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success         bool               `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BootstrapBeaconsResponse) Reset() {
+	*x = BootstrapBeaconsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootstrapBeaconsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapBeaconsResponse) ProtoMessage() {}
+
+func (x *BootstrapBeaconsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapBeaconsResponse.ProtoReflect.Descriptor instead.
+func (*BootstrapBeaconsResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BootstrapBeaconsResponse) GetExpectedBeacons() []*BootstrapBeacon {
+	if x != nil {
+		return x.ExpectedBeacons
+	}
+	return nil
+}
+
+func (x *BootstrapBeaconsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BootstrapBeaconsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BootstrapBeaconsResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_config_proto protoreflect.FileDescriptor
+
+var file_rpcpb_config_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x22, 0x5b, 0x0a, 0x13, 0x53,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x4a, 0x73, 0x6f, 0x6e, 0x22, 0xd1, 0x01, 0x0a, 0x14, 0x53, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x75,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x35, 0x0a, 0x19,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0x95, 0x01, 0x0a, 0x17, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x49, 0x70, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
+	0x61, 0x70, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x07,
+	0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x22, 0x5c, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x69, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0xbf, 0x01, 0x0a, 0x18, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x32, 0x8e, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x53, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73, 0x2f, 0x61, 0x76, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_config_proto_rawDescOnce sync.Once
+	file_rpcpb_config_proto_rawDescData = file_rpcpb_config_proto_rawDesc
+)
+
+func file_rpcpb_config_proto_rawDescGZIP() []byte {
+	file_rpcpb_config_proto_rawDescOnce.Do(func() {
+		file_rpcpb_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_config_proto_rawDescData)
+	})
+	return file_rpcpb_config_proto_rawDescData
+}
+
+var file_rpcpb_config_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_rpcpb_config_proto_goTypes = []interface{}{
+	(*SubnetConfigRequest)(nil),        // 0: rpcpb.SubnetConfigRequest
+	(*SubnetConfigResponse)(nil),       // 1: rpcpb.SubnetConfigResponse
+	(*ChainConfigContentRequest)(nil),  // 2: rpcpb.ChainConfigContentRequest
+	(*ChainConfigContentResponse)(nil), // 3: rpcpb.ChainConfigContentResponse
+	(*BootstrapBeaconsRequest)(nil),    // 4: rpcpb.BootstrapBeaconsRequest
+	(*BootstrapBeacon)(nil),            // 5: rpcpb.BootstrapBeacon
+	(*BootstrapBeaconsResponse)(nil),   // 6: rpcpb.BootstrapBeaconsResponse
+}
+var file_rpcpb_config_proto_depIdxs = []int32{
+	5, // 0: rpcpb.BootstrapBeaconsRequest.beacons:type_name -> rpcpb.BootstrapBeacon
+	5, // 1: rpcpb.BootstrapBeaconsResponse.expected_beacons:type_name -> rpcpb.BootstrapBeacon
+	0, // 2: rpcpb.ConfigService.SubnetConfig:input_type -> rpcpb.SubnetConfigRequest
+	2, // 3: rpcpb.ConfigService.ChainConfigContent:input_type -> rpcpb.ChainConfigContentRequest
+	4, // 4: rpcpb.ConfigService.BootstrapBeacons:input_type -> rpcpb.BootstrapBeaconsRequest
+	1, // 5: rpcpb.ConfigService.SubnetConfig:output_type -> rpcpb.SubnetConfigResponse
+	3, // 6: rpcpb.ConfigService.ChainConfigContent:output_type -> rpcpb.ChainConfigContentResponse
+	6, // 7: rpcpb.ConfigService.BootstrapBeacons:output_type -> rpcpb.BootstrapBeaconsResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_config_proto_init() }
+func file_rpcpb_config_proto_init() {
+	if File_rpcpb_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainConfigContentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainConfigContentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapBeaconsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapBeacon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapBeaconsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_config_proto_goTypes,
+		DependencyIndexes: file_rpcpb_config_proto_depIdxs,
+		MessageInfos:      file_rpcpb_config_proto_msgTypes,
+	}.Build()
+	File_rpcpb_config_proto = out.File
+	file_rpcpb_config_proto_rawDesc = nil
+	file_rpcpb_config_proto_goTypes = nil
+	file_rpcpb_config_proto_depIdxs = nil
+}
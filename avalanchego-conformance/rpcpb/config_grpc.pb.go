@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/config.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ConfigService_SubnetConfig_FullMethodName       = "/rpcpb.ConfigService/SubnetConfig"
+	ConfigService_ChainConfigContent_FullMethodName = "/rpcpb.ConfigService/ChainConfigContent"
+	ConfigService_BootstrapBeacons_FullMethodName   = "/rpcpb.ConfigService/BootstrapBeacons"
+)
+
+// ConfigServiceClient is the client API for ConfigService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ConfigServiceClient interface {
+	SubnetConfig(ctx context.Context, in *SubnetConfigRequest, opts ...grpc.CallOption) (*SubnetConfigResponse, error)
+	ChainConfigContent(ctx context.Context, in *ChainConfigContentRequest, opts ...grpc.CallOption) (*ChainConfigContentResponse, error)
+	BootstrapBeacons(ctx context.Context, in *BootstrapBeaconsRequest, opts ...grpc.CallOption) (*BootstrapBeaconsResponse, error)
+}
+
+type configServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConfigServiceClient(cc grpc.ClientConnInterface) ConfigServiceClient {
+	return &configServiceClient{cc}
+}
+
+func (c *configServiceClient) SubnetConfig(ctx context.Context, in *SubnetConfigRequest, opts ...grpc.CallOption) (*SubnetConfigResponse, error) {
+	out := new(SubnetConfigResponse)
+	err := c.cc.Invoke(ctx, ConfigService_SubnetConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) ChainConfigContent(ctx context.Context, in *ChainConfigContentRequest, opts ...grpc.CallOption) (*ChainConfigContentResponse, error) {
+	out := new(ChainConfigContentResponse)
+	err := c.cc.Invoke(ctx, ConfigService_ChainConfigContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configServiceClient) BootstrapBeacons(ctx context.Context, in *BootstrapBeaconsRequest, opts ...grpc.CallOption) (*BootstrapBeaconsResponse, error) {
+	out := new(BootstrapBeaconsResponse)
+	err := c.cc.Invoke(ctx, ConfigService_BootstrapBeacons_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfigServiceServer is the server API for ConfigService service.
+// All implementations must embed UnimplementedConfigServiceServer
+// for forward compatibility
+type ConfigServiceServer interface {
+	SubnetConfig(context.Context, *SubnetConfigRequest) (*SubnetConfigResponse, error)
+	ChainConfigContent(context.Context, *ChainConfigContentRequest) (*ChainConfigContentResponse, error)
+	BootstrapBeacons(context.Context, *BootstrapBeaconsRequest) (*BootstrapBeaconsResponse, error)
+	mustEmbedUnimplementedConfigServiceServer()
+}
+
+// UnimplementedConfigServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedConfigServiceServer struct {
+}
+
+func (UnimplementedConfigServiceServer) SubnetConfig(context.Context, *SubnetConfigRequest) (*SubnetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubnetConfig not implemented")
+}
+func (UnimplementedConfigServiceServer) ChainConfigContent(context.Context, *ChainConfigContentRequest) (*ChainConfigContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainConfigContent not implemented")
+}
+func (UnimplementedConfigServiceServer) BootstrapBeacons(context.Context, *BootstrapBeaconsRequest) (*BootstrapBeaconsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BootstrapBeacons not implemented")
+}
+func (UnimplementedConfigServiceServer) mustEmbedUnimplementedConfigServiceServer() {}
+
+// UnsafeConfigServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigServiceServer will
+// result in compilation errors.
+type UnsafeConfigServiceServer interface {
+	mustEmbedUnimplementedConfigServiceServer()
+}
+
+func RegisterConfigServiceServer(s grpc.ServiceRegistrar, srv ConfigServiceServer) {
+	s.RegisterService(&ConfigService_ServiceDesc, srv)
+}
+
+func _ConfigService_SubnetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubnetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).SubnetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_SubnetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).SubnetConfig(ctx, req.(*SubnetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_ChainConfigContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainConfigContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).ChainConfigContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_ChainConfigContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).ChainConfigContent(ctx, req.(*ChainConfigContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigService_BootstrapBeacons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapBeaconsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServiceServer).BootstrapBeacons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigService_BootstrapBeacons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServiceServer).BootstrapBeacons(ctx, req.(*BootstrapBeaconsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConfigService_ServiceDesc is the grpc.ServiceDesc for ConfigService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConfigService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.ConfigService",
+	HandlerType: (*ConfigServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubnetConfig",
+			Handler:    _ConfigService_SubnetConfig_Handler,
+		},
+		{
+			MethodName: "ChainConfigContent",
+			Handler:    _ConfigService_ChainConfigContent_Handler,
+		},
+		{
+			MethodName: "BootstrapBeacons",
+			Handler:    _ConfigService_BootstrapBeacons_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/config.proto",
+}
@@ -0,0 +1,1845 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/coreth.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EthTxType is the type of an Ethereum tx.
+type EthTxType int32
+
+const (
+	EthTxType_ETH_TX_TYPE_UNSPECIFIED EthTxType = 0
+	// EIP-155 replay protected tx, or a Homestead tx if the chain ID is zero.
+	EthTxType_ETH_TX_TYPE_LEGACY EthTxType = 1
+	// EIP-2930 access list tx (type 0x01).
+	EthTxType_ETH_TX_TYPE_ACCESS_LIST EthTxType = 2
+	// EIP-1559 dynamic fee tx (type 0x02).
+	EthTxType_ETH_TX_TYPE_DYNAMIC_FEE EthTxType = 3
+)
+
+// Enum value maps for EthTxType.
+var (
+	EthTxType_name = map[int32]string{
+		0: "ETH_TX_TYPE_UNSPECIFIED",
+		1: "ETH_TX_TYPE_LEGACY",
+		2: "ETH_TX_TYPE_ACCESS_LIST",
+		3: "ETH_TX_TYPE_DYNAMIC_FEE",
+	}
+	EthTxType_value = map[string]int32{
+		"ETH_TX_TYPE_UNSPECIFIED": 0,
+		"ETH_TX_TYPE_LEGACY":      1,
+		"ETH_TX_TYPE_ACCESS_LIST": 2,
+		"ETH_TX_TYPE_DYNAMIC_FEE": 3,
+	}
+)
+
+func (x EthTxType) Enum() *EthTxType {
+	p := new(EthTxType)
+	*p = x
+	return p
+}
+
+func (x EthTxType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EthTxType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_coreth_proto_enumTypes[0].Descriptor()
+}
+
+func (EthTxType) Type() protoreflect.EnumType {
+	return &file_rpcpb_coreth_proto_enumTypes[0]
+}
+
+func (x EthTxType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EthTxType.Descriptor instead.
+func (EthTxType) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{0}
+}
+
+// EvmOutput mirrors coreth "evm.EVMOutput".
+type EvmOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 20-byte EVM address.
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AssetId []byte `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+}
+
+func (x *EvmOutput) Reset() {
+	*x = EvmOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmOutput) ProtoMessage() {}
+
+func (x *EvmOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmOutput.ProtoReflect.Descriptor instead.
+func (*EvmOutput) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EvmOutput) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *EvmOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EvmOutput) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+// EvmInput mirrors coreth "evm.EVMInput".
+type EvmInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 20-byte EVM address.
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AssetId []byte `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Nonce   uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *EvmInput) Reset() {
+	*x = EvmInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvmInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvmInput) ProtoMessage() {}
+
+func (x *EvmInput) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvmInput.ProtoReflect.Descriptor instead.
+func (*EvmInput) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EvmInput) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *EvmInput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EvmInput) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *EvmInput) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+type UnsignedImportTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId      uint32               `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	BlockchainId   []byte               `protobuf:"bytes,2,opt,name=blockchain_id,json=blockchainId,proto3" json:"blockchain_id,omitempty"`
+	SourceChain    []byte               `protobuf:"bytes,3,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	ImportedInputs []*TransferableInput `protobuf:"bytes,4,rep,name=imported_inputs,json=importedInputs,proto3" json:"imported_inputs,omitempty"`
+	Outputs        []*EvmOutput         `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,6,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *UnsignedImportTxRequest) Reset() {
+	*x = UnsignedImportTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsignedImportTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsignedImportTxRequest) ProtoMessage() {}
+
+func (x *UnsignedImportTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsignedImportTxRequest.ProtoReflect.Descriptor instead.
+func (*UnsignedImportTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnsignedImportTxRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *UnsignedImportTxRequest) GetBlockchainId() []byte {
+	if x != nil {
+		return x.BlockchainId
+	}
+	return nil
+}
+
+func (x *UnsignedImportTxRequest) GetSourceChain() []byte {
+	if x != nil {
+		return x.SourceChain
+	}
+	return nil
+}
+
+func (x *UnsignedImportTxRequest) GetImportedInputs() []*TransferableInput {
+	if x != nil {
+		return x.ImportedInputs
+	}
+	return nil
+}
+
+func (x *UnsignedImportTxRequest) GetOutputs() []*EvmOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *UnsignedImportTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type UnsignedImportTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *UnsignedImportTxResponse) Reset() {
+	*x = UnsignedImportTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsignedImportTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsignedImportTxResponse) ProtoMessage() {}
+
+func (x *UnsignedImportTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsignedImportTxResponse.ProtoReflect.Descriptor instead.
+func (*UnsignedImportTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnsignedImportTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *UnsignedImportTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *UnsignedImportTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnsignedImportTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnsignedImportTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *UnsignedImportTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type UnsignedExportTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId        uint32                `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	BlockchainId     []byte                `protobuf:"bytes,2,opt,name=blockchain_id,json=blockchainId,proto3" json:"blockchain_id,omitempty"`
+	DestinationChain []byte                `protobuf:"bytes,3,opt,name=destination_chain,json=destinationChain,proto3" json:"destination_chain,omitempty"`
+	Inputs           []*EvmInput           `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	ExportedOutputs  []*TransferableOutput `protobuf:"bytes,5,rep,name=exported_outputs,json=exportedOutputs,proto3" json:"exported_outputs,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,6,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *UnsignedExportTxRequest) Reset() {
+	*x = UnsignedExportTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsignedExportTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsignedExportTxRequest) ProtoMessage() {}
+
+func (x *UnsignedExportTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsignedExportTxRequest.ProtoReflect.Descriptor instead.
+func (*UnsignedExportTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnsignedExportTxRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *UnsignedExportTxRequest) GetBlockchainId() []byte {
+	if x != nil {
+		return x.BlockchainId
+	}
+	return nil
+}
+
+func (x *UnsignedExportTxRequest) GetDestinationChain() []byte {
+	if x != nil {
+		return x.DestinationChain
+	}
+	return nil
+}
+
+func (x *UnsignedExportTxRequest) GetInputs() []*EvmInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *UnsignedExportTxRequest) GetExportedOutputs() []*TransferableOutput {
+	if x != nil {
+		return x.ExportedOutputs
+	}
+	return nil
+}
+
+func (x *UnsignedExportTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type UnsignedExportTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *UnsignedExportTxResponse) Reset() {
+	*x = UnsignedExportTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsignedExportTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsignedExportTxResponse) ProtoMessage() {}
+
+func (x *UnsignedExportTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsignedExportTxResponse.ProtoReflect.Descriptor instead.
+func (*UnsignedExportTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnsignedExportTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *UnsignedExportTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *UnsignedExportTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnsignedExportTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnsignedExportTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *UnsignedExportTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+// EthAccessTuple mirrors go-ethereum "types.AccessTuple".
+type EthAccessTuple struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 20-byte EVM address.
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// 32-byte storage keys.
+	StorageKeys [][]byte `protobuf:"bytes,2,rep,name=storage_keys,json=storageKeys,proto3" json:"storage_keys,omitempty"`
+}
+
+func (x *EthAccessTuple) Reset() {
+	*x = EthAccessTuple{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthAccessTuple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthAccessTuple) ProtoMessage() {}
+
+func (x *EthAccessTuple) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthAccessTuple.ProtoReflect.Descriptor instead.
+func (*EthAccessTuple) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EthAccessTuple) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *EthAccessTuple) GetStorageKeys() [][]byte {
+	if x != nil {
+		return x.StorageKeys
+	}
+	return nil
+}
+
+type EthTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxType  EthTxType `protobuf:"varint,1,opt,name=tx_type,json=txType,proto3,enum=rpcpb.EthTxType" json:"tx_type,omitempty"`
+	ChainId uint64    `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce   uint64    `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Big-endian integers. The gas price is of legacy and access list txs,
+	// and the fee caps are of dynamic fee txs.
+	GasPrice  []byte `protobuf:"bytes,4,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasTipCap []byte `protobuf:"bytes,5,opt,name=gas_tip_cap,json=gasTipCap,proto3" json:"gas_tip_cap,omitempty"`
+	GasFeeCap []byte `protobuf:"bytes,6,opt,name=gas_fee_cap,json=gasFeeCap,proto3" json:"gas_fee_cap,omitempty"`
+	Gas       uint64 `protobuf:"varint,7,opt,name=gas,proto3" json:"gas,omitempty"`
+	// 20-byte EVM address, or empty for contract creation.
+	To []byte `protobuf:"bytes,8,opt,name=to,proto3" json:"to,omitempty"`
+	// Big-endian integer.
+	Value      []byte            `protobuf:"bytes,9,opt,name=value,proto3" json:"value,omitempty"`
+	Data       []byte            `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+	AccessList []*EthAccessTuple `protobuf:"bytes,11,rep,name=access_list,json=accessList,proto3" json:"access_list,omitempty"`
+	// If set, the tx is signed with the 32-byte private key. Otherwise, the
+	// sender is recovered from the signature.
+	PrivateKey []byte `protobuf:"bytes,12,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// 65-byte [r || s || v] signature, with v the recovery ID (0 or 1).
+	Signature []byte `protobuf:"bytes,13,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Hash the sender signs.
+	SigningHash []byte `protobuf:"bytes,14,opt,name=signing_hash,json=signingHash,proto3" json:"signing_hash,omitempty"`
+	// RLP encoding of the signed tx, prefixed with the tx type if typed.
+	TxBytes []byte `protobuf:"bytes,15,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	TxHash  []byte `protobuf:"bytes,16,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// 20-byte address of the sender.
+	Sender []byte `protobuf:"bytes,17,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (x *EthTxRequest) Reset() {
+	*x = EthTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthTxRequest) ProtoMessage() {}
+
+func (x *EthTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthTxRequest.ProtoReflect.Descriptor instead.
+func (*EthTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EthTxRequest) GetTxType() EthTxType {
+	if x != nil {
+		return x.TxType
+	}
+	return EthTxType_ETH_TX_TYPE_UNSPECIFIED
+}
+
+func (x *EthTxRequest) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *EthTxRequest) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *EthTxRequest) GetGasPrice() []byte {
+	if x != nil {
+		return x.GasPrice
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetGasTipCap() []byte {
+	if x != nil {
+		return x.GasTipCap
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetGasFeeCap() []byte {
+	if x != nil {
+		return x.GasFeeCap
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *EthTxRequest) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetAccessList() []*EthAccessTuple {
+	if x != nil {
+		return x.AccessList
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetSigningHash() []byte {
+	if x != nil {
+		return x.SigningHash
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetTxBytes() []byte {
+	if x != nil {
+		return x.TxBytes
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *EthTxRequest) GetSender() []byte {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+type EthTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedSigningHash []byte `protobuf:"bytes,1,opt,name=expected_signing_hash,json=expectedSigningHash,proto3" json:"expected_signing_hash,omitempty"`
+	ExpectedSignature   []byte `protobuf:"bytes,2,opt,name=expected_signature,json=expectedSignature,proto3" json:"expected_signature,omitempty"`
+	ExpectedTxBytes     []byte `protobuf:"bytes,3,opt,name=expected_tx_bytes,json=expectedTxBytes,proto3" json:"expected_tx_bytes,omitempty"`
+	ExpectedTxHash      []byte `protobuf:"bytes,4,opt,name=expected_tx_hash,json=expectedTxHash,proto3" json:"expected_tx_hash,omitempty"`
+	ExpectedSender      []byte `protobuf:"bytes,5,opt,name=expected_sender,json=expectedSender,proto3" json:"expected_sender,omitempty"`
+	Message             string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Success             bool   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the tx bytes differ.
+	Diff *Diff `protobuf:"bytes,8,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,9,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *EthTxResponse) Reset() {
+	*x = EthTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthTxResponse) ProtoMessage() {}
+
+func (x *EthTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthTxResponse.ProtoReflect.Descriptor instead.
+func (*EthTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EthTxResponse) GetExpectedSigningHash() []byte {
+	if x != nil {
+		return x.ExpectedSigningHash
+	}
+	return nil
+}
+
+func (x *EthTxResponse) GetExpectedSignature() []byte {
+	if x != nil {
+		return x.ExpectedSignature
+	}
+	return nil
+}
+
+func (x *EthTxResponse) GetExpectedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedTxBytes
+	}
+	return nil
+}
+
+func (x *EthTxResponse) GetExpectedTxHash() []byte {
+	if x != nil {
+		return x.ExpectedTxHash
+	}
+	return nil
+}
+
+func (x *EthTxResponse) GetExpectedSender() []byte {
+	if x != nil {
+		return x.ExpectedSender
+	}
+	return nil
+}
+
+func (x *EthTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EthTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EthTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *EthTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type EthPersonalSignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Message as given to personal_sign (i.e., without the prefix).
+	Message []byte `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// If set, the hash is signed with the 32-byte private key. Otherwise, the
+	// signer is recovered from the signature.
+	PrivateKey []byte `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// 65-byte [r || s || v] signature, with v 27 or 28 as wallets return it.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// keccak256("\x19Ethereum Signed Message:\n" || len(message) || message),
+	// with the length in decimal.
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// 20-byte address of the signer.
+	Signer []byte `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *EthPersonalSignRequest) Reset() {
+	*x = EthPersonalSignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthPersonalSignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthPersonalSignRequest) ProtoMessage() {}
+
+func (x *EthPersonalSignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthPersonalSignRequest.ProtoReflect.Descriptor instead.
+func (*EthPersonalSignRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EthPersonalSignRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *EthPersonalSignRequest) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *EthPersonalSignRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *EthPersonalSignRequest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *EthPersonalSignRequest) GetSigner() []byte {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+type EthPersonalSignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedHash      []byte `protobuf:"bytes,1,opt,name=expected_hash,json=expectedHash,proto3" json:"expected_hash,omitempty"`
+	ExpectedSignature []byte `protobuf:"bytes,2,opt,name=expected_signature,json=expectedSignature,proto3" json:"expected_signature,omitempty"`
+	ExpectedSigner    []byte `protobuf:"bytes,3,opt,name=expected_signer,json=expectedSigner,proto3" json:"expected_signer,omitempty"`
+	Message           string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success           bool   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *EthPersonalSignResponse) Reset() {
+	*x = EthPersonalSignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthPersonalSignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthPersonalSignResponse) ProtoMessage() {}
+
+func (x *EthPersonalSignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthPersonalSignResponse.ProtoReflect.Descriptor instead.
+func (*EthPersonalSignResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EthPersonalSignResponse) GetExpectedHash() []byte {
+	if x != nil {
+		return x.ExpectedHash
+	}
+	return nil
+}
+
+func (x *EthPersonalSignResponse) GetExpectedSignature() []byte {
+	if x != nil {
+		return x.ExpectedSignature
+	}
+	return nil
+}
+
+func (x *EthPersonalSignResponse) GetExpectedSigner() []byte {
+	if x != nil {
+		return x.ExpectedSigner
+	}
+	return nil
+}
+
+func (x *EthPersonalSignResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EthPersonalSignResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EthPersonalSignResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type EthTypedDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Typed data as given to eth_signTypedData_v4 (i.e., with the "types",
+	// "primaryType", "domain" and "message" members).
+	TypedDataJson string `protobuf:"bytes,1,opt,name=typed_data_json,json=typedDataJson,proto3" json:"typed_data_json,omitempty"`
+	// If set, the digest is signed with the 32-byte private key. Otherwise,
+	// the signer is recovered from the signature.
+	PrivateKey []byte `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// 65-byte [r || s || v] signature, with v 27 or 28 as wallets return it.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// hashStruct of the domain, as the "EIP712Domain" type.
+	DomainSeparator []byte `protobuf:"bytes,4,opt,name=domain_separator,json=domainSeparator,proto3" json:"domain_separator,omitempty"`
+	// hashStruct of the message, as the primary type.
+	MessageHash []byte `protobuf:"bytes,5,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
+	// keccak256("\x19\x01" || domain_separator || message_hash).
+	Digest []byte `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`
+	// 20-byte address of the signer.
+	Signer []byte `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Whether the client fails to hash the typed data (e.g., a value that
+	// does not match its type).
+	Rejected bool `protobuf:"varint,8,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *EthTypedDataRequest) Reset() {
+	*x = EthTypedDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthTypedDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthTypedDataRequest) ProtoMessage() {}
+
+func (x *EthTypedDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthTypedDataRequest.ProtoReflect.Descriptor instead.
+func (*EthTypedDataRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EthTypedDataRequest) GetTypedDataJson() string {
+	if x != nil {
+		return x.TypedDataJson
+	}
+	return ""
+}
+
+func (x *EthTypedDataRequest) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *EthTypedDataRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *EthTypedDataRequest) GetDomainSeparator() []byte {
+	if x != nil {
+		return x.DomainSeparator
+	}
+	return nil
+}
+
+func (x *EthTypedDataRequest) GetMessageHash() []byte {
+	if x != nil {
+		return x.MessageHash
+	}
+	return nil
+}
+
+func (x *EthTypedDataRequest) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *EthTypedDataRequest) GetSigner() []byte {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *EthTypedDataRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type EthTypedDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Encoding of the primary type, then of the types it references sorted
+	// by name (e.g., "Mail(Person from,Person to,string contents)Person(string
+	// name,address wallet)"), which its type hash is the hash of.
+	ExpectedEncodedType     string `protobuf:"bytes,1,opt,name=expected_encoded_type,json=expectedEncodedType,proto3" json:"expected_encoded_type,omitempty"`
+	ExpectedDomainSeparator []byte `protobuf:"bytes,2,opt,name=expected_domain_separator,json=expectedDomainSeparator,proto3" json:"expected_domain_separator,omitempty"`
+	ExpectedMessageHash     []byte `protobuf:"bytes,3,opt,name=expected_message_hash,json=expectedMessageHash,proto3" json:"expected_message_hash,omitempty"`
+	ExpectedDigest          []byte `protobuf:"bytes,4,opt,name=expected_digest,json=expectedDigest,proto3" json:"expected_digest,omitempty"`
+	ExpectedSignature       []byte `protobuf:"bytes,5,opt,name=expected_signature,json=expectedSignature,proto3" json:"expected_signature,omitempty"`
+	ExpectedSigner          []byte `protobuf:"bytes,6,opt,name=expected_signer,json=expectedSigner,proto3" json:"expected_signer,omitempty"`
+	// Error go-ethereum rejects the typed data with.
+	ExpectedError string `protobuf:"bytes,7,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	Message       string `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool   `protobuf:"varint,9,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,10,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *EthTypedDataResponse) Reset() {
+	*x = EthTypedDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_coreth_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthTypedDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthTypedDataResponse) ProtoMessage() {}
+
+func (x *EthTypedDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_coreth_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthTypedDataResponse.ProtoReflect.Descriptor instead.
+func (*EthTypedDataResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_coreth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EthTypedDataResponse) GetExpectedEncodedType() string {
+	if x != nil {
+		return x.ExpectedEncodedType
+	}
+	return ""
+}
+
+func (x *EthTypedDataResponse) GetExpectedDomainSeparator() []byte {
+	if x != nil {
+		return x.ExpectedDomainSeparator
+	}
+	return nil
+}
+
+func (x *EthTypedDataResponse) GetExpectedMessageHash() []byte {
+	if x != nil {
+		return x.ExpectedMessageHash
+	}
+	return nil
+}
+
+func (x *EthTypedDataResponse) GetExpectedDigest() []byte {
+	if x != nil {
+		return x.ExpectedDigest
+	}
+	return nil
+}
+
+func (x *EthTypedDataResponse) GetExpectedSignature() []byte {
+	if x != nil {
+		return x.ExpectedSignature
+	}
+	return nil
+}
+
+func (x *EthTypedDataResponse) GetExpectedSigner() []byte {
+	if x != nil {
+		return x.ExpectedSigner
+	}
+	return nil
+}
+
+func (x *EthTypedDataResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *EthTypedDataResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EthTypedDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EthTypedDataResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_coreth_proto protoreflect.FileDescriptor
+
+var file_rpcpb_coreth_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x74, 0x68, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x1a, 0x10, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2f, 0x64, 0x69, 0x66, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a,
+	0x09, 0x45, 0x76, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x08, 0x45, 0x76, 0x6d, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x9b, 0x02, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x41, 0x0a, 0x0f, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x0e, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x07,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x6d, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x18, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55,
+	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x54, 0x78, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xa5, 0x02, 0x0a, 0x17, 0x55, 0x6e, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x6d,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x44, 0x0a,
+	0x10, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0x80, 0x02, 0x0a, 0x18, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x1a,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x22, 0x4d, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79,
+	0x73, 0x22, 0xf9, 0x03, 0x0a, 0x0c, 0x45, 0x74, 0x68, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x54,
+	0x78, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x67,
+	0x61, 0x73, 0x5f, 0x74, 0x69, 0x70, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x67, 0x61, 0x73, 0x54, 0x69, 0x70, 0x43, 0x61, 0x70, 0x12, 0x1e, 0x0a, 0x0b, 0x67,
+	0x61, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x67, 0x61, 0x73, 0x46, 0x65, 0x65, 0x43, 0x61, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x67,
+	0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x75,
+	0x70, 0x6c, 0x65, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xf4, 0x02,
+	0x0a, 0x0d, 0x45, 0x74, 0x68, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x16, 0x45, 0x74, 0x68, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x22, 0xf8, 0x01, 0x0a, 0x17, 0x45, 0x74, 0x68, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0x96, 0x02, 0x0a, 0x13, 0x45, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x79, 0x70, 0x65, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x4a, 0x73, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xc4, 0x03, 0x0a, 0x14, 0x45, 0x74, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x2d,
+	0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x2a,
+	0x7a, 0x0a, 0x09, 0x45, 0x74, 0x68, 0x54, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17,
+	0x45, 0x54, 0x48, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x54, 0x48,
+	0x5f, 0x54, 0x58, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x10,
+	0x01, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x59,
+	0x4e, 0x41, 0x4d, 0x49, 0x43, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x03, 0x32, 0x92, 0x03, 0x0a, 0x0d,
+	0x43, 0x6f, 0x72, 0x65, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a,
+	0x10, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x78, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x10, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x05, 0x45,
+	0x74, 0x68, 0x54, 0x78, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68,
+	0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x45, 0x74, 0x68, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x52, 0x0a, 0x0f, 0x45, 0x74, 0x68, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
+	0x53, 0x69, 0x67, 0x6e, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x45, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x74,
+	0x68, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x54, 0x79, 0x70,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x2d, 0x72, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f,
+	0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_coreth_proto_rawDescOnce sync.Once
+	file_rpcpb_coreth_proto_rawDescData = file_rpcpb_coreth_proto_rawDesc
+)
+
+func file_rpcpb_coreth_proto_rawDescGZIP() []byte {
+	file_rpcpb_coreth_proto_rawDescOnce.Do(func() {
+		file_rpcpb_coreth_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_coreth_proto_rawDescData)
+	})
+	return file_rpcpb_coreth_proto_rawDescData
+}
+
+var file_rpcpb_coreth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpcpb_coreth_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_rpcpb_coreth_proto_goTypes = []interface{}{
+	(EthTxType)(0),                   // 0: rpcpb.EthTxType
+	(*EvmOutput)(nil),                // 1: rpcpb.EvmOutput
+	(*EvmInput)(nil),                 // 2: rpcpb.EvmInput
+	(*UnsignedImportTxRequest)(nil),  // 3: rpcpb.UnsignedImportTxRequest
+	(*UnsignedImportTxResponse)(nil), // 4: rpcpb.UnsignedImportTxResponse
+	(*UnsignedExportTxRequest)(nil),  // 5: rpcpb.UnsignedExportTxRequest
+	(*UnsignedExportTxResponse)(nil), // 6: rpcpb.UnsignedExportTxResponse
+	(*EthAccessTuple)(nil),           // 7: rpcpb.EthAccessTuple
+	(*EthTxRequest)(nil),             // 8: rpcpb.EthTxRequest
+	(*EthTxResponse)(nil),            // 9: rpcpb.EthTxResponse
+	(*EthPersonalSignRequest)(nil),   // 10: rpcpb.EthPersonalSignRequest
+	(*EthPersonalSignResponse)(nil),  // 11: rpcpb.EthPersonalSignResponse
+	(*EthTypedDataRequest)(nil),      // 12: rpcpb.EthTypedDataRequest
+	(*EthTypedDataResponse)(nil),     // 13: rpcpb.EthTypedDataResponse
+	(*TransferableInput)(nil),        // 14: rpcpb.TransferableInput
+	(*Diff)(nil),                     // 15: rpcpb.Diff
+	(*TransferableOutput)(nil),       // 16: rpcpb.TransferableOutput
+}
+var file_rpcpb_coreth_proto_depIdxs = []int32{
+	14, // 0: rpcpb.UnsignedImportTxRequest.imported_inputs:type_name -> rpcpb.TransferableInput
+	1,  // 1: rpcpb.UnsignedImportTxRequest.outputs:type_name -> rpcpb.EvmOutput
+	15, // 2: rpcpb.UnsignedImportTxResponse.diff:type_name -> rpcpb.Diff
+	2,  // 3: rpcpb.UnsignedExportTxRequest.inputs:type_name -> rpcpb.EvmInput
+	16, // 4: rpcpb.UnsignedExportTxRequest.exported_outputs:type_name -> rpcpb.TransferableOutput
+	15, // 5: rpcpb.UnsignedExportTxResponse.diff:type_name -> rpcpb.Diff
+	0,  // 6: rpcpb.EthTxRequest.tx_type:type_name -> rpcpb.EthTxType
+	7,  // 7: rpcpb.EthTxRequest.access_list:type_name -> rpcpb.EthAccessTuple
+	15, // 8: rpcpb.EthTxResponse.diff:type_name -> rpcpb.Diff
+	3,  // 9: rpcpb.CorethService.UnsignedImportTx:input_type -> rpcpb.UnsignedImportTxRequest
+	5,  // 10: rpcpb.CorethService.UnsignedExportTx:input_type -> rpcpb.UnsignedExportTxRequest
+	8,  // 11: rpcpb.CorethService.EthTx:input_type -> rpcpb.EthTxRequest
+	10, // 12: rpcpb.CorethService.EthPersonalSign:input_type -> rpcpb.EthPersonalSignRequest
+	12, // 13: rpcpb.CorethService.EthTypedData:input_type -> rpcpb.EthTypedDataRequest
+	4,  // 14: rpcpb.CorethService.UnsignedImportTx:output_type -> rpcpb.UnsignedImportTxResponse
+	6,  // 15: rpcpb.CorethService.UnsignedExportTx:output_type -> rpcpb.UnsignedExportTxResponse
+	9,  // 16: rpcpb.CorethService.EthTx:output_type -> rpcpb.EthTxResponse
+	11, // 17: rpcpb.CorethService.EthPersonalSign:output_type -> rpcpb.EthPersonalSignResponse
+	13, // 18: rpcpb.CorethService.EthTypedData:output_type -> rpcpb.EthTypedDataResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_coreth_proto_init() }
+func file_rpcpb_coreth_proto_init() {
+	if File_rpcpb_coreth_proto != nil {
+		return
+	}
+	file_rpcpb_diff_proto_init()
+	file_rpcpb_tx_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_coreth_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvmInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsignedImportTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsignedImportTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsignedExportTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsignedExportTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAccessTuple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthPersonalSignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthPersonalSignResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthTypedDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_coreth_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthTypedDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_coreth_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_coreth_proto_goTypes,
+		DependencyIndexes: file_rpcpb_coreth_proto_depIdxs,
+		EnumInfos:         file_rpcpb_coreth_proto_enumTypes,
+		MessageInfos:      file_rpcpb_coreth_proto_msgTypes,
+	}.Build()
+	File_rpcpb_coreth_proto = out.File
+	file_rpcpb_coreth_proto_rawDesc = nil
+	file_rpcpb_coreth_proto_goTypes = nil
+	file_rpcpb_coreth_proto_depIdxs = nil
+}
@@ -0,0 +1,275 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/coreth.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CorethService_UnsignedImportTx_FullMethodName = "/rpcpb.CorethService/UnsignedImportTx"
+	CorethService_UnsignedExportTx_FullMethodName = "/rpcpb.CorethService/UnsignedExportTx"
+	CorethService_EthTx_FullMethodName            = "/rpcpb.CorethService/EthTx"
+	CorethService_EthPersonalSign_FullMethodName  = "/rpcpb.CorethService/EthPersonalSign"
+	CorethService_EthTypedData_FullMethodName     = "/rpcpb.CorethService/EthTypedData"
+)
+
+// CorethServiceClient is the client API for CorethService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CorethServiceClient interface {
+	UnsignedImportTx(ctx context.Context, in *UnsignedImportTxRequest, opts ...grpc.CallOption) (*UnsignedImportTxResponse, error)
+	UnsignedExportTx(ctx context.Context, in *UnsignedExportTxRequest, opts ...grpc.CallOption) (*UnsignedExportTxResponse, error)
+	// Signs or recovers the sender of an Ethereum tx, as coreth does with the
+	// latest signer of the chain ID.
+	// ref. "types.LatestSignerForChainID", "types.Transaction.MarshalBinary"
+	EthTx(ctx context.Context, in *EthTxRequest, opts ...grpc.CallOption) (*EthTxResponse, error)
+	// Hashes a message as personal_sign does (i.e., EIP-191 version 0x45),
+	// then signs it or recovers its signer.
+	// ref. "accounts.TextHash", "ethapi.PersonalAccountAPI.EcRecover"
+	EthPersonalSign(ctx context.Context, in *EthPersonalSignRequest, opts ...grpc.CallOption) (*EthPersonalSignResponse, error)
+	// Hashes EIP-712 typed data as eth_signTypedData_v4 does, then signs its
+	// digest or recovers its signer.
+	// ref. "apitypes.TypedDataAndHash"
+	EthTypedData(ctx context.Context, in *EthTypedDataRequest, opts ...grpc.CallOption) (*EthTypedDataResponse, error)
+}
+
+type corethServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCorethServiceClient(cc grpc.ClientConnInterface) CorethServiceClient {
+	return &corethServiceClient{cc}
+}
+
+func (c *corethServiceClient) UnsignedImportTx(ctx context.Context, in *UnsignedImportTxRequest, opts ...grpc.CallOption) (*UnsignedImportTxResponse, error) {
+	out := new(UnsignedImportTxResponse)
+	err := c.cc.Invoke(ctx, CorethService_UnsignedImportTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *corethServiceClient) UnsignedExportTx(ctx context.Context, in *UnsignedExportTxRequest, opts ...grpc.CallOption) (*UnsignedExportTxResponse, error) {
+	out := new(UnsignedExportTxResponse)
+	err := c.cc.Invoke(ctx, CorethService_UnsignedExportTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *corethServiceClient) EthTx(ctx context.Context, in *EthTxRequest, opts ...grpc.CallOption) (*EthTxResponse, error) {
+	out := new(EthTxResponse)
+	err := c.cc.Invoke(ctx, CorethService_EthTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *corethServiceClient) EthPersonalSign(ctx context.Context, in *EthPersonalSignRequest, opts ...grpc.CallOption) (*EthPersonalSignResponse, error) {
+	out := new(EthPersonalSignResponse)
+	err := c.cc.Invoke(ctx, CorethService_EthPersonalSign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *corethServiceClient) EthTypedData(ctx context.Context, in *EthTypedDataRequest, opts ...grpc.CallOption) (*EthTypedDataResponse, error) {
+	out := new(EthTypedDataResponse)
+	err := c.cc.Invoke(ctx, CorethService_EthTypedData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CorethServiceServer is the server API for CorethService service.
+// All implementations must embed UnimplementedCorethServiceServer
+// for forward compatibility
+type CorethServiceServer interface {
+	UnsignedImportTx(context.Context, *UnsignedImportTxRequest) (*UnsignedImportTxResponse, error)
+	UnsignedExportTx(context.Context, *UnsignedExportTxRequest) (*UnsignedExportTxResponse, error)
+	// Signs or recovers the sender of an Ethereum tx, as coreth does with the
+	// latest signer of the chain ID.
+	// ref. "types.LatestSignerForChainID", "types.Transaction.MarshalBinary"
+	EthTx(context.Context, *EthTxRequest) (*EthTxResponse, error)
+	// Hashes a message as personal_sign does (i.e., EIP-191 version 0x45),
+	// then signs it or recovers its signer.
+	// ref. "accounts.TextHash", "ethapi.PersonalAccountAPI.EcRecover"
+	EthPersonalSign(context.Context, *EthPersonalSignRequest) (*EthPersonalSignResponse, error)
+	// Hashes EIP-712 typed data as eth_signTypedData_v4 does, then signs its
+	// digest or recovers its signer.
+	// ref. "apitypes.TypedDataAndHash"
+	EthTypedData(context.Context, *EthTypedDataRequest) (*EthTypedDataResponse, error)
+	mustEmbedUnimplementedCorethServiceServer()
+}
+
+// UnimplementedCorethServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCorethServiceServer struct {
+}
+
+func (UnimplementedCorethServiceServer) UnsignedImportTx(context.Context, *UnsignedImportTxRequest) (*UnsignedImportTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignedImportTx not implemented")
+}
+func (UnimplementedCorethServiceServer) UnsignedExportTx(context.Context, *UnsignedExportTxRequest) (*UnsignedExportTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsignedExportTx not implemented")
+}
+func (UnimplementedCorethServiceServer) EthTx(context.Context, *EthTxRequest) (*EthTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthTx not implemented")
+}
+func (UnimplementedCorethServiceServer) EthPersonalSign(context.Context, *EthPersonalSignRequest) (*EthPersonalSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthPersonalSign not implemented")
+}
+func (UnimplementedCorethServiceServer) EthTypedData(context.Context, *EthTypedDataRequest) (*EthTypedDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthTypedData not implemented")
+}
+func (UnimplementedCorethServiceServer) mustEmbedUnimplementedCorethServiceServer() {}
+
+// UnsafeCorethServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CorethServiceServer will
+// result in compilation errors.
+type UnsafeCorethServiceServer interface {
+	mustEmbedUnimplementedCorethServiceServer()
+}
+
+func RegisterCorethServiceServer(s grpc.ServiceRegistrar, srv CorethServiceServer) {
+	s.RegisterService(&CorethService_ServiceDesc, srv)
+}
+
+func _CorethService_UnsignedImportTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignedImportTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CorethServiceServer).UnsignedImportTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CorethService_UnsignedImportTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CorethServiceServer).UnsignedImportTx(ctx, req.(*UnsignedImportTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CorethService_UnsignedExportTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsignedExportTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CorethServiceServer).UnsignedExportTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CorethService_UnsignedExportTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CorethServiceServer).UnsignedExportTx(ctx, req.(*UnsignedExportTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CorethService_EthTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CorethServiceServer).EthTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CorethService_EthTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CorethServiceServer).EthTx(ctx, req.(*EthTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CorethService_EthPersonalSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthPersonalSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CorethServiceServer).EthPersonalSign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CorethService_EthPersonalSign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CorethServiceServer).EthPersonalSign(ctx, req.(*EthPersonalSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CorethService_EthTypedData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthTypedDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CorethServiceServer).EthTypedData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CorethService_EthTypedData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CorethServiceServer).EthTypedData(ctx, req.(*EthTypedDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CorethService_ServiceDesc is the grpc.ServiceDesc for CorethService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CorethService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.CorethService",
+	HandlerType: (*CorethServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UnsignedImportTx",
+			Handler:    _CorethService_UnsignedImportTx_Handler,
+		},
+		{
+			MethodName: "UnsignedExportTx",
+			Handler:    _CorethService_UnsignedExportTx_Handler,
+		},
+		{
+			MethodName: "EthTx",
+			Handler:    _CorethService_EthTx_Handler,
+		},
+		{
+			MethodName: "EthPersonalSign",
+			Handler:    _CorethService_EthPersonalSign_Handler,
+		},
+		{
+			MethodName: "EthTypedData",
+			Handler:    _CorethService_EthTypedData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/coreth.proto",
+}
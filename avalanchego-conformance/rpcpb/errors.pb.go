@@ -0,0 +1,248 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/errors.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InputErrorClass classifies the malformed request fields rejected with
+// INVALID_ARGUMENT.
+type InputErrorClass int32
+
+const (
+	InputErrorClass_INPUT_ERROR_CLASS_UNSPECIFIED InputErrorClass = 0
+	// The field is malformed in another way (e.g., an invalid BLS public key).
+	InputErrorClass_INPUT_ERROR_CLASS_INVALID InputErrorClass = 1
+	// The field is an ID, key or signature of the wrong length (e.g., a 31-byte
+	// chain ID).
+	InputErrorClass_INPUT_ERROR_CLASS_WRONG_LENGTH InputErrorClass = 2
+	// The field is an integer out of the range of its serialized type (e.g., a
+	// port over 65535).
+	InputErrorClass_INPUT_ERROR_CLASS_OUT_OF_RANGE InputErrorClass = 3
+	// The field is an unset message or oneof.
+	InputErrorClass_INPUT_ERROR_CLASS_MISSING InputErrorClass = 4
+)
+
+// Enum value maps for InputErrorClass.
+var (
+	InputErrorClass_name = map[int32]string{
+		0: "INPUT_ERROR_CLASS_UNSPECIFIED",
+		1: "INPUT_ERROR_CLASS_INVALID",
+		2: "INPUT_ERROR_CLASS_WRONG_LENGTH",
+		3: "INPUT_ERROR_CLASS_OUT_OF_RANGE",
+		4: "INPUT_ERROR_CLASS_MISSING",
+	}
+	InputErrorClass_value = map[string]int32{
+		"INPUT_ERROR_CLASS_UNSPECIFIED":  0,
+		"INPUT_ERROR_CLASS_INVALID":      1,
+		"INPUT_ERROR_CLASS_WRONG_LENGTH": 2,
+		"INPUT_ERROR_CLASS_OUT_OF_RANGE": 3,
+		"INPUT_ERROR_CLASS_MISSING":      4,
+	}
+)
+
+func (x InputErrorClass) Enum() *InputErrorClass {
+	p := new(InputErrorClass)
+	*p = x
+	return p
+}
+
+func (x InputErrorClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InputErrorClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_errors_proto_enumTypes[0].Descriptor()
+}
+
+func (InputErrorClass) Type() protoreflect.EnumType {
+	return &file_rpcpb_errors_proto_enumTypes[0]
+}
+
+func (x InputErrorClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InputErrorClass.Descriptor instead.
+func (InputErrorClass) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_errors_proto_rawDescGZIP(), []int{0}
+}
+
+// InputError is a status detail of INVALID_ARGUMENT errors, along with the
+// "google.rpc.BadRequest" detail, classifying the malformed request field.
+type InputError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Class InputErrorClass `protobuf:"varint,1,opt,name=class,proto3,enum=rpcpb.InputErrorClass" json:"class,omitempty"`
+	// Path of the field (e.g., "base_tx.outputs[1].asset_id").
+	Field       string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *InputError) Reset() {
+	*x = InputError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_errors_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InputError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputError) ProtoMessage() {}
+
+func (x *InputError) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_errors_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputError.ProtoReflect.Descriptor instead.
+func (*InputError) Descriptor() ([]byte, []int) {
+	return file_rpcpb_errors_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InputError) GetClass() InputErrorClass {
+	if x != nil {
+		return x.Class
+	}
+	return InputErrorClass_INPUT_ERROR_CLASS_UNSPECIFIED
+}
+
+func (x *InputError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *InputError) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_rpcpb_errors_proto protoreflect.FileDescriptor
+
+var file_rpcpb_errors_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x22, 0x72, 0x0a, 0x0a, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2a,
+	0xba, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x57, 0x52, 0x4f, 0x4e, 0x47,
+	0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x50,
+	0x55, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x4f,
+	0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x03, 0x12, 0x1d, 0x0a,
+	0x19, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41,
+	0x53, 0x53, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x42, 0x40, 0x5a, 0x3e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73,
+	0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_errors_proto_rawDescOnce sync.Once
+	file_rpcpb_errors_proto_rawDescData = file_rpcpb_errors_proto_rawDesc
+)
+
+func file_rpcpb_errors_proto_rawDescGZIP() []byte {
+	file_rpcpb_errors_proto_rawDescOnce.Do(func() {
+		file_rpcpb_errors_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_errors_proto_rawDescData)
+	})
+	return file_rpcpb_errors_proto_rawDescData
+}
+
+var file_rpcpb_errors_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpcpb_errors_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rpcpb_errors_proto_goTypes = []interface{}{
+	(InputErrorClass)(0), // 0: rpcpb.InputErrorClass
+	(*InputError)(nil),   // 1: rpcpb.InputError
+}
+var file_rpcpb_errors_proto_depIdxs = []int32{
+	0, // 0: rpcpb.InputError.class:type_name -> rpcpb.InputErrorClass
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_errors_proto_init() }
+func file_rpcpb_errors_proto_init() {
+	if File_rpcpb_errors_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_errors_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InputError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_errors_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpcpb_errors_proto_goTypes,
+		DependencyIndexes: file_rpcpb_errors_proto_depIdxs,
+		EnumInfos:         file_rpcpb_errors_proto_enumTypes,
+		MessageInfos:      file_rpcpb_errors_proto_msgTypes,
+	}.Build()
+	File_rpcpb_errors_proto = out.File
+	file_rpcpb_errors_proto_rawDesc = nil
+	file_rpcpb_errors_proto_goTypes = nil
+	file_rpcpb_errors_proto_depIdxs = nil
+}
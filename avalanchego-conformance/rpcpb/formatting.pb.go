@@ -0,0 +1,556 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/formatting.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Cb58ErrorClass classifies the errors of decoding a cb58 string.
+type Cb58ErrorClass int32
+
+const (
+	// The string is accepted.
+	Cb58ErrorClass_CB58_ERROR_CLASS_UNSPECIFIED Cb58ErrorClass = 0
+	// ref. "cb58.ErrBase58Decoding"
+	Cb58ErrorClass_CB58_ERROR_CLASS_EMPTY Cb58ErrorClass = 1
+	// Character out of the base58 alphabet.
+	// ref. "cb58.ErrBase58Decoding"
+	Cb58ErrorClass_CB58_ERROR_CLASS_BAD_CHARACTER Cb58ErrorClass = 2
+	// Other base58 decoding errors (e.g., overflows of the decoder).
+	// ref. "cb58.ErrBase58Decoding"
+	Cb58ErrorClass_CB58_ERROR_CLASS_BASE58_DECODING Cb58ErrorClass = 3
+	// Fewer decoded bytes than the 4-byte checksum.
+	// ref. "cb58.ErrMissingChecksum"
+	Cb58ErrorClass_CB58_ERROR_CLASS_TOO_SHORT Cb58ErrorClass = 4
+	// ref. "cb58.errBadChecksum"
+	Cb58ErrorClass_CB58_ERROR_CLASS_BAD_CHECKSUM Cb58ErrorClass = 5
+)
+
+// Enum value maps for Cb58ErrorClass.
+var (
+	Cb58ErrorClass_name = map[int32]string{
+		0: "CB58_ERROR_CLASS_UNSPECIFIED",
+		1: "CB58_ERROR_CLASS_EMPTY",
+		2: "CB58_ERROR_CLASS_BAD_CHARACTER",
+		3: "CB58_ERROR_CLASS_BASE58_DECODING",
+		4: "CB58_ERROR_CLASS_TOO_SHORT",
+		5: "CB58_ERROR_CLASS_BAD_CHECKSUM",
+	}
+	Cb58ErrorClass_value = map[string]int32{
+		"CB58_ERROR_CLASS_UNSPECIFIED":     0,
+		"CB58_ERROR_CLASS_EMPTY":           1,
+		"CB58_ERROR_CLASS_BAD_CHARACTER":   2,
+		"CB58_ERROR_CLASS_BASE58_DECODING": 3,
+		"CB58_ERROR_CLASS_TOO_SHORT":       4,
+		"CB58_ERROR_CLASS_BAD_CHECKSUM":    5,
+	}
+)
+
+func (x Cb58ErrorClass) Enum() *Cb58ErrorClass {
+	p := new(Cb58ErrorClass)
+	*p = x
+	return p
+}
+
+func (x Cb58ErrorClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Cb58ErrorClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_formatting_proto_enumTypes[0].Descriptor()
+}
+
+func (Cb58ErrorClass) Type() protoreflect.EnumType {
+	return &file_rpcpb_formatting_proto_enumTypes[0]
+}
+
+func (x Cb58ErrorClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Cb58ErrorClass.Descriptor instead.
+func (Cb58ErrorClass) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_formatting_proto_rawDescGZIP(), []int{0}
+}
+
+type Cb58EncodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data    []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Encoded string `protobuf:"bytes,2,opt,name=encoded,proto3" json:"encoded,omitempty"`
+}
+
+func (x *Cb58EncodeRequest) Reset() {
+	*x = Cb58EncodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_formatting_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cb58EncodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cb58EncodeRequest) ProtoMessage() {}
+
+func (x *Cb58EncodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_formatting_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cb58EncodeRequest.ProtoReflect.Descriptor instead.
+func (*Cb58EncodeRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_formatting_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Cb58EncodeRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Cb58EncodeRequest) GetEncoded() string {
+	if x != nil {
+		return x.Encoded
+	}
+	return ""
+}
+
+type Cb58EncodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedEncoded string `protobuf:"bytes,1,opt,name=expected_encoded,json=expectedEncoded,proto3" json:"expected_encoded,omitempty"`
+	Message         string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success         bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *Cb58EncodeResponse) Reset() {
+	*x = Cb58EncodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_formatting_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cb58EncodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cb58EncodeResponse) ProtoMessage() {}
+
+func (x *Cb58EncodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_formatting_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cb58EncodeResponse.ProtoReflect.Descriptor instead.
+func (*Cb58EncodeResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_formatting_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Cb58EncodeResponse) GetExpectedEncoded() string {
+	if x != nil {
+		return x.ExpectedEncoded
+	}
+	return ""
+}
+
+func (x *Cb58EncodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Cb58EncodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Cb58EncodeResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type Cb58DecodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Encoded string `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	// Decoded bytes, or the class of the error the client rejects the string
+	// with.
+	Data       []byte         `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ErrorClass Cb58ErrorClass `protobuf:"varint,3,opt,name=error_class,json=errorClass,proto3,enum=rpcpb.Cb58ErrorClass" json:"error_class,omitempty"`
+}
+
+func (x *Cb58DecodeRequest) Reset() {
+	*x = Cb58DecodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_formatting_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cb58DecodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cb58DecodeRequest) ProtoMessage() {}
+
+func (x *Cb58DecodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_formatting_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cb58DecodeRequest.ProtoReflect.Descriptor instead.
+func (*Cb58DecodeRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_formatting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Cb58DecodeRequest) GetEncoded() string {
+	if x != nil {
+		return x.Encoded
+	}
+	return ""
+}
+
+func (x *Cb58DecodeRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Cb58DecodeRequest) GetErrorClass() Cb58ErrorClass {
+	if x != nil {
+		return x.ErrorClass
+	}
+	return Cb58ErrorClass_CB58_ERROR_CLASS_UNSPECIFIED
+}
+
+type Cb58DecodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedData       []byte         `protobuf:"bytes,1,opt,name=expected_data,json=expectedData,proto3" json:"expected_data,omitempty"`
+	ExpectedErrorClass Cb58ErrorClass `protobuf:"varint,2,opt,name=expected_error_class,json=expectedErrorClass,proto3,enum=rpcpb.Cb58ErrorClass" json:"expected_error_class,omitempty"`
+	// Error avalanchego rejects the string with.
+	ExpectedError string `protobuf:"bytes,3,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *Cb58DecodeResponse) Reset() {
+	*x = Cb58DecodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_formatting_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cb58DecodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cb58DecodeResponse) ProtoMessage() {}
+
+func (x *Cb58DecodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_formatting_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cb58DecodeResponse.ProtoReflect.Descriptor instead.
+func (*Cb58DecodeResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_formatting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Cb58DecodeResponse) GetExpectedData() []byte {
+	if x != nil {
+		return x.ExpectedData
+	}
+	return nil
+}
+
+func (x *Cb58DecodeResponse) GetExpectedErrorClass() Cb58ErrorClass {
+	if x != nil {
+		return x.ExpectedErrorClass
+	}
+	return Cb58ErrorClass_CB58_ERROR_CLASS_UNSPECIFIED
+}
+
+func (x *Cb58DecodeResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *Cb58DecodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Cb58DecodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Cb58DecodeResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_formatting_proto protoreflect.FileDescriptor
+
+var file_rpcpb_formatting_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x22,
+	0x41, 0x0a, 0x11, 0x43, 0x62, 0x35, 0x38, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x12, 0x43, 0x62, 0x35, 0x38, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x79, 0x0a, 0x11, 0x43, 0x62, 0x35, 0x38, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x0b, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x62, 0x35, 0x38, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x22, 0x8b, 0x02, 0x0a, 0x12, 0x43, 0x62, 0x35, 0x38, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a,
+	0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x43, 0x62, 0x35, 0x38, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x2a,
+	0xdb, 0x01, 0x0a, 0x0e, 0x43, 0x62, 0x35, 0x38, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x42, 0x35, 0x38, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x42, 0x35, 0x38, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x01,
+	0x12, 0x22, 0x0a, 0x1e, 0x43, 0x42, 0x35, 0x38, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43,
+	0x4c, 0x41, 0x53, 0x53, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54,
+	0x45, 0x52, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x42, 0x35, 0x38, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x35, 0x38, 0x5f,
+	0x44, 0x45, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x42,
+	0x35, 0x38, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x54,
+	0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x04, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x42,
+	0x35, 0x38, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x42,
+	0x41, 0x44, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x10, 0x05, 0x32, 0x9d, 0x01,
+	0x0a, 0x11, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x43, 0x62, 0x35, 0x38, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x62, 0x35, 0x38, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x43, 0x62, 0x35, 0x38, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x43, 0x62, 0x35, 0x38,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43,
+	0x62, 0x35, 0x38, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x62, 0x35, 0x38, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a,
+	0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72,
+	0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_formatting_proto_rawDescOnce sync.Once
+	file_rpcpb_formatting_proto_rawDescData = file_rpcpb_formatting_proto_rawDesc
+)
+
+func file_rpcpb_formatting_proto_rawDescGZIP() []byte {
+	file_rpcpb_formatting_proto_rawDescOnce.Do(func() {
+		file_rpcpb_formatting_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_formatting_proto_rawDescData)
+	})
+	return file_rpcpb_formatting_proto_rawDescData
+}
+
+var file_rpcpb_formatting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpcpb_formatting_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpcpb_formatting_proto_goTypes = []interface{}{
+	(Cb58ErrorClass)(0),        // 0: rpcpb.Cb58ErrorClass
+	(*Cb58EncodeRequest)(nil),  // 1: rpcpb.Cb58EncodeRequest
+	(*Cb58EncodeResponse)(nil), // 2: rpcpb.Cb58EncodeResponse
+	(*Cb58DecodeRequest)(nil),  // 3: rpcpb.Cb58DecodeRequest
+	(*Cb58DecodeResponse)(nil), // 4: rpcpb.Cb58DecodeResponse
+}
+var file_rpcpb_formatting_proto_depIdxs = []int32{
+	0, // 0: rpcpb.Cb58DecodeRequest.error_class:type_name -> rpcpb.Cb58ErrorClass
+	0, // 1: rpcpb.Cb58DecodeResponse.expected_error_class:type_name -> rpcpb.Cb58ErrorClass
+	1, // 2: rpcpb.FormattingService.Cb58Encode:input_type -> rpcpb.Cb58EncodeRequest
+	3, // 3: rpcpb.FormattingService.Cb58Decode:input_type -> rpcpb.Cb58DecodeRequest
+	2, // 4: rpcpb.FormattingService.Cb58Encode:output_type -> rpcpb.Cb58EncodeResponse
+	4, // 5: rpcpb.FormattingService.Cb58Decode:output_type -> rpcpb.Cb58DecodeResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_formatting_proto_init() }
+func file_rpcpb_formatting_proto_init() {
+	if File_rpcpb_formatting_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_formatting_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cb58EncodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_formatting_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cb58EncodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_formatting_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cb58DecodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_formatting_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cb58DecodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_formatting_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_formatting_proto_goTypes,
+		DependencyIndexes: file_rpcpb_formatting_proto_depIdxs,
+		EnumInfos:         file_rpcpb_formatting_proto_enumTypes,
+		MessageInfos:      file_rpcpb_formatting_proto_msgTypes,
+	}.Build()
+	File_rpcpb_formatting_proto = out.File
+	file_rpcpb_formatting_proto_rawDesc = nil
+	file_rpcpb_formatting_proto_goTypes = nil
+	file_rpcpb_formatting_proto_depIdxs = nil
+}
@@ -0,0 +1,156 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/formatting.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	FormattingService_Cb58Encode_FullMethodName = "/rpcpb.FormattingService/Cb58Encode"
+	FormattingService_Cb58Decode_FullMethodName = "/rpcpb.FormattingService/Cb58Decode"
+)
+
+// FormattingServiceClient is the client API for FormattingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FormattingServiceClient interface {
+	// Encodes bytes with their 4-byte checksum in base58.
+	// ref. "cb58.Encode"
+	Cb58Encode(ctx context.Context, in *Cb58EncodeRequest, opts ...grpc.CallOption) (*Cb58EncodeResponse, error)
+	// Decodes a string, or reports the class of the error avalanchego rejects
+	// it with.
+	// ref. "cb58.Decode"
+	Cb58Decode(ctx context.Context, in *Cb58DecodeRequest, opts ...grpc.CallOption) (*Cb58DecodeResponse, error)
+}
+
+type formattingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFormattingServiceClient(cc grpc.ClientConnInterface) FormattingServiceClient {
+	return &formattingServiceClient{cc}
+}
+
+func (c *formattingServiceClient) Cb58Encode(ctx context.Context, in *Cb58EncodeRequest, opts ...grpc.CallOption) (*Cb58EncodeResponse, error) {
+	out := new(Cb58EncodeResponse)
+	err := c.cc.Invoke(ctx, FormattingService_Cb58Encode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formattingServiceClient) Cb58Decode(ctx context.Context, in *Cb58DecodeRequest, opts ...grpc.CallOption) (*Cb58DecodeResponse, error) {
+	out := new(Cb58DecodeResponse)
+	err := c.cc.Invoke(ctx, FormattingService_Cb58Decode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FormattingServiceServer is the server API for FormattingService service.
+// All implementations must embed UnimplementedFormattingServiceServer
+// for forward compatibility
+type FormattingServiceServer interface {
+	// Encodes bytes with their 4-byte checksum in base58.
+	// ref. "cb58.Encode"
+	Cb58Encode(context.Context, *Cb58EncodeRequest) (*Cb58EncodeResponse, error)
+	// Decodes a string, or reports the class of the error avalanchego rejects
+	// it with.
+	// ref. "cb58.Decode"
+	Cb58Decode(context.Context, *Cb58DecodeRequest) (*Cb58DecodeResponse, error)
+	mustEmbedUnimplementedFormattingServiceServer()
+}
+
+// UnimplementedFormattingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFormattingServiceServer struct {
+}
+
+func (UnimplementedFormattingServiceServer) Cb58Encode(context.Context, *Cb58EncodeRequest) (*Cb58EncodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cb58Encode not implemented")
+}
+func (UnimplementedFormattingServiceServer) Cb58Decode(context.Context, *Cb58DecodeRequest) (*Cb58DecodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cb58Decode not implemented")
+}
+func (UnimplementedFormattingServiceServer) mustEmbedUnimplementedFormattingServiceServer() {}
+
+// UnsafeFormattingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FormattingServiceServer will
+// result in compilation errors.
+type UnsafeFormattingServiceServer interface {
+	mustEmbedUnimplementedFormattingServiceServer()
+}
+
+func RegisterFormattingServiceServer(s grpc.ServiceRegistrar, srv FormattingServiceServer) {
+	s.RegisterService(&FormattingService_ServiceDesc, srv)
+}
+
+func _FormattingService_Cb58Encode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Cb58EncodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormattingServiceServer).Cb58Encode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormattingService_Cb58Encode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormattingServiceServer).Cb58Encode(ctx, req.(*Cb58EncodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormattingService_Cb58Decode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Cb58DecodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormattingServiceServer).Cb58Decode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormattingService_Cb58Decode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormattingServiceServer).Cb58Decode(ctx, req.(*Cb58DecodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FormattingService_ServiceDesc is the grpc.ServiceDesc for FormattingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FormattingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.FormattingService",
+	HandlerType: (*FormattingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Cb58Encode",
+			Handler:    _FormattingService_Cb58Encode_Handler,
+		},
+		{
+			MethodName: "Cb58Decode",
+			Handler:    _FormattingService_Cb58Decode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/formatting.proto",
+}
@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/fuzz.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	FuzzService_Fuzz_FullMethodName              = "/rpcpb.FuzzService/Fuzz"
+	FuzzService_StartFuzzSession_FullMethodName  = "/rpcpb.FuzzService/StartFuzzSession"
+	FuzzService_ReportDivergence_FullMethodName  = "/rpcpb.FuzzService/ReportDivergence"
+	FuzzService_ReportVariant_FullMethodName     = "/rpcpb.FuzzService/ReportVariant"
+	FuzzService_FuzzSessionReport_FullMethodName = "/rpcpb.FuzzService/FuzzSessionReport"
+)
+
+// FuzzServiceClient is the client API for FuzzService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FuzzServiceClient interface {
+	Fuzz(ctx context.Context, in *FuzzRequest, opts ...grpc.CallOption) (*FuzzResponse, error)
+	// StartFuzzSession starts a differential fuzz session, which tracks the
+	// inputs the client reports diverging on and minimizes them.
+	StartFuzzSession(ctx context.Context, in *StartFuzzSessionRequest, opts ...grpc.CallOption) (*StartFuzzSessionResponse, error)
+	// ReportDivergence reports an input the client diverged on, and returns
+	// the first reduced variant of it for the client to check. The input and
+	// received bytes are each bounded by the max message size, and the cases
+	// of a session by 1000 cases and 64 MiB.
+	ReportDivergence(ctx context.Context, in *ReportDivergenceRequest, opts ...grpc.CallOption) (*MinimizeStep, error)
+	// ReportVariant reports whether the client still diverges on the last
+	// variant of a case, and returns the next one. The received bytes are
+	// bounded by the max message size.
+	ReportVariant(ctx context.Context, in *ReportVariantRequest, opts ...grpc.CallOption) (*MinimizeStep, error)
+	// FuzzSessionReport returns the divergent cases of a session with their
+	// minimized reproducers.
+	FuzzSessionReport(ctx context.Context, in *FuzzSessionReportRequest, opts ...grpc.CallOption) (*FuzzSessionReportResponse, error)
+}
+
+type fuzzServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFuzzServiceClient(cc grpc.ClientConnInterface) FuzzServiceClient {
+	return &fuzzServiceClient{cc}
+}
+
+func (c *fuzzServiceClient) Fuzz(ctx context.Context, in *FuzzRequest, opts ...grpc.CallOption) (*FuzzResponse, error) {
+	out := new(FuzzResponse)
+	err := c.cc.Invoke(ctx, FuzzService_Fuzz_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuzzServiceClient) StartFuzzSession(ctx context.Context, in *StartFuzzSessionRequest, opts ...grpc.CallOption) (*StartFuzzSessionResponse, error) {
+	out := new(StartFuzzSessionResponse)
+	err := c.cc.Invoke(ctx, FuzzService_StartFuzzSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuzzServiceClient) ReportDivergence(ctx context.Context, in *ReportDivergenceRequest, opts ...grpc.CallOption) (*MinimizeStep, error) {
+	out := new(MinimizeStep)
+	err := c.cc.Invoke(ctx, FuzzService_ReportDivergence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuzzServiceClient) ReportVariant(ctx context.Context, in *ReportVariantRequest, opts ...grpc.CallOption) (*MinimizeStep, error) {
+	out := new(MinimizeStep)
+	err := c.cc.Invoke(ctx, FuzzService_ReportVariant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuzzServiceClient) FuzzSessionReport(ctx context.Context, in *FuzzSessionReportRequest, opts ...grpc.CallOption) (*FuzzSessionReportResponse, error) {
+	out := new(FuzzSessionReportResponse)
+	err := c.cc.Invoke(ctx, FuzzService_FuzzSessionReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FuzzServiceServer is the server API for FuzzService service.
+// All implementations must embed UnimplementedFuzzServiceServer
+// for forward compatibility
+type FuzzServiceServer interface {
+	Fuzz(context.Context, *FuzzRequest) (*FuzzResponse, error)
+	// StartFuzzSession starts a differential fuzz session, which tracks the
+	// inputs the client reports diverging on and minimizes them.
+	StartFuzzSession(context.Context, *StartFuzzSessionRequest) (*StartFuzzSessionResponse, error)
+	// ReportDivergence reports an input the client diverged on, and returns
+	// the first reduced variant of it for the client to check. The input and
+	// received bytes are each bounded by the max message size, and the cases
+	// of a session by 1000 cases and 64 MiB.
+	ReportDivergence(context.Context, *ReportDivergenceRequest) (*MinimizeStep, error)
+	// ReportVariant reports whether the client still diverges on the last
+	// variant of a case, and returns the next one. The received bytes are
+	// bounded by the max message size.
+	ReportVariant(context.Context, *ReportVariantRequest) (*MinimizeStep, error)
+	// FuzzSessionReport returns the divergent cases of a session with their
+	// minimized reproducers.
+	FuzzSessionReport(context.Context, *FuzzSessionReportRequest) (*FuzzSessionReportResponse, error)
+	mustEmbedUnimplementedFuzzServiceServer()
+}
+
+// UnimplementedFuzzServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFuzzServiceServer struct {
+}
+
+func (UnimplementedFuzzServiceServer) Fuzz(context.Context, *FuzzRequest) (*FuzzResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fuzz not implemented")
+}
+func (UnimplementedFuzzServiceServer) StartFuzzSession(context.Context, *StartFuzzSessionRequest) (*StartFuzzSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartFuzzSession not implemented")
+}
+func (UnimplementedFuzzServiceServer) ReportDivergence(context.Context, *ReportDivergenceRequest) (*MinimizeStep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDivergence not implemented")
+}
+func (UnimplementedFuzzServiceServer) ReportVariant(context.Context, *ReportVariantRequest) (*MinimizeStep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportVariant not implemented")
+}
+func (UnimplementedFuzzServiceServer) FuzzSessionReport(context.Context, *FuzzSessionReportRequest) (*FuzzSessionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FuzzSessionReport not implemented")
+}
+func (UnimplementedFuzzServiceServer) mustEmbedUnimplementedFuzzServiceServer() {}
+
+// UnsafeFuzzServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FuzzServiceServer will
+// result in compilation errors.
+type UnsafeFuzzServiceServer interface {
+	mustEmbedUnimplementedFuzzServiceServer()
+}
+
+func RegisterFuzzServiceServer(s grpc.ServiceRegistrar, srv FuzzServiceServer) {
+	s.RegisterService(&FuzzService_ServiceDesc, srv)
+}
+
+func _FuzzService_Fuzz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuzzRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuzzServiceServer).Fuzz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuzzService_Fuzz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuzzServiceServer).Fuzz(ctx, req.(*FuzzRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuzzService_StartFuzzSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartFuzzSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuzzServiceServer).StartFuzzSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuzzService_StartFuzzSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuzzServiceServer).StartFuzzSession(ctx, req.(*StartFuzzSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuzzService_ReportDivergence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDivergenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuzzServiceServer).ReportDivergence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuzzService_ReportDivergence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuzzServiceServer).ReportDivergence(ctx, req.(*ReportDivergenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuzzService_ReportVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuzzServiceServer).ReportVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuzzService_ReportVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuzzServiceServer).ReportVariant(ctx, req.(*ReportVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuzzService_FuzzSessionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuzzSessionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuzzServiceServer).FuzzSessionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuzzService_FuzzSessionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuzzServiceServer).FuzzSessionReport(ctx, req.(*FuzzSessionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FuzzService_ServiceDesc is the grpc.ServiceDesc for FuzzService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FuzzService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.FuzzService",
+	HandlerType: (*FuzzServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Fuzz",
+			Handler:    _FuzzService_Fuzz_Handler,
+		},
+		{
+			MethodName: "StartFuzzSession",
+			Handler:    _FuzzService_StartFuzzSession_Handler,
+		},
+		{
+			MethodName: "ReportDivergence",
+			Handler:    _FuzzService_ReportDivergence_Handler,
+		},
+		{
+			MethodName: "ReportVariant",
+			Handler:    _FuzzService_ReportVariant_Handler,
+		},
+		{
+			MethodName: "FuzzSessionReport",
+			Handler:    _FuzzService_FuzzSessionReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/fuzz.proto",
+}
@@ -0,0 +1,900 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/genesis.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ParseGenesisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Genesis bytes (i.e., the P-chain genesis state) such as mainnet or fuji genesis.
+	GenesisBytes []byte `protobuf:"bytes,1,opt,name=genesis_bytes,json=genesisBytes,proto3" json:"genesis_bytes,omitempty"`
+	// Optional. Structured genesis JSON decoded by the caller, to be
+	// semantically compared with the avalanchego one.
+	Json string `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *ParseGenesisRequest) Reset() {
+	*x = ParseGenesisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseGenesisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseGenesisRequest) ProtoMessage() {}
+
+func (x *ParseGenesisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseGenesisRequest.ProtoReflect.Descriptor instead.
+func (*ParseGenesisRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParseGenesisRequest) GetGenesisBytes() []byte {
+	if x != nil {
+		return x.GenesisBytes
+	}
+	return nil
+}
+
+func (x *ParseGenesisRequest) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+type ParseGenesisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Structured genesis JSON decoded by avalanchego (initial validators,
+	// allocation UTXOs, chain creation txs, timestamp and initial supply).
+	ExpectedJson string `protobuf:"bytes,1,opt,name=expected_json,json=expectedJson,proto3" json:"expected_json,omitempty"`
+	// ID of the genesis (i.e., sha256 of the genesis bytes).
+	ExpectedGenesisId []byte `protobuf:"bytes,2,opt,name=expected_genesis_id,json=expectedGenesisId,proto3" json:"expected_genesis_id,omitempty"`
+	Message           string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success           bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *ParseGenesisResponse) Reset() {
+	*x = ParseGenesisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseGenesisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseGenesisResponse) ProtoMessage() {}
+
+func (x *ParseGenesisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseGenesisResponse.ProtoReflect.Descriptor instead.
+func (*ParseGenesisResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParseGenesisResponse) GetExpectedJson() string {
+	if x != nil {
+		return x.ExpectedJson
+	}
+	return ""
+}
+
+func (x *ParseGenesisResponse) GetExpectedGenesisId() []byte {
+	if x != nil {
+		return x.ExpectedGenesisId
+	}
+	return nil
+}
+
+func (x *ParseGenesisResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ParseGenesisResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ParseGenesisResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+// GenesisLockedAmount mirrors "genesis.LockedAmount".
+type GenesisLockedAmount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount   uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Locktime uint64 `protobuf:"varint,2,opt,name=locktime,proto3" json:"locktime,omitempty"`
+}
+
+func (x *GenesisLockedAmount) Reset() {
+	*x = GenesisLockedAmount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisLockedAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisLockedAmount) ProtoMessage() {}
+
+func (x *GenesisLockedAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisLockedAmount.ProtoReflect.Descriptor instead.
+func (*GenesisLockedAmount) Descriptor() ([]byte, []int) {
+	return file_rpcpb_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenesisLockedAmount) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *GenesisLockedAmount) GetLocktime() uint64 {
+	if x != nil {
+		return x.Locktime
+	}
+	return 0
+}
+
+// GenesisAllocation mirrors "genesis.Allocation".
+type GenesisAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 20-byte addresses.
+	EthAddr  []byte `protobuf:"bytes,1,opt,name=eth_addr,json=ethAddr,proto3" json:"eth_addr,omitempty"`
+	AvaxAddr []byte `protobuf:"bytes,2,opt,name=avax_addr,json=avaxAddr,proto3" json:"avax_addr,omitempty"`
+	// Amount allocated on the X-chain.
+	InitialAmount uint64 `protobuf:"varint,3,opt,name=initial_amount,json=initialAmount,proto3" json:"initial_amount,omitempty"`
+	// Amounts allocated on the P-chain.
+	UnlockSchedule []*GenesisLockedAmount `protobuf:"bytes,4,rep,name=unlock_schedule,json=unlockSchedule,proto3" json:"unlock_schedule,omitempty"`
+}
+
+func (x *GenesisAllocation) Reset() {
+	*x = GenesisAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_genesis_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisAllocation) ProtoMessage() {}
+
+func (x *GenesisAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisAllocation.ProtoReflect.Descriptor instead.
+func (*GenesisAllocation) Descriptor() ([]byte, []int) {
+	return file_rpcpb_genesis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GenesisAllocation) GetEthAddr() []byte {
+	if x != nil {
+		return x.EthAddr
+	}
+	return nil
+}
+
+func (x *GenesisAllocation) GetAvaxAddr() []byte {
+	if x != nil {
+		return x.AvaxAddr
+	}
+	return nil
+}
+
+func (x *GenesisAllocation) GetInitialAmount() uint64 {
+	if x != nil {
+		return x.InitialAmount
+	}
+	return 0
+}
+
+func (x *GenesisAllocation) GetUnlockSchedule() []*GenesisLockedAmount {
+	if x != nil {
+		return x.UnlockSchedule
+	}
+	return nil
+}
+
+// GenesisStaker mirrors "genesis.Staker".
+type GenesisStaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId []byte `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// 20-byte address.
+	RewardAddress []byte `protobuf:"bytes,2,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// In units of 0.0001%.
+	DelegationFee uint32 `protobuf:"varint,3,opt,name=delegation_fee,json=delegationFee,proto3" json:"delegation_fee,omitempty"`
+}
+
+func (x *GenesisStaker) Reset() {
+	*x = GenesisStaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_genesis_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisStaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisStaker) ProtoMessage() {}
+
+func (x *GenesisStaker) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisStaker.ProtoReflect.Descriptor instead.
+func (*GenesisStaker) Descriptor() ([]byte, []int) {
+	return file_rpcpb_genesis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GenesisStaker) GetNodeId() []byte {
+	if x != nil {
+		return x.NodeId
+	}
+	return nil
+}
+
+func (x *GenesisStaker) GetRewardAddress() []byte {
+	if x != nil {
+		return x.RewardAddress
+	}
+	return nil
+}
+
+func (x *GenesisStaker) GetDelegationFee() uint32 {
+	if x != nil {
+		return x.DelegationFee
+	}
+	return 0
+}
+
+type BuildGenesisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId   uint32               `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Allocations []*GenesisAllocation `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	// Unix time in seconds.
+	StartTime uint64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// In seconds.
+	InitialStakeDuration       uint64 `protobuf:"varint,4,opt,name=initial_stake_duration,json=initialStakeDuration,proto3" json:"initial_stake_duration,omitempty"`
+	InitialStakeDurationOffset uint64 `protobuf:"varint,5,opt,name=initial_stake_duration_offset,json=initialStakeDurationOffset,proto3" json:"initial_stake_duration_offset,omitempty"`
+	// 20-byte addresses of the allocations staked by the initial stakers.
+	InitialStakedFunds [][]byte         `protobuf:"bytes,6,rep,name=initial_staked_funds,json=initialStakedFunds,proto3" json:"initial_staked_funds,omitempty"`
+	InitialStakers     []*GenesisStaker `protobuf:"bytes,7,rep,name=initial_stakers,json=initialStakers,proto3" json:"initial_stakers,omitempty"`
+	// C-chain genesis JSON.
+	CChainGenesis string `protobuf:"bytes,8,opt,name=c_chain_genesis,json=cChainGenesis,proto3" json:"c_chain_genesis,omitempty"`
+	Message       string `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	// Genesis bytes (i.e., the P-chain genesis state).
+	GenesisBytes []byte `protobuf:"bytes,10,opt,name=genesis_bytes,json=genesisBytes,proto3" json:"genesis_bytes,omitempty"`
+	AvaxAssetId  []byte `protobuf:"bytes,11,opt,name=avax_asset_id,json=avaxAssetId,proto3" json:"avax_asset_id,omitempty"`
+	// IDs of the X-chain and C-chain creation txs.
+	XChainId []byte `protobuf:"bytes,12,opt,name=x_chain_id,json=xChainId,proto3" json:"x_chain_id,omitempty"`
+	CChainId []byte `protobuf:"bytes,13,opt,name=c_chain_id,json=cChainId,proto3" json:"c_chain_id,omitempty"`
+}
+
+func (x *BuildGenesisRequest) Reset() {
+	*x = BuildGenesisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_genesis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildGenesisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildGenesisRequest) ProtoMessage() {}
+
+func (x *BuildGenesisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_genesis_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildGenesisRequest.ProtoReflect.Descriptor instead.
+func (*BuildGenesisRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_genesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BuildGenesisRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *BuildGenesisRequest) GetAllocations() []*GenesisAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *BuildGenesisRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *BuildGenesisRequest) GetInitialStakeDuration() uint64 {
+	if x != nil {
+		return x.InitialStakeDuration
+	}
+	return 0
+}
+
+func (x *BuildGenesisRequest) GetInitialStakeDurationOffset() uint64 {
+	if x != nil {
+		return x.InitialStakeDurationOffset
+	}
+	return 0
+}
+
+func (x *BuildGenesisRequest) GetInitialStakedFunds() [][]byte {
+	if x != nil {
+		return x.InitialStakedFunds
+	}
+	return nil
+}
+
+func (x *BuildGenesisRequest) GetInitialStakers() []*GenesisStaker {
+	if x != nil {
+		return x.InitialStakers
+	}
+	return nil
+}
+
+func (x *BuildGenesisRequest) GetCChainGenesis() string {
+	if x != nil {
+		return x.CChainGenesis
+	}
+	return ""
+}
+
+func (x *BuildGenesisRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BuildGenesisRequest) GetGenesisBytes() []byte {
+	if x != nil {
+		return x.GenesisBytes
+	}
+	return nil
+}
+
+func (x *BuildGenesisRequest) GetAvaxAssetId() []byte {
+	if x != nil {
+		return x.AvaxAssetId
+	}
+	return nil
+}
+
+func (x *BuildGenesisRequest) GetXChainId() []byte {
+	if x != nil {
+		return x.XChainId
+	}
+	return nil
+}
+
+func (x *BuildGenesisRequest) GetCChainId() []byte {
+	if x != nil {
+		return x.CChainId
+	}
+	return nil
+}
+
+type BuildGenesisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedGenesisBytes []byte `protobuf:"bytes,1,opt,name=expected_genesis_bytes,json=expectedGenesisBytes,proto3" json:"expected_genesis_bytes,omitempty"`
+	// ID of the genesis (i.e., sha256 of the genesis bytes).
+	ExpectedGenesisId   []byte `protobuf:"bytes,2,opt,name=expected_genesis_id,json=expectedGenesisId,proto3" json:"expected_genesis_id,omitempty"`
+	ExpectedAvaxAssetId []byte `protobuf:"bytes,3,opt,name=expected_avax_asset_id,json=expectedAvaxAssetId,proto3" json:"expected_avax_asset_id,omitempty"`
+	ExpectedXChainId    []byte `protobuf:"bytes,4,opt,name=expected_x_chain_id,json=expectedXChainId,proto3" json:"expected_x_chain_id,omitempty"`
+	ExpectedCChainId    []byte `protobuf:"bytes,5,opt,name=expected_c_chain_id,json=expectedCChainId,proto3" json:"expected_c_chain_id,omitempty"`
+	Message             string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Success             bool   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the genesis bytes differ.
+	Diff *Diff `protobuf:"bytes,8,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,9,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BuildGenesisResponse) Reset() {
+	*x = BuildGenesisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_genesis_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildGenesisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildGenesisResponse) ProtoMessage() {}
+
+func (x *BuildGenesisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_genesis_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildGenesisResponse.ProtoReflect.Descriptor instead.
+func (*BuildGenesisResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_genesis_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BuildGenesisResponse) GetExpectedGenesisBytes() []byte {
+	if x != nil {
+		return x.ExpectedGenesisBytes
+	}
+	return nil
+}
+
+func (x *BuildGenesisResponse) GetExpectedGenesisId() []byte {
+	if x != nil {
+		return x.ExpectedGenesisId
+	}
+	return nil
+}
+
+func (x *BuildGenesisResponse) GetExpectedAvaxAssetId() []byte {
+	if x != nil {
+		return x.ExpectedAvaxAssetId
+	}
+	return nil
+}
+
+func (x *BuildGenesisResponse) GetExpectedXChainId() []byte {
+	if x != nil {
+		return x.ExpectedXChainId
+	}
+	return nil
+}
+
+func (x *BuildGenesisResponse) GetExpectedCChainId() []byte {
+	if x != nil {
+		return x.ExpectedCChainId
+	}
+	return nil
+}
+
+func (x *BuildGenesisResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BuildGenesisResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BuildGenesisResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *BuildGenesisResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_genesis_proto protoreflect.FileDescriptor
+
+var file_rpcpb_genesis_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x1a, 0x10, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2f, 0x64, 0x69, 0x66, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4e,
+	0x0a, 0x13, 0x50, 0x61, 0x72, 0x73, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x67, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0xcd,
+	0x01, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x73, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x49,
+	0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x65, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76,
+	0x61, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61,
+	0x76, 0x61, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43,
+	0x0a, 0x0f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x0e, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x22, 0x76, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x22, 0xc0, 0x04, 0x0a, 0x13,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a,
+	0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x1d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x22,
+	0x0a, 0x0d, 0x61, 0x76, 0x61, 0x78, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x76, 0x61, 0x78, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x78, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x0a, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x92,
+	0x03, 0x0a, 0x14, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x49, 0x64, 0x12, 0x33, 0x0a,
+	0x16, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x76, 0x61, 0x78, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x76, 0x61, 0x78, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x78,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x58, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x2d, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x32, 0xa6, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50,
+	0x61, 0x72, 0x73, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x0c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73,
+	0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_genesis_proto_rawDescOnce sync.Once
+	file_rpcpb_genesis_proto_rawDescData = file_rpcpb_genesis_proto_rawDesc
+)
+
+func file_rpcpb_genesis_proto_rawDescGZIP() []byte {
+	file_rpcpb_genesis_proto_rawDescOnce.Do(func() {
+		file_rpcpb_genesis_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_genesis_proto_rawDescData)
+	})
+	return file_rpcpb_genesis_proto_rawDescData
+}
+
+var file_rpcpb_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_rpcpb_genesis_proto_goTypes = []interface{}{
+	(*ParseGenesisRequest)(nil),  // 0: rpcpb.ParseGenesisRequest
+	(*ParseGenesisResponse)(nil), // 1: rpcpb.ParseGenesisResponse
+	(*GenesisLockedAmount)(nil),  // 2: rpcpb.GenesisLockedAmount
+	(*GenesisAllocation)(nil),    // 3: rpcpb.GenesisAllocation
+	(*GenesisStaker)(nil),        // 4: rpcpb.GenesisStaker
+	(*BuildGenesisRequest)(nil),  // 5: rpcpb.BuildGenesisRequest
+	(*BuildGenesisResponse)(nil), // 6: rpcpb.BuildGenesisResponse
+	(*Diff)(nil),                 // 7: rpcpb.Diff
+}
+var file_rpcpb_genesis_proto_depIdxs = []int32{
+	2, // 0: rpcpb.GenesisAllocation.unlock_schedule:type_name -> rpcpb.GenesisLockedAmount
+	3, // 1: rpcpb.BuildGenesisRequest.allocations:type_name -> rpcpb.GenesisAllocation
+	4, // 2: rpcpb.BuildGenesisRequest.initial_stakers:type_name -> rpcpb.GenesisStaker
+	7, // 3: rpcpb.BuildGenesisResponse.diff:type_name -> rpcpb.Diff
+	0, // 4: rpcpb.GenesisService.ParseGenesis:input_type -> rpcpb.ParseGenesisRequest
+	5, // 5: rpcpb.GenesisService.BuildGenesis:input_type -> rpcpb.BuildGenesisRequest
+	1, // 6: rpcpb.GenesisService.ParseGenesis:output_type -> rpcpb.ParseGenesisResponse
+	6, // 7: rpcpb.GenesisService.BuildGenesis:output_type -> rpcpb.BuildGenesisResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_genesis_proto_init() }
+func file_rpcpb_genesis_proto_init() {
+	if File_rpcpb_genesis_proto != nil {
+		return
+	}
+	file_rpcpb_diff_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseGenesisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseGenesisResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisLockedAmount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisStaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_genesis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildGenesisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_genesis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildGenesisResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_genesis_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_genesis_proto_goTypes,
+		DependencyIndexes: file_rpcpb_genesis_proto_depIdxs,
+		MessageInfos:      file_rpcpb_genesis_proto_msgTypes,
+	}.Build()
+	File_rpcpb_genesis_proto = out.File
+	file_rpcpb_genesis_proto_rawDesc = nil
+	file_rpcpb_genesis_proto_goTypes = nil
+	file_rpcpb_genesis_proto_depIdxs = nil
+}
@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/genesis.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GenesisService_ParseGenesis_FullMethodName = "/rpcpb.GenesisService/ParseGenesis"
+	GenesisService_BuildGenesis_FullMethodName = "/rpcpb.GenesisService/BuildGenesis"
+)
+
+// GenesisServiceClient is the client API for GenesisService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GenesisServiceClient interface {
+	ParseGenesis(ctx context.Context, in *ParseGenesisRequest, opts ...grpc.CallOption) (*ParseGenesisResponse, error)
+	// Builds the genesis of a custom network from its config.
+	// ref. "genesis.FromConfig"
+	BuildGenesis(ctx context.Context, in *BuildGenesisRequest, opts ...grpc.CallOption) (*BuildGenesisResponse, error)
+}
+
+type genesisServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGenesisServiceClient(cc grpc.ClientConnInterface) GenesisServiceClient {
+	return &genesisServiceClient{cc}
+}
+
+func (c *genesisServiceClient) ParseGenesis(ctx context.Context, in *ParseGenesisRequest, opts ...grpc.CallOption) (*ParseGenesisResponse, error) {
+	out := new(ParseGenesisResponse)
+	err := c.cc.Invoke(ctx, GenesisService_ParseGenesis_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genesisServiceClient) BuildGenesis(ctx context.Context, in *BuildGenesisRequest, opts ...grpc.CallOption) (*BuildGenesisResponse, error) {
+	out := new(BuildGenesisResponse)
+	err := c.cc.Invoke(ctx, GenesisService_BuildGenesis_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GenesisServiceServer is the server API for GenesisService service.
+// All implementations must embed UnimplementedGenesisServiceServer
+// for forward compatibility
+type GenesisServiceServer interface {
+	ParseGenesis(context.Context, *ParseGenesisRequest) (*ParseGenesisResponse, error)
+	// Builds the genesis of a custom network from its config.
+	// ref. "genesis.FromConfig"
+	BuildGenesis(context.Context, *BuildGenesisRequest) (*BuildGenesisResponse, error)
+	mustEmbedUnimplementedGenesisServiceServer()
+}
+
+// UnimplementedGenesisServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGenesisServiceServer struct {
+}
+
+func (UnimplementedGenesisServiceServer) ParseGenesis(context.Context, *ParseGenesisRequest) (*ParseGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseGenesis not implemented")
+}
+func (UnimplementedGenesisServiceServer) BuildGenesis(context.Context, *BuildGenesisRequest) (*BuildGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildGenesis not implemented")
+}
+func (UnimplementedGenesisServiceServer) mustEmbedUnimplementedGenesisServiceServer() {}
+
+// UnsafeGenesisServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GenesisServiceServer will
+// result in compilation errors.
+type UnsafeGenesisServiceServer interface {
+	mustEmbedUnimplementedGenesisServiceServer()
+}
+
+func RegisterGenesisServiceServer(s grpc.ServiceRegistrar, srv GenesisServiceServer) {
+	s.RegisterService(&GenesisService_ServiceDesc, srv)
+}
+
+func _GenesisService_ParseGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenesisServiceServer).ParseGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenesisService_ParseGenesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenesisServiceServer).ParseGenesis(ctx, req.(*ParseGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenesisService_BuildGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenesisServiceServer).BuildGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenesisService_BuildGenesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenesisServiceServer).BuildGenesis(ctx, req.(*BuildGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GenesisService_ServiceDesc is the grpc.ServiceDesc for GenesisService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GenesisService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.GenesisService",
+	HandlerType: (*GenesisServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ParseGenesis",
+			Handler:    _GenesisService_ParseGenesis_Handler,
+		},
+		{
+			MethodName: "BuildGenesis",
+			Handler:    _GenesisService_BuildGenesis_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/genesis.proto",
+}
@@ -0,0 +1,448 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/hash.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type HashFunction int32
+
+const (
+	HashFunction_HASH_FUNCTION_UNSPECIFIED HashFunction = 0
+	// SHA-256.
+	// ref. "hashing.ComputeHash256", "hashing.ComputeHash256Array"
+	HashFunction_HASH_FUNCTION_HASH256 HashFunction = 1
+	// SHA-256 of the concatenated ranges of the data.
+	// ref. "hashing.ComputeHash256Ranges"
+	HashFunction_HASH_FUNCTION_HASH256_RANGES HashFunction = 2
+	// RIPEMD-160.
+	// ref. "hashing.ComputeHash160", "hashing.ComputeHash160Array"
+	HashFunction_HASH_FUNCTION_HASH160 HashFunction = 3
+	// Last checksum_length bytes of the SHA-256.
+	// ref. "hashing.Checksum"
+	HashFunction_HASH_FUNCTION_CHECKSUM HashFunction = 4
+	// RIPEMD-160 of the SHA-256.
+	// ref. "hashing.PubkeyBytesToAddress"
+	HashFunction_HASH_FUNCTION_PUBKEY_BYTES_TO_ADDRESS HashFunction = 5
+)
+
+// Enum value maps for HashFunction.
+var (
+	HashFunction_name = map[int32]string{
+		0: "HASH_FUNCTION_UNSPECIFIED",
+		1: "HASH_FUNCTION_HASH256",
+		2: "HASH_FUNCTION_HASH256_RANGES",
+		3: "HASH_FUNCTION_HASH160",
+		4: "HASH_FUNCTION_CHECKSUM",
+		5: "HASH_FUNCTION_PUBKEY_BYTES_TO_ADDRESS",
+	}
+	HashFunction_value = map[string]int32{
+		"HASH_FUNCTION_UNSPECIFIED":             0,
+		"HASH_FUNCTION_HASH256":                 1,
+		"HASH_FUNCTION_HASH256_RANGES":          2,
+		"HASH_FUNCTION_HASH160":                 3,
+		"HASH_FUNCTION_CHECKSUM":                4,
+		"HASH_FUNCTION_PUBKEY_BYTES_TO_ADDRESS": 5,
+	}
+)
+
+func (x HashFunction) Enum() *HashFunction {
+	p := new(HashFunction)
+	*p = x
+	return p
+}
+
+func (x HashFunction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HashFunction) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_hash_proto_enumTypes[0].Descriptor()
+}
+
+func (HashFunction) Type() protoreflect.EnumType {
+	return &file_rpcpb_hash_proto_enumTypes[0]
+}
+
+func (x HashFunction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HashFunction.Descriptor instead.
+func (HashFunction) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_hash_proto_rawDescGZIP(), []int{0}
+}
+
+// HashRange is the range [start, end) of the data.
+type HashRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *HashRange) Reset() {
+	*x = HashRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_hash_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashRange) ProtoMessage() {}
+
+func (x *HashRange) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_hash_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashRange.ProtoReflect.Descriptor instead.
+func (*HashRange) Descriptor() ([]byte, []int) {
+	return file_rpcpb_hash_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HashRange) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *HashRange) GetEnd() uint32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type HashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Function HashFunction `protobuf:"varint,1,opt,name=function,proto3,enum=rpcpb.HashFunction" json:"function,omitempty"`
+	Data     []byte       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Ranges of HASH_FUNCTION_HASH256_RANGES.
+	Ranges []*HashRange `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	// Length of HASH_FUNCTION_CHECKSUM, 4 if zero (as cb58 uses).
+	ChecksumLength uint32 `protobuf:"varint,4,opt,name=checksum_length,json=checksumLength,proto3" json:"checksum_length,omitempty"`
+	Digest         []byte `protobuf:"bytes,5,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *HashRequest) Reset() {
+	*x = HashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_hash_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashRequest) ProtoMessage() {}
+
+func (x *HashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_hash_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashRequest.ProtoReflect.Descriptor instead.
+func (*HashRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_hash_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HashRequest) GetFunction() HashFunction {
+	if x != nil {
+		return x.Function
+	}
+	return HashFunction_HASH_FUNCTION_UNSPECIFIED
+}
+
+func (x *HashRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *HashRequest) GetRanges() []*HashRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+func (x *HashRequest) GetChecksumLength() uint32 {
+	if x != nil {
+		return x.ChecksumLength
+	}
+	return 0
+}
+
+func (x *HashRequest) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+type HashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedDigest []byte `protobuf:"bytes,1,opt,name=expected_digest,json=expectedDigest,proto3" json:"expected_digest,omitempty"`
+	Message        string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success        bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *HashResponse) Reset() {
+	*x = HashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_hash_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashResponse) ProtoMessage() {}
+
+func (x *HashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_hash_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashResponse.ProtoReflect.Descriptor instead.
+func (*HashResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_hash_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HashResponse) GetExpectedDigest() []byte {
+	if x != nil {
+		return x.ExpectedDigest
+	}
+	return nil
+}
+
+func (x *HashResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HashResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HashResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_hash_proto protoreflect.FileDescriptor
+
+var file_rpcpb_hash_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x22, 0x33, 0x0a, 0x09, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xbd,
+	0x01, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f,
+	0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0x99,
+	0x01, 0x0a, 0x0c, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x2a, 0xcc, 0x01, 0x0a, 0x0c, 0x48,
+	0x61, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x48,
+	0x41, 0x53, 0x48, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x48, 0x41,
+	0x53, 0x48, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x41, 0x53, 0x48,
+	0x32, 0x35, 0x36, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x46, 0x55,
+	0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x32, 0x35, 0x36, 0x5f, 0x52,
+	0x41, 0x4e, 0x47, 0x45, 0x53, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x48, 0x41, 0x53, 0x48, 0x5f,
+	0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x31, 0x36, 0x30,
+	0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x10, 0x04, 0x12, 0x29,
+	0x0a, 0x25, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x55, 0x42, 0x4b, 0x45, 0x59, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x54, 0x4f, 0x5f,
+	0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x05, 0x32, 0x40, 0x0a, 0x0b, 0x48, 0x61, 0x73,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73, 0x2f,
+	0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_hash_proto_rawDescOnce sync.Once
+	file_rpcpb_hash_proto_rawDescData = file_rpcpb_hash_proto_rawDesc
+)
+
+func file_rpcpb_hash_proto_rawDescGZIP() []byte {
+	file_rpcpb_hash_proto_rawDescOnce.Do(func() {
+		file_rpcpb_hash_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_hash_proto_rawDescData)
+	})
+	return file_rpcpb_hash_proto_rawDescData
+}
+
+var file_rpcpb_hash_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpcpb_hash_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpcpb_hash_proto_goTypes = []interface{}{
+	(HashFunction)(0),    // 0: rpcpb.HashFunction
+	(*HashRange)(nil),    // 1: rpcpb.HashRange
+	(*HashRequest)(nil),  // 2: rpcpb.HashRequest
+	(*HashResponse)(nil), // 3: rpcpb.HashResponse
+}
+var file_rpcpb_hash_proto_depIdxs = []int32{
+	0, // 0: rpcpb.HashRequest.function:type_name -> rpcpb.HashFunction
+	1, // 1: rpcpb.HashRequest.ranges:type_name -> rpcpb.HashRange
+	2, // 2: rpcpb.HashService.Hash:input_type -> rpcpb.HashRequest
+	3, // 3: rpcpb.HashService.Hash:output_type -> rpcpb.HashResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_hash_proto_init() }
+func file_rpcpb_hash_proto_init() {
+	if File_rpcpb_hash_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_hash_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_hash_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_hash_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_hash_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_hash_proto_goTypes,
+		DependencyIndexes: file_rpcpb_hash_proto_depIdxs,
+		EnumInfos:         file_rpcpb_hash_proto_enumTypes,
+		MessageInfos:      file_rpcpb_hash_proto_msgTypes,
+	}.Build()
+	File_rpcpb_hash_proto = out.File
+	file_rpcpb_hash_proto_rawDesc = nil
+	file_rpcpb_hash_proto_goTypes = nil
+	file_rpcpb_hash_proto_depIdxs = nil
+}
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/hash.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	HashService_Hash_FullMethodName = "/rpcpb.HashService/Hash"
+)
+
+// HashServiceClient is the client API for HashService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HashServiceClient interface {
+	Hash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*HashResponse, error)
+}
+
+type hashServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHashServiceClient(cc grpc.ClientConnInterface) HashServiceClient {
+	return &hashServiceClient{cc}
+}
+
+func (c *hashServiceClient) Hash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*HashResponse, error) {
+	out := new(HashResponse)
+	err := c.cc.Invoke(ctx, HashService_Hash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HashServiceServer is the server API for HashService service.
+// All implementations must embed UnimplementedHashServiceServer
+// for forward compatibility
+type HashServiceServer interface {
+	Hash(context.Context, *HashRequest) (*HashResponse, error)
+	mustEmbedUnimplementedHashServiceServer()
+}
+
+// UnimplementedHashServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedHashServiceServer struct {
+}
+
+func (UnimplementedHashServiceServer) Hash(context.Context, *HashRequest) (*HashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hash not implemented")
+}
+func (UnimplementedHashServiceServer) mustEmbedUnimplementedHashServiceServer() {}
+
+// UnsafeHashServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HashServiceServer will
+// result in compilation errors.
+type UnsafeHashServiceServer interface {
+	mustEmbedUnimplementedHashServiceServer()
+}
+
+func RegisterHashServiceServer(s grpc.ServiceRegistrar, srv HashServiceServer) {
+	s.RegisterService(&HashService_ServiceDesc, srv)
+}
+
+func _HashService_Hash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HashServiceServer).Hash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HashService_Hash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HashServiceServer).Hash(ctx, req.(*HashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HashService_ServiceDesc is the grpc.ServiceDesc for HashService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HashService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.HashService",
+	HandlerType: (*HashServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Hash",
+			Handler:    _HashService_Hash_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/hash.proto",
+}
@@ -0,0 +1,1052 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/ids.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IdKind int32
+
+const (
+	IdKind_ID_KIND_UNSPECIFIED IdKind = 0
+	// 32-byte ID formatted in cb58.
+	IdKind_ID_KIND_ID IdKind = 1
+	// 20-byte ID formatted in cb58.
+	IdKind_ID_KIND_SHORT_ID IdKind = 2
+	// 20-byte ID formatted in cb58 with the "NodeID-" prefix.
+	IdKind_ID_KIND_NODE_ID IdKind = 3
+)
+
+// Enum value maps for IdKind.
+var (
+	IdKind_name = map[int32]string{
+		0: "ID_KIND_UNSPECIFIED",
+		1: "ID_KIND_ID",
+		2: "ID_KIND_SHORT_ID",
+		3: "ID_KIND_NODE_ID",
+	}
+	IdKind_value = map[string]int32{
+		"ID_KIND_UNSPECIFIED": 0,
+		"ID_KIND_ID":          1,
+		"ID_KIND_SHORT_ID":    2,
+		"ID_KIND_NODE_ID":     3,
+	}
+)
+
+func (x IdKind) Enum() *IdKind {
+	p := new(IdKind)
+	*p = x
+	return p
+}
+
+func (x IdKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_ids_proto_enumTypes[0].Descriptor()
+}
+
+func (IdKind) Type() protoreflect.EnumType {
+	return &file_rpcpb_ids_proto_enumTypes[0]
+}
+
+func (x IdKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdKind.Descriptor instead.
+func (IdKind) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_ids_proto_rawDescGZIP(), []int{0}
+}
+
+type IdFromBytesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind    IdKind `protobuf:"varint,1,opt,name=kind,proto3,enum=rpcpb.IdKind" json:"kind,omitempty"`
+	IdBytes []byte `protobuf:"bytes,2,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
+	// Formatted ID, or rejected if the client rejects the bytes.
+	Formatted string `protobuf:"bytes,3,opt,name=formatted,proto3" json:"formatted,omitempty"`
+	Hex       string `protobuf:"bytes,4,opt,name=hex,proto3" json:"hex,omitempty"`
+	Rejected  bool   `protobuf:"varint,5,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *IdFromBytesRequest) Reset() {
+	*x = IdFromBytesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_ids_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdFromBytesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdFromBytesRequest) ProtoMessage() {}
+
+func (x *IdFromBytesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_ids_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdFromBytesRequest.ProtoReflect.Descriptor instead.
+func (*IdFromBytesRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_ids_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IdFromBytesRequest) GetKind() IdKind {
+	if x != nil {
+		return x.Kind
+	}
+	return IdKind_ID_KIND_UNSPECIFIED
+}
+
+func (x *IdFromBytesRequest) GetIdBytes() []byte {
+	if x != nil {
+		return x.IdBytes
+	}
+	return nil
+}
+
+func (x *IdFromBytesRequest) GetFormatted() string {
+	if x != nil {
+		return x.Formatted
+	}
+	return ""
+}
+
+func (x *IdFromBytesRequest) GetHex() string {
+	if x != nil {
+		return x.Hex
+	}
+	return ""
+}
+
+func (x *IdFromBytesRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type IdFromBytesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedFormatted string `protobuf:"bytes,1,opt,name=expected_formatted,json=expectedFormatted,proto3" json:"expected_formatted,omitempty"`
+	ExpectedHex       string `protobuf:"bytes,2,opt,name=expected_hex,json=expectedHex,proto3" json:"expected_hex,omitempty"`
+	// Error avalanchego rejects the bytes with.
+	ExpectedError string `protobuf:"bytes,3,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *IdFromBytesResponse) Reset() {
+	*x = IdFromBytesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_ids_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdFromBytesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdFromBytesResponse) ProtoMessage() {}
+
+func (x *IdFromBytesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_ids_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdFromBytesResponse.ProtoReflect.Descriptor instead.
+func (*IdFromBytesResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_ids_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IdFromBytesResponse) GetExpectedFormatted() string {
+	if x != nil {
+		return x.ExpectedFormatted
+	}
+	return ""
+}
+
+func (x *IdFromBytesResponse) GetExpectedHex() string {
+	if x != nil {
+		return x.ExpectedHex
+	}
+	return ""
+}
+
+func (x *IdFromBytesResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *IdFromBytesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *IdFromBytesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *IdFromBytesResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type IdParseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      IdKind `protobuf:"varint,1,opt,name=kind,proto3,enum=rpcpb.IdKind" json:"kind,omitempty"`
+	Formatted string `protobuf:"bytes,2,opt,name=formatted,proto3" json:"formatted,omitempty"`
+	// Parsed ID, or rejected if the client rejects the string.
+	IdBytes  []byte `protobuf:"bytes,3,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
+	Rejected bool   `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *IdParseRequest) Reset() {
+	*x = IdParseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_ids_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdParseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdParseRequest) ProtoMessage() {}
+
+func (x *IdParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_ids_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdParseRequest.ProtoReflect.Descriptor instead.
+func (*IdParseRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_ids_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IdParseRequest) GetKind() IdKind {
+	if x != nil {
+		return x.Kind
+	}
+	return IdKind_ID_KIND_UNSPECIFIED
+}
+
+func (x *IdParseRequest) GetFormatted() string {
+	if x != nil {
+		return x.Formatted
+	}
+	return ""
+}
+
+func (x *IdParseRequest) GetIdBytes() []byte {
+	if x != nil {
+		return x.IdBytes
+	}
+	return nil
+}
+
+func (x *IdParseRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type IdParseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedIdBytes []byte `protobuf:"bytes,1,opt,name=expected_id_bytes,json=expectedIdBytes,proto3" json:"expected_id_bytes,omitempty"`
+	// Error avalanchego rejects the string with.
+	ExpectedError string `protobuf:"bytes,2,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *IdParseResponse) Reset() {
+	*x = IdParseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_ids_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdParseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdParseResponse) ProtoMessage() {}
+
+func (x *IdParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_ids_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdParseResponse.ProtoReflect.Descriptor instead.
+func (*IdParseResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_ids_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IdParseResponse) GetExpectedIdBytes() []byte {
+	if x != nil {
+		return x.ExpectedIdBytes
+	}
+	return nil
+}
+
+func (x *IdParseResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *IdParseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *IdParseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *IdParseResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type ShortIdFromPublicKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 33-byte compressed public key.
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	ShortId   []byte `protobuf:"bytes,2,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
+}
+
+func (x *ShortIdFromPublicKeyRequest) Reset() {
+	*x = ShortIdFromPublicKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_ids_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShortIdFromPublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortIdFromPublicKeyRequest) ProtoMessage() {}
+
+func (x *ShortIdFromPublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_ids_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortIdFromPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*ShortIdFromPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_ids_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ShortIdFromPublicKeyRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ShortIdFromPublicKeyRequest) GetShortId() []byte {
+	if x != nil {
+		return x.ShortId
+	}
+	return nil
+}
+
+type ShortIdFromPublicKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RIPEMD-160 of the SHA-256 of the compressed public key.
+	ExpectedShortId     []byte `protobuf:"bytes,1,opt,name=expected_short_id,json=expectedShortId,proto3" json:"expected_short_id,omitempty"`
+	ExpectedShortIdCb58 string `protobuf:"bytes,2,opt,name=expected_short_id_cb58,json=expectedShortIdCb58,proto3" json:"expected_short_id_cb58,omitempty"`
+	Message             string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success             bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *ShortIdFromPublicKeyResponse) Reset() {
+	*x = ShortIdFromPublicKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_ids_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShortIdFromPublicKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortIdFromPublicKeyResponse) ProtoMessage() {}
+
+func (x *ShortIdFromPublicKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_ids_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortIdFromPublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*ShortIdFromPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_ids_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ShortIdFromPublicKeyResponse) GetExpectedShortId() []byte {
+	if x != nil {
+		return x.ExpectedShortId
+	}
+	return nil
+}
+
+func (x *ShortIdFromPublicKeyResponse) GetExpectedShortIdCb58() string {
+	if x != nil {
+		return x.ExpectedShortIdCb58
+	}
+	return ""
+}
+
+func (x *ShortIdFromPublicKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ShortIdFromPublicKeyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ShortIdFromPublicKeyResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type IdBitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OtherId []byte `protobuf:"bytes,2,opt,name=other_id,json=otherId,proto3" json:"other_id,omitempty"`
+	// Bit range [start, stop) compared between the IDs. Bit i is the bit of
+	// byte i / 8 at index i % 8 from the least significant bit.
+	Start    uint32   `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Stop     uint32   `protobuf:"varint,4,opt,name=stop,proto3" json:"stop,omitempty"`
+	Prefixes []uint64 `protobuf:"varint,5,rep,packed,name=prefixes,proto3" json:"prefixes,omitempty"`
+	// 256 bytes of 0 or 1, one per bit of the ID.
+	Bits        []byte `protobuf:"bytes,6,opt,name=bits,proto3" json:"bits,omitempty"`
+	EqualSubset bool   `protobuf:"varint,7,opt,name=equal_subset,json=equalSubset,proto3" json:"equal_subset,omitempty"`
+	// Index of the first differing bit in the range, or -1 if none.
+	FirstDifference int32  `protobuf:"varint,8,opt,name=first_difference,json=firstDifference,proto3" json:"first_difference,omitempty"`
+	PrefixedId      []byte `protobuf:"bytes,9,opt,name=prefixed_id,json=prefixedId,proto3" json:"prefixed_id,omitempty"`
+}
+
+func (x *IdBitsRequest) Reset() {
+	*x = IdBitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_ids_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdBitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdBitsRequest) ProtoMessage() {}
+
+func (x *IdBitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_ids_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdBitsRequest.ProtoReflect.Descriptor instead.
+func (*IdBitsRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_ids_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IdBitsRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *IdBitsRequest) GetOtherId() []byte {
+	if x != nil {
+		return x.OtherId
+	}
+	return nil
+}
+
+func (x *IdBitsRequest) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *IdBitsRequest) GetStop() uint32 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+func (x *IdBitsRequest) GetPrefixes() []uint64 {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+func (x *IdBitsRequest) GetBits() []byte {
+	if x != nil {
+		return x.Bits
+	}
+	return nil
+}
+
+func (x *IdBitsRequest) GetEqualSubset() bool {
+	if x != nil {
+		return x.EqualSubset
+	}
+	return false
+}
+
+func (x *IdBitsRequest) GetFirstDifference() int32 {
+	if x != nil {
+		return x.FirstDifference
+	}
+	return 0
+}
+
+func (x *IdBitsRequest) GetPrefixedId() []byte {
+	if x != nil {
+		return x.PrefixedId
+	}
+	return nil
+}
+
+type IdBitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedBits            []byte `protobuf:"bytes,1,opt,name=expected_bits,json=expectedBits,proto3" json:"expected_bits,omitempty"`
+	ExpectedEqualSubset     bool   `protobuf:"varint,2,opt,name=expected_equal_subset,json=expectedEqualSubset,proto3" json:"expected_equal_subset,omitempty"`
+	ExpectedFirstDifference int32  `protobuf:"varint,3,opt,name=expected_first_difference,json=expectedFirstDifference,proto3" json:"expected_first_difference,omitempty"`
+	ExpectedPrefixedId      []byte `protobuf:"bytes,4,opt,name=expected_prefixed_id,json=expectedPrefixedId,proto3" json:"expected_prefixed_id,omitempty"`
+	Message                 string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Success                 bool   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,7,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *IdBitsResponse) Reset() {
+	*x = IdBitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_ids_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdBitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdBitsResponse) ProtoMessage() {}
+
+func (x *IdBitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_ids_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdBitsResponse.ProtoReflect.Descriptor instead.
+func (*IdBitsResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_ids_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IdBitsResponse) GetExpectedBits() []byte {
+	if x != nil {
+		return x.ExpectedBits
+	}
+	return nil
+}
+
+func (x *IdBitsResponse) GetExpectedEqualSubset() bool {
+	if x != nil {
+		return x.ExpectedEqualSubset
+	}
+	return false
+}
+
+func (x *IdBitsResponse) GetExpectedFirstDifference() int32 {
+	if x != nil {
+		return x.ExpectedFirstDifference
+	}
+	return 0
+}
+
+func (x *IdBitsResponse) GetExpectedPrefixedId() []byte {
+	if x != nil {
+		return x.ExpectedPrefixedId
+	}
+	return nil
+}
+
+func (x *IdBitsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *IdBitsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *IdBitsResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_ids_proto protoreflect.FileDescriptor
+
+var file_rpcpb_ids_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x69, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x22, 0x9e, 0x01, 0x0a, 0x12, 0x49, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x49, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x68,
+	0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x68, 0x65, 0x78, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x13, 0x49, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x48, 0x65, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x88, 0x01, 0x0a,
+	0x0e, 0x49, 0x64, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x49, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x0f, 0x49, 0x64, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x49, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x22, 0x57, 0x0a, 0x1b, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x1c, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x49, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x63, 0x62, 0x35, 0x38,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x43, 0x62, 0x35, 0x38, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x83, 0x02,
+	0x0a, 0x0d, 0x49, 0x64, 0x42, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x73, 0x74, 0x6f, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x62, 0x69, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x75,
+	0x62, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x71, 0x75, 0x61,
+	0x6c, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x49, 0x64, 0x22, 0xb9, 0x02, 0x0a, 0x0e, 0x49, 0x64, 0x42, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x69, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x75,
+	0x62, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12,
+	0x3a, 0x0a, 0x19, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x72, 0x73,
+	0x74, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x2a,
+	0x5c, 0x0a, 0x06, 0x49, 0x64, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x44, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x49, 0x44,
+	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x44, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x48,
+	0x4f, 0x52, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x44, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x44, 0x10, 0x03, 0x32, 0xab, 0x02,
+	0x0a, 0x09, 0x49, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x49,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x49, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x49, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x07, 0x49, 0x64, 0x50, 0x61, 0x72, 0x73, 0x65, 0x12, 0x15,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x49, 0x64, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x49, 0x64,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x61, 0x0a, 0x14, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x06, 0x49, 0x64, 0x42, 0x69, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x49, 0x64, 0x42, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x49, 0x64, 0x42, 0x69, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73, 0x2f,
+	0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_ids_proto_rawDescOnce sync.Once
+	file_rpcpb_ids_proto_rawDescData = file_rpcpb_ids_proto_rawDesc
+)
+
+func file_rpcpb_ids_proto_rawDescGZIP() []byte {
+	file_rpcpb_ids_proto_rawDescOnce.Do(func() {
+		file_rpcpb_ids_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_ids_proto_rawDescData)
+	})
+	return file_rpcpb_ids_proto_rawDescData
+}
+
+var file_rpcpb_ids_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpcpb_ids_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_rpcpb_ids_proto_goTypes = []interface{}{
+	(IdKind)(0),                          // 0: rpcpb.IdKind
+	(*IdFromBytesRequest)(nil),           // 1: rpcpb.IdFromBytesRequest
+	(*IdFromBytesResponse)(nil),          // 2: rpcpb.IdFromBytesResponse
+	(*IdParseRequest)(nil),               // 3: rpcpb.IdParseRequest
+	(*IdParseResponse)(nil),              // 4: rpcpb.IdParseResponse
+	(*ShortIdFromPublicKeyRequest)(nil),  // 5: rpcpb.ShortIdFromPublicKeyRequest
+	(*ShortIdFromPublicKeyResponse)(nil), // 6: rpcpb.ShortIdFromPublicKeyResponse
+	(*IdBitsRequest)(nil),                // 7: rpcpb.IdBitsRequest
+	(*IdBitsResponse)(nil),               // 8: rpcpb.IdBitsResponse
+}
+var file_rpcpb_ids_proto_depIdxs = []int32{
+	0, // 0: rpcpb.IdFromBytesRequest.kind:type_name -> rpcpb.IdKind
+	0, // 1: rpcpb.IdParseRequest.kind:type_name -> rpcpb.IdKind
+	1, // 2: rpcpb.IdService.IdFromBytes:input_type -> rpcpb.IdFromBytesRequest
+	3, // 3: rpcpb.IdService.IdParse:input_type -> rpcpb.IdParseRequest
+	5, // 4: rpcpb.IdService.ShortIdFromPublicKey:input_type -> rpcpb.ShortIdFromPublicKeyRequest
+	7, // 5: rpcpb.IdService.IdBits:input_type -> rpcpb.IdBitsRequest
+	2, // 6: rpcpb.IdService.IdFromBytes:output_type -> rpcpb.IdFromBytesResponse
+	4, // 7: rpcpb.IdService.IdParse:output_type -> rpcpb.IdParseResponse
+	6, // 8: rpcpb.IdService.ShortIdFromPublicKey:output_type -> rpcpb.ShortIdFromPublicKeyResponse
+	8, // 9: rpcpb.IdService.IdBits:output_type -> rpcpb.IdBitsResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_ids_proto_init() }
+func file_rpcpb_ids_proto_init() {
+	if File_rpcpb_ids_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_ids_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdFromBytesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_ids_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdFromBytesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_ids_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdParseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_ids_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdParseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_ids_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortIdFromPublicKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_ids_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShortIdFromPublicKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_ids_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdBitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_ids_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdBitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_ids_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_ids_proto_goTypes,
+		DependencyIndexes: file_rpcpb_ids_proto_depIdxs,
+		EnumInfos:         file_rpcpb_ids_proto_enumTypes,
+		MessageInfos:      file_rpcpb_ids_proto_msgTypes,
+	}.Build()
+	File_rpcpb_ids_proto = out.File
+	file_rpcpb_ids_proto_rawDesc = nil
+	file_rpcpb_ids_proto_goTypes = nil
+	file_rpcpb_ids_proto_depIdxs = nil
+}
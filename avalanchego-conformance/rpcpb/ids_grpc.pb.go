@@ -0,0 +1,236 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/ids.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	IdService_IdFromBytes_FullMethodName          = "/rpcpb.IdService/IdFromBytes"
+	IdService_IdParse_FullMethodName              = "/rpcpb.IdService/IdParse"
+	IdService_ShortIdFromPublicKey_FullMethodName = "/rpcpb.IdService/ShortIdFromPublicKey"
+	IdService_IdBits_FullMethodName               = "/rpcpb.IdService/IdBits"
+)
+
+// IdServiceClient is the client API for IdService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IdServiceClient interface {
+	// Converts bytes into an ID, and formats it.
+	// ref. "ids.ToID", "ids.ToShortID", "ids.ToNodeID"
+	IdFromBytes(ctx context.Context, in *IdFromBytesRequest, opts ...grpc.CallOption) (*IdFromBytesResponse, error)
+	// Parses a formatted ID.
+	// ref. "ids.FromString", "ids.ShortFromString", "ids.NodeIDFromString"
+	IdParse(ctx context.Context, in *IdParseRequest, opts ...grpc.CallOption) (*IdParseResponse, error)
+	// Derives the short ID (i.e., address) of a secp256k1 public key.
+	// ref. "secp256k1.PublicKey.Address"
+	ShortIdFromPublicKey(ctx context.Context, in *ShortIdFromPublicKeyRequest, opts ...grpc.CallOption) (*ShortIdFromPublicKeyResponse, error)
+	// Reads the bits of an ID, as the consensus and snowman trees do.
+	// ref. "ids.ID.Bit", "ids.ID.Prefix", "ids.EqualSubset", "ids.FirstDifferenceSubset"
+	IdBits(ctx context.Context, in *IdBitsRequest, opts ...grpc.CallOption) (*IdBitsResponse, error)
+}
+
+type idServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIdServiceClient(cc grpc.ClientConnInterface) IdServiceClient {
+	return &idServiceClient{cc}
+}
+
+func (c *idServiceClient) IdFromBytes(ctx context.Context, in *IdFromBytesRequest, opts ...grpc.CallOption) (*IdFromBytesResponse, error) {
+	out := new(IdFromBytesResponse)
+	err := c.cc.Invoke(ctx, IdService_IdFromBytes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idServiceClient) IdParse(ctx context.Context, in *IdParseRequest, opts ...grpc.CallOption) (*IdParseResponse, error) {
+	out := new(IdParseResponse)
+	err := c.cc.Invoke(ctx, IdService_IdParse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idServiceClient) ShortIdFromPublicKey(ctx context.Context, in *ShortIdFromPublicKeyRequest, opts ...grpc.CallOption) (*ShortIdFromPublicKeyResponse, error) {
+	out := new(ShortIdFromPublicKeyResponse)
+	err := c.cc.Invoke(ctx, IdService_ShortIdFromPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idServiceClient) IdBits(ctx context.Context, in *IdBitsRequest, opts ...grpc.CallOption) (*IdBitsResponse, error) {
+	out := new(IdBitsResponse)
+	err := c.cc.Invoke(ctx, IdService_IdBits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IdServiceServer is the server API for IdService service.
+// All implementations must embed UnimplementedIdServiceServer
+// for forward compatibility
+type IdServiceServer interface {
+	// Converts bytes into an ID, and formats it.
+	// ref. "ids.ToID", "ids.ToShortID", "ids.ToNodeID"
+	IdFromBytes(context.Context, *IdFromBytesRequest) (*IdFromBytesResponse, error)
+	// Parses a formatted ID.
+	// ref. "ids.FromString", "ids.ShortFromString", "ids.NodeIDFromString"
+	IdParse(context.Context, *IdParseRequest) (*IdParseResponse, error)
+	// Derives the short ID (i.e., address) of a secp256k1 public key.
+	// ref. "secp256k1.PublicKey.Address"
+	ShortIdFromPublicKey(context.Context, *ShortIdFromPublicKeyRequest) (*ShortIdFromPublicKeyResponse, error)
+	// Reads the bits of an ID, as the consensus and snowman trees do.
+	// ref. "ids.ID.Bit", "ids.ID.Prefix", "ids.EqualSubset", "ids.FirstDifferenceSubset"
+	IdBits(context.Context, *IdBitsRequest) (*IdBitsResponse, error)
+	mustEmbedUnimplementedIdServiceServer()
+}
+
+// UnimplementedIdServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedIdServiceServer struct {
+}
+
+func (UnimplementedIdServiceServer) IdFromBytes(context.Context, *IdFromBytesRequest) (*IdFromBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdFromBytes not implemented")
+}
+func (UnimplementedIdServiceServer) IdParse(context.Context, *IdParseRequest) (*IdParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdParse not implemented")
+}
+func (UnimplementedIdServiceServer) ShortIdFromPublicKey(context.Context, *ShortIdFromPublicKeyRequest) (*ShortIdFromPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortIdFromPublicKey not implemented")
+}
+func (UnimplementedIdServiceServer) IdBits(context.Context, *IdBitsRequest) (*IdBitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdBits not implemented")
+}
+func (UnimplementedIdServiceServer) mustEmbedUnimplementedIdServiceServer() {}
+
+// UnsafeIdServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IdServiceServer will
+// result in compilation errors.
+type UnsafeIdServiceServer interface {
+	mustEmbedUnimplementedIdServiceServer()
+}
+
+func RegisterIdServiceServer(s grpc.ServiceRegistrar, srv IdServiceServer) {
+	s.RegisterService(&IdService_ServiceDesc, srv)
+}
+
+func _IdService_IdFromBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdFromBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdServiceServer).IdFromBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdService_IdFromBytes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdServiceServer).IdFromBytes(ctx, req.(*IdFromBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdService_IdParse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdServiceServer).IdParse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdService_IdParse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdServiceServer).IdParse(ctx, req.(*IdParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdService_ShortIdFromPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShortIdFromPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdServiceServer).ShortIdFromPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdService_ShortIdFromPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdServiceServer).ShortIdFromPublicKey(ctx, req.(*ShortIdFromPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdService_IdBits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdBitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdServiceServer).IdBits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdService_IdBits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdServiceServer).IdBits(ctx, req.(*IdBitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IdService_ServiceDesc is the grpc.ServiceDesc for IdService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IdService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.IdService",
+	HandlerType: (*IdServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IdFromBytes",
+			Handler:    _IdService_IdFromBytes_Handler,
+		},
+		{
+			MethodName: "IdParse",
+			Handler:    _IdService_IdParse_Handler,
+		},
+		{
+			MethodName: "ShortIdFromPublicKey",
+			Handler:    _IdService_ShortIdFromPublicKey_Handler,
+		},
+		{
+			MethodName: "IdBits",
+			Handler:    _IdService_IdBits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/ids.proto",
+}
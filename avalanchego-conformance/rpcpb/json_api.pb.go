@@ -0,0 +1,1287 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/json_api.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ApiFieldBindingStatus is how a parameter binds to the arguments of a
+// method.
+type ApiFieldBindingStatus int32
+
+const (
+	ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_UNSPECIFIED ApiFieldBindingStatus = 0
+	// The parameter binds to the field of the same name.
+	ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_BOUND ApiFieldBindingStatus = 1
+	// The parameter binds to a field whose name only matches case-insensitively
+	// (e.g., "txId" for "txID"), which encoding/json accepts.
+	ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_CASE_MISMATCH ApiFieldBindingStatus = 2
+	// The value of the parameter fails to unmarshal into its field (e.g., an
+	// ID not in cb58, or a number not encoded as a string).
+	ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_INVALID ApiFieldBindingStatus = 3
+	// The parameter matches no field, and is ignored.
+	ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_UNKNOWN ApiFieldBindingStatus = 4
+	// No parameter binds to the field, left to its zero value.
+	ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_MISSING ApiFieldBindingStatus = 5
+)
+
+// Enum value maps for ApiFieldBindingStatus.
+var (
+	ApiFieldBindingStatus_name = map[int32]string{
+		0: "API_FIELD_BINDING_STATUS_UNSPECIFIED",
+		1: "API_FIELD_BINDING_STATUS_BOUND",
+		2: "API_FIELD_BINDING_STATUS_CASE_MISMATCH",
+		3: "API_FIELD_BINDING_STATUS_INVALID",
+		4: "API_FIELD_BINDING_STATUS_UNKNOWN",
+		5: "API_FIELD_BINDING_STATUS_MISSING",
+	}
+	ApiFieldBindingStatus_value = map[string]int32{
+		"API_FIELD_BINDING_STATUS_UNSPECIFIED":   0,
+		"API_FIELD_BINDING_STATUS_BOUND":         1,
+		"API_FIELD_BINDING_STATUS_CASE_MISMATCH": 2,
+		"API_FIELD_BINDING_STATUS_INVALID":       3,
+		"API_FIELD_BINDING_STATUS_UNKNOWN":       4,
+		"API_FIELD_BINDING_STATUS_MISSING":       5,
+	}
+)
+
+func (x ApiFieldBindingStatus) Enum() *ApiFieldBindingStatus {
+	p := new(ApiFieldBindingStatus)
+	*p = x
+	return p
+}
+
+func (x ApiFieldBindingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApiFieldBindingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_json_api_proto_enumTypes[0].Descriptor()
+}
+
+func (ApiFieldBindingStatus) Type() protoreflect.EnumType {
+	return &file_rpcpb_json_api_proto_enumTypes[0]
+}
+
+func (x ApiFieldBindingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApiFieldBindingStatus.Descriptor instead.
+func (ApiFieldBindingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_json_api_proto_rawDescGZIP(), []int{0}
+}
+
+type PlatformGetTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed P-chain transaction bytes, as stored by the chain.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// Encoding requested: "hex" (the default if empty), "hexc", "hexnc" or
+	// "json".
+	Encoding string `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// Network of the addresses of the "json" encoding.
+	NetworkId uint32 `protobuf:"varint,3,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// ID of the JSON-RPC request.
+	Id   uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	Json string `protobuf:"bytes,5,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *PlatformGetTxRequest) Reset() {
+	*x = PlatformGetTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_json_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlatformGetTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformGetTxRequest) ProtoMessage() {}
+
+func (x *PlatformGetTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_json_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformGetTxRequest.ProtoReflect.Descriptor instead.
+func (*PlatformGetTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_json_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlatformGetTxRequest) GetTxBytes() []byte {
+	if x != nil {
+		return x.TxBytes
+	}
+	return nil
+}
+
+func (x *PlatformGetTxRequest) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *PlatformGetTxRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *PlatformGetTxRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlatformGetTxRequest) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+type PlatformGetTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedJson string `protobuf:"bytes,1,opt,name=expected_json,json=expectedJson,proto3" json:"expected_json,omitempty"`
+	Message      string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *PlatformGetTxResponse) Reset() {
+	*x = PlatformGetTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_json_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlatformGetTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformGetTxResponse) ProtoMessage() {}
+
+func (x *PlatformGetTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_json_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformGetTxResponse.ProtoReflect.Descriptor instead.
+func (*PlatformGetTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_json_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlatformGetTxResponse) GetExpectedJson() string {
+	if x != nil {
+		return x.ExpectedJson
+	}
+	return ""
+}
+
+func (x *PlatformGetTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlatformGetTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PlatformGetTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type PlatformGetUtxosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UTXOs fetched, serialized with the P-chain codec.
+	Utxos [][]byte `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	// 20-byte address and 32-byte UTXO ID of the end index (i.e., of the last
+	// UTXO fetched). Empty (zero) address and ID if empty.
+	EndAddress []byte `protobuf:"bytes,2,opt,name=end_address,json=endAddress,proto3" json:"end_address,omitempty"`
+	EndUtxoId  []byte `protobuf:"bytes,3,opt,name=end_utxo_id,json=endUtxoId,proto3" json:"end_utxo_id,omitempty"`
+	// Encoding requested: "hex" (the default if empty), "hexc" or "hexnc".
+	// "json" fails to encode the UTXOs, if any.
+	Encoding  string `protobuf:"bytes,4,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	NetworkId uint32 `protobuf:"varint,5,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// ID of the JSON-RPC request.
+	Id   uint64 `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+	Json string `protobuf:"bytes,7,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *PlatformGetUtxosRequest) Reset() {
+	*x = PlatformGetUtxosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_json_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlatformGetUtxosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformGetUtxosRequest) ProtoMessage() {}
+
+func (x *PlatformGetUtxosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_json_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformGetUtxosRequest.ProtoReflect.Descriptor instead.
+func (*PlatformGetUtxosRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_json_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlatformGetUtxosRequest) GetUtxos() [][]byte {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+func (x *PlatformGetUtxosRequest) GetEndAddress() []byte {
+	if x != nil {
+		return x.EndAddress
+	}
+	return nil
+}
+
+func (x *PlatformGetUtxosRequest) GetEndUtxoId() []byte {
+	if x != nil {
+		return x.EndUtxoId
+	}
+	return nil
+}
+
+func (x *PlatformGetUtxosRequest) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *PlatformGetUtxosRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *PlatformGetUtxosRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlatformGetUtxosRequest) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+type PlatformGetUtxosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedJson string `protobuf:"bytes,1,opt,name=expected_json,json=expectedJson,proto3" json:"expected_json,omitempty"`
+	Message      string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *PlatformGetUtxosResponse) Reset() {
+	*x = PlatformGetUtxosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_json_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlatformGetUtxosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformGetUtxosResponse) ProtoMessage() {}
+
+func (x *PlatformGetUtxosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_json_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformGetUtxosResponse.ProtoReflect.Descriptor instead.
+func (*PlatformGetUtxosResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_json_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlatformGetUtxosResponse) GetExpectedJson() string {
+	if x != nil {
+		return x.ExpectedJson
+	}
+	return ""
+}
+
+func (x *PlatformGetUtxosResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlatformGetUtxosResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PlatformGetUtxosResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+// CurrentStaker is a current staker of the P-chain.
+type CurrentStaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed P-chain transaction bytes adding the staker (e.g.,
+	// AddPermissionlessValidatorTx).
+	TxBytes         []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	PotentialReward uint64 `protobuf:"varint,2,opt,name=potential_reward,json=potentialReward,proto3" json:"potential_reward,omitempty"`
+	// Rewards accrued by the validator of the staker from its delegators.
+	DelegateeReward uint64 `protobuf:"varint,3,opt,name=delegatee_reward,json=delegateeReward,proto3" json:"delegatee_reward,omitempty"`
+	// Uptime of a validator, in [0, 1].
+	Uptime float64 `protobuf:"fixed64,4,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// Whether the node of a validator is connected.
+	Connected bool `protobuf:"varint,5,opt,name=connected,proto3" json:"connected,omitempty"`
+}
+
+func (x *CurrentStaker) Reset() {
+	*x = CurrentStaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_json_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrentStaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentStaker) ProtoMessage() {}
+
+func (x *CurrentStaker) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_json_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentStaker.ProtoReflect.Descriptor instead.
+func (*CurrentStaker) Descriptor() ([]byte, []int) {
+	return file_rpcpb_json_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CurrentStaker) GetTxBytes() []byte {
+	if x != nil {
+		return x.TxBytes
+	}
+	return nil
+}
+
+func (x *CurrentStaker) GetPotentialReward() uint64 {
+	if x != nil {
+		return x.PotentialReward
+	}
+	return 0
+}
+
+func (x *CurrentStaker) GetDelegateeReward() uint64 {
+	if x != nil {
+		return x.DelegateeReward
+	}
+	return 0
+}
+
+func (x *CurrentStaker) GetUptime() float64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *CurrentStaker) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+type PlatformGetCurrentValidatorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Current stakers, in any order. Stakers of other subnets are omitted.
+	Stakers []*CurrentStaker `protobuf:"bytes,1,rep,name=stakers,proto3" json:"stakers,omitempty"`
+	// 32-byte subnet ID requested, the primary network if empty.
+	SubnetId []byte `protobuf:"bytes,2,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	// 20-byte node ID requested, listing the delegators of its validator.
+	// Every validator if empty.
+	NodeId []byte `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Whether the node tracks the subnet requested, for the uptimes of its
+	// validators to be reported.
+	SubnetTracked bool   `protobuf:"varint,4,opt,name=subnet_tracked,json=subnetTracked,proto3" json:"subnet_tracked,omitempty"`
+	NetworkId     uint32 `protobuf:"varint,5,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// ID of the JSON-RPC request.
+	Id   uint64 `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+	Json string `protobuf:"bytes,7,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *PlatformGetCurrentValidatorsRequest) Reset() {
+	*x = PlatformGetCurrentValidatorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_json_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlatformGetCurrentValidatorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformGetCurrentValidatorsRequest) ProtoMessage() {}
+
+func (x *PlatformGetCurrentValidatorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_json_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformGetCurrentValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*PlatformGetCurrentValidatorsRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_json_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PlatformGetCurrentValidatorsRequest) GetStakers() []*CurrentStaker {
+	if x != nil {
+		return x.Stakers
+	}
+	return nil
+}
+
+func (x *PlatformGetCurrentValidatorsRequest) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+func (x *PlatformGetCurrentValidatorsRequest) GetNodeId() []byte {
+	if x != nil {
+		return x.NodeId
+	}
+	return nil
+}
+
+func (x *PlatformGetCurrentValidatorsRequest) GetSubnetTracked() bool {
+	if x != nil {
+		return x.SubnetTracked
+	}
+	return false
+}
+
+func (x *PlatformGetCurrentValidatorsRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *PlatformGetCurrentValidatorsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlatformGetCurrentValidatorsRequest) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+type PlatformGetCurrentValidatorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedJson string `protobuf:"bytes,1,opt,name=expected_json,json=expectedJson,proto3" json:"expected_json,omitempty"`
+	Message      string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *PlatformGetCurrentValidatorsResponse) Reset() {
+	*x = PlatformGetCurrentValidatorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_json_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlatformGetCurrentValidatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformGetCurrentValidatorsResponse) ProtoMessage() {}
+
+func (x *PlatformGetCurrentValidatorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_json_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformGetCurrentValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*PlatformGetCurrentValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_json_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PlatformGetCurrentValidatorsResponse) GetExpectedJson() string {
+	if x != nil {
+		return x.ExpectedJson
+	}
+	return ""
+}
+
+func (x *PlatformGetCurrentValidatorsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlatformGetCurrentValidatorsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PlatformGetCurrentValidatorsResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+// ApiFieldBinding is the binding of a parameter, or of a field no parameter
+// binds to.
+type ApiFieldBinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JSON name of the field (e.g., "txID"), unless unknown.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Name of the parameter (e.g., "txId"), unless missing.
+	Param  string                `protobuf:"bytes,2,opt,name=param,proto3" json:"param,omitempty"`
+	Status ApiFieldBindingStatus `protobuf:"varint,3,opt,name=status,proto3,enum=rpcpb.ApiFieldBindingStatus" json:"status,omitempty"`
+	// Error the value fails to unmarshal with, if invalid.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ApiFieldBinding) Reset() {
+	*x = ApiFieldBinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_json_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiFieldBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiFieldBinding) ProtoMessage() {}
+
+func (x *ApiFieldBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_json_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiFieldBinding.ProtoReflect.Descriptor instead.
+func (*ApiFieldBinding) Descriptor() ([]byte, []int) {
+	return file_rpcpb_json_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApiFieldBinding) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ApiFieldBinding) GetParam() string {
+	if x != nil {
+		return x.Param
+	}
+	return ""
+}
+
+func (x *ApiFieldBinding) GetStatus() ApiFieldBindingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_UNSPECIFIED
+}
+
+func (x *ApiFieldBinding) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ParseApiRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JSON-RPC request body (e.g., {"jsonrpc":"2.0","method":"avm.issueTx",
+	// "params":{...},"id":1}). Methods of the "platform" (e.g., "getTx",
+	// "issueTx"), "avm" (e.g., "issueTx", "getBalance", "send"), "info" (e.g.,
+	// "getNodeID", "peers") and "keystore" (e.g., "createUser") APIs are known.
+	Body string `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	// Parameters the client meant, as avalanchego marshals its arguments
+	// (e.g., {"txID":"...","encoding":"hex"}), unless it rejects the body.
+	ParamsJson string `protobuf:"bytes,2,opt,name=params_json,json=paramsJson,proto3" json:"params_json,omitempty"`
+	// Whether the client fails to build or parse the body.
+	Rejected bool `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *ParseApiRequestRequest) Reset() {
+	*x = ParseApiRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_json_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseApiRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseApiRequestRequest) ProtoMessage() {}
+
+func (x *ParseApiRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_json_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseApiRequestRequest.ProtoReflect.Descriptor instead.
+func (*ParseApiRequestRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_json_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ParseApiRequestRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ParseApiRequestRequest) GetParamsJson() string {
+	if x != nil {
+		return x.ParamsJson
+	}
+	return ""
+}
+
+func (x *ParseApiRequestRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type ParseApiRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Method called, as the API server resolves it (e.g., "platform.GetTx").
+	ExpectedMethod string `protobuf:"bytes,1,opt,name=expected_method,json=expectedMethod,proto3" json:"expected_method,omitempty"`
+	// Arguments parsed, marshaled back to JSON.
+	ExpectedParamsJson string `protobuf:"bytes,2,opt,name=expected_params_json,json=expectedParamsJson,proto3" json:"expected_params_json,omitempty"`
+	// Error the API server rejects the body with.
+	ExpectedError string `protobuf:"bytes,3,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+	// Bindings of the parameters in order, then of the fields no parameter
+	// binds to, once the method is known. Bodies the client accepts must bind
+	// every parameter to the field of the same name.
+	FieldBindings []*ApiFieldBinding `protobuf:"bytes,7,rep,name=field_bindings,json=fieldBindings,proto3" json:"field_bindings,omitempty"`
+}
+
+func (x *ParseApiRequestResponse) Reset() {
+	*x = ParseApiRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_json_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseApiRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseApiRequestResponse) ProtoMessage() {}
+
+func (x *ParseApiRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_json_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseApiRequestResponse.ProtoReflect.Descriptor instead.
+func (*ParseApiRequestResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_json_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ParseApiRequestResponse) GetExpectedMethod() string {
+	if x != nil {
+		return x.ExpectedMethod
+	}
+	return ""
+}
+
+func (x *ParseApiRequestResponse) GetExpectedParamsJson() string {
+	if x != nil {
+		return x.ExpectedParamsJson
+	}
+	return ""
+}
+
+func (x *ParseApiRequestResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *ParseApiRequestResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ParseApiRequestResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ParseApiRequestResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+func (x *ParseApiRequestResponse) GetFieldBindings() []*ApiFieldBinding {
+	if x != nil {
+		return x.FieldBindings
+	}
+	return nil
+}
+
+var File_rpcpb_json_api_proto protoreflect.FileDescriptor
+
+var file_rpcpb_json_api_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x22, 0x90, 0x01,
+	0x0a, 0x14, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e,
+	0x22, 0x9e, 0x01, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74,
+	0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x22, 0xcf, 0x01, 0x0a, 0x17, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65,
+	0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x75, 0x74,
+	0x78, 0x6f, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x74, 0x78, 0x6f,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x55, 0x74,
+	0x78, 0x6f, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6a,
+	0x73, 0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x18, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x22, 0xf5, 0x01, 0x0a, 0x23, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x52,
+	0x07, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0xad, 0x01, 0x0a, 0x24, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x0f, 0x41, 0x70, 0x69,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x41, 0x70, 0x69, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x16, 0x50, 0x61, 0x72, 0x73, 0x65, 0x41, 0x70, 0x69,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a,
+	0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22,
+	0xbc, 0x02, 0x0a, 0x17, 0x50, 0x61, 0x72, 0x73, 0x65, 0x41, 0x70, 0x69, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12,
+	0x3d, 0x0a, 0x0e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x41, 0x70, 0x69, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2a, 0x83,
+	0x02, 0x0a, 0x15, 0x41, 0x70, 0x69, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x50, 0x49, 0x5f,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x50, 0x49, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f,
+	0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x2a, 0x0a, 0x26, 0x41, 0x50, 0x49, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x41, 0x53, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x50, 0x49, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f,
+	0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x50, 0x49, 0x5f,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x04, 0x12, 0x24,
+	0x0a, 0x20, 0x41, 0x50, 0x49, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x42, 0x49, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4e, 0x47, 0x10, 0x05, 0x32, 0x84, 0x03, 0x0a, 0x0e, 0x4a, 0x73, 0x6f, 0x6e, 0x41, 0x70, 0x69,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74, 0x54, 0x78, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78,
+	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x1c,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x73, 0x65,
+	0x41, 0x70, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x41, 0x70, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x41, 0x70, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73, 0x2f,
+	0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_json_api_proto_rawDescOnce sync.Once
+	file_rpcpb_json_api_proto_rawDescData = file_rpcpb_json_api_proto_rawDesc
+)
+
+func file_rpcpb_json_api_proto_rawDescGZIP() []byte {
+	file_rpcpb_json_api_proto_rawDescOnce.Do(func() {
+		file_rpcpb_json_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_json_api_proto_rawDescData)
+	})
+	return file_rpcpb_json_api_proto_rawDescData
+}
+
+var file_rpcpb_json_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpcpb_json_api_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_rpcpb_json_api_proto_goTypes = []interface{}{
+	(ApiFieldBindingStatus)(0),                   // 0: rpcpb.ApiFieldBindingStatus
+	(*PlatformGetTxRequest)(nil),                 // 1: rpcpb.PlatformGetTxRequest
+	(*PlatformGetTxResponse)(nil),                // 2: rpcpb.PlatformGetTxResponse
+	(*PlatformGetUtxosRequest)(nil),              // 3: rpcpb.PlatformGetUtxosRequest
+	(*PlatformGetUtxosResponse)(nil),             // 4: rpcpb.PlatformGetUtxosResponse
+	(*CurrentStaker)(nil),                        // 5: rpcpb.CurrentStaker
+	(*PlatformGetCurrentValidatorsRequest)(nil),  // 6: rpcpb.PlatformGetCurrentValidatorsRequest
+	(*PlatformGetCurrentValidatorsResponse)(nil), // 7: rpcpb.PlatformGetCurrentValidatorsResponse
+	(*ApiFieldBinding)(nil),                      // 8: rpcpb.ApiFieldBinding
+	(*ParseApiRequestRequest)(nil),               // 9: rpcpb.ParseApiRequestRequest
+	(*ParseApiRequestResponse)(nil),              // 10: rpcpb.ParseApiRequestResponse
+}
+var file_rpcpb_json_api_proto_depIdxs = []int32{
+	5,  // 0: rpcpb.PlatformGetCurrentValidatorsRequest.stakers:type_name -> rpcpb.CurrentStaker
+	0,  // 1: rpcpb.ApiFieldBinding.status:type_name -> rpcpb.ApiFieldBindingStatus
+	8,  // 2: rpcpb.ParseApiRequestResponse.field_bindings:type_name -> rpcpb.ApiFieldBinding
+	1,  // 3: rpcpb.JsonApiService.PlatformGetTx:input_type -> rpcpb.PlatformGetTxRequest
+	3,  // 4: rpcpb.JsonApiService.PlatformGetUtxos:input_type -> rpcpb.PlatformGetUtxosRequest
+	6,  // 5: rpcpb.JsonApiService.PlatformGetCurrentValidators:input_type -> rpcpb.PlatformGetCurrentValidatorsRequest
+	9,  // 6: rpcpb.JsonApiService.ParseApiRequest:input_type -> rpcpb.ParseApiRequestRequest
+	2,  // 7: rpcpb.JsonApiService.PlatformGetTx:output_type -> rpcpb.PlatformGetTxResponse
+	4,  // 8: rpcpb.JsonApiService.PlatformGetUtxos:output_type -> rpcpb.PlatformGetUtxosResponse
+	7,  // 9: rpcpb.JsonApiService.PlatformGetCurrentValidators:output_type -> rpcpb.PlatformGetCurrentValidatorsResponse
+	10, // 10: rpcpb.JsonApiService.ParseApiRequest:output_type -> rpcpb.ParseApiRequestResponse
+	7,  // [7:11] is the sub-list for method output_type
+	3,  // [3:7] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_json_api_proto_init() }
+func file_rpcpb_json_api_proto_init() {
+	if File_rpcpb_json_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_json_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlatformGetTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_json_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlatformGetTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_json_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlatformGetUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_json_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlatformGetUtxosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_json_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrentStaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_json_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlatformGetCurrentValidatorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_json_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlatformGetCurrentValidatorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_json_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiFieldBinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_json_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseApiRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_json_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseApiRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_json_api_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_json_api_proto_goTypes,
+		DependencyIndexes: file_rpcpb_json_api_proto_depIdxs,
+		EnumInfos:         file_rpcpb_json_api_proto_enumTypes,
+		MessageInfos:      file_rpcpb_json_api_proto_msgTypes,
+	}.Build()
+	File_rpcpb_json_api_proto = out.File
+	file_rpcpb_json_api_proto_rawDesc = nil
+	file_rpcpb_json_api_proto_goTypes = nil
+	file_rpcpb_json_api_proto_depIdxs = nil
+}
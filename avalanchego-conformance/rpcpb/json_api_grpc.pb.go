@@ -0,0 +1,234 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/json_api.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	JsonApiService_PlatformGetTx_FullMethodName                = "/rpcpb.JsonApiService/PlatformGetTx"
+	JsonApiService_PlatformGetUtxos_FullMethodName             = "/rpcpb.JsonApiService/PlatformGetUtxos"
+	JsonApiService_PlatformGetCurrentValidators_FullMethodName = "/rpcpb.JsonApiService/PlatformGetCurrentValidators"
+	JsonApiService_ParseApiRequest_FullMethodName              = "/rpcpb.JsonApiService/ParseApiRequest"
+)
+
+// JsonApiServiceClient is the client API for JsonApiService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JsonApiServiceClient interface {
+	// ref. "platformvm.Service.GetTx"
+	PlatformGetTx(ctx context.Context, in *PlatformGetTxRequest, opts ...grpc.CallOption) (*PlatformGetTxResponse, error)
+	// ref. "platformvm.Service.GetUTXOs"
+	PlatformGetUtxos(ctx context.Context, in *PlatformGetUtxosRequest, opts ...grpc.CallOption) (*PlatformGetUtxosResponse, error)
+	// ref. "platformvm.Service.GetCurrentValidators"
+	PlatformGetCurrentValidators(ctx context.Context, in *PlatformGetCurrentValidatorsRequest, opts ...grpc.CallOption) (*PlatformGetCurrentValidatorsResponse, error)
+	// Parses a request body, as the API server does before calling the
+	// method, or reports the error it rejects it with, and reports how each
+	// parameter binds to the arguments of the method.
+	// ref. "json.NewCodec", "json2.CodecRequest.ReadRequest"
+	ParseApiRequest(ctx context.Context, in *ParseApiRequestRequest, opts ...grpc.CallOption) (*ParseApiRequestResponse, error)
+}
+
+type jsonApiServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJsonApiServiceClient(cc grpc.ClientConnInterface) JsonApiServiceClient {
+	return &jsonApiServiceClient{cc}
+}
+
+func (c *jsonApiServiceClient) PlatformGetTx(ctx context.Context, in *PlatformGetTxRequest, opts ...grpc.CallOption) (*PlatformGetTxResponse, error) {
+	out := new(PlatformGetTxResponse)
+	err := c.cc.Invoke(ctx, JsonApiService_PlatformGetTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsonApiServiceClient) PlatformGetUtxos(ctx context.Context, in *PlatformGetUtxosRequest, opts ...grpc.CallOption) (*PlatformGetUtxosResponse, error) {
+	out := new(PlatformGetUtxosResponse)
+	err := c.cc.Invoke(ctx, JsonApiService_PlatformGetUtxos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsonApiServiceClient) PlatformGetCurrentValidators(ctx context.Context, in *PlatformGetCurrentValidatorsRequest, opts ...grpc.CallOption) (*PlatformGetCurrentValidatorsResponse, error) {
+	out := new(PlatformGetCurrentValidatorsResponse)
+	err := c.cc.Invoke(ctx, JsonApiService_PlatformGetCurrentValidators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsonApiServiceClient) ParseApiRequest(ctx context.Context, in *ParseApiRequestRequest, opts ...grpc.CallOption) (*ParseApiRequestResponse, error) {
+	out := new(ParseApiRequestResponse)
+	err := c.cc.Invoke(ctx, JsonApiService_ParseApiRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JsonApiServiceServer is the server API for JsonApiService service.
+// All implementations must embed UnimplementedJsonApiServiceServer
+// for forward compatibility
+type JsonApiServiceServer interface {
+	// ref. "platformvm.Service.GetTx"
+	PlatformGetTx(context.Context, *PlatformGetTxRequest) (*PlatformGetTxResponse, error)
+	// ref. "platformvm.Service.GetUTXOs"
+	PlatformGetUtxos(context.Context, *PlatformGetUtxosRequest) (*PlatformGetUtxosResponse, error)
+	// ref. "platformvm.Service.GetCurrentValidators"
+	PlatformGetCurrentValidators(context.Context, *PlatformGetCurrentValidatorsRequest) (*PlatformGetCurrentValidatorsResponse, error)
+	// Parses a request body, as the API server does before calling the
+	// method, or reports the error it rejects it with, and reports how each
+	// parameter binds to the arguments of the method.
+	// ref. "json.NewCodec", "json2.CodecRequest.ReadRequest"
+	ParseApiRequest(context.Context, *ParseApiRequestRequest) (*ParseApiRequestResponse, error)
+	mustEmbedUnimplementedJsonApiServiceServer()
+}
+
+// UnimplementedJsonApiServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedJsonApiServiceServer struct {
+}
+
+func (UnimplementedJsonApiServiceServer) PlatformGetTx(context.Context, *PlatformGetTxRequest) (*PlatformGetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformGetTx not implemented")
+}
+func (UnimplementedJsonApiServiceServer) PlatformGetUtxos(context.Context, *PlatformGetUtxosRequest) (*PlatformGetUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformGetUtxos not implemented")
+}
+func (UnimplementedJsonApiServiceServer) PlatformGetCurrentValidators(context.Context, *PlatformGetCurrentValidatorsRequest) (*PlatformGetCurrentValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformGetCurrentValidators not implemented")
+}
+func (UnimplementedJsonApiServiceServer) ParseApiRequest(context.Context, *ParseApiRequestRequest) (*ParseApiRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseApiRequest not implemented")
+}
+func (UnimplementedJsonApiServiceServer) mustEmbedUnimplementedJsonApiServiceServer() {}
+
+// UnsafeJsonApiServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JsonApiServiceServer will
+// result in compilation errors.
+type UnsafeJsonApiServiceServer interface {
+	mustEmbedUnimplementedJsonApiServiceServer()
+}
+
+func RegisterJsonApiServiceServer(s grpc.ServiceRegistrar, srv JsonApiServiceServer) {
+	s.RegisterService(&JsonApiService_ServiceDesc, srv)
+}
+
+func _JsonApiService_PlatformGetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlatformGetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsonApiServiceServer).PlatformGetTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JsonApiService_PlatformGetTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsonApiServiceServer).PlatformGetTx(ctx, req.(*PlatformGetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsonApiService_PlatformGetUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlatformGetUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsonApiServiceServer).PlatformGetUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JsonApiService_PlatformGetUtxos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsonApiServiceServer).PlatformGetUtxos(ctx, req.(*PlatformGetUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsonApiService_PlatformGetCurrentValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlatformGetCurrentValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsonApiServiceServer).PlatformGetCurrentValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JsonApiService_PlatformGetCurrentValidators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsonApiServiceServer).PlatformGetCurrentValidators(ctx, req.(*PlatformGetCurrentValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsonApiService_ParseApiRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseApiRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsonApiServiceServer).ParseApiRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JsonApiService_ParseApiRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsonApiServiceServer).ParseApiRequest(ctx, req.(*ParseApiRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JsonApiService_ServiceDesc is the grpc.ServiceDesc for JsonApiService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JsonApiService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.JsonApiService",
+	HandlerType: (*JsonApiServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlatformGetTx",
+			Handler:    _JsonApiService_PlatformGetTx_Handler,
+		},
+		{
+			MethodName: "PlatformGetUtxos",
+			Handler:    _JsonApiService_PlatformGetUtxos_Handler,
+		},
+		{
+			MethodName: "PlatformGetCurrentValidators",
+			Handler:    _JsonApiService_PlatformGetCurrentValidators_Handler,
+		},
+		{
+			MethodName: "ParseApiRequest",
+			Handler:    _JsonApiService_ParseApiRequest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/json_api.proto",
+}
@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/json.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	JsonService_IdJson_FullMethodName         = "/rpcpb.JsonService/IdJson"
+	JsonService_ShortIdJson_FullMethodName    = "/rpcpb.JsonService/ShortIdJson"
+	JsonService_NodeIdJson_FullMethodName     = "/rpcpb.JsonService/NodeIdJson"
+	JsonService_UtxoJson_FullMethodName       = "/rpcpb.JsonService/UtxoJson"
+	JsonService_PlatformTxJson_FullMethodName = "/rpcpb.JsonService/PlatformTxJson"
+)
+
+// JsonServiceClient is the client API for JsonService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JsonServiceClient interface {
+	IdJson(ctx context.Context, in *IdJsonRequest, opts ...grpc.CallOption) (*IdJsonResponse, error)
+	ShortIdJson(ctx context.Context, in *ShortIdJsonRequest, opts ...grpc.CallOption) (*ShortIdJsonResponse, error)
+	NodeIdJson(ctx context.Context, in *NodeIdJsonRequest, opts ...grpc.CallOption) (*NodeIdJsonResponse, error)
+	UtxoJson(ctx context.Context, in *UtxoJsonRequest, opts ...grpc.CallOption) (*UtxoJsonResponse, error)
+	PlatformTxJson(ctx context.Context, in *PlatformTxJsonRequest, opts ...grpc.CallOption) (*PlatformTxJsonResponse, error)
+}
+
+type jsonServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJsonServiceClient(cc grpc.ClientConnInterface) JsonServiceClient {
+	return &jsonServiceClient{cc}
+}
+
+func (c *jsonServiceClient) IdJson(ctx context.Context, in *IdJsonRequest, opts ...grpc.CallOption) (*IdJsonResponse, error) {
+	out := new(IdJsonResponse)
+	err := c.cc.Invoke(ctx, JsonService_IdJson_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsonServiceClient) ShortIdJson(ctx context.Context, in *ShortIdJsonRequest, opts ...grpc.CallOption) (*ShortIdJsonResponse, error) {
+	out := new(ShortIdJsonResponse)
+	err := c.cc.Invoke(ctx, JsonService_ShortIdJson_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsonServiceClient) NodeIdJson(ctx context.Context, in *NodeIdJsonRequest, opts ...grpc.CallOption) (*NodeIdJsonResponse, error) {
+	out := new(NodeIdJsonResponse)
+	err := c.cc.Invoke(ctx, JsonService_NodeIdJson_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsonServiceClient) UtxoJson(ctx context.Context, in *UtxoJsonRequest, opts ...grpc.CallOption) (*UtxoJsonResponse, error) {
+	out := new(UtxoJsonResponse)
+	err := c.cc.Invoke(ctx, JsonService_UtxoJson_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsonServiceClient) PlatformTxJson(ctx context.Context, in *PlatformTxJsonRequest, opts ...grpc.CallOption) (*PlatformTxJsonResponse, error) {
+	out := new(PlatformTxJsonResponse)
+	err := c.cc.Invoke(ctx, JsonService_PlatformTxJson_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JsonServiceServer is the server API for JsonService service.
+// All implementations must embed UnimplementedJsonServiceServer
+// for forward compatibility
+type JsonServiceServer interface {
+	IdJson(context.Context, *IdJsonRequest) (*IdJsonResponse, error)
+	ShortIdJson(context.Context, *ShortIdJsonRequest) (*ShortIdJsonResponse, error)
+	NodeIdJson(context.Context, *NodeIdJsonRequest) (*NodeIdJsonResponse, error)
+	UtxoJson(context.Context, *UtxoJsonRequest) (*UtxoJsonResponse, error)
+	PlatformTxJson(context.Context, *PlatformTxJsonRequest) (*PlatformTxJsonResponse, error)
+	mustEmbedUnimplementedJsonServiceServer()
+}
+
+// UnimplementedJsonServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedJsonServiceServer struct {
+}
+
+func (UnimplementedJsonServiceServer) IdJson(context.Context, *IdJsonRequest) (*IdJsonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdJson not implemented")
+}
+func (UnimplementedJsonServiceServer) ShortIdJson(context.Context, *ShortIdJsonRequest) (*ShortIdJsonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortIdJson not implemented")
+}
+func (UnimplementedJsonServiceServer) NodeIdJson(context.Context, *NodeIdJsonRequest) (*NodeIdJsonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeIdJson not implemented")
+}
+func (UnimplementedJsonServiceServer) UtxoJson(context.Context, *UtxoJsonRequest) (*UtxoJsonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UtxoJson not implemented")
+}
+func (UnimplementedJsonServiceServer) PlatformTxJson(context.Context, *PlatformTxJsonRequest) (*PlatformTxJsonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformTxJson not implemented")
+}
+func (UnimplementedJsonServiceServer) mustEmbedUnimplementedJsonServiceServer() {}
+
+// UnsafeJsonServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JsonServiceServer will
+// result in compilation errors.
+type UnsafeJsonServiceServer interface {
+	mustEmbedUnimplementedJsonServiceServer()
+}
+
+func RegisterJsonServiceServer(s grpc.ServiceRegistrar, srv JsonServiceServer) {
+	s.RegisterService(&JsonService_ServiceDesc, srv)
+}
+
+func _JsonService_IdJson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdJsonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsonServiceServer).IdJson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JsonService_IdJson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsonServiceServer).IdJson(ctx, req.(*IdJsonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsonService_ShortIdJson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShortIdJsonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsonServiceServer).ShortIdJson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JsonService_ShortIdJson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsonServiceServer).ShortIdJson(ctx, req.(*ShortIdJsonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsonService_NodeIdJson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeIdJsonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsonServiceServer).NodeIdJson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JsonService_NodeIdJson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsonServiceServer).NodeIdJson(ctx, req.(*NodeIdJsonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsonService_UtxoJson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UtxoJsonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsonServiceServer).UtxoJson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JsonService_UtxoJson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsonServiceServer).UtxoJson(ctx, req.(*UtxoJsonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsonService_PlatformTxJson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlatformTxJsonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsonServiceServer).PlatformTxJson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JsonService_PlatformTxJson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsonServiceServer).PlatformTxJson(ctx, req.(*PlatformTxJsonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JsonService_ServiceDesc is the grpc.ServiceDesc for JsonService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JsonService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.JsonService",
+	HandlerType: (*JsonServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IdJson",
+			Handler:    _JsonService_IdJson_Handler,
+		},
+		{
+			MethodName: "ShortIdJson",
+			Handler:    _JsonService_ShortIdJson_Handler,
+		},
+		{
+			MethodName: "NodeIdJson",
+			Handler:    _JsonService_NodeIdJson_Handler,
+		},
+		{
+			MethodName: "UtxoJson",
+			Handler:    _JsonService_UtxoJson_Handler,
+		},
+		{
+			MethodName: "PlatformTxJson",
+			Handler:    _JsonService_PlatformTxJson_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/json.proto",
+}
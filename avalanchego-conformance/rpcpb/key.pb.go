@@ -83,6 +83,8 @@ type CertificateToNodeIdResponse struct {
 	ExpectedNodeId []byte `protobuf:"bytes,1,opt,name=expected_node_id,json=expectedNodeId,proto3" json:"expected_node_id,omitempty"`
 	Message        string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success        bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *CertificateToNodeIdResponse) Reset() {
@@ -138,6 +140,184 @@ func (x *CertificateToNodeIdResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *CertificateToNodeIdResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type SignedIpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PEM or DER encoded staking certificate.
+	Certificate []byte `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// 4-byte or 16-byte IP address.
+	IpAddr    []byte `protobuf:"bytes,2,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
+	IpPort    uint32 `protobuf:"varint,3,opt,name=ip_port,json=ipPort,proto3" json:"ip_port,omitempty"`
+	Timestamp uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Signature over the SHA-256 digest of the unsigned IP bytes.
+	Sig             []byte `protobuf:"bytes,5,opt,name=sig,proto3" json:"sig,omitempty"`
+	UnsignedIpBytes []byte `protobuf:"bytes,6,opt,name=unsigned_ip_bytes,json=unsignedIpBytes,proto3" json:"unsigned_ip_bytes,omitempty"`
+}
+
+func (x *SignedIpRequest) Reset() {
+	*x = SignedIpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedIpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedIpRequest) ProtoMessage() {}
+
+func (x *SignedIpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedIpRequest.ProtoReflect.Descriptor instead.
+func (*SignedIpRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignedIpRequest) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *SignedIpRequest) GetIpAddr() []byte {
+	if x != nil {
+		return x.IpAddr
+	}
+	return nil
+}
+
+func (x *SignedIpRequest) GetIpPort() uint32 {
+	if x != nil {
+		return x.IpPort
+	}
+	return 0
+}
+
+func (x *SignedIpRequest) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SignedIpRequest) GetSig() []byte {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+func (x *SignedIpRequest) GetUnsignedIpBytes() []byte {
+	if x != nil {
+		return x.UnsignedIpBytes
+	}
+	return nil
+}
+
+type SignedIpResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedIpBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_ip_bytes,json=expectedUnsignedIpBytes,proto3" json:"expected_unsigned_ip_bytes,omitempty"`
+	Message                 string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success                 bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the unsigned IP bytes differ.
+	Diff *Diff `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *SignedIpResponse) Reset() {
+	*x = SignedIpResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedIpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedIpResponse) ProtoMessage() {}
+
+func (x *SignedIpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedIpResponse.ProtoReflect.Descriptor instead.
+func (*SignedIpResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SignedIpResponse) GetExpectedUnsignedIpBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedIpBytes
+	}
+	return nil
+}
+
+func (x *SignedIpResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SignedIpResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SignedIpResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *SignedIpResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type Secp256K1RecoverHashPublicKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -151,7 +331,7 @@ type Secp256K1RecoverHashPublicKeyRequest struct {
 func (x *Secp256K1RecoverHashPublicKeyRequest) Reset() {
 	*x = Secp256K1RecoverHashPublicKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_key_proto_msgTypes[2]
+		mi := &file_rpcpb_key_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -164,7 +344,7 @@ func (x *Secp256K1RecoverHashPublicKeyRequest) String() string {
 func (*Secp256K1RecoverHashPublicKeyRequest) ProtoMessage() {}
 
 func (x *Secp256K1RecoverHashPublicKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_key_proto_msgTypes[2]
+	mi := &file_rpcpb_key_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +357,7 @@ func (x *Secp256K1RecoverHashPublicKeyRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use Secp256K1RecoverHashPublicKeyRequest.ProtoReflect.Descriptor instead.
 func (*Secp256K1RecoverHashPublicKeyRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_key_proto_rawDescGZIP(), []int{2}
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Secp256K1RecoverHashPublicKeyRequest) GetMessage() []byte {
@@ -209,12 +389,14 @@ type Secp256K1RecoverHashPublicKeyResponse struct {
 	ExpectedPublicKeyShortIdCb58 string `protobuf:"bytes,1,opt,name=expected_public_key_short_id_cb58,json=expectedPublicKeyShortIdCb58,proto3" json:"expected_public_key_short_id_cb58,omitempty"`
 	Message                      string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success                      bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *Secp256K1RecoverHashPublicKeyResponse) Reset() {
 	*x = Secp256K1RecoverHashPublicKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_key_proto_msgTypes[3]
+		mi := &file_rpcpb_key_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -227,7 +409,7 @@ func (x *Secp256K1RecoverHashPublicKeyResponse) String() string {
 func (*Secp256K1RecoverHashPublicKeyResponse) ProtoMessage() {}
 
 func (x *Secp256K1RecoverHashPublicKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_key_proto_msgTypes[3]
+	mi := &file_rpcpb_key_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,7 +422,7 @@ func (x *Secp256K1RecoverHashPublicKeyResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use Secp256K1RecoverHashPublicKeyResponse.ProtoReflect.Descriptor instead.
 func (*Secp256K1RecoverHashPublicKeyResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_key_proto_rawDescGZIP(), []int{3}
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Secp256K1RecoverHashPublicKeyResponse) GetExpectedPublicKeyShortIdCb58() string {
@@ -264,18 +446,29 @@ func (x *Secp256K1RecoverHashPublicKeyResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *Secp256K1RecoverHashPublicKeyResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type Secp256K1InfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Secp256K1Info *Secp256K1Info `protobuf:"bytes,1,opt,name=secp256k1_info,json=secp256k1Info,proto3" json:"secp256k1_info,omitempty"`
+	// Addresses of the key to check besides the chain addresses of the info,
+	// for any network or HRP and any chain alias (e.g., the "C" bech32
+	// address of atomic txs).
+	AddressMatrix []*AddressMatrixEntry `protobuf:"bytes,2,rep,name=address_matrix,json=addressMatrix,proto3" json:"address_matrix,omitempty"`
 }
 
 func (x *Secp256K1InfoRequest) Reset() {
 	*x = Secp256K1InfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_key_proto_msgTypes[4]
+		mi := &file_rpcpb_key_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -288,7 +481,7 @@ func (x *Secp256K1InfoRequest) String() string {
 func (*Secp256K1InfoRequest) ProtoMessage() {}
 
 func (x *Secp256K1InfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_key_proto_msgTypes[4]
+	mi := &file_rpcpb_key_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +494,7 @@ func (x *Secp256K1InfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secp256K1InfoRequest.ProtoReflect.Descriptor instead.
 func (*Secp256K1InfoRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_key_proto_rawDescGZIP(), []int{4}
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Secp256K1InfoRequest) GetSecp256K1Info() *Secp256K1Info {
@@ -311,6 +504,13 @@ func (x *Secp256K1InfoRequest) GetSecp256K1Info() *Secp256K1Info {
 	return nil
 }
 
+func (x *Secp256K1InfoRequest) GetAddressMatrix() []*AddressMatrixEntry {
+	if x != nil {
+		return x.AddressMatrix
+	}
+	return nil
+}
+
 type Secp256K1InfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -319,12 +519,18 @@ type Secp256K1InfoResponse struct {
 	ExpectedSecp256K1Info *Secp256K1Info `protobuf:"bytes,1,opt,name=expected_secp256k1_info,json=expectedSecp256k1Info,proto3" json:"expected_secp256k1_info,omitempty"`
 	Message               string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool           `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+	// Entries of the address matrix in order, with the expected address.
+	ExpectedAddressMatrix []*AddressMatrixEntry `protobuf:"bytes,5,rep,name=expected_address_matrix,json=expectedAddressMatrix,proto3" json:"expected_address_matrix,omitempty"`
+	// Indices of the entries whose address differs.
+	MismatchedEntries []uint32 `protobuf:"varint,6,rep,packed,name=mismatched_entries,json=mismatchedEntries,proto3" json:"mismatched_entries,omitempty"`
 }
 
 func (x *Secp256K1InfoResponse) Reset() {
 	*x = Secp256K1InfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_key_proto_msgTypes[5]
+		mi := &file_rpcpb_key_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -337,7 +543,7 @@ func (x *Secp256K1InfoResponse) String() string {
 func (*Secp256K1InfoResponse) ProtoMessage() {}
 
 func (x *Secp256K1InfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_key_proto_msgTypes[5]
+	mi := &file_rpcpb_key_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +556,7 @@ func (x *Secp256K1InfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secp256K1InfoResponse.ProtoReflect.Descriptor instead.
 func (*Secp256K1InfoResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_key_proto_rawDescGZIP(), []int{5}
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Secp256K1InfoResponse) GetExpectedSecp256K1Info() *Secp256K1Info {
@@ -374,6 +580,102 @@ func (x *Secp256K1InfoResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *Secp256K1InfoResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+func (x *Secp256K1InfoResponse) GetExpectedAddressMatrix() []*AddressMatrixEntry {
+	if x != nil {
+		return x.ExpectedAddressMatrix
+	}
+	return nil
+}
+
+func (x *Secp256K1InfoResponse) GetMismatchedEntries() []uint32 {
+	if x != nil {
+		return x.MismatchedEntries
+	}
+	return nil
+}
+
+// AddressMatrixEntry is the bech32 address of a key on a chain of a network.
+type AddressMatrixEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Network whose HRP the address is formatted with (e.g., "custom" for
+	// networks avalanchego has no HRP for), unless the HRP is set.
+	NetworkId uint32 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Hrp       string `protobuf:"bytes,2,opt,name=hrp,proto3" json:"hrp,omitempty"`
+	// e.g., "X", "P", "C", or a chain ID.
+	ChainAlias string `protobuf:"bytes,3,opt,name=chain_alias,json=chainAlias,proto3" json:"chain_alias,omitempty"`
+	Address    string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AddressMatrixEntry) Reset() {
+	*x = AddressMatrixEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressMatrixEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressMatrixEntry) ProtoMessage() {}
+
+func (x *AddressMatrixEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressMatrixEntry.ProtoReflect.Descriptor instead.
+func (*AddressMatrixEntry) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddressMatrixEntry) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *AddressMatrixEntry) GetHrp() string {
+	if x != nil {
+		return x.Hrp
+	}
+	return ""
+}
+
+func (x *AddressMatrixEntry) GetChainAlias() string {
+	if x != nil {
+		return x.ChainAlias
+	}
+	return ""
+}
+
+func (x *AddressMatrixEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 type Secp256K1Info struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -391,7 +693,7 @@ type Secp256K1Info struct {
 func (x *Secp256K1Info) Reset() {
 	*x = Secp256K1Info{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_key_proto_msgTypes[6]
+		mi := &file_rpcpb_key_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -404,7 +706,7 @@ func (x *Secp256K1Info) String() string {
 func (*Secp256K1Info) ProtoMessage() {}
 
 func (x *Secp256K1Info) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_key_proto_msgTypes[6]
+	mi := &file_rpcpb_key_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +719,7 @@ func (x *Secp256K1Info) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secp256K1Info.ProtoReflect.Descriptor instead.
 func (*Secp256K1Info) Descriptor() ([]byte, []int) {
-	return file_rpcpb_key_proto_rawDescGZIP(), []int{6}
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Secp256K1Info) GetKeyType() string {
@@ -474,7 +776,7 @@ type ChainAddresses struct {
 func (x *ChainAddresses) Reset() {
 	*x = ChainAddresses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_key_proto_msgTypes[7]
+		mi := &file_rpcpb_key_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -487,7 +789,7 @@ func (x *ChainAddresses) String() string {
 func (*ChainAddresses) ProtoMessage() {}
 
 func (x *ChainAddresses) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_key_proto_msgTypes[7]
+	mi := &file_rpcpb_key_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +802,7 @@ func (x *ChainAddresses) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChainAddresses.ProtoReflect.Descriptor instead.
 func (*ChainAddresses) Descriptor() ([]byte, []int) {
-	return file_rpcpb_key_proto_rawDescGZIP(), []int{7}
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ChainAddresses) GetX() string {
@@ -517,35 +819,37 @@ func (x *ChainAddresses) GetP() string {
 	return ""
 }
 
-type BlsSignatureRequest struct {
+type Secp256K1SignRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrivateKey                 []byte `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	PublicKey                  []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Message                    []byte `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Signature                  []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
-	SignatureProofOfPossession []byte `protobuf:"bytes,5,opt,name=signature_proof_of_possession,json=signatureProofOfPossession,proto3" json:"signature_proof_of_possession,omitempty"`
+	PrivateKey []byte `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	Message    []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// If set, the message is the 32-byte hash to sign. Otherwise, its SHA-256
+	// is signed.
+	Prehashed bool `protobuf:"varint,3,opt,name=prehashed,proto3" json:"prehashed,omitempty"`
+	// 65-byte [r || s || v] signature, with a low s.
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *BlsSignatureRequest) Reset() {
-	*x = BlsSignatureRequest{}
+func (x *Secp256K1SignRequest) Reset() {
+	*x = Secp256K1SignRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_key_proto_msgTypes[8]
+		mi := &file_rpcpb_key_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlsSignatureRequest) String() string {
+func (x *Secp256K1SignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlsSignatureRequest) ProtoMessage() {}
+func (*Secp256K1SignRequest) ProtoMessage() {}
 
-func (x *BlsSignatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_key_proto_msgTypes[8]
+func (x *Secp256K1SignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,72 +860,3155 @@ func (x *BlsSignatureRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlsSignatureRequest.ProtoReflect.Descriptor instead.
-func (*BlsSignatureRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_key_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Secp256K1SignRequest.ProtoReflect.Descriptor instead.
+func (*Secp256K1SignRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BlsSignatureRequest) GetPrivateKey() []byte {
+func (x *Secp256K1SignRequest) GetPrivateKey() []byte {
 	if x != nil {
 		return x.PrivateKey
 	}
 	return nil
 }
 
-func (x *BlsSignatureRequest) GetPublicKey() []byte {
+func (x *Secp256K1SignRequest) GetMessage() []byte {
 	if x != nil {
-		return x.PublicKey
+		return x.Message
 	}
 	return nil
 }
 
-func (x *BlsSignatureRequest) GetMessage() []byte {
+func (x *Secp256K1SignRequest) GetPrehashed() bool {
 	if x != nil {
-		return x.Message
+		return x.Prehashed
 	}
-	return nil
+	return false
 }
 
-func (x *BlsSignatureRequest) GetSignature() []byte {
+func (x *Secp256K1SignRequest) GetSignature() []byte {
 	if x != nil {
 		return x.Signature
 	}
 	return nil
 }
 
-func (x *BlsSignatureRequest) GetSignatureProofOfPossession() []byte {
-	if x != nil {
-		return x.SignatureProofOfPossession
+type Secp256K1SignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedHash      []byte `protobuf:"bytes,1,opt,name=expected_hash,json=expectedHash,proto3" json:"expected_hash,omitempty"`
+	ExpectedSignature []byte `protobuf:"bytes,2,opt,name=expected_signature,json=expectedSignature,proto3" json:"expected_signature,omitempty"`
+	Message           string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success           bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the signatures differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *Secp256K1SignResponse) Reset() {
+	*x = Secp256K1SignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1SignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1SignResponse) ProtoMessage() {}
+
+func (x *Secp256K1SignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1SignResponse.ProtoReflect.Descriptor instead.
+func (*Secp256K1SignResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Secp256K1SignResponse) GetExpectedHash() []byte {
+	if x != nil {
+		return x.ExpectedHash
+	}
+	return nil
+}
+
+func (x *Secp256K1SignResponse) GetExpectedSignature() []byte {
+	if x != nil {
+		return x.ExpectedSignature
+	}
+	return nil
+}
+
+func (x *Secp256K1SignResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Secp256K1SignResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Secp256K1SignResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *Secp256K1SignResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type Secp256K1VerifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 33-byte compressed public key.
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Message   []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// If set, the message is the 32-byte hash the signature is of.
+	Prehashed bool   `protobuf:"varint,3,opt,name=prehashed,proto3" json:"prehashed,omitempty"`
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Valid     bool   `protobuf:"varint,5,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *Secp256K1VerifyRequest) Reset() {
+	*x = Secp256K1VerifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1VerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1VerifyRequest) ProtoMessage() {}
+
+func (x *Secp256K1VerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1VerifyRequest.ProtoReflect.Descriptor instead.
+func (*Secp256K1VerifyRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Secp256K1VerifyRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *Secp256K1VerifyRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Secp256K1VerifyRequest) GetPrehashed() bool {
+	if x != nil {
+		return x.Prehashed
+	}
+	return false
+}
+
+func (x *Secp256K1VerifyRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Secp256K1VerifyRequest) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type Secp256K1VerifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedValid bool `protobuf:"varint,1,opt,name=expected_valid,json=expectedValid,proto3" json:"expected_valid,omitempty"`
+	// Error avalanchego rejects the signature with, if any.
+	ExpectedError string `protobuf:"bytes,2,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *Secp256K1VerifyResponse) Reset() {
+	*x = Secp256K1VerifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1VerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1VerifyResponse) ProtoMessage() {}
+
+func (x *Secp256K1VerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1VerifyResponse.ProtoReflect.Descriptor instead.
+func (*Secp256K1VerifyResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Secp256K1VerifyResponse) GetExpectedValid() bool {
+	if x != nil {
+		return x.ExpectedValid
+	}
+	return false
+}
+
+func (x *Secp256K1VerifyResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *Secp256K1VerifyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Secp256K1VerifyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Secp256K1VerifyResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type Secp256K1PublicKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrivateKey []byte `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PublicKey  []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (x *Secp256K1PublicKeyRequest) Reset() {
+	*x = Secp256K1PublicKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1PublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1PublicKeyRequest) ProtoMessage() {}
+
+func (x *Secp256K1PublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1PublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*Secp256K1PublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Secp256K1PublicKeyRequest) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *Secp256K1PublicKeyRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+type Secp256K1PublicKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedPublicKey []byte `protobuf:"bytes,1,opt,name=expected_public_key,json=expectedPublicKey,proto3" json:"expected_public_key,omitempty"`
+	Message           string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success           bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *Secp256K1PublicKeyResponse) Reset() {
+	*x = Secp256K1PublicKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1PublicKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1PublicKeyResponse) ProtoMessage() {}
+
+func (x *Secp256K1PublicKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1PublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*Secp256K1PublicKeyResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Secp256K1PublicKeyResponse) GetExpectedPublicKey() []byte {
+	if x != nil {
+		return x.ExpectedPublicKey
+	}
+	return nil
+}
+
+func (x *Secp256K1PublicKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Secp256K1PublicKeyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Secp256K1PublicKeyResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type Secp256K1DeriveKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Space-separated mnemonic words. Its checksum is not verified, since
+	// the seed does not depend on it.
+	Mnemonic   string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// Path of the parent of the derived keys, "m/44'/9000'/0'/0" if empty.
+	// Hardened indices end with "'" or "h".
+	Path      string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	NetworkId uint32 `protobuf:"varint,4,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// Keys derived by the caller, at the given child indices of the path.
+	DerivedKeys []*Secp256K1DerivedKey `protobuf:"bytes,5,rep,name=derived_keys,json=derivedKeys,proto3" json:"derived_keys,omitempty"`
+}
+
+func (x *Secp256K1DeriveKeysRequest) Reset() {
+	*x = Secp256K1DeriveKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1DeriveKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1DeriveKeysRequest) ProtoMessage() {}
+
+func (x *Secp256K1DeriveKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1DeriveKeysRequest.ProtoReflect.Descriptor instead.
+func (*Secp256K1DeriveKeysRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Secp256K1DeriveKeysRequest) GetMnemonic() string {
+	if x != nil {
+		return x.Mnemonic
+	}
+	return ""
+}
+
+func (x *Secp256K1DeriveKeysRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *Secp256K1DeriveKeysRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Secp256K1DeriveKeysRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *Secp256K1DeriveKeysRequest) GetDerivedKeys() []*Secp256K1DerivedKey {
+	if x != nil {
+		return x.DerivedKeys
+	}
+	return nil
+}
+
+type Secp256K1DerivedKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index         uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PrivateKeyHex string `protobuf:"bytes,2,opt,name=private_key_hex,json=privateKeyHex,proto3" json:"private_key_hex,omitempty"`
+	XAddress      string `protobuf:"bytes,3,opt,name=x_address,json=xAddress,proto3" json:"x_address,omitempty"`
+	PAddress      string `protobuf:"bytes,4,opt,name=p_address,json=pAddress,proto3" json:"p_address,omitempty"`
+	CAddress      string `protobuf:"bytes,5,opt,name=c_address,json=cAddress,proto3" json:"c_address,omitempty"`
+	EthAddress    string `protobuf:"bytes,6,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+}
+
+func (x *Secp256K1DerivedKey) Reset() {
+	*x = Secp256K1DerivedKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1DerivedKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1DerivedKey) ProtoMessage() {}
+
+func (x *Secp256K1DerivedKey) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1DerivedKey.ProtoReflect.Descriptor instead.
+func (*Secp256K1DerivedKey) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Secp256K1DerivedKey) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Secp256K1DerivedKey) GetPrivateKeyHex() string {
+	if x != nil {
+		return x.PrivateKeyHex
+	}
+	return ""
+}
+
+func (x *Secp256K1DerivedKey) GetXAddress() string {
+	if x != nil {
+		return x.XAddress
+	}
+	return ""
+}
+
+func (x *Secp256K1DerivedKey) GetPAddress() string {
+	if x != nil {
+		return x.PAddress
+	}
+	return ""
+}
+
+func (x *Secp256K1DerivedKey) GetCAddress() string {
+	if x != nil {
+		return x.CAddress
+	}
+	return ""
+}
+
+func (x *Secp256K1DerivedKey) GetEthAddress() string {
+	if x != nil {
+		return x.EthAddress
+	}
+	return ""
+}
+
+type Secp256K1DeriveKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedDerivedKeys []*Secp256K1DerivedKey `protobuf:"bytes,1,rep,name=expected_derived_keys,json=expectedDerivedKeys,proto3" json:"expected_derived_keys,omitempty"`
+	Message             string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success             bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *Secp256K1DeriveKeysResponse) Reset() {
+	*x = Secp256K1DeriveKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1DeriveKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1DeriveKeysResponse) ProtoMessage() {}
+
+func (x *Secp256K1DeriveKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1DeriveKeysResponse.ProtoReflect.Descriptor instead.
+func (*Secp256K1DeriveKeysResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Secp256K1DeriveKeysResponse) GetExpectedDerivedKeys() []*Secp256K1DerivedKey {
+	if x != nil {
+		return x.ExpectedDerivedKeys
+	}
+	return nil
+}
+
+func (x *Secp256K1DeriveKeysResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Secp256K1DeriveKeysResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Secp256K1DeriveKeysResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type Secp256K1KmsSignatureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ASN.1 DER signature (i.e., SEQUENCE { r INTEGER, s INTEGER }).
+	DerSignature []byte `protobuf:"bytes,1,opt,name=der_signature,json=derSignature,proto3" json:"der_signature,omitempty"`
+	// 32-byte hash signed.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Public key of the signer: 33-byte compressed, 65-byte uncompressed, or
+	// DER SubjectPublicKeyInfo as AWS KMS returns it.
+	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// 65-byte [r || s || v] signature, with a low s.
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Whether the client fails to convert the signature (e.g., malformed DER,
+	// or no recovery ID recovers the public key).
+	Rejected bool `protobuf:"varint,5,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *Secp256K1KmsSignatureRequest) Reset() {
+	*x = Secp256K1KmsSignatureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1KmsSignatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1KmsSignatureRequest) ProtoMessage() {}
+
+func (x *Secp256K1KmsSignatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1KmsSignatureRequest.ProtoReflect.Descriptor instead.
+func (*Secp256K1KmsSignatureRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Secp256K1KmsSignatureRequest) GetDerSignature() []byte {
+	if x != nil {
+		return x.DerSignature
+	}
+	return nil
+}
+
+func (x *Secp256K1KmsSignatureRequest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Secp256K1KmsSignatureRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *Secp256K1KmsSignatureRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Secp256K1KmsSignatureRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type Secp256K1KmsSignatureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedSignature []byte `protobuf:"bytes,1,opt,name=expected_signature,json=expectedSignature,proto3" json:"expected_signature,omitempty"`
+	// Whether the s of the DER signature is over the half order, and negated.
+	ExpectedSNormalized bool `protobuf:"varint,2,opt,name=expected_s_normalized,json=expectedSNormalized,proto3" json:"expected_s_normalized,omitempty"`
+	// Error the signature is rejected with.
+	ExpectedError string `protobuf:"bytes,3,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the signatures differ.
+	Diff *Diff `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,7,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *Secp256K1KmsSignatureResponse) Reset() {
+	*x = Secp256K1KmsSignatureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1KmsSignatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1KmsSignatureResponse) ProtoMessage() {}
+
+func (x *Secp256K1KmsSignatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1KmsSignatureResponse.ProtoReflect.Descriptor instead.
+func (*Secp256K1KmsSignatureResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Secp256K1KmsSignatureResponse) GetExpectedSignature() []byte {
+	if x != nil {
+		return x.ExpectedSignature
+	}
+	return nil
+}
+
+func (x *Secp256K1KmsSignatureResponse) GetExpectedSNormalized() bool {
+	if x != nil {
+		return x.ExpectedSNormalized
+	}
+	return false
+}
+
+func (x *Secp256K1KmsSignatureResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *Secp256K1KmsSignatureResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Secp256K1KmsSignatureResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Secp256K1KmsSignatureResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *Secp256K1KmsSignatureResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type KeystoreImportUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Chain whose user database stores the keys (e.g., the X-chain).
+	BlockchainId []byte `protobuf:"bytes,3,opt,name=blockchain_id,json=blockchainId,proto3" json:"blockchain_id,omitempty"`
+	// Codec-packed user, as returned by "keystore.exportUser".
+	UserBytes []byte `protobuf:"bytes,4,opt,name=user_bytes,json=userBytes,proto3" json:"user_bytes,omitempty"`
+	// 32-byte private keys expected in the user, in any order.
+	PrivateKeys [][]byte `protobuf:"bytes,5,rep,name=private_keys,json=privateKeys,proto3" json:"private_keys,omitempty"`
+}
+
+func (x *KeystoreImportUserRequest) Reset() {
+	*x = KeystoreImportUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeystoreImportUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeystoreImportUserRequest) ProtoMessage() {}
+
+func (x *KeystoreImportUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeystoreImportUserRequest.ProtoReflect.Descriptor instead.
+func (*KeystoreImportUserRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *KeystoreImportUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *KeystoreImportUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *KeystoreImportUserRequest) GetBlockchainId() []byte {
+	if x != nil {
+		return x.BlockchainId
+	}
+	return nil
+}
+
+func (x *KeystoreImportUserRequest) GetUserBytes() []byte {
+	if x != nil {
+		return x.UserBytes
+	}
+	return nil
+}
+
+func (x *KeystoreImportUserRequest) GetPrivateKeys() [][]byte {
+	if x != nil {
+		return x.PrivateKeys
+	}
+	return nil
+}
+
+type KeystoreImportUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Private keys of the user, ordered by address.
+	RecoveredPrivateKeys [][]byte `protobuf:"bytes,1,rep,name=recovered_private_keys,json=recoveredPrivateKeys,proto3" json:"recovered_private_keys,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success              bool     `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *KeystoreImportUserResponse) Reset() {
+	*x = KeystoreImportUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeystoreImportUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeystoreImportUserResponse) ProtoMessage() {}
+
+func (x *KeystoreImportUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeystoreImportUserResponse.ProtoReflect.Descriptor instead.
+func (*KeystoreImportUserResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *KeystoreImportUserResponse) GetRecoveredPrivateKeys() [][]byte {
+	if x != nil {
+		return x.RecoveredPrivateKeys
+	}
+	return nil
+}
+
+func (x *KeystoreImportUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *KeystoreImportUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *KeystoreImportUserResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type KeystoreExportUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Must be strong enough for the keystore to create the user.
+	Password     string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	BlockchainId []byte   `protobuf:"bytes,3,opt,name=blockchain_id,json=blockchainId,proto3" json:"blockchain_id,omitempty"`
+	PrivateKeys  [][]byte `protobuf:"bytes,4,rep,name=private_keys,json=privateKeys,proto3" json:"private_keys,omitempty"`
+}
+
+func (x *KeystoreExportUserRequest) Reset() {
+	*x = KeystoreExportUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeystoreExportUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeystoreExportUserRequest) ProtoMessage() {}
+
+func (x *KeystoreExportUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeystoreExportUserRequest.ProtoReflect.Descriptor instead.
+func (*KeystoreExportUserRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *KeystoreExportUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *KeystoreExportUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *KeystoreExportUserRequest) GetBlockchainId() []byte {
+	if x != nil {
+		return x.BlockchainId
+	}
+	return nil
+}
+
+func (x *KeystoreExportUserRequest) GetPrivateKeys() [][]byte {
+	if x != nil {
+		return x.PrivateKeys
+	}
+	return nil
+}
+
+type KeystoreExportUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Codec-packed user. The password hash and encrypted values are salted,
+	// so the bytes differ across calls.
+	UserBytes []byte `protobuf:"bytes,1,opt,name=user_bytes,json=userBytes,proto3" json:"user_bytes,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,2,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *KeystoreExportUserResponse) Reset() {
+	*x = KeystoreExportUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeystoreExportUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeystoreExportUserResponse) ProtoMessage() {}
+
+func (x *KeystoreExportUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeystoreExportUserResponse.ProtoReflect.Descriptor instead.
+func (*KeystoreExportUserResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *KeystoreExportUserResponse) GetUserBytes() []byte {
+	if x != nil {
+		return x.UserBytes
+	}
+	return nil
+}
+
+func (x *KeystoreExportUserResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type EthKeyfileDecryptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyfileJson []byte `protobuf:"bytes,1,opt,name=keyfile_json,json=keyfileJson,proto3" json:"keyfile_json,omitempty"`
+	Passphrase  string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// 32-byte private key expected in the keyfile.
+	PrivateKey []byte `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+}
+
+func (x *EthKeyfileDecryptRequest) Reset() {
+	*x = EthKeyfileDecryptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthKeyfileDecryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthKeyfileDecryptRequest) ProtoMessage() {}
+
+func (x *EthKeyfileDecryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthKeyfileDecryptRequest.ProtoReflect.Descriptor instead.
+func (*EthKeyfileDecryptRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EthKeyfileDecryptRequest) GetKeyfileJson() []byte {
+	if x != nil {
+		return x.KeyfileJson
+	}
+	return nil
+}
+
+func (x *EthKeyfileDecryptRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *EthKeyfileDecryptRequest) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+type EthKeyfileDecryptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedPrivateKey []byte `protobuf:"bytes,1,opt,name=expected_private_key,json=expectedPrivateKey,proto3" json:"expected_private_key,omitempty"`
+	ExpectedEthAddress string `protobuf:"bytes,2,opt,name=expected_eth_address,json=expectedEthAddress,proto3" json:"expected_eth_address,omitempty"`
+	Message            string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success            bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *EthKeyfileDecryptResponse) Reset() {
+	*x = EthKeyfileDecryptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthKeyfileDecryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthKeyfileDecryptResponse) ProtoMessage() {}
+
+func (x *EthKeyfileDecryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthKeyfileDecryptResponse.ProtoReflect.Descriptor instead.
+func (*EthKeyfileDecryptResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EthKeyfileDecryptResponse) GetExpectedPrivateKey() []byte {
+	if x != nil {
+		return x.ExpectedPrivateKey
+	}
+	return nil
+}
+
+func (x *EthKeyfileDecryptResponse) GetExpectedEthAddress() string {
+	if x != nil {
+		return x.ExpectedEthAddress
+	}
+	return ""
+}
+
+func (x *EthKeyfileDecryptResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EthKeyfileDecryptResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EthKeyfileDecryptResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type EthKeyfileEncryptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrivateKey []byte `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// Scrypt parameters, the light ones (N = 4096, P = 6) if zero.
+	ScryptN uint64 `protobuf:"varint,3,opt,name=scrypt_n,json=scryptN,proto3" json:"scrypt_n,omitempty"`
+	ScryptP uint32 `protobuf:"varint,4,opt,name=scrypt_p,json=scryptP,proto3" json:"scrypt_p,omitempty"`
+}
+
+func (x *EthKeyfileEncryptRequest) Reset() {
+	*x = EthKeyfileEncryptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthKeyfileEncryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthKeyfileEncryptRequest) ProtoMessage() {}
+
+func (x *EthKeyfileEncryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthKeyfileEncryptRequest.ProtoReflect.Descriptor instead.
+func (*EthKeyfileEncryptRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *EthKeyfileEncryptRequest) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *EthKeyfileEncryptRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *EthKeyfileEncryptRequest) GetScryptN() uint64 {
+	if x != nil {
+		return x.ScryptN
+	}
+	return 0
+}
+
+func (x *EthKeyfileEncryptRequest) GetScryptP() uint32 {
+	if x != nil {
+		return x.ScryptP
+	}
+	return 0
+}
+
+type EthKeyfileEncryptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyfileJson []byte `protobuf:"bytes,1,opt,name=keyfile_json,json=keyfileJson,proto3" json:"keyfile_json,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,2,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *EthKeyfileEncryptResponse) Reset() {
+	*x = EthKeyfileEncryptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthKeyfileEncryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthKeyfileEncryptResponse) ProtoMessage() {}
+
+func (x *EthKeyfileEncryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthKeyfileEncryptResponse.ProtoReflect.Descriptor instead.
+func (*EthKeyfileEncryptResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EthKeyfileEncryptResponse) GetKeyfileJson() []byte {
+	if x != nil {
+		return x.KeyfileJson
+	}
+	return nil
+}
+
+func (x *EthKeyfileEncryptResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BlsSignatureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrivateKey                 []byte `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PublicKey                  []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Message                    []byte `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Signature                  []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignatureProofOfPossession []byte `protobuf:"bytes,5,opt,name=signature_proof_of_possession,json=signatureProofOfPossession,proto3" json:"signature_proof_of_possession,omitempty"`
+}
+
+func (x *BlsSignatureRequest) Reset() {
+	*x = BlsSignatureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsSignatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsSignatureRequest) ProtoMessage() {}
+
+func (x *BlsSignatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsSignatureRequest.ProtoReflect.Descriptor instead.
+func (*BlsSignatureRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BlsSignatureRequest) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *BlsSignatureRequest) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *BlsSignatureRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *BlsSignatureRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *BlsSignatureRequest) GetSignatureProofOfPossession() []byte {
+	if x != nil {
+		return x.SignatureProofOfPossession
+	}
+	return nil
+}
+
+type BlsSignatureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,3,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BlsSignatureResponse) Reset() {
+	*x = BlsSignatureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsSignatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsSignatureResponse) ProtoMessage() {}
+
+func (x *BlsSignatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsSignatureResponse.ProtoReflect.Descriptor instead.
+func (*BlsSignatureResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BlsSignatureResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BlsSignatureResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BlsSignatureResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BlsBatchItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Message   []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Verification result of the caller.
+	Valid bool `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *BlsBatchItem) Reset() {
+	*x = BlsBatchItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsBatchItem) ProtoMessage() {}
+
+func (x *BlsBatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsBatchItem.ProtoReflect.Descriptor instead.
+func (*BlsBatchItem) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BlsBatchItem) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *BlsBatchItem) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *BlsBatchItem) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *BlsBatchItem) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type BlsBatchVerifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*BlsBatchItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BlsBatchVerifyRequest) Reset() {
+	*x = BlsBatchVerifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsBatchVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsBatchVerifyRequest) ProtoMessage() {}
+
+func (x *BlsBatchVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsBatchVerifyRequest.ProtoReflect.Descriptor instead.
+func (*BlsBatchVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BlsBatchVerifyRequest) GetItems() []*BlsBatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BlsBatchVerifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Per-item verification results, in request order. Items with malformed
+	// public keys or signatures are invalid.
+	ExpectedValid []bool `protobuf:"varint,1,rep,packed,name=expected_valid,json=expectedValid,proto3" json:"expected_valid,omitempty"`
+	// Time spent verifying all items one by one.
+	VerifyDurationNs uint64 `protobuf:"varint,2,opt,name=verify_duration_ns,json=verifyDurationNs,proto3" json:"verify_duration_ns,omitempty"`
+	// Set when all items sign the same message and parse, in which case the
+	// batch is also verified as a single aggregate signature, as is done for
+	// warp messages.
+	Aggregated             bool `protobuf:"varint,3,opt,name=aggregated,proto3" json:"aggregated,omitempty"`
+	ExpectedAggregateValid bool `protobuf:"varint,4,opt,name=expected_aggregate_valid,json=expectedAggregateValid,proto3" json:"expected_aggregate_valid,omitempty"`
+	// Time spent aggregating the public keys and signatures and verifying
+	// the aggregate signature.
+	AggregateVerifyDurationNs uint64 `protobuf:"varint,5,opt,name=aggregate_verify_duration_ns,json=aggregateVerifyDurationNs,proto3" json:"aggregate_verify_duration_ns,omitempty"`
+	Message                   string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Success                   bool   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,8,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BlsBatchVerifyResponse) Reset() {
+	*x = BlsBatchVerifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsBatchVerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsBatchVerifyResponse) ProtoMessage() {}
+
+func (x *BlsBatchVerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsBatchVerifyResponse.ProtoReflect.Descriptor instead.
+func (*BlsBatchVerifyResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BlsBatchVerifyResponse) GetExpectedValid() []bool {
+	if x != nil {
+		return x.ExpectedValid
+	}
+	return nil
+}
+
+func (x *BlsBatchVerifyResponse) GetVerifyDurationNs() uint64 {
+	if x != nil {
+		return x.VerifyDurationNs
+	}
+	return 0
+}
+
+func (x *BlsBatchVerifyResponse) GetAggregated() bool {
+	if x != nil {
+		return x.Aggregated
+	}
+	return false
+}
+
+func (x *BlsBatchVerifyResponse) GetExpectedAggregateValid() bool {
+	if x != nil {
+		return x.ExpectedAggregateValid
+	}
+	return false
+}
+
+func (x *BlsBatchVerifyResponse) GetAggregateVerifyDurationNs() uint64 {
+	if x != nil {
+		return x.AggregateVerifyDurationNs
+	}
+	return 0
+}
+
+func (x *BlsBatchVerifyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BlsBatchVerifyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BlsBatchVerifyResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BlsAggregatePublicKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 48-byte compressed public keys.
+	PublicKeys         [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	AggregatePublicKey []byte   `protobuf:"bytes,2,opt,name=aggregate_public_key,json=aggregatePublicKey,proto3" json:"aggregate_public_key,omitempty"`
+}
+
+func (x *BlsAggregatePublicKeysRequest) Reset() {
+	*x = BlsAggregatePublicKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsAggregatePublicKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsAggregatePublicKeysRequest) ProtoMessage() {}
+
+func (x *BlsAggregatePublicKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsAggregatePublicKeysRequest.ProtoReflect.Descriptor instead.
+func (*BlsAggregatePublicKeysRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BlsAggregatePublicKeysRequest) GetPublicKeys() [][]byte {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+func (x *BlsAggregatePublicKeysRequest) GetAggregatePublicKey() []byte {
+	if x != nil {
+		return x.AggregatePublicKey
+	}
+	return nil
+}
+
+type BlsAggregatePublicKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedAggregatePublicKey []byte `protobuf:"bytes,1,opt,name=expected_aggregate_public_key,json=expectedAggregatePublicKey,proto3" json:"expected_aggregate_public_key,omitempty"`
+	Message                    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success                    bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BlsAggregatePublicKeysResponse) Reset() {
+	*x = BlsAggregatePublicKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsAggregatePublicKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsAggregatePublicKeysResponse) ProtoMessage() {}
+
+func (x *BlsAggregatePublicKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsAggregatePublicKeysResponse.ProtoReflect.Descriptor instead.
+func (*BlsAggregatePublicKeysResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BlsAggregatePublicKeysResponse) GetExpectedAggregatePublicKey() []byte {
+	if x != nil {
+		return x.ExpectedAggregatePublicKey
+	}
+	return nil
+}
+
+func (x *BlsAggregatePublicKeysResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BlsAggregatePublicKeysResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BlsAggregatePublicKeysResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *BlsAggregatePublicKeysResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BlsAggregateSignaturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 96-byte compressed signatures.
+	Signatures         [][]byte `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	AggregateSignature []byte   `protobuf:"bytes,2,opt,name=aggregate_signature,json=aggregateSignature,proto3" json:"aggregate_signature,omitempty"`
+}
+
+func (x *BlsAggregateSignaturesRequest) Reset() {
+	*x = BlsAggregateSignaturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsAggregateSignaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsAggregateSignaturesRequest) ProtoMessage() {}
+
+func (x *BlsAggregateSignaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsAggregateSignaturesRequest.ProtoReflect.Descriptor instead.
+func (*BlsAggregateSignaturesRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BlsAggregateSignaturesRequest) GetSignatures() [][]byte {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *BlsAggregateSignaturesRequest) GetAggregateSignature() []byte {
+	if x != nil {
+		return x.AggregateSignature
+	}
+	return nil
+}
+
+type BlsAggregateSignaturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedAggregateSignature []byte `protobuf:"bytes,1,opt,name=expected_aggregate_signature,json=expectedAggregateSignature,proto3" json:"expected_aggregate_signature,omitempty"`
+	Message                    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success                    bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BlsAggregateSignaturesResponse) Reset() {
+	*x = BlsAggregateSignaturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsAggregateSignaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsAggregateSignaturesResponse) ProtoMessage() {}
+
+func (x *BlsAggregateSignaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsAggregateSignaturesResponse.ProtoReflect.Descriptor instead.
+func (*BlsAggregateSignaturesResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BlsAggregateSignaturesResponse) GetExpectedAggregateSignature() []byte {
+	if x != nil {
+		return x.ExpectedAggregateSignature
+	}
+	return nil
+}
+
+func (x *BlsAggregateSignaturesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BlsAggregateSignaturesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BlsAggregateSignaturesResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *BlsAggregateSignaturesResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BlsAggregateVerifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Public keys of the signers.
+	PublicKeys         [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Message            []byte   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	AggregateSignature []byte   `protobuf:"bytes,3,opt,name=aggregate_signature,json=aggregateSignature,proto3" json:"aggregate_signature,omitempty"`
+	// Verification result of the caller.
+	Valid bool `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *BlsAggregateVerifyRequest) Reset() {
+	*x = BlsAggregateVerifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsAggregateVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsAggregateVerifyRequest) ProtoMessage() {}
+
+func (x *BlsAggregateVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsAggregateVerifyRequest.ProtoReflect.Descriptor instead.
+func (*BlsAggregateVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BlsAggregateVerifyRequest) GetPublicKeys() [][]byte {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+func (x *BlsAggregateVerifyRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *BlsAggregateVerifyRequest) GetAggregateSignature() []byte {
+	if x != nil {
+		return x.AggregateSignature
+	}
+	return nil
+}
+
+func (x *BlsAggregateVerifyRequest) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type BlsAggregateVerifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// False when any public key or the signature is malformed.
+	ExpectedValid bool   `protobuf:"varint,1,opt,name=expected_valid,json=expectedValid,proto3" json:"expected_valid,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BlsAggregateVerifyResponse) Reset() {
+	*x = BlsAggregateVerifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsAggregateVerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsAggregateVerifyResponse) ProtoMessage() {}
+
+func (x *BlsAggregateVerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsAggregateVerifyResponse.ProtoReflect.Descriptor instead.
+func (*BlsAggregateVerifyResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BlsAggregateVerifyResponse) GetExpectedValid() bool {
+	if x != nil {
+		return x.ExpectedValid
+	}
+	return false
+}
+
+func (x *BlsAggregateVerifyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BlsAggregateVerifyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BlsAggregateVerifyResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BlsPublicKeyEncodingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SecretKey []byte `protobuf:"bytes,1,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// 48-byte compressed encoding, as in txs and warp messages.
+	CompressedPublicKey []byte `protobuf:"bytes,2,opt,name=compressed_public_key,json=compressedPublicKey,proto3" json:"compressed_public_key,omitempty"`
+	// 96-byte uncompressed encoding, by which warp validators are ordered.
+	UncompressedPublicKey []byte `protobuf:"bytes,3,opt,name=uncompressed_public_key,json=uncompressedPublicKey,proto3" json:"uncompressed_public_key,omitempty"`
+}
+
+func (x *BlsPublicKeyEncodingRequest) Reset() {
+	*x = BlsPublicKeyEncodingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsPublicKeyEncodingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsPublicKeyEncodingRequest) ProtoMessage() {}
+
+func (x *BlsPublicKeyEncodingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsPublicKeyEncodingRequest.ProtoReflect.Descriptor instead.
+func (*BlsPublicKeyEncodingRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BlsPublicKeyEncodingRequest) GetSecretKey() []byte {
+	if x != nil {
+		return x.SecretKey
+	}
+	return nil
+}
+
+func (x *BlsPublicKeyEncodingRequest) GetCompressedPublicKey() []byte {
+	if x != nil {
+		return x.CompressedPublicKey
+	}
+	return nil
+}
+
+func (x *BlsPublicKeyEncodingRequest) GetUncompressedPublicKey() []byte {
+	if x != nil {
+		return x.UncompressedPublicKey
+	}
+	return nil
+}
+
+type BlsPublicKeyEncodingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedCompressedPublicKey   []byte `protobuf:"bytes,1,opt,name=expected_compressed_public_key,json=expectedCompressedPublicKey,proto3" json:"expected_compressed_public_key,omitempty"`
+	ExpectedUncompressedPublicKey []byte `protobuf:"bytes,2,opt,name=expected_uncompressed_public_key,json=expectedUncompressedPublicKey,proto3" json:"expected_uncompressed_public_key,omitempty"`
+	Message                       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success                       bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the compressed encodings differ.
+	CompressedDiff *Diff `protobuf:"bytes,5,opt,name=compressed_diff,json=compressedDiff,proto3" json:"compressed_diff,omitempty"`
+	// Set when the uncompressed encodings differ.
+	UncompressedDiff *Diff `protobuf:"bytes,6,opt,name=uncompressed_diff,json=uncompressedDiff,proto3" json:"uncompressed_diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,7,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BlsPublicKeyEncodingResponse) Reset() {
+	*x = BlsPublicKeyEncodingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsPublicKeyEncodingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsPublicKeyEncodingResponse) ProtoMessage() {}
+
+func (x *BlsPublicKeyEncodingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsPublicKeyEncodingResponse.ProtoReflect.Descriptor instead.
+func (*BlsPublicKeyEncodingResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BlsPublicKeyEncodingResponse) GetExpectedCompressedPublicKey() []byte {
+	if x != nil {
+		return x.ExpectedCompressedPublicKey
+	}
+	return nil
+}
+
+func (x *BlsPublicKeyEncodingResponse) GetExpectedUncompressedPublicKey() []byte {
+	if x != nil {
+		return x.ExpectedUncompressedPublicKey
+	}
+	return nil
+}
+
+func (x *BlsPublicKeyEncodingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BlsPublicKeyEncodingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BlsPublicKeyEncodingResponse) GetCompressedDiff() *Diff {
+	if x != nil {
+		return x.CompressedDiff
+	}
+	return nil
+}
+
+func (x *BlsPublicKeyEncodingResponse) GetUncompressedDiff() *Diff {
+	if x != nil {
+		return x.UncompressedDiff
+	}
+	return nil
+}
+
+func (x *BlsPublicKeyEncodingResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type LedgerPathRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Absolute path (e.g., "m/44'/9000'/0'/0/5"), or path relative to the
+	// root path as signing paths are (e.g., "0/5"). Hardened indices end with
+	// "'" or "h".
+	Path           string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	SerializedPath []byte `protobuf:"bytes,2,opt,name=serialized_path,json=serializedPath,proto3" json:"serialized_path,omitempty"`
+	// Whether the client fails to parse the path.
+	Rejected bool `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *LedgerPathRequest) Reset() {
+	*x = LedgerPathRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerPathRequest) ProtoMessage() {}
+
+func (x *LedgerPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerPathRequest.ProtoReflect.Descriptor instead.
+func (*LedgerPathRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *LedgerPathRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *LedgerPathRequest) GetSerializedPath() []byte {
+	if x != nil {
+		return x.SerializedPath
+	}
+	return nil
+}
+
+func (x *LedgerPathRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type LedgerPathResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedSerializedPath []byte `protobuf:"bytes,1,opt,name=expected_serialized_path,json=expectedSerializedPath,proto3" json:"expected_serialized_path,omitempty"`
+	// Error the path is rejected with.
+	ExpectedError string `protobuf:"bytes,2,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized paths differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *LedgerPathResponse) Reset() {
+	*x = LedgerPathResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerPathResponse) ProtoMessage() {}
+
+func (x *LedgerPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerPathResponse.ProtoReflect.Descriptor instead.
+func (*LedgerPathResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LedgerPathResponse) GetExpectedSerializedPath() []byte {
+	if x != nil {
+		return x.ExpectedSerializedPath
+	}
+	return nil
+}
+
+func (x *LedgerPathResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *LedgerPathResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LedgerPathResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LedgerPathResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *LedgerPathResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type LedgerSignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unsigned tx bytes, prefixed with the codec version.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// Indices of the addresses signing, on the external chain of the first
+	// account (i.e., "m/44'/9000'/0'/0/<index>").
+	AddressIndices []uint32 `protobuf:"varint,2,rep,packed,name=address_indices,json=addressIndices,proto3" json:"address_indices,omitempty"`
+	// Serialized root path, "m/44'/9000'/0'".
+	RootPath []byte `protobuf:"bytes,3,opt,name=root_path,json=rootPath,proto3" json:"root_path,omitempty"`
+	// Serialized signing path of each address (i.e., "0/<index>"), relative
+	// to the root path.
+	SigningPaths [][]byte `protobuf:"bytes,4,rep,name=signing_paths,json=signingPaths,proto3" json:"signing_paths,omitempty"`
+	// Serialized path of each address, as its public key is read at.
+	AddressPaths [][]byte `protobuf:"bytes,5,rep,name=address_paths,json=addressPaths,proto3" json:"address_paths,omitempty"`
+	// Whether the tx is signed by its hash, as it is when too large for the
+	// app to parse.
+	SignHash bool `protobuf:"varint,6,opt,name=sign_hash,json=signHash,proto3" json:"sign_hash,omitempty"`
+	// SHA-256 hash of the tx bytes, which the app signs either way.
+	Hash []byte `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *LedgerSignRequest) Reset() {
+	*x = LedgerSignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerSignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerSignRequest) ProtoMessage() {}
+
+func (x *LedgerSignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerSignRequest.ProtoReflect.Descriptor instead.
+func (*LedgerSignRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LedgerSignRequest) GetTxBytes() []byte {
+	if x != nil {
+		return x.TxBytes
+	}
+	return nil
+}
+
+func (x *LedgerSignRequest) GetAddressIndices() []uint32 {
+	if x != nil {
+		return x.AddressIndices
+	}
+	return nil
+}
+
+func (x *LedgerSignRequest) GetRootPath() []byte {
+	if x != nil {
+		return x.RootPath
+	}
+	return nil
+}
+
+func (x *LedgerSignRequest) GetSigningPaths() [][]byte {
+	if x != nil {
+		return x.SigningPaths
+	}
+	return nil
+}
+
+func (x *LedgerSignRequest) GetAddressPaths() [][]byte {
+	if x != nil {
+		return x.AddressPaths
+	}
+	return nil
+}
+
+func (x *LedgerSignRequest) GetSignHash() bool {
+	if x != nil {
+		return x.SignHash
+	}
+	return false
+}
+
+func (x *LedgerSignRequest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+type LedgerSignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedRootPath     []byte   `protobuf:"bytes,1,opt,name=expected_root_path,json=expectedRootPath,proto3" json:"expected_root_path,omitempty"`
+	ExpectedSigningPaths [][]byte `protobuf:"bytes,2,rep,name=expected_signing_paths,json=expectedSigningPaths,proto3" json:"expected_signing_paths,omitempty"`
+	ExpectedAddressPaths [][]byte `protobuf:"bytes,3,rep,name=expected_address_paths,json=expectedAddressPaths,proto3" json:"expected_address_paths,omitempty"`
+	ExpectedSignHash     bool     `protobuf:"varint,4,opt,name=expected_sign_hash,json=expectedSignHash,proto3" json:"expected_sign_hash,omitempty"`
+	ExpectedHash         []byte   `protobuf:"bytes,5,opt,name=expected_hash,json=expectedHash,proto3" json:"expected_hash,omitempty"`
+	Message              string   `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Success              bool     `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,8,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *LedgerSignResponse) Reset() {
+	*x = LedgerSignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerSignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerSignResponse) ProtoMessage() {}
+
+func (x *LedgerSignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerSignResponse.ProtoReflect.Descriptor instead.
+func (*LedgerSignResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LedgerSignResponse) GetExpectedRootPath() []byte {
+	if x != nil {
+		return x.ExpectedRootPath
+	}
+	return nil
+}
+
+func (x *LedgerSignResponse) GetExpectedSigningPaths() [][]byte {
+	if x != nil {
+		return x.ExpectedSigningPaths
+	}
+	return nil
+}
+
+func (x *LedgerSignResponse) GetExpectedAddressPaths() [][]byte {
+	if x != nil {
+		return x.ExpectedAddressPaths
+	}
+	return nil
+}
+
+func (x *LedgerSignResponse) GetExpectedSignHash() bool {
+	if x != nil {
+		return x.ExpectedSignHash
+	}
+	return false
+}
+
+func (x *LedgerSignResponse) GetExpectedHash() []byte {
+	if x != nil {
+		return x.ExpectedHash
+	}
+	return nil
+}
+
+func (x *LedgerSignResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LedgerSignResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LedgerSignResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type Secp256K1KeyFromSeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed []byte `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Index of the key among the keys of the seed.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Network whose HRP the addresses are formatted with.
+	NetworkId uint32 `protobuf:"varint,3,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+}
+
+func (x *Secp256K1KeyFromSeedRequest) Reset() {
+	*x = Secp256K1KeyFromSeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1KeyFromSeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1KeyFromSeedRequest) ProtoMessage() {}
+
+func (x *Secp256K1KeyFromSeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1KeyFromSeedRequest.ProtoReflect.Descriptor instead.
+func (*Secp256K1KeyFromSeedRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Secp256K1KeyFromSeedRequest) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
 	}
 	return nil
 }
 
-type BlsSignatureResponse struct {
+func (x *Secp256K1KeyFromSeedRequest) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Secp256K1KeyFromSeedRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+type Secp256K1KeyFromSeedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	PrivateKey    []byte `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PrivateKeyHex string `protobuf:"bytes,2,opt,name=private_key_hex,json=privateKeyHex,proto3" json:"private_key_hex,omitempty"`
+	// e.g., "PrivateKey-..."
+	PrivateKeyCb58 string `protobuf:"bytes,3,opt,name=private_key_cb58,json=privateKeyCb58,proto3" json:"private_key_cb58,omitempty"`
+	// 33-byte SEC 1 compressed public key.
+	PublicKey []byte `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// 65-byte SEC 1 uncompressed public key.
+	UncompressedPublicKey []byte `protobuf:"bytes,5,opt,name=uncompressed_public_key,json=uncompressedPublicKey,proto3" json:"uncompressed_public_key,omitempty"`
+	ShortAddress          string `protobuf:"bytes,6,opt,name=short_address,json=shortAddress,proto3" json:"short_address,omitempty"`
+	XAddress              string `protobuf:"bytes,7,opt,name=x_address,json=xAddress,proto3" json:"x_address,omitempty"`
+	PAddress              string `protobuf:"bytes,8,opt,name=p_address,json=pAddress,proto3" json:"p_address,omitempty"`
+	CAddress              string `protobuf:"bytes,9,opt,name=c_address,json=cAddress,proto3" json:"c_address,omitempty"`
+	EthAddress            string `protobuf:"bytes,10,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,11,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
-func (x *BlsSignatureResponse) Reset() {
-	*x = BlsSignatureResponse{}
+func (x *Secp256K1KeyFromSeedResponse) Reset() {
+	*x = Secp256K1KeyFromSeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secp256K1KeyFromSeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secp256K1KeyFromSeedResponse) ProtoMessage() {}
+
+func (x *Secp256K1KeyFromSeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secp256K1KeyFromSeedResponse.ProtoReflect.Descriptor instead.
+func (*Secp256K1KeyFromSeedResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Secp256K1KeyFromSeedResponse) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *Secp256K1KeyFromSeedResponse) GetPrivateKeyHex() string {
+	if x != nil {
+		return x.PrivateKeyHex
+	}
+	return ""
+}
+
+func (x *Secp256K1KeyFromSeedResponse) GetPrivateKeyCb58() string {
+	if x != nil {
+		return x.PrivateKeyCb58
+	}
+	return ""
+}
+
+func (x *Secp256K1KeyFromSeedResponse) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *Secp256K1KeyFromSeedResponse) GetUncompressedPublicKey() []byte {
+	if x != nil {
+		return x.UncompressedPublicKey
+	}
+	return nil
+}
+
+func (x *Secp256K1KeyFromSeedResponse) GetShortAddress() string {
+	if x != nil {
+		return x.ShortAddress
+	}
+	return ""
+}
+
+func (x *Secp256K1KeyFromSeedResponse) GetXAddress() string {
+	if x != nil {
+		return x.XAddress
+	}
+	return ""
+}
+
+func (x *Secp256K1KeyFromSeedResponse) GetPAddress() string {
+	if x != nil {
+		return x.PAddress
+	}
+	return ""
+}
+
+func (x *Secp256K1KeyFromSeedResponse) GetCAddress() string {
+	if x != nil {
+		return x.CAddress
+	}
+	return ""
+}
+
+func (x *Secp256K1KeyFromSeedResponse) GetEthAddress() string {
+	if x != nil {
+		return x.EthAddress
+	}
+	return ""
+}
+
+func (x *Secp256K1KeyFromSeedResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BlsKeyFromSeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed []byte `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Index of the key among the keys of the seed.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *BlsKeyFromSeedRequest) Reset() {
+	*x = BlsKeyFromSeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsKeyFromSeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsKeyFromSeedRequest) ProtoMessage() {}
+
+func (x *BlsKeyFromSeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsKeyFromSeedRequest.ProtoReflect.Descriptor instead.
+func (*BlsKeyFromSeedRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BlsKeyFromSeedRequest) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *BlsKeyFromSeedRequest) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type BlsKeyFromSeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 32-byte big-endian scalar.
+	SecretKey []byte `protobuf:"bytes,1,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// 48-byte compressed encoding.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// 96-byte uncompressed encoding.
+	UncompressedPublicKey []byte `protobuf:"bytes,3,opt,name=uncompressed_public_key,json=uncompressedPublicKey,proto3" json:"uncompressed_public_key,omitempty"`
+	// Proof of possession signature of the compressed public key.
+	// ref. "signer.ProofOfPossession"
+	ProofOfPossession []byte `protobuf:"bytes,4,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BlsKeyFromSeedResponse) Reset() {
+	*x = BlsKeyFromSeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlsKeyFromSeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlsKeyFromSeedResponse) ProtoMessage() {}
+
+func (x *BlsKeyFromSeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlsKeyFromSeedResponse.ProtoReflect.Descriptor instead.
+func (*BlsKeyFromSeedResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BlsKeyFromSeedResponse) GetSecretKey() []byte {
+	if x != nil {
+		return x.SecretKey
+	}
+	return nil
+}
+
+func (x *BlsKeyFromSeedResponse) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *BlsKeyFromSeedResponse) GetUncompressedPublicKey() []byte {
+	if x != nil {
+		return x.UncompressedPublicKey
+	}
+	return nil
+}
+
+func (x *BlsKeyFromSeedResponse) GetProofOfPossession() []byte {
+	if x != nil {
+		return x.ProofOfPossession
+	}
+	return nil
+}
+
+func (x *BlsKeyFromSeedResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type StakingKeyFromSeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed []byte `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Index of the key among the keys of the seed.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *StakingKeyFromSeedRequest) Reset() {
+	*x = StakingKeyFromSeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_key_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingKeyFromSeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingKeyFromSeedRequest) ProtoMessage() {}
+
+func (x *StakingKeyFromSeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingKeyFromSeedRequest.ProtoReflect.Descriptor instead.
+func (*StakingKeyFromSeedRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *StakingKeyFromSeedRequest) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *StakingKeyFromSeedRequest) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type StakingKeyFromSeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ECDSA P-256 key in PKCS #8 form, as avalanchego writes "staker.key".
+	PrivateKey    []byte `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PrivateKeyPem []byte `protobuf:"bytes,2,opt,name=private_key_pem,json=privateKeyPem,proto3" json:"private_key_pem,omitempty"`
+	// Self-signed certificate of the template of "staking.NewCertAndKeyBytes",
+	// valid until 9999-12-31 (no expiration) and signed with ECDSA-SHA256
+	// hedged with no entropy, so that it is deterministic.
+	Certificate    []byte `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	CertificatePem []byte `protobuf:"bytes,4,opt,name=certificate_pem,json=certificatePem,proto3" json:"certificate_pem,omitempty"`
+	NodeId         []byte `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// e.g., "NodeID-..."
+	NodeIdString string `protobuf:"bytes,6,opt,name=node_id_string,json=nodeIdString,proto3" json:"node_id_string,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,7,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *StakingKeyFromSeedResponse) Reset() {
+	*x = StakingKeyFromSeedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_key_proto_msgTypes[9]
+		mi := &file_rpcpb_key_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlsSignatureResponse) String() string {
+func (x *StakingKeyFromSeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlsSignatureResponse) ProtoMessage() {}
+func (*StakingKeyFromSeedResponse) ProtoMessage() {}
 
-func (x *BlsSignatureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_key_proto_msgTypes[9]
+func (x *StakingKeyFromSeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_key_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,151 +4019,836 @@ func (x *BlsSignatureResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlsSignatureResponse.ProtoReflect.Descriptor instead.
-func (*BlsSignatureResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_key_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use StakingKeyFromSeedResponse.ProtoReflect.Descriptor instead.
+func (*StakingKeyFromSeedResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_key_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *BlsSignatureResponse) GetMessage() string {
+func (x *StakingKeyFromSeedResponse) GetPrivateKey() []byte {
 	if x != nil {
-		return x.Message
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *StakingKeyFromSeedResponse) GetPrivateKeyPem() []byte {
+	if x != nil {
+		return x.PrivateKeyPem
+	}
+	return nil
+}
+
+func (x *StakingKeyFromSeedResponse) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *StakingKeyFromSeedResponse) GetCertificatePem() []byte {
+	if x != nil {
+		return x.CertificatePem
+	}
+	return nil
+}
+
+func (x *StakingKeyFromSeedResponse) GetNodeId() []byte {
+	if x != nil {
+		return x.NodeId
+	}
+	return nil
+}
+
+func (x *StakingKeyFromSeedResponse) GetNodeIdString() string {
+	if x != nil {
+		return x.NodeIdString
 	}
 	return ""
 }
 
-func (x *BlsSignatureResponse) GetSuccess() bool {
+func (x *StakingKeyFromSeedResponse) GetServerDurationMs() uint64 {
 	if x != nil {
-		return x.Success
+		return x.ServerDurationMs
 	}
-	return false
+	return 0
 }
 
 var File_rpcpb_key_proto protoreflect.FileDescriptor
 
 var file_rpcpb_key_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x6b, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x22, 0x57, 0x0a, 0x1a, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
-	0x64, 0x22, 0x7b, 0x0a, 0x1b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x54, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x96,
-	0x01, 0x0a, 0x24, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x36, 0x0a, 0x18, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x63, 0x62, 0x35, 0x38, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x14, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x6f, 0x72,
-	0x74, 0x49, 0x64, 0x43, 0x62, 0x35, 0x38, 0x22, 0xa4, 0x01, 0x0a, 0x25, 0x53, 0x65, 0x63, 0x70,
-	0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x47, 0x0a, 0x21, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69,
-	0x64, 0x5f, 0x63, 0x62, 0x35, 0x38, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x65, 0x78,
-	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53,
-	0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x43, 0x62, 0x35, 0x38, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x1a, 0x10, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f,
+	0x64, 0x69, 0x66, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x57, 0x0a, 0x1a, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x1b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0xc1, 0x01, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x69, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x49, 0x70, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x49, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x69, 0x70,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x49, 0x70,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x24, 0x53, 0x65, 0x63,
+	0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x48, 0x61, 0x73,
+	0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x18, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64,
+	0x5f, 0x63, 0x62, 0x35, 0x38, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x43, 0x62, 0x35,
+	0x38, 0x22, 0xd2, 0x01, 0x0a, 0x25, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x21, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x63, 0x62, 0x35, 0x38,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64,
+	0x43, 0x62, 0x35, 0x38, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x63, 0x70, 0x32,
+	0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3b, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x73,
+	0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x0e,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x22, 0xc9,
+	0x02, 0x0a, 0x15, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36,
+	0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x51, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12, 0x2d, 0x0a, 0x12, 0x6d,
+	0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x68, 0x72, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x68,
+	0x72, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xef, 0x02,
+	0x0a, 0x0d, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x62, 0x35, 0x38, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x43, 0x62, 0x35, 0x38, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x48, 0x65, 0x78, 0x12, 0x51, 0x0a, 0x0f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x58, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x2c, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x70, 0x22, 0x8d, 0x01,
+	0x0a, 0x14, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x65, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xee, 0x01,
+	0x0a, 0x15, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2d, 0x0a, 0x12,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xa3,
+	0x01, 0x0a, 0x16, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x65, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36,
+	0x6b, 0x31, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x22, 0x5b, 0x0a, 0x19, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0xae, 0x01,
+	0x0a, 0x1a, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xca,
+	0x01, 0x0a, 0x1a, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x44, 0x65, 0x72, 0x69,
+	0x76, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0c,
+	0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32,
+	0x35, 0x36, 0x6b, 0x31, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x0b,
+	0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x13,
+	0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x48, 0x65,
+	0x78, 0x12, 0x1b, 0x0a, 0x09, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x78, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x74, 0x68, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x1b, 0x53, 0x65,
+	0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x15, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65,
+	0x64, 0x4b, 0x65, 0x79, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x1c,
+	0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x4b, 0x6d, 0x73, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xac,
+	0x02, 0x0a, 0x1d, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x4b, 0x6d, 0x73, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x32, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x5f, 0x6e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x53,
-	0x0a, 0x14, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x70, 0x32, 0x35,
-	0x36, 0x6b, 0x31, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x99, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b,
-	0x31, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
-	0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x70, 0x32, 0x35,
-	0x36, 0x6b, 0x31, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65,
-	0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f,
+	0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xba, 0x01,
+	0x0a, 0x19, 0x4b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x1a, 0x4b,
+	0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x22, 0x9b, 0x01, 0x0a, 0x19, 0x4b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x22,
+	0x69, 0x0a, 0x1a, 0x4b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x7e, 0x0a, 0x18, 0x45, 0x74,
+	0x68, 0x4b, 0x65, 0x79, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6b, 0x65,
+	0x79, 0x66, 0x69, 0x6c, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0xe1, 0x01, 0x0a, 0x19, 0x45,
+	0x74, 0x68, 0x4b, 0x65, 0x79, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x91,
+	0x01, 0x0a, 0x18, 0x45, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x63, 0x72, 0x79, 0x70, 0x74, 0x5f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x73, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4e, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x5f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x50, 0x22, 0x6c, 0x0a, 0x19, 0x45, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x66, 0x69, 0x6c, 0x65,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x66, 0x69, 0x6c, 0x65, 0x4a, 0x73,
+	0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x22, 0xd0, 0x01, 0x0a, 0x13, 0x42, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x41, 0x0a, 0x1d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x78, 0x0a, 0x14, 0x42, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
-	0xef, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x62, 0x35, 0x38,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
-	0x65, 0x79, 0x43, 0x62, 0x35, 0x38, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x48, 0x65, 0x78, 0x12, 0x51,
-	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x41,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x7b, 0x0a,
+	0x0c, 0x42, 0x6c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x15, 0x42, 0x6c,
+	0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x73, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xea,
+	0x02, 0x0a, 0x16, 0x42, 0x6c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x08, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x12, 0x2c, 0x0a, 0x12, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x38,
+	0x0a, 0x18, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x16, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x72, 0x0a, 0x1d, 0x42,
+	0x6c, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22,
+	0xe6, 0x01, 0x0a, 0x1e, 0x42, 0x6c, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1a, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x70, 0x0a, 0x1d, 0x42, 0x6c, 0x73, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe5, 0x01, 0x0a, 0x1e, 0x42,
+	0x6c, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x1c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x19, 0x42, 0x6c, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x1a, 0x42, 0x6c, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x1b, 0x42,
+	0x6c, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x36, 0x0a,
+	0x17, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15,
+	0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0xfe, 0x02, 0x0a, 0x1c, 0x42, 0x6c, 0x73, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x1e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x47, 0x0a, 0x20, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55,
+	0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0e,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x38,
+	0x0a, 0x11, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x64,
+	0x69, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x10, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x6c, 0x0a, 0x11, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x22, 0xf8, 0x01, 0x0a, 0x12, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0xef, 0x01, 0x0a, 0x11, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f,
+	0x6f, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0xe3, 0x02, 0x0a, 0x12, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f,
+	0x6f, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x34, 0x0a, 0x16,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x14, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74,
+	0x68, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x66, 0x0a, 0x1b, 0x53, 0x65, 0x63, 0x70, 0x32,
+	0x35, 0x36, 0x6b, 0x31, 0x4b, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x22,
+	0xb3, 0x03, 0x0a, 0x1c, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x4b, 0x65, 0x79,
+	0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x68, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x48, 0x65, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x62, 0x35, 0x38, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x43,
+	0x62, 0x35, 0x38, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x15, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x78, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x41,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x58, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x2c, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01,
-	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x70, 0x22,
-	0xd0, 0x01, 0x0a, 0x13, 0x42, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x41, 0x0a, 0x1d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x41, 0x0a, 0x15, 0x42, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x46,
+	0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xec, 0x01, 0x0a, 0x16, 0x42, 0x6c, 0x73,
+	0x4b, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x36, 0x0a, 0x17, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x15, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x6f,
 	0x6f, 0x66, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x14, 0x42, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x32, 0x83,
-	0x03, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x50,
+	0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x45, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x9d,
+	0x02, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x46, 0x72, 0x6f,
+	0x6d, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x26,
+	0x0a, 0x0f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x65,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x32, 0xf3,
+	0x10, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a,
 	0x13, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x4e, 0x6f,
 	0x64, 0x65, 0x49, 0x64, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x65, 0x72,
 	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
 	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x4e, 0x6f, 0x64,
-	0x65, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a,
-	0x1d, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x48, 0x61, 0x73, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2b,
+	0x65, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x08, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x49, 0x70, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x1d,
+	0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x48, 0x61, 0x73, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2b, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x53, 0x65,
+	0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x70,
+	0x32, 0x35, 0x36, 0x6b, 0x31, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35,
+	0x36, 0x6b, 0x31, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x53, 0x65,
+	0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36,
+	0x6b, 0x31, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32,
+	0x35, 0x36, 0x6b, 0x31, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x13, 0x53, 0x65, 0x63, 0x70, 0x32,
+	0x35, 0x36, 0x6b, 0x31, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x21,
 	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x65, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x53,
-	0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x2e, 0x72,
-	0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x42, 0x6c, 0x73,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x42, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c,
-	0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35,
+	0x36, 0x6b, 0x31, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x15, 0x53, 0x65, 0x63, 0x70, 0x32,
+	0x35, 0x36, 0x6b, 0x31, 0x4b, 0x6d, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36,
+	0x6b, 0x31, 0x4b, 0x6d, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x4b, 0x6d, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a,
+	0x12, 0x4b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4b, 0x65,
+	0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x4b, 0x65,
+	0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x45, 0x74, 0x68, 0x4b, 0x65,
+	0x79, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x1f, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x66, 0x69, 0x6c, 0x65, 0x44,
+	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x66, 0x69, 0x6c, 0x65,
+	0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x58, 0x0a, 0x11, 0x45, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x66, 0x69, 0x6c, 0x65, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45,
+	0x74, 0x68, 0x4b, 0x65, 0x79, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x45, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x42,
+	0x6c, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x42, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x42, 0x6c, 0x73, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x42, 0x6c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42,
+	0x6c, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x16, 0x42, 0x6c, 0x73, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x24, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x73, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x42, 0x6c, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x67, 0x0a, 0x16, 0x42, 0x6c, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x42, 0x6c, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x73, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x42, 0x6c, 0x73,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12,
+	0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x73, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x42, 0x6c, 0x73, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x22,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x73, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x0a, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x18, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31,
+	0x4b, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x4b, 0x65, 0x79,
+	0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b,
+	0x31, 0x4b, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x42, 0x6c, 0x73, 0x4b, 0x65, 0x79,
+	0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x42, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42,
+	0x6c, 0x73, 0x4b, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x12, 0x20, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79,
+	0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
 	0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x75, 0x73, 0x74, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
-	0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -791,38 +4863,135 @@ func file_rpcpb_key_proto_rawDescGZIP() []byte {
 	return file_rpcpb_key_proto_rawDescData
 }
 
-var file_rpcpb_key_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_rpcpb_key_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_rpcpb_key_proto_goTypes = []interface{}{
 	(*CertificateToNodeIdRequest)(nil),            // 0: rpcpb.CertificateToNodeIdRequest
 	(*CertificateToNodeIdResponse)(nil),           // 1: rpcpb.CertificateToNodeIdResponse
-	(*Secp256K1RecoverHashPublicKeyRequest)(nil),  // 2: rpcpb.Secp256k1RecoverHashPublicKeyRequest
-	(*Secp256K1RecoverHashPublicKeyResponse)(nil), // 3: rpcpb.Secp256k1RecoverHashPublicKeyResponse
-	(*Secp256K1InfoRequest)(nil),                  // 4: rpcpb.Secp256k1InfoRequest
-	(*Secp256K1InfoResponse)(nil),                 // 5: rpcpb.Secp256k1InfoResponse
-	(*Secp256K1Info)(nil),                         // 6: rpcpb.Secp256k1Info
-	(*ChainAddresses)(nil),                        // 7: rpcpb.ChainAddresses
-	(*BlsSignatureRequest)(nil),                   // 8: rpcpb.BlsSignatureRequest
-	(*BlsSignatureResponse)(nil),                  // 9: rpcpb.BlsSignatureResponse
-	nil,                                           // 10: rpcpb.Secp256k1Info.ChainAddressesEntry
+	(*SignedIpRequest)(nil),                       // 2: rpcpb.SignedIpRequest
+	(*SignedIpResponse)(nil),                      // 3: rpcpb.SignedIpResponse
+	(*Secp256K1RecoverHashPublicKeyRequest)(nil),  // 4: rpcpb.Secp256k1RecoverHashPublicKeyRequest
+	(*Secp256K1RecoverHashPublicKeyResponse)(nil), // 5: rpcpb.Secp256k1RecoverHashPublicKeyResponse
+	(*Secp256K1InfoRequest)(nil),                  // 6: rpcpb.Secp256k1InfoRequest
+	(*Secp256K1InfoResponse)(nil),                 // 7: rpcpb.Secp256k1InfoResponse
+	(*AddressMatrixEntry)(nil),                    // 8: rpcpb.AddressMatrixEntry
+	(*Secp256K1Info)(nil),                         // 9: rpcpb.Secp256k1Info
+	(*ChainAddresses)(nil),                        // 10: rpcpb.ChainAddresses
+	(*Secp256K1SignRequest)(nil),                  // 11: rpcpb.Secp256k1SignRequest
+	(*Secp256K1SignResponse)(nil),                 // 12: rpcpb.Secp256k1SignResponse
+	(*Secp256K1VerifyRequest)(nil),                // 13: rpcpb.Secp256k1VerifyRequest
+	(*Secp256K1VerifyResponse)(nil),               // 14: rpcpb.Secp256k1VerifyResponse
+	(*Secp256K1PublicKeyRequest)(nil),             // 15: rpcpb.Secp256k1PublicKeyRequest
+	(*Secp256K1PublicKeyResponse)(nil),            // 16: rpcpb.Secp256k1PublicKeyResponse
+	(*Secp256K1DeriveKeysRequest)(nil),            // 17: rpcpb.Secp256k1DeriveKeysRequest
+	(*Secp256K1DerivedKey)(nil),                   // 18: rpcpb.Secp256k1DerivedKey
+	(*Secp256K1DeriveKeysResponse)(nil),           // 19: rpcpb.Secp256k1DeriveKeysResponse
+	(*Secp256K1KmsSignatureRequest)(nil),          // 20: rpcpb.Secp256k1KmsSignatureRequest
+	(*Secp256K1KmsSignatureResponse)(nil),         // 21: rpcpb.Secp256k1KmsSignatureResponse
+	(*KeystoreImportUserRequest)(nil),             // 22: rpcpb.KeystoreImportUserRequest
+	(*KeystoreImportUserResponse)(nil),            // 23: rpcpb.KeystoreImportUserResponse
+	(*KeystoreExportUserRequest)(nil),             // 24: rpcpb.KeystoreExportUserRequest
+	(*KeystoreExportUserResponse)(nil),            // 25: rpcpb.KeystoreExportUserResponse
+	(*EthKeyfileDecryptRequest)(nil),              // 26: rpcpb.EthKeyfileDecryptRequest
+	(*EthKeyfileDecryptResponse)(nil),             // 27: rpcpb.EthKeyfileDecryptResponse
+	(*EthKeyfileEncryptRequest)(nil),              // 28: rpcpb.EthKeyfileEncryptRequest
+	(*EthKeyfileEncryptResponse)(nil),             // 29: rpcpb.EthKeyfileEncryptResponse
+	(*BlsSignatureRequest)(nil),                   // 30: rpcpb.BlsSignatureRequest
+	(*BlsSignatureResponse)(nil),                  // 31: rpcpb.BlsSignatureResponse
+	(*BlsBatchItem)(nil),                          // 32: rpcpb.BlsBatchItem
+	(*BlsBatchVerifyRequest)(nil),                 // 33: rpcpb.BlsBatchVerifyRequest
+	(*BlsBatchVerifyResponse)(nil),                // 34: rpcpb.BlsBatchVerifyResponse
+	(*BlsAggregatePublicKeysRequest)(nil),         // 35: rpcpb.BlsAggregatePublicKeysRequest
+	(*BlsAggregatePublicKeysResponse)(nil),        // 36: rpcpb.BlsAggregatePublicKeysResponse
+	(*BlsAggregateSignaturesRequest)(nil),         // 37: rpcpb.BlsAggregateSignaturesRequest
+	(*BlsAggregateSignaturesResponse)(nil),        // 38: rpcpb.BlsAggregateSignaturesResponse
+	(*BlsAggregateVerifyRequest)(nil),             // 39: rpcpb.BlsAggregateVerifyRequest
+	(*BlsAggregateVerifyResponse)(nil),            // 40: rpcpb.BlsAggregateVerifyResponse
+	(*BlsPublicKeyEncodingRequest)(nil),           // 41: rpcpb.BlsPublicKeyEncodingRequest
+	(*BlsPublicKeyEncodingResponse)(nil),          // 42: rpcpb.BlsPublicKeyEncodingResponse
+	(*LedgerPathRequest)(nil),                     // 43: rpcpb.LedgerPathRequest
+	(*LedgerPathResponse)(nil),                    // 44: rpcpb.LedgerPathResponse
+	(*LedgerSignRequest)(nil),                     // 45: rpcpb.LedgerSignRequest
+	(*LedgerSignResponse)(nil),                    // 46: rpcpb.LedgerSignResponse
+	(*Secp256K1KeyFromSeedRequest)(nil),           // 47: rpcpb.Secp256k1KeyFromSeedRequest
+	(*Secp256K1KeyFromSeedResponse)(nil),          // 48: rpcpb.Secp256k1KeyFromSeedResponse
+	(*BlsKeyFromSeedRequest)(nil),                 // 49: rpcpb.BlsKeyFromSeedRequest
+	(*BlsKeyFromSeedResponse)(nil),                // 50: rpcpb.BlsKeyFromSeedResponse
+	(*StakingKeyFromSeedRequest)(nil),             // 51: rpcpb.StakingKeyFromSeedRequest
+	(*StakingKeyFromSeedResponse)(nil),            // 52: rpcpb.StakingKeyFromSeedResponse
+	nil,                                           // 53: rpcpb.Secp256k1Info.ChainAddressesEntry
+	(*Diff)(nil),                                  // 54: rpcpb.Diff
 }
 var file_rpcpb_key_proto_depIdxs = []int32{
-	6,  // 0: rpcpb.Secp256k1InfoRequest.secp256k1_info:type_name -> rpcpb.Secp256k1Info
-	6,  // 1: rpcpb.Secp256k1InfoResponse.expected_secp256k1_info:type_name -> rpcpb.Secp256k1Info
-	10, // 2: rpcpb.Secp256k1Info.chain_addresses:type_name -> rpcpb.Secp256k1Info.ChainAddressesEntry
-	7,  // 3: rpcpb.Secp256k1Info.ChainAddressesEntry.value:type_name -> rpcpb.ChainAddresses
-	0,  // 4: rpcpb.KeyService.CertificateToNodeId:input_type -> rpcpb.CertificateToNodeIdRequest
-	2,  // 5: rpcpb.KeyService.Secp256k1RecoverHashPublicKey:input_type -> rpcpb.Secp256k1RecoverHashPublicKeyRequest
-	4,  // 6: rpcpb.KeyService.Secp256k1Info:input_type -> rpcpb.Secp256k1InfoRequest
-	8,  // 7: rpcpb.KeyService.BlsSignature:input_type -> rpcpb.BlsSignatureRequest
-	1,  // 8: rpcpb.KeyService.CertificateToNodeId:output_type -> rpcpb.CertificateToNodeIdResponse
-	3,  // 9: rpcpb.KeyService.Secp256k1RecoverHashPublicKey:output_type -> rpcpb.Secp256k1RecoverHashPublicKeyResponse
-	5,  // 10: rpcpb.KeyService.Secp256k1Info:output_type -> rpcpb.Secp256k1InfoResponse
-	9,  // 11: rpcpb.KeyService.BlsSignature:output_type -> rpcpb.BlsSignatureResponse
-	8,  // [8:12] is the sub-list for method output_type
-	4,  // [4:8] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	54, // 0: rpcpb.SignedIpResponse.diff:type_name -> rpcpb.Diff
+	9,  // 1: rpcpb.Secp256k1InfoRequest.secp256k1_info:type_name -> rpcpb.Secp256k1Info
+	8,  // 2: rpcpb.Secp256k1InfoRequest.address_matrix:type_name -> rpcpb.AddressMatrixEntry
+	9,  // 3: rpcpb.Secp256k1InfoResponse.expected_secp256k1_info:type_name -> rpcpb.Secp256k1Info
+	8,  // 4: rpcpb.Secp256k1InfoResponse.expected_address_matrix:type_name -> rpcpb.AddressMatrixEntry
+	53, // 5: rpcpb.Secp256k1Info.chain_addresses:type_name -> rpcpb.Secp256k1Info.ChainAddressesEntry
+	54, // 6: rpcpb.Secp256k1SignResponse.diff:type_name -> rpcpb.Diff
+	18, // 7: rpcpb.Secp256k1DeriveKeysRequest.derived_keys:type_name -> rpcpb.Secp256k1DerivedKey
+	18, // 8: rpcpb.Secp256k1DeriveKeysResponse.expected_derived_keys:type_name -> rpcpb.Secp256k1DerivedKey
+	54, // 9: rpcpb.Secp256k1KmsSignatureResponse.diff:type_name -> rpcpb.Diff
+	32, // 10: rpcpb.BlsBatchVerifyRequest.items:type_name -> rpcpb.BlsBatchItem
+	54, // 11: rpcpb.BlsAggregatePublicKeysResponse.diff:type_name -> rpcpb.Diff
+	54, // 12: rpcpb.BlsAggregateSignaturesResponse.diff:type_name -> rpcpb.Diff
+	54, // 13: rpcpb.BlsPublicKeyEncodingResponse.compressed_diff:type_name -> rpcpb.Diff
+	54, // 14: rpcpb.BlsPublicKeyEncodingResponse.uncompressed_diff:type_name -> rpcpb.Diff
+	54, // 15: rpcpb.LedgerPathResponse.diff:type_name -> rpcpb.Diff
+	10, // 16: rpcpb.Secp256k1Info.ChainAddressesEntry.value:type_name -> rpcpb.ChainAddresses
+	0,  // 17: rpcpb.KeyService.CertificateToNodeId:input_type -> rpcpb.CertificateToNodeIdRequest
+	2,  // 18: rpcpb.KeyService.SignedIp:input_type -> rpcpb.SignedIpRequest
+	4,  // 19: rpcpb.KeyService.Secp256k1RecoverHashPublicKey:input_type -> rpcpb.Secp256k1RecoverHashPublicKeyRequest
+	6,  // 20: rpcpb.KeyService.Secp256k1Info:input_type -> rpcpb.Secp256k1InfoRequest
+	11, // 21: rpcpb.KeyService.Secp256k1Sign:input_type -> rpcpb.Secp256k1SignRequest
+	13, // 22: rpcpb.KeyService.Secp256k1Verify:input_type -> rpcpb.Secp256k1VerifyRequest
+	15, // 23: rpcpb.KeyService.Secp256k1PublicKey:input_type -> rpcpb.Secp256k1PublicKeyRequest
+	17, // 24: rpcpb.KeyService.Secp256k1DeriveKeys:input_type -> rpcpb.Secp256k1DeriveKeysRequest
+	20, // 25: rpcpb.KeyService.Secp256k1KmsSignature:input_type -> rpcpb.Secp256k1KmsSignatureRequest
+	22, // 26: rpcpb.KeyService.KeystoreImportUser:input_type -> rpcpb.KeystoreImportUserRequest
+	24, // 27: rpcpb.KeyService.KeystoreExportUser:input_type -> rpcpb.KeystoreExportUserRequest
+	26, // 28: rpcpb.KeyService.EthKeyfileDecrypt:input_type -> rpcpb.EthKeyfileDecryptRequest
+	28, // 29: rpcpb.KeyService.EthKeyfileEncrypt:input_type -> rpcpb.EthKeyfileEncryptRequest
+	30, // 30: rpcpb.KeyService.BlsSignature:input_type -> rpcpb.BlsSignatureRequest
+	33, // 31: rpcpb.KeyService.BlsBatchVerify:input_type -> rpcpb.BlsBatchVerifyRequest
+	35, // 32: rpcpb.KeyService.BlsAggregatePublicKeys:input_type -> rpcpb.BlsAggregatePublicKeysRequest
+	37, // 33: rpcpb.KeyService.BlsAggregateSignatures:input_type -> rpcpb.BlsAggregateSignaturesRequest
+	39, // 34: rpcpb.KeyService.BlsAggregateVerify:input_type -> rpcpb.BlsAggregateVerifyRequest
+	41, // 35: rpcpb.KeyService.BlsPublicKeyEncoding:input_type -> rpcpb.BlsPublicKeyEncodingRequest
+	43, // 36: rpcpb.KeyService.LedgerPath:input_type -> rpcpb.LedgerPathRequest
+	45, // 37: rpcpb.KeyService.LedgerSign:input_type -> rpcpb.LedgerSignRequest
+	47, // 38: rpcpb.KeyService.Secp256k1KeyFromSeed:input_type -> rpcpb.Secp256k1KeyFromSeedRequest
+	49, // 39: rpcpb.KeyService.BlsKeyFromSeed:input_type -> rpcpb.BlsKeyFromSeedRequest
+	51, // 40: rpcpb.KeyService.StakingKeyFromSeed:input_type -> rpcpb.StakingKeyFromSeedRequest
+	1,  // 41: rpcpb.KeyService.CertificateToNodeId:output_type -> rpcpb.CertificateToNodeIdResponse
+	3,  // 42: rpcpb.KeyService.SignedIp:output_type -> rpcpb.SignedIpResponse
+	5,  // 43: rpcpb.KeyService.Secp256k1RecoverHashPublicKey:output_type -> rpcpb.Secp256k1RecoverHashPublicKeyResponse
+	7,  // 44: rpcpb.KeyService.Secp256k1Info:output_type -> rpcpb.Secp256k1InfoResponse
+	12, // 45: rpcpb.KeyService.Secp256k1Sign:output_type -> rpcpb.Secp256k1SignResponse
+	14, // 46: rpcpb.KeyService.Secp256k1Verify:output_type -> rpcpb.Secp256k1VerifyResponse
+	16, // 47: rpcpb.KeyService.Secp256k1PublicKey:output_type -> rpcpb.Secp256k1PublicKeyResponse
+	19, // 48: rpcpb.KeyService.Secp256k1DeriveKeys:output_type -> rpcpb.Secp256k1DeriveKeysResponse
+	21, // 49: rpcpb.KeyService.Secp256k1KmsSignature:output_type -> rpcpb.Secp256k1KmsSignatureResponse
+	23, // 50: rpcpb.KeyService.KeystoreImportUser:output_type -> rpcpb.KeystoreImportUserResponse
+	25, // 51: rpcpb.KeyService.KeystoreExportUser:output_type -> rpcpb.KeystoreExportUserResponse
+	27, // 52: rpcpb.KeyService.EthKeyfileDecrypt:output_type -> rpcpb.EthKeyfileDecryptResponse
+	29, // 53: rpcpb.KeyService.EthKeyfileEncrypt:output_type -> rpcpb.EthKeyfileEncryptResponse
+	31, // 54: rpcpb.KeyService.BlsSignature:output_type -> rpcpb.BlsSignatureResponse
+	34, // 55: rpcpb.KeyService.BlsBatchVerify:output_type -> rpcpb.BlsBatchVerifyResponse
+	36, // 56: rpcpb.KeyService.BlsAggregatePublicKeys:output_type -> rpcpb.BlsAggregatePublicKeysResponse
+	38, // 57: rpcpb.KeyService.BlsAggregateSignatures:output_type -> rpcpb.BlsAggregateSignaturesResponse
+	40, // 58: rpcpb.KeyService.BlsAggregateVerify:output_type -> rpcpb.BlsAggregateVerifyResponse
+	42, // 59: rpcpb.KeyService.BlsPublicKeyEncoding:output_type -> rpcpb.BlsPublicKeyEncodingResponse
+	44, // 60: rpcpb.KeyService.LedgerPath:output_type -> rpcpb.LedgerPathResponse
+	46, // 61: rpcpb.KeyService.LedgerSign:output_type -> rpcpb.LedgerSignResponse
+	48, // 62: rpcpb.KeyService.Secp256k1KeyFromSeed:output_type -> rpcpb.Secp256k1KeyFromSeedResponse
+	50, // 63: rpcpb.KeyService.BlsKeyFromSeed:output_type -> rpcpb.BlsKeyFromSeedResponse
+	52, // 64: rpcpb.KeyService.StakingKeyFromSeed:output_type -> rpcpb.StakingKeyFromSeedResponse
+	41, // [41:65] is the sub-list for method output_type
+	17, // [17:41] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_rpcpb_key_proto_init() }
@@ -830,6 +4999,7 @@ func file_rpcpb_key_proto_init() {
 	if File_rpcpb_key_proto != nil {
 		return
 	}
+	file_rpcpb_diff_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_rpcpb_key_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CertificateToNodeIdRequest); i {
@@ -843,8 +5013,236 @@ func file_rpcpb_key_proto_init() {
 				return nil
 			}
 		}
-		file_rpcpb_key_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CertificateToNodeIdResponse); i {
+		file_rpcpb_key_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateToNodeIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedIpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedIpResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1RecoverHashPublicKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1RecoverHashPublicKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1InfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1InfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressMatrixEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1Info); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainAddresses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1SignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1SignResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1VerifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1VerifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1PublicKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1PublicKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1DeriveKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1DerivedKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1DeriveKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1KmsSignatureRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -855,8 +5253,8 @@ func file_rpcpb_key_proto_init() {
 				return nil
 			}
 		}
-		file_rpcpb_key_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Secp256K1RecoverHashPublicKeyRequest); i {
+		file_rpcpb_key_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1KmsSignatureResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -867,8 +5265,8 @@ func file_rpcpb_key_proto_init() {
 				return nil
 			}
 		}
-		file_rpcpb_key_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Secp256K1RecoverHashPublicKeyResponse); i {
+		file_rpcpb_key_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeystoreImportUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -879,8 +5277,8 @@ func file_rpcpb_key_proto_init() {
 				return nil
 			}
 		}
-		file_rpcpb_key_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Secp256K1InfoRequest); i {
+		file_rpcpb_key_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeystoreImportUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -891,8 +5289,8 @@ func file_rpcpb_key_proto_init() {
 				return nil
 			}
 		}
-		file_rpcpb_key_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Secp256K1InfoResponse); i {
+		file_rpcpb_key_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeystoreExportUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -903,8 +5301,8 @@ func file_rpcpb_key_proto_init() {
 				return nil
 			}
 		}
-		file_rpcpb_key_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Secp256K1Info); i {
+		file_rpcpb_key_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeystoreExportUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -915,8 +5313,8 @@ func file_rpcpb_key_proto_init() {
 				return nil
 			}
 		}
-		file_rpcpb_key_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainAddresses); i {
+		file_rpcpb_key_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthKeyfileDecryptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -927,7 +5325,43 @@ func file_rpcpb_key_proto_init() {
 				return nil
 			}
 		}
-		file_rpcpb_key_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_rpcpb_key_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthKeyfileDecryptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthKeyfileEncryptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthKeyfileEncryptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlsSignatureRequest); i {
 			case 0:
 				return &v.state
@@ -939,7 +5373,7 @@ func file_rpcpb_key_proto_init() {
 				return nil
 			}
 		}
-		file_rpcpb_key_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_rpcpb_key_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlsSignatureResponse); i {
 			case 0:
 				return &v.state
@@ -951,6 +5385,258 @@ func file_rpcpb_key_proto_init() {
 				return nil
 			}
 		}
+		file_rpcpb_key_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsBatchItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsBatchVerifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsBatchVerifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsAggregatePublicKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsAggregatePublicKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsAggregateSignaturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsAggregateSignaturesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsAggregateVerifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsAggregateVerifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsPublicKeyEncodingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsPublicKeyEncodingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerPathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerSignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerSignResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1KeyFromSeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secp256K1KeyFromSeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsKeyFromSeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlsKeyFromSeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingKeyFromSeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_key_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingKeyFromSeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -958,7 +5644,7 @@ func file_rpcpb_key_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpcpb_key_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,9 +20,29 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	KeyService_CertificateToNodeId_FullMethodName           = "/rpcpb.KeyService/CertificateToNodeId"
+	KeyService_SignedIp_FullMethodName                      = "/rpcpb.KeyService/SignedIp"
 	KeyService_Secp256K1RecoverHashPublicKey_FullMethodName = "/rpcpb.KeyService/Secp256k1RecoverHashPublicKey"
 	KeyService_Secp256K1Info_FullMethodName                 = "/rpcpb.KeyService/Secp256k1Info"
+	KeyService_Secp256K1Sign_FullMethodName                 = "/rpcpb.KeyService/Secp256k1Sign"
+	KeyService_Secp256K1Verify_FullMethodName               = "/rpcpb.KeyService/Secp256k1Verify"
+	KeyService_Secp256K1PublicKey_FullMethodName            = "/rpcpb.KeyService/Secp256k1PublicKey"
+	KeyService_Secp256K1DeriveKeys_FullMethodName           = "/rpcpb.KeyService/Secp256k1DeriveKeys"
+	KeyService_Secp256K1KmsSignature_FullMethodName         = "/rpcpb.KeyService/Secp256k1KmsSignature"
+	KeyService_KeystoreImportUser_FullMethodName            = "/rpcpb.KeyService/KeystoreImportUser"
+	KeyService_KeystoreExportUser_FullMethodName            = "/rpcpb.KeyService/KeystoreExportUser"
+	KeyService_EthKeyfileDecrypt_FullMethodName             = "/rpcpb.KeyService/EthKeyfileDecrypt"
+	KeyService_EthKeyfileEncrypt_FullMethodName             = "/rpcpb.KeyService/EthKeyfileEncrypt"
 	KeyService_BlsSignature_FullMethodName                  = "/rpcpb.KeyService/BlsSignature"
+	KeyService_BlsBatchVerify_FullMethodName                = "/rpcpb.KeyService/BlsBatchVerify"
+	KeyService_BlsAggregatePublicKeys_FullMethodName        = "/rpcpb.KeyService/BlsAggregatePublicKeys"
+	KeyService_BlsAggregateSignatures_FullMethodName        = "/rpcpb.KeyService/BlsAggregateSignatures"
+	KeyService_BlsAggregateVerify_FullMethodName            = "/rpcpb.KeyService/BlsAggregateVerify"
+	KeyService_BlsPublicKeyEncoding_FullMethodName          = "/rpcpb.KeyService/BlsPublicKeyEncoding"
+	KeyService_LedgerPath_FullMethodName                    = "/rpcpb.KeyService/LedgerPath"
+	KeyService_LedgerSign_FullMethodName                    = "/rpcpb.KeyService/LedgerSign"
+	KeyService_Secp256K1KeyFromSeed_FullMethodName          = "/rpcpb.KeyService/Secp256k1KeyFromSeed"
+	KeyService_BlsKeyFromSeed_FullMethodName                = "/rpcpb.KeyService/BlsKeyFromSeed"
+	KeyService_StakingKeyFromSeed_FullMethodName            = "/rpcpb.KeyService/StakingKeyFromSeed"
 )
 
 // KeyServiceClient is the client API for KeyService service.
@@ -30,9 +50,61 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type KeyServiceClient interface {
 	CertificateToNodeId(ctx context.Context, in *CertificateToNodeIdRequest, opts ...grpc.CallOption) (*CertificateToNodeIdResponse, error)
+	// Packs the IP claimed by a peer, and verifies its signature with the
+	// staking certificate of the peer.
+	// ref. "peer.UnsignedIP", "peer.SignedIP.Verify"
+	SignedIp(ctx context.Context, in *SignedIpRequest, opts ...grpc.CallOption) (*SignedIpResponse, error)
 	Secp256K1RecoverHashPublicKey(ctx context.Context, in *Secp256K1RecoverHashPublicKeyRequest, opts ...grpc.CallOption) (*Secp256K1RecoverHashPublicKeyResponse, error)
 	Secp256K1Info(ctx context.Context, in *Secp256K1InfoRequest, opts ...grpc.CallOption) (*Secp256K1InfoResponse, error)
+	// Signs a message (or its hash) with RFC 6979 nonces, as
+	// "secp256k1.PrivateKey.Sign" and "secp256k1.PrivateKey.SignHash".
+	Secp256K1Sign(ctx context.Context, in *Secp256K1SignRequest, opts ...grpc.CallOption) (*Secp256K1SignResponse, error)
+	// ref. "secp256k1.PublicKey.Verify", "secp256k1.PublicKey.VerifyHash"
+	Secp256K1Verify(ctx context.Context, in *Secp256K1VerifyRequest, opts ...grpc.CallOption) (*Secp256K1VerifyResponse, error)
+	// Encodes the public key of a private key in SEC 1 compressed form.
+	// ref. "secp256k1.PublicKey.Bytes"
+	Secp256K1PublicKey(ctx context.Context, in *Secp256K1PublicKeyRequest, opts ...grpc.CallOption) (*Secp256K1PublicKeyResponse, error)
+	// Derives keys from a BIP-39 mnemonic along a BIP-32 path.
+	Secp256K1DeriveKeys(ctx context.Context, in *Secp256K1DeriveKeysRequest, opts ...grpc.CallOption) (*Secp256K1DeriveKeysResponse, error)
+	// Converts a DER signature of a hash, as AWS KMS returns it, into the
+	// 65-byte [r || s || v] signature avalanchego verifies: s is normalized to
+	// the lower half order, and v is the recovery ID of the public key.
+	// ref. "secp256k1.Factory.RecoverHashPublicKey"
+	Secp256K1KmsSignature(ctx context.Context, in *Secp256K1KmsSignatureRequest, opts ...grpc.CallOption) (*Secp256K1KmsSignatureResponse, error)
+	// Imports a keystore user export, and recovers the private keys stored
+	// for a chain.
+	KeystoreImportUser(ctx context.Context, in *KeystoreImportUserRequest, opts ...grpc.CallOption) (*KeystoreImportUserResponse, error)
+	// Exports a keystore user storing the private keys for a chain.
+	KeystoreExportUser(ctx context.Context, in *KeystoreExportUserRequest, opts ...grpc.CallOption) (*KeystoreExportUserResponse, error)
+	// Decrypts a version 3 EVM keyfile.
+	EthKeyfileDecrypt(ctx context.Context, in *EthKeyfileDecryptRequest, opts ...grpc.CallOption) (*EthKeyfileDecryptResponse, error)
+	// Encrypts a private key into a version 3 EVM keyfile.
+	EthKeyfileEncrypt(ctx context.Context, in *EthKeyfileEncryptRequest, opts ...grpc.CallOption) (*EthKeyfileEncryptResponse, error)
 	BlsSignature(ctx context.Context, in *BlsSignatureRequest, opts ...grpc.CallOption) (*BlsSignatureResponse, error)
+	BlsBatchVerify(ctx context.Context, in *BlsBatchVerifyRequest, opts ...grpc.CallOption) (*BlsBatchVerifyResponse, error)
+	BlsAggregatePublicKeys(ctx context.Context, in *BlsAggregatePublicKeysRequest, opts ...grpc.CallOption) (*BlsAggregatePublicKeysResponse, error)
+	BlsAggregateSignatures(ctx context.Context, in *BlsAggregateSignaturesRequest, opts ...grpc.CallOption) (*BlsAggregateSignaturesResponse, error)
+	// Verifies an aggregate signature of N signers of the same message.
+	BlsAggregateVerify(ctx context.Context, in *BlsAggregateVerifyRequest, opts ...grpc.CallOption) (*BlsAggregateVerifyResponse, error)
+	// Checks the compressed and uncompressed encodings of a public key.
+	BlsPublicKeyEncoding(ctx context.Context, in *BlsPublicKeyEncodingRequest, opts ...grpc.CallOption) (*BlsPublicKeyEncodingResponse, error)
+	// Serializes a BIP-32 path as the Avalanche Ledger app reads it.
+	// ref. "ledger.Ledger.Address"
+	LedgerPath(ctx context.Context, in *LedgerPathRequest, opts ...grpc.CallOption) (*LedgerPathResponse, error)
+	// Checks what a Ledger signer sends the Avalanche Ledger app to sign an
+	// unsigned tx: the root path, the signing path of each address, and
+	// whether the tx is too large to be signed but by its hash.
+	// ref. "ledger.Ledger.Sign", "ledger.Ledger.SignHash"
+	LedgerSign(ctx context.Context, in *LedgerSignRequest, opts ...grpc.CallOption) (*LedgerSignResponse, error)
+	// Generates the secp256k1 key of a seed, with all its representations, so
+	// that test suites can share fixtures without committing private keys.
+	Secp256K1KeyFromSeed(ctx context.Context, in *Secp256K1KeyFromSeedRequest, opts ...grpc.CallOption) (*Secp256K1KeyFromSeedResponse, error)
+	// Generates the BLS signer key of a seed, with its public key and proof
+	// of possession.
+	BlsKeyFromSeed(ctx context.Context, in *BlsKeyFromSeedRequest, opts ...grpc.CallOption) (*BlsKeyFromSeedResponse, error)
+	// Generates the TLS staking key and self-signed certificate of a seed,
+	// with the node ID of the certificate.
+	StakingKeyFromSeed(ctx context.Context, in *StakingKeyFromSeedRequest, opts ...grpc.CallOption) (*StakingKeyFromSeedResponse, error)
 }
 
 type keyServiceClient struct {
@@ -52,6 +124,15 @@ func (c *keyServiceClient) CertificateToNodeId(ctx context.Context, in *Certific
 	return out, nil
 }
 
+func (c *keyServiceClient) SignedIp(ctx context.Context, in *SignedIpRequest, opts ...grpc.CallOption) (*SignedIpResponse, error) {
+	out := new(SignedIpResponse)
+	err := c.cc.Invoke(ctx, KeyService_SignedIp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keyServiceClient) Secp256K1RecoverHashPublicKey(ctx context.Context, in *Secp256K1RecoverHashPublicKeyRequest, opts ...grpc.CallOption) (*Secp256K1RecoverHashPublicKeyResponse, error) {
 	out := new(Secp256K1RecoverHashPublicKeyResponse)
 	err := c.cc.Invoke(ctx, KeyService_Secp256K1RecoverHashPublicKey_FullMethodName, in, out, opts...)
@@ -70,6 +151,87 @@ func (c *keyServiceClient) Secp256K1Info(ctx context.Context, in *Secp256K1InfoR
 	return out, nil
 }
 
+func (c *keyServiceClient) Secp256K1Sign(ctx context.Context, in *Secp256K1SignRequest, opts ...grpc.CallOption) (*Secp256K1SignResponse, error) {
+	out := new(Secp256K1SignResponse)
+	err := c.cc.Invoke(ctx, KeyService_Secp256K1Sign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Secp256K1Verify(ctx context.Context, in *Secp256K1VerifyRequest, opts ...grpc.CallOption) (*Secp256K1VerifyResponse, error) {
+	out := new(Secp256K1VerifyResponse)
+	err := c.cc.Invoke(ctx, KeyService_Secp256K1Verify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Secp256K1PublicKey(ctx context.Context, in *Secp256K1PublicKeyRequest, opts ...grpc.CallOption) (*Secp256K1PublicKeyResponse, error) {
+	out := new(Secp256K1PublicKeyResponse)
+	err := c.cc.Invoke(ctx, KeyService_Secp256K1PublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Secp256K1DeriveKeys(ctx context.Context, in *Secp256K1DeriveKeysRequest, opts ...grpc.CallOption) (*Secp256K1DeriveKeysResponse, error) {
+	out := new(Secp256K1DeriveKeysResponse)
+	err := c.cc.Invoke(ctx, KeyService_Secp256K1DeriveKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Secp256K1KmsSignature(ctx context.Context, in *Secp256K1KmsSignatureRequest, opts ...grpc.CallOption) (*Secp256K1KmsSignatureResponse, error) {
+	out := new(Secp256K1KmsSignatureResponse)
+	err := c.cc.Invoke(ctx, KeyService_Secp256K1KmsSignature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) KeystoreImportUser(ctx context.Context, in *KeystoreImportUserRequest, opts ...grpc.CallOption) (*KeystoreImportUserResponse, error) {
+	out := new(KeystoreImportUserResponse)
+	err := c.cc.Invoke(ctx, KeyService_KeystoreImportUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) KeystoreExportUser(ctx context.Context, in *KeystoreExportUserRequest, opts ...grpc.CallOption) (*KeystoreExportUserResponse, error) {
+	out := new(KeystoreExportUserResponse)
+	err := c.cc.Invoke(ctx, KeyService_KeystoreExportUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) EthKeyfileDecrypt(ctx context.Context, in *EthKeyfileDecryptRequest, opts ...grpc.CallOption) (*EthKeyfileDecryptResponse, error) {
+	out := new(EthKeyfileDecryptResponse)
+	err := c.cc.Invoke(ctx, KeyService_EthKeyfileDecrypt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) EthKeyfileEncrypt(ctx context.Context, in *EthKeyfileEncryptRequest, opts ...grpc.CallOption) (*EthKeyfileEncryptResponse, error) {
+	out := new(EthKeyfileEncryptResponse)
+	err := c.cc.Invoke(ctx, KeyService_EthKeyfileEncrypt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keyServiceClient) BlsSignature(ctx context.Context, in *BlsSignatureRequest, opts ...grpc.CallOption) (*BlsSignatureResponse, error) {
 	out := new(BlsSignatureResponse)
 	err := c.cc.Invoke(ctx, KeyService_BlsSignature_FullMethodName, in, out, opts...)
@@ -79,14 +241,156 @@ func (c *keyServiceClient) BlsSignature(ctx context.Context, in *BlsSignatureReq
 	return out, nil
 }
 
+func (c *keyServiceClient) BlsBatchVerify(ctx context.Context, in *BlsBatchVerifyRequest, opts ...grpc.CallOption) (*BlsBatchVerifyResponse, error) {
+	out := new(BlsBatchVerifyResponse)
+	err := c.cc.Invoke(ctx, KeyService_BlsBatchVerify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) BlsAggregatePublicKeys(ctx context.Context, in *BlsAggregatePublicKeysRequest, opts ...grpc.CallOption) (*BlsAggregatePublicKeysResponse, error) {
+	out := new(BlsAggregatePublicKeysResponse)
+	err := c.cc.Invoke(ctx, KeyService_BlsAggregatePublicKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) BlsAggregateSignatures(ctx context.Context, in *BlsAggregateSignaturesRequest, opts ...grpc.CallOption) (*BlsAggregateSignaturesResponse, error) {
+	out := new(BlsAggregateSignaturesResponse)
+	err := c.cc.Invoke(ctx, KeyService_BlsAggregateSignatures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) BlsAggregateVerify(ctx context.Context, in *BlsAggregateVerifyRequest, opts ...grpc.CallOption) (*BlsAggregateVerifyResponse, error) {
+	out := new(BlsAggregateVerifyResponse)
+	err := c.cc.Invoke(ctx, KeyService_BlsAggregateVerify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) BlsPublicKeyEncoding(ctx context.Context, in *BlsPublicKeyEncodingRequest, opts ...grpc.CallOption) (*BlsPublicKeyEncodingResponse, error) {
+	out := new(BlsPublicKeyEncodingResponse)
+	err := c.cc.Invoke(ctx, KeyService_BlsPublicKeyEncoding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) LedgerPath(ctx context.Context, in *LedgerPathRequest, opts ...grpc.CallOption) (*LedgerPathResponse, error) {
+	out := new(LedgerPathResponse)
+	err := c.cc.Invoke(ctx, KeyService_LedgerPath_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) LedgerSign(ctx context.Context, in *LedgerSignRequest, opts ...grpc.CallOption) (*LedgerSignResponse, error) {
+	out := new(LedgerSignResponse)
+	err := c.cc.Invoke(ctx, KeyService_LedgerSign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Secp256K1KeyFromSeed(ctx context.Context, in *Secp256K1KeyFromSeedRequest, opts ...grpc.CallOption) (*Secp256K1KeyFromSeedResponse, error) {
+	out := new(Secp256K1KeyFromSeedResponse)
+	err := c.cc.Invoke(ctx, KeyService_Secp256K1KeyFromSeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) BlsKeyFromSeed(ctx context.Context, in *BlsKeyFromSeedRequest, opts ...grpc.CallOption) (*BlsKeyFromSeedResponse, error) {
+	out := new(BlsKeyFromSeedResponse)
+	err := c.cc.Invoke(ctx, KeyService_BlsKeyFromSeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) StakingKeyFromSeed(ctx context.Context, in *StakingKeyFromSeedRequest, opts ...grpc.CallOption) (*StakingKeyFromSeedResponse, error) {
+	out := new(StakingKeyFromSeedResponse)
+	err := c.cc.Invoke(ctx, KeyService_StakingKeyFromSeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KeyServiceServer is the server API for KeyService service.
 // All implementations must embed UnimplementedKeyServiceServer
 // for forward compatibility
 type KeyServiceServer interface {
 	CertificateToNodeId(context.Context, *CertificateToNodeIdRequest) (*CertificateToNodeIdResponse, error)
+	// Packs the IP claimed by a peer, and verifies its signature with the
+	// staking certificate of the peer.
+	// ref. "peer.UnsignedIP", "peer.SignedIP.Verify"
+	SignedIp(context.Context, *SignedIpRequest) (*SignedIpResponse, error)
 	Secp256K1RecoverHashPublicKey(context.Context, *Secp256K1RecoverHashPublicKeyRequest) (*Secp256K1RecoverHashPublicKeyResponse, error)
 	Secp256K1Info(context.Context, *Secp256K1InfoRequest) (*Secp256K1InfoResponse, error)
+	// Signs a message (or its hash) with RFC 6979 nonces, as
+	// "secp256k1.PrivateKey.Sign" and "secp256k1.PrivateKey.SignHash".
+	Secp256K1Sign(context.Context, *Secp256K1SignRequest) (*Secp256K1SignResponse, error)
+	// ref. "secp256k1.PublicKey.Verify", "secp256k1.PublicKey.VerifyHash"
+	Secp256K1Verify(context.Context, *Secp256K1VerifyRequest) (*Secp256K1VerifyResponse, error)
+	// Encodes the public key of a private key in SEC 1 compressed form.
+	// ref. "secp256k1.PublicKey.Bytes"
+	Secp256K1PublicKey(context.Context, *Secp256K1PublicKeyRequest) (*Secp256K1PublicKeyResponse, error)
+	// Derives keys from a BIP-39 mnemonic along a BIP-32 path.
+	Secp256K1DeriveKeys(context.Context, *Secp256K1DeriveKeysRequest) (*Secp256K1DeriveKeysResponse, error)
+	// Converts a DER signature of a hash, as AWS KMS returns it, into the
+	// 65-byte [r || s || v] signature avalanchego verifies: s is normalized to
+	// the lower half order, and v is the recovery ID of the public key.
+	// ref. "secp256k1.Factory.RecoverHashPublicKey"
+	Secp256K1KmsSignature(context.Context, *Secp256K1KmsSignatureRequest) (*Secp256K1KmsSignatureResponse, error)
+	// Imports a keystore user export, and recovers the private keys stored
+	// for a chain.
+	KeystoreImportUser(context.Context, *KeystoreImportUserRequest) (*KeystoreImportUserResponse, error)
+	// Exports a keystore user storing the private keys for a chain.
+	KeystoreExportUser(context.Context, *KeystoreExportUserRequest) (*KeystoreExportUserResponse, error)
+	// Decrypts a version 3 EVM keyfile.
+	EthKeyfileDecrypt(context.Context, *EthKeyfileDecryptRequest) (*EthKeyfileDecryptResponse, error)
+	// Encrypts a private key into a version 3 EVM keyfile.
+	EthKeyfileEncrypt(context.Context, *EthKeyfileEncryptRequest) (*EthKeyfileEncryptResponse, error)
 	BlsSignature(context.Context, *BlsSignatureRequest) (*BlsSignatureResponse, error)
+	BlsBatchVerify(context.Context, *BlsBatchVerifyRequest) (*BlsBatchVerifyResponse, error)
+	BlsAggregatePublicKeys(context.Context, *BlsAggregatePublicKeysRequest) (*BlsAggregatePublicKeysResponse, error)
+	BlsAggregateSignatures(context.Context, *BlsAggregateSignaturesRequest) (*BlsAggregateSignaturesResponse, error)
+	// Verifies an aggregate signature of N signers of the same message.
+	BlsAggregateVerify(context.Context, *BlsAggregateVerifyRequest) (*BlsAggregateVerifyResponse, error)
+	// Checks the compressed and uncompressed encodings of a public key.
+	BlsPublicKeyEncoding(context.Context, *BlsPublicKeyEncodingRequest) (*BlsPublicKeyEncodingResponse, error)
+	// Serializes a BIP-32 path as the Avalanche Ledger app reads it.
+	// ref. "ledger.Ledger.Address"
+	LedgerPath(context.Context, *LedgerPathRequest) (*LedgerPathResponse, error)
+	// Checks what a Ledger signer sends the Avalanche Ledger app to sign an
+	// unsigned tx: the root path, the signing path of each address, and
+	// whether the tx is too large to be signed but by its hash.
+	// ref. "ledger.Ledger.Sign", "ledger.Ledger.SignHash"
+	LedgerSign(context.Context, *LedgerSignRequest) (*LedgerSignResponse, error)
+	// Generates the secp256k1 key of a seed, with all its representations, so
+	// that test suites can share fixtures without committing private keys.
+	Secp256K1KeyFromSeed(context.Context, *Secp256K1KeyFromSeedRequest) (*Secp256K1KeyFromSeedResponse, error)
+	// Generates the BLS signer key of a seed, with its public key and proof
+	// of possession.
+	BlsKeyFromSeed(context.Context, *BlsKeyFromSeedRequest) (*BlsKeyFromSeedResponse, error)
+	// Generates the TLS staking key and self-signed certificate of a seed,
+	// with the node ID of the certificate.
+	StakingKeyFromSeed(context.Context, *StakingKeyFromSeedRequest) (*StakingKeyFromSeedResponse, error)
 	mustEmbedUnimplementedKeyServiceServer()
 }
 
@@ -97,15 +401,75 @@ type UnimplementedKeyServiceServer struct {
 func (UnimplementedKeyServiceServer) CertificateToNodeId(context.Context, *CertificateToNodeIdRequest) (*CertificateToNodeIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CertificateToNodeId not implemented")
 }
+func (UnimplementedKeyServiceServer) SignedIp(context.Context, *SignedIpRequest) (*SignedIpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignedIp not implemented")
+}
 func (UnimplementedKeyServiceServer) Secp256K1RecoverHashPublicKey(context.Context, *Secp256K1RecoverHashPublicKeyRequest) (*Secp256K1RecoverHashPublicKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Secp256K1RecoverHashPublicKey not implemented")
 }
 func (UnimplementedKeyServiceServer) Secp256K1Info(context.Context, *Secp256K1InfoRequest) (*Secp256K1InfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Secp256K1Info not implemented")
 }
+func (UnimplementedKeyServiceServer) Secp256K1Sign(context.Context, *Secp256K1SignRequest) (*Secp256K1SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Secp256K1Sign not implemented")
+}
+func (UnimplementedKeyServiceServer) Secp256K1Verify(context.Context, *Secp256K1VerifyRequest) (*Secp256K1VerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Secp256K1Verify not implemented")
+}
+func (UnimplementedKeyServiceServer) Secp256K1PublicKey(context.Context, *Secp256K1PublicKeyRequest) (*Secp256K1PublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Secp256K1PublicKey not implemented")
+}
+func (UnimplementedKeyServiceServer) Secp256K1DeriveKeys(context.Context, *Secp256K1DeriveKeysRequest) (*Secp256K1DeriveKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Secp256K1DeriveKeys not implemented")
+}
+func (UnimplementedKeyServiceServer) Secp256K1KmsSignature(context.Context, *Secp256K1KmsSignatureRequest) (*Secp256K1KmsSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Secp256K1KmsSignature not implemented")
+}
+func (UnimplementedKeyServiceServer) KeystoreImportUser(context.Context, *KeystoreImportUserRequest) (*KeystoreImportUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeystoreImportUser not implemented")
+}
+func (UnimplementedKeyServiceServer) KeystoreExportUser(context.Context, *KeystoreExportUserRequest) (*KeystoreExportUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeystoreExportUser not implemented")
+}
+func (UnimplementedKeyServiceServer) EthKeyfileDecrypt(context.Context, *EthKeyfileDecryptRequest) (*EthKeyfileDecryptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthKeyfileDecrypt not implemented")
+}
+func (UnimplementedKeyServiceServer) EthKeyfileEncrypt(context.Context, *EthKeyfileEncryptRequest) (*EthKeyfileEncryptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthKeyfileEncrypt not implemented")
+}
 func (UnimplementedKeyServiceServer) BlsSignature(context.Context, *BlsSignatureRequest) (*BlsSignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlsSignature not implemented")
 }
+func (UnimplementedKeyServiceServer) BlsBatchVerify(context.Context, *BlsBatchVerifyRequest) (*BlsBatchVerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsBatchVerify not implemented")
+}
+func (UnimplementedKeyServiceServer) BlsAggregatePublicKeys(context.Context, *BlsAggregatePublicKeysRequest) (*BlsAggregatePublicKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsAggregatePublicKeys not implemented")
+}
+func (UnimplementedKeyServiceServer) BlsAggregateSignatures(context.Context, *BlsAggregateSignaturesRequest) (*BlsAggregateSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsAggregateSignatures not implemented")
+}
+func (UnimplementedKeyServiceServer) BlsAggregateVerify(context.Context, *BlsAggregateVerifyRequest) (*BlsAggregateVerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsAggregateVerify not implemented")
+}
+func (UnimplementedKeyServiceServer) BlsPublicKeyEncoding(context.Context, *BlsPublicKeyEncodingRequest) (*BlsPublicKeyEncodingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsPublicKeyEncoding not implemented")
+}
+func (UnimplementedKeyServiceServer) LedgerPath(context.Context, *LedgerPathRequest) (*LedgerPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LedgerPath not implemented")
+}
+func (UnimplementedKeyServiceServer) LedgerSign(context.Context, *LedgerSignRequest) (*LedgerSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LedgerSign not implemented")
+}
+func (UnimplementedKeyServiceServer) Secp256K1KeyFromSeed(context.Context, *Secp256K1KeyFromSeedRequest) (*Secp256K1KeyFromSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Secp256K1KeyFromSeed not implemented")
+}
+func (UnimplementedKeyServiceServer) BlsKeyFromSeed(context.Context, *BlsKeyFromSeedRequest) (*BlsKeyFromSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsKeyFromSeed not implemented")
+}
+func (UnimplementedKeyServiceServer) StakingKeyFromSeed(context.Context, *StakingKeyFromSeedRequest) (*StakingKeyFromSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingKeyFromSeed not implemented")
+}
 func (UnimplementedKeyServiceServer) mustEmbedUnimplementedKeyServiceServer() {}
 
 // UnsafeKeyServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -137,6 +501,24 @@ func _KeyService_CertificateToNodeId_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KeyService_SignedIp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignedIpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).SignedIp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_SignedIp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).SignedIp(ctx, req.(*SignedIpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KeyService_Secp256K1RecoverHashPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Secp256K1RecoverHashPublicKeyRequest)
 	if err := dec(in); err != nil {
@@ -173,6 +555,168 @@ func _KeyService_Secp256K1Info_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KeyService_Secp256K1Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Secp256K1SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Secp256K1Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_Secp256K1Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Secp256K1Sign(ctx, req.(*Secp256K1SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Secp256K1Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Secp256K1VerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Secp256K1Verify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_Secp256K1Verify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Secp256K1Verify(ctx, req.(*Secp256K1VerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Secp256K1PublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Secp256K1PublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Secp256K1PublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_Secp256K1PublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Secp256K1PublicKey(ctx, req.(*Secp256K1PublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Secp256K1DeriveKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Secp256K1DeriveKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Secp256K1DeriveKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_Secp256K1DeriveKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Secp256K1DeriveKeys(ctx, req.(*Secp256K1DeriveKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Secp256K1KmsSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Secp256K1KmsSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Secp256K1KmsSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_Secp256K1KmsSignature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Secp256K1KmsSignature(ctx, req.(*Secp256K1KmsSignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_KeystoreImportUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeystoreImportUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).KeystoreImportUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_KeystoreImportUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).KeystoreImportUser(ctx, req.(*KeystoreImportUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_KeystoreExportUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeystoreExportUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).KeystoreExportUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_KeystoreExportUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).KeystoreExportUser(ctx, req.(*KeystoreExportUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_EthKeyfileDecrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthKeyfileDecryptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).EthKeyfileDecrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_EthKeyfileDecrypt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).EthKeyfileDecrypt(ctx, req.(*EthKeyfileDecryptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_EthKeyfileEncrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthKeyfileEncryptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).EthKeyfileEncrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_EthKeyfileEncrypt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).EthKeyfileEncrypt(ctx, req.(*EthKeyfileEncryptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KeyService_BlsSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlsSignatureRequest)
 	if err := dec(in); err != nil {
@@ -191,6 +735,186 @@ func _KeyService_BlsSignature_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KeyService_BlsBatchVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlsBatchVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).BlsBatchVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_BlsBatchVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).BlsBatchVerify(ctx, req.(*BlsBatchVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_BlsAggregatePublicKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlsAggregatePublicKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).BlsAggregatePublicKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_BlsAggregatePublicKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).BlsAggregatePublicKeys(ctx, req.(*BlsAggregatePublicKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_BlsAggregateSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlsAggregateSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).BlsAggregateSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_BlsAggregateSignatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).BlsAggregateSignatures(ctx, req.(*BlsAggregateSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_BlsAggregateVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlsAggregateVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).BlsAggregateVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_BlsAggregateVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).BlsAggregateVerify(ctx, req.(*BlsAggregateVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_BlsPublicKeyEncoding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlsPublicKeyEncodingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).BlsPublicKeyEncoding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_BlsPublicKeyEncoding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).BlsPublicKeyEncoding(ctx, req.(*BlsPublicKeyEncodingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_LedgerPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).LedgerPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_LedgerPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).LedgerPath(ctx, req.(*LedgerPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_LedgerSign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).LedgerSign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_LedgerSign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).LedgerSign(ctx, req.(*LedgerSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Secp256K1KeyFromSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Secp256K1KeyFromSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Secp256K1KeyFromSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_Secp256K1KeyFromSeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Secp256K1KeyFromSeed(ctx, req.(*Secp256K1KeyFromSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_BlsKeyFromSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlsKeyFromSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).BlsKeyFromSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_BlsKeyFromSeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).BlsKeyFromSeed(ctx, req.(*BlsKeyFromSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_StakingKeyFromSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StakingKeyFromSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).StakingKeyFromSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KeyService_StakingKeyFromSeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).StakingKeyFromSeed(ctx, req.(*StakingKeyFromSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KeyService_ServiceDesc is the grpc.ServiceDesc for KeyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +926,10 @@ var KeyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CertificateToNodeId",
 			Handler:    _KeyService_CertificateToNodeId_Handler,
 		},
+		{
+			MethodName: "SignedIp",
+			Handler:    _KeyService_SignedIp_Handler,
+		},
 		{
 			MethodName: "Secp256k1RecoverHashPublicKey",
 			Handler:    _KeyService_Secp256K1RecoverHashPublicKey_Handler,
@@ -210,10 +938,86 @@ var KeyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Secp256k1Info",
 			Handler:    _KeyService_Secp256K1Info_Handler,
 		},
+		{
+			MethodName: "Secp256k1Sign",
+			Handler:    _KeyService_Secp256K1Sign_Handler,
+		},
+		{
+			MethodName: "Secp256k1Verify",
+			Handler:    _KeyService_Secp256K1Verify_Handler,
+		},
+		{
+			MethodName: "Secp256k1PublicKey",
+			Handler:    _KeyService_Secp256K1PublicKey_Handler,
+		},
+		{
+			MethodName: "Secp256k1DeriveKeys",
+			Handler:    _KeyService_Secp256K1DeriveKeys_Handler,
+		},
+		{
+			MethodName: "Secp256k1KmsSignature",
+			Handler:    _KeyService_Secp256K1KmsSignature_Handler,
+		},
+		{
+			MethodName: "KeystoreImportUser",
+			Handler:    _KeyService_KeystoreImportUser_Handler,
+		},
+		{
+			MethodName: "KeystoreExportUser",
+			Handler:    _KeyService_KeystoreExportUser_Handler,
+		},
+		{
+			MethodName: "EthKeyfileDecrypt",
+			Handler:    _KeyService_EthKeyfileDecrypt_Handler,
+		},
+		{
+			MethodName: "EthKeyfileEncrypt",
+			Handler:    _KeyService_EthKeyfileEncrypt_Handler,
+		},
 		{
 			MethodName: "BlsSignature",
 			Handler:    _KeyService_BlsSignature_Handler,
 		},
+		{
+			MethodName: "BlsBatchVerify",
+			Handler:    _KeyService_BlsBatchVerify_Handler,
+		},
+		{
+			MethodName: "BlsAggregatePublicKeys",
+			Handler:    _KeyService_BlsAggregatePublicKeys_Handler,
+		},
+		{
+			MethodName: "BlsAggregateSignatures",
+			Handler:    _KeyService_BlsAggregateSignatures_Handler,
+		},
+		{
+			MethodName: "BlsAggregateVerify",
+			Handler:    _KeyService_BlsAggregateVerify_Handler,
+		},
+		{
+			MethodName: "BlsPublicKeyEncoding",
+			Handler:    _KeyService_BlsPublicKeyEncoding_Handler,
+		},
+		{
+			MethodName: "LedgerPath",
+			Handler:    _KeyService_LedgerPath_Handler,
+		},
+		{
+			MethodName: "LedgerSign",
+			Handler:    _KeyService_LedgerSign_Handler,
+		},
+		{
+			MethodName: "Secp256k1KeyFromSeed",
+			Handler:    _KeyService_Secp256K1KeyFromSeed_Handler,
+		},
+		{
+			MethodName: "BlsKeyFromSeed",
+			Handler:    _KeyService_BlsKeyFromSeed_Handler,
+		},
+		{
+			MethodName: "StakingKeyFromSeed",
+			Handler:    _KeyService_StakingKeyFromSeed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpcpb/key.proto",
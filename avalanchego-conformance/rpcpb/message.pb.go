@@ -9,6 +9,7 @@ package rpcpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +21,231 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// CompressionType mirrors avalanchego "compression.Type", and replaces the
+// gzip_compressed request flag.
+type CompressionType int32
+
+const (
+	CompressionType_COMPRESSION_TYPE_UNSPECIFIED CompressionType = 0
+	CompressionType_COMPRESSION_TYPE_NONE        CompressionType = 1
+	CompressionType_COMPRESSION_TYPE_GZIP        CompressionType = 2
+	CompressionType_COMPRESSION_TYPE_ZSTD        CompressionType = 3
+)
+
+// Enum value maps for CompressionType.
+var (
+	CompressionType_name = map[int32]string{
+		0: "COMPRESSION_TYPE_UNSPECIFIED",
+		1: "COMPRESSION_TYPE_NONE",
+		2: "COMPRESSION_TYPE_GZIP",
+		3: "COMPRESSION_TYPE_ZSTD",
+	}
+	CompressionType_value = map[string]int32{
+		"COMPRESSION_TYPE_UNSPECIFIED": 0,
+		"COMPRESSION_TYPE_NONE":        1,
+		"COMPRESSION_TYPE_GZIP":        2,
+		"COMPRESSION_TYPE_ZSTD":        3,
+	}
+)
+
+func (x CompressionType) Enum() *CompressionType {
+	p := new(CompressionType)
+	*p = x
+	return p
+}
+
+func (x CompressionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompressionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_message_proto_enumTypes[0].Descriptor()
+}
+
+func (CompressionType) Type() protoreflect.EnumType {
+	return &file_rpcpb_message_proto_enumTypes[0]
+}
+
+func (x CompressionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompressionType.Descriptor instead.
+func (CompressionType) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{0}
+}
+
+// CompressionFlag is the request field the compression type was read from.
+type CompressionFlag int32
+
+const (
+	CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED  CompressionFlag = 0
+	CompressionFlag_COMPRESSION_FLAG_COMPRESSION_TYPE CompressionFlag = 1
+)
+
+// Enum value maps for CompressionFlag.
+var (
+	CompressionFlag_name = map[int32]string{
+		0: "COMPRESSION_FLAG_GZIP_COMPRESSED",
+		1: "COMPRESSION_FLAG_COMPRESSION_TYPE",
+	}
+	CompressionFlag_value = map[string]int32{
+		"COMPRESSION_FLAG_GZIP_COMPRESSED":  0,
+		"COMPRESSION_FLAG_COMPRESSION_TYPE": 1,
+	}
+)
+
+func (x CompressionFlag) Enum() *CompressionFlag {
+	p := new(CompressionFlag)
+	*p = x
+	return p
+}
+
+func (x CompressionFlag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompressionFlag) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_message_proto_enumTypes[1].Descriptor()
+}
+
+func (CompressionFlag) Type() protoreflect.EnumType {
+	return &file_rpcpb_message_proto_enumTypes[1]
+}
+
+func (x CompressionFlag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompressionFlag.Descriptor instead.
+func (CompressionFlag) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{1}
+}
+
+// EngineType mirrors avalanchego "p2p.EngineType". Unspecified requests
+// keep the snowman engine type, so ENGINE_TYPE_OMITTED selects the p2p
+// unspecified engine type, which is omitted from the serialized message.
+type EngineType int32
+
+const (
+	EngineType_ENGINE_TYPE_UNSPECIFIED EngineType = 0
+	EngineType_ENGINE_TYPE_OMITTED     EngineType = 1
+	EngineType_ENGINE_TYPE_AVALANCHE   EngineType = 2
+	EngineType_ENGINE_TYPE_SNOWMAN     EngineType = 3
+)
+
+// Enum value maps for EngineType.
+var (
+	EngineType_name = map[int32]string{
+		0: "ENGINE_TYPE_UNSPECIFIED",
+		1: "ENGINE_TYPE_OMITTED",
+		2: "ENGINE_TYPE_AVALANCHE",
+		3: "ENGINE_TYPE_SNOWMAN",
+	}
+	EngineType_value = map[string]int32{
+		"ENGINE_TYPE_UNSPECIFIED": 0,
+		"ENGINE_TYPE_OMITTED":     1,
+		"ENGINE_TYPE_AVALANCHE":   2,
+		"ENGINE_TYPE_SNOWMAN":     3,
+	}
+)
+
+func (x EngineType) Enum() *EngineType {
+	p := new(EngineType)
+	*p = x
+	return p
+}
+
+func (x EngineType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EngineType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_message_proto_enumTypes[2].Descriptor()
+}
+
+func (EngineType) Type() protoreflect.EnumType {
+	return &file_rpcpb_message_proto_enumTypes[2]
+}
+
+func (x EngineType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EngineType.Descriptor instead.
+func (EngineType) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{2}
+}
+
+// CompressionStats reports the size breakdown of a compressed message.
+// Compressed sizes are of the serialized message without its 4-byte
+// length prefix, which is what avalanchego checks against the maximum
+// message size.
+type CompressionStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UncompressedSize uint64 `protobuf:"varint,1,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressed_size,omitempty"`
+	// Size of the message compressed by avalanchego.
+	ExpectedCompressedSize uint64 `protobuf:"varint,2,opt,name=expected_compressed_size,json=expectedCompressedSize,proto3" json:"expected_compressed_size,omitempty"`
+	// Size of the received message (e.g., compressed by avalanche-rs).
+	ReceivedCompressedSize uint64 `protobuf:"varint,3,opt,name=received_compressed_size,json=receivedCompressedSize,proto3" json:"received_compressed_size,omitempty"`
+}
+
+func (x *CompressionStats) Reset() {
+	*x = CompressionStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompressionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompressionStats) ProtoMessage() {}
+
+func (x *CompressionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompressionStats.ProtoReflect.Descriptor instead.
+func (*CompressionStats) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CompressionStats) GetUncompressedSize() uint64 {
+	if x != nil {
+		return x.UncompressedSize
+	}
+	return 0
+}
+
+func (x *CompressionStats) GetExpectedCompressedSize() uint64 {
+	if x != nil {
+		return x.ExpectedCompressedSize
+	}
+	return 0
+}
+
+func (x *CompressionStats) GetReceivedCompressedSize() uint64 {
+	if x != nil {
+		return x.ReceivedCompressedSize
+	}
+	return 0
+}
+
 type AcceptedFrontierRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -34,7 +260,7 @@ type AcceptedFrontierRequest struct {
 func (x *AcceptedFrontierRequest) Reset() {
 	*x = AcceptedFrontierRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[0]
+		mi := &file_rpcpb_message_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -47,7 +273,7 @@ func (x *AcceptedFrontierRequest) String() string {
 func (*AcceptedFrontierRequest) ProtoMessage() {}
 
 func (x *AcceptedFrontierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[0]
+	mi := &file_rpcpb_message_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,7 +286,7 @@ func (x *AcceptedFrontierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptedFrontierRequest.ProtoReflect.Descriptor instead.
 func (*AcceptedFrontierRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{0}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *AcceptedFrontierRequest) GetChainId() []byte {
@@ -99,12 +325,16 @@ type AcceptedFrontierResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *AcceptedFrontierResponse) Reset() {
 	*x = AcceptedFrontierResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[1]
+		mi := &file_rpcpb_message_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -117,7 +347,7 @@ func (x *AcceptedFrontierResponse) String() string {
 func (*AcceptedFrontierResponse) ProtoMessage() {}
 
 func (x *AcceptedFrontierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[1]
+	mi := &file_rpcpb_message_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -130,7 +360,7 @@ func (x *AcceptedFrontierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptedFrontierResponse.ProtoReflect.Descriptor instead.
 func (*AcceptedFrontierResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{1}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *AcceptedFrontierResponse) GetExpectedSerializedMsg() []byte {
@@ -154,6 +384,20 @@ func (x *AcceptedFrontierResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *AcceptedFrontierResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AcceptedFrontierResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type AcceptedStateSummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -163,13 +407,15 @@ type AcceptedStateSummaryRequest struct {
 	RequestId      uint32   `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	SummaryIds     [][]byte `protobuf:"bytes,3,rep,name=summary_ids,json=summaryIds,proto3" json:"summary_ids,omitempty"`
 	GzipCompressed bool     `protobuf:"varint,4,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
-	SerializedMsg  []byte   `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	// Takes precedence over gzip_compressed when specified.
+	CompressionType CompressionType `protobuf:"varint,6,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+	SerializedMsg   []byte          `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *AcceptedStateSummaryRequest) Reset() {
 	*x = AcceptedStateSummaryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[2]
+		mi := &file_rpcpb_message_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -182,7 +428,7 @@ func (x *AcceptedStateSummaryRequest) String() string {
 func (*AcceptedStateSummaryRequest) ProtoMessage() {}
 
 func (x *AcceptedStateSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[2]
+	mi := &file_rpcpb_message_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,7 +441,7 @@ func (x *AcceptedStateSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptedStateSummaryRequest.ProtoReflect.Descriptor instead.
 func (*AcceptedStateSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{2}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AcceptedStateSummaryRequest) GetChainId() []byte {
@@ -226,6 +472,13 @@ func (x *AcceptedStateSummaryRequest) GetGzipCompressed() bool {
 	return false
 }
 
+func (x *AcceptedStateSummaryRequest) GetCompressionType() CompressionType {
+	if x != nil {
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
 func (x *AcceptedStateSummaryRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -241,12 +494,20 @@ type AcceptedStateSummaryResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the request was compressed.
+	CompressionStats *CompressionStats `protobuf:"bytes,4,opt,name=compression_stats,json=compressionStats,proto3" json:"compression_stats,omitempty"`
+	// Compression flag of the request that was honored.
+	CompressionFlag CompressionFlag `protobuf:"varint,6,opt,name=compression_flag,json=compressionFlag,proto3,enum=rpcpb.CompressionFlag" json:"compression_flag,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *AcceptedStateSummaryResponse) Reset() {
 	*x = AcceptedStateSummaryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[3]
+		mi := &file_rpcpb_message_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -259,7 +520,7 @@ func (x *AcceptedStateSummaryResponse) String() string {
 func (*AcceptedStateSummaryResponse) ProtoMessage() {}
 
 func (x *AcceptedStateSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[3]
+	mi := &file_rpcpb_message_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,7 +533,7 @@ func (x *AcceptedStateSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptedStateSummaryResponse.ProtoReflect.Descriptor instead.
 func (*AcceptedStateSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{3}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AcceptedStateSummaryResponse) GetExpectedSerializedMsg() []byte {
@@ -296,6 +557,34 @@ func (x *AcceptedStateSummaryResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *AcceptedStateSummaryResponse) GetCompressionStats() *CompressionStats {
+	if x != nil {
+		return x.CompressionStats
+	}
+	return nil
+}
+
+func (x *AcceptedStateSummaryResponse) GetCompressionFlag() CompressionFlag {
+	if x != nil {
+		return x.CompressionFlag
+	}
+	return CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED
+}
+
+func (x *AcceptedStateSummaryResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AcceptedStateSummaryResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type AcceptedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -310,7 +599,7 @@ type AcceptedRequest struct {
 func (x *AcceptedRequest) Reset() {
 	*x = AcceptedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[4]
+		mi := &file_rpcpb_message_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -323,7 +612,7 @@ func (x *AcceptedRequest) String() string {
 func (*AcceptedRequest) ProtoMessage() {}
 
 func (x *AcceptedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[4]
+	mi := &file_rpcpb_message_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +625,7 @@ func (x *AcceptedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptedRequest.ProtoReflect.Descriptor instead.
 func (*AcceptedRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{4}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AcceptedRequest) GetChainId() []byte {
@@ -375,12 +664,16 @@ type AcceptedResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *AcceptedResponse) Reset() {
 	*x = AcceptedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[5]
+		mi := &file_rpcpb_message_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -393,7 +686,7 @@ func (x *AcceptedResponse) String() string {
 func (*AcceptedResponse) ProtoMessage() {}
 
 func (x *AcceptedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[5]
+	mi := &file_rpcpb_message_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,7 +699,7 @@ func (x *AcceptedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptedResponse.ProtoReflect.Descriptor instead.
 func (*AcceptedResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{5}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AcceptedResponse) GetExpectedSerializedMsg() []byte {
@@ -430,6 +723,20 @@ func (x *AcceptedResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *AcceptedResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AcceptedResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type AncestorsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -439,13 +746,15 @@ type AncestorsRequest struct {
 	RequestId      uint32   `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	Containers     [][]byte `protobuf:"bytes,3,rep,name=containers,proto3" json:"containers,omitempty"`
 	GzipCompressed bool     `protobuf:"varint,4,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
-	SerializedMsg  []byte   `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	// Takes precedence over gzip_compressed when specified.
+	CompressionType CompressionType `protobuf:"varint,6,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+	SerializedMsg   []byte          `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *AncestorsRequest) Reset() {
 	*x = AncestorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[6]
+		mi := &file_rpcpb_message_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -458,7 +767,7 @@ func (x *AncestorsRequest) String() string {
 func (*AncestorsRequest) ProtoMessage() {}
 
 func (x *AncestorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[6]
+	mi := &file_rpcpb_message_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +780,7 @@ func (x *AncestorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AncestorsRequest.ProtoReflect.Descriptor instead.
 func (*AncestorsRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{6}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AncestorsRequest) GetChainId() []byte {
@@ -502,6 +811,13 @@ func (x *AncestorsRequest) GetGzipCompressed() bool {
 	return false
 }
 
+func (x *AncestorsRequest) GetCompressionType() CompressionType {
+	if x != nil {
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
 func (x *AncestorsRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -517,12 +833,20 @@ type AncestorsResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the request was compressed.
+	CompressionStats *CompressionStats `protobuf:"bytes,4,opt,name=compression_stats,json=compressionStats,proto3" json:"compression_stats,omitempty"`
+	// Compression flag of the request that was honored.
+	CompressionFlag CompressionFlag `protobuf:"varint,6,opt,name=compression_flag,json=compressionFlag,proto3,enum=rpcpb.CompressionFlag" json:"compression_flag,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *AncestorsResponse) Reset() {
 	*x = AncestorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[7]
+		mi := &file_rpcpb_message_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -535,7 +859,7 @@ func (x *AncestorsResponse) String() string {
 func (*AncestorsResponse) ProtoMessage() {}
 
 func (x *AncestorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[7]
+	mi := &file_rpcpb_message_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +872,7 @@ func (x *AncestorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AncestorsResponse.ProtoReflect.Descriptor instead.
 func (*AncestorsResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{7}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AncestorsResponse) GetExpectedSerializedMsg() []byte {
@@ -572,34 +896,69 @@ func (x *AncestorsResponse) GetSuccess() bool {
 	return false
 }
 
-type AppGossipRequest struct {
+func (x *AncestorsResponse) GetCompressionStats() *CompressionStats {
+	if x != nil {
+		return x.CompressionStats
+	}
+	return nil
+}
+
+func (x *AncestorsResponse) GetCompressionFlag() CompressionFlag {
+	if x != nil {
+		return x.CompressionFlag
+	}
+	return CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED
+}
+
+func (x *AncestorsResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AncestorsResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+// AncestorsChunk is one piece of an AncestorsChunked upload.
+// Header fields (chain_id, request_id, gzip_compressed) are read from the
+// first chunk only. Containers are appended in order, and serialized_msg
+// pieces are concatenated in order.
+type AncestorsChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ChainId        []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	AppBytes       []byte `protobuf:"bytes,2,opt,name=app_bytes,json=appBytes,proto3" json:"app_bytes,omitempty"`
+	RequestId      uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	GzipCompressed bool   `protobuf:"varint,3,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
-	SerializedMsg  []byte `protobuf:"bytes,4,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	// Takes precedence over gzip_compressed when specified.
+	CompressionType CompressionType `protobuf:"varint,6,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+	Containers      [][]byte        `protobuf:"bytes,4,rep,name=containers,proto3" json:"containers,omitempty"`
+	SerializedMsg   []byte          `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
-func (x *AppGossipRequest) Reset() {
-	*x = AppGossipRequest{}
+func (x *AncestorsChunk) Reset() {
+	*x = AncestorsChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[8]
+		mi := &file_rpcpb_message_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AppGossipRequest) String() string {
+func (x *AncestorsChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppGossipRequest) ProtoMessage() {}
+func (*AncestorsChunk) ProtoMessage() {}
 
-func (x *AppGossipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[8]
+func (x *AncestorsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,53 +969,155 @@ func (x *AppGossipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppGossipRequest.ProtoReflect.Descriptor instead.
-func (*AppGossipRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AncestorsChunk.ProtoReflect.Descriptor instead.
+func (*AncestorsChunk) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AppGossipRequest) GetChainId() []byte {
+func (x *AncestorsChunk) GetChainId() []byte {
 	if x != nil {
 		return x.ChainId
 	}
 	return nil
 }
 
-func (x *AppGossipRequest) GetAppBytes() []byte {
+func (x *AncestorsChunk) GetRequestId() uint32 {
 	if x != nil {
-		return x.AppBytes
+		return x.RequestId
 	}
-	return nil
+	return 0
 }
 
-func (x *AppGossipRequest) GetGzipCompressed() bool {
+func (x *AncestorsChunk) GetGzipCompressed() bool {
 	if x != nil {
 		return x.GzipCompressed
 	}
 	return false
 }
 
-func (x *AppGossipRequest) GetSerializedMsg() []byte {
+func (x *AncestorsChunk) GetCompressionType() CompressionType {
 	if x != nil {
-		return x.SerializedMsg
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
+func (x *AncestorsChunk) GetContainers() [][]byte {
+	if x != nil {
+		return x.Containers
 	}
 	return nil
 }
 
-type AppGossipResponse struct {
-	state         protoimpl.MessageState
+func (x *AncestorsChunk) GetSerializedMsg() []byte {
+	if x != nil {
+		return x.SerializedMsg
+	}
+	return nil
+}
+
+type AppGossipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId        []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AppBytes       []byte `protobuf:"bytes,2,opt,name=app_bytes,json=appBytes,proto3" json:"app_bytes,omitempty"`
+	GzipCompressed bool   `protobuf:"varint,3,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
+	// Takes precedence over gzip_compressed when specified.
+	CompressionType CompressionType `protobuf:"varint,5,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+	SerializedMsg   []byte          `protobuf:"bytes,4,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+}
+
+func (x *AppGossipRequest) Reset() {
+	*x = AppGossipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppGossipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppGossipRequest) ProtoMessage() {}
+
+func (x *AppGossipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppGossipRequest.ProtoReflect.Descriptor instead.
+func (*AppGossipRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AppGossipRequest) GetChainId() []byte {
+	if x != nil {
+		return x.ChainId
+	}
+	return nil
+}
+
+func (x *AppGossipRequest) GetAppBytes() []byte {
+	if x != nil {
+		return x.AppBytes
+	}
+	return nil
+}
+
+func (x *AppGossipRequest) GetGzipCompressed() bool {
+	if x != nil {
+		return x.GzipCompressed
+	}
+	return false
+}
+
+func (x *AppGossipRequest) GetCompressionType() CompressionType {
+	if x != nil {
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
+func (x *AppGossipRequest) GetSerializedMsg() []byte {
+	if x != nil {
+		return x.SerializedMsg
+	}
+	return nil
+}
+
+type AppGossipResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the request was compressed.
+	CompressionStats *CompressionStats `protobuf:"bytes,4,opt,name=compression_stats,json=compressionStats,proto3" json:"compression_stats,omitempty"`
+	// Compression flag of the request that was honored.
+	CompressionFlag CompressionFlag `protobuf:"varint,6,opt,name=compression_flag,json=compressionFlag,proto3,enum=rpcpb.CompressionFlag" json:"compression_flag,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *AppGossipResponse) Reset() {
 	*x = AppGossipResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[9]
+		mi := &file_rpcpb_message_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -669,7 +1130,7 @@ func (x *AppGossipResponse) String() string {
 func (*AppGossipResponse) ProtoMessage() {}
 
 func (x *AppGossipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[9]
+	mi := &file_rpcpb_message_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +1143,7 @@ func (x *AppGossipResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppGossipResponse.ProtoReflect.Descriptor instead.
 func (*AppGossipResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{9}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AppGossipResponse) GetExpectedSerializedMsg() []byte {
@@ -706,23 +1167,57 @@ func (x *AppGossipResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *AppGossipResponse) GetCompressionStats() *CompressionStats {
+	if x != nil {
+		return x.CompressionStats
+	}
+	return nil
+}
+
+func (x *AppGossipResponse) GetCompressionFlag() CompressionFlag {
+	if x != nil {
+		return x.CompressionFlag
+	}
+	return CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED
+}
+
+func (x *AppGossipResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AppGossipResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type AppRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId        []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	RequestId      uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Deadline       uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	ChainId   []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RequestId uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Deadline  uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Maximum message timeout of the receiving peer in nanoseconds, which
+	// clamps the deadline (see expected_timeout). Zero means the server
+	// default ("--max-message-timeout").
+	MaxTimeout     uint64 `protobuf:"varint,8,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
 	AppBytes       []byte `protobuf:"bytes,4,opt,name=app_bytes,json=appBytes,proto3" json:"app_bytes,omitempty"`
 	GzipCompressed bool   `protobuf:"varint,5,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
-	SerializedMsg  []byte `protobuf:"bytes,6,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	// Takes precedence over gzip_compressed when specified.
+	CompressionType CompressionType `protobuf:"varint,7,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+	SerializedMsg   []byte          `protobuf:"bytes,6,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *AppRequestRequest) Reset() {
 	*x = AppRequestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[10]
+		mi := &file_rpcpb_message_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -735,7 +1230,7 @@ func (x *AppRequestRequest) String() string {
 func (*AppRequestRequest) ProtoMessage() {}
 
 func (x *AppRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[10]
+	mi := &file_rpcpb_message_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +1243,7 @@ func (x *AppRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppRequestRequest.ProtoReflect.Descriptor instead.
 func (*AppRequestRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{10}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AppRequestRequest) GetChainId() []byte {
@@ -772,6 +1267,13 @@ func (x *AppRequestRequest) GetDeadline() uint64 {
 	return 0
 }
 
+func (x *AppRequestRequest) GetMaxTimeout() uint64 {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return 0
+}
+
 func (x *AppRequestRequest) GetAppBytes() []byte {
 	if x != nil {
 		return x.AppBytes
@@ -786,6 +1288,13 @@ func (x *AppRequestRequest) GetGzipCompressed() bool {
 	return false
 }
 
+func (x *AppRequestRequest) GetCompressionType() CompressionType {
+	if x != nil {
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
 func (x *AppRequestRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -801,12 +1310,25 @@ type AppRequestResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the request was compressed.
+	CompressionStats *CompressionStats `protobuf:"bytes,4,opt,name=compression_stats,json=compressionStats,proto3" json:"compression_stats,omitempty"`
+	// Compression flag of the request that was honored.
+	CompressionFlag CompressionFlag `protobuf:"varint,6,opt,name=compression_flag,json=compressionFlag,proto3,enum=rpcpb.CompressionFlag" json:"compression_flag,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Timeout the receiving peer applies to the message in nanoseconds, the
+	// deadline clamped to max_timeout. Negative for deadlines above the max
+	// int64, which avalanchego handles as already expired.
+	// ref. "message.msgBuilder.parseInbound"
+	ExpectedTimeout int64 `protobuf:"varint,8,opt,name=expected_timeout,json=expectedTimeout,proto3" json:"expected_timeout,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *AppRequestResponse) Reset() {
 	*x = AppRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[11]
+		mi := &file_rpcpb_message_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -819,7 +1341,7 @@ func (x *AppRequestResponse) String() string {
 func (*AppRequestResponse) ProtoMessage() {}
 
 func (x *AppRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[11]
+	mi := &file_rpcpb_message_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,7 +1354,7 @@ func (x *AppRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppRequestResponse.ProtoReflect.Descriptor instead.
 func (*AppRequestResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{11}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AppRequestResponse) GetExpectedSerializedMsg() []byte {
@@ -856,6 +1378,41 @@ func (x *AppRequestResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *AppRequestResponse) GetCompressionStats() *CompressionStats {
+	if x != nil {
+		return x.CompressionStats
+	}
+	return nil
+}
+
+func (x *AppRequestResponse) GetCompressionFlag() CompressionFlag {
+	if x != nil {
+		return x.CompressionFlag
+	}
+	return CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED
+}
+
+func (x *AppRequestResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AppRequestResponse) GetExpectedTimeout() int64 {
+	if x != nil {
+		return x.ExpectedTimeout
+	}
+	return 0
+}
+
+func (x *AppRequestResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type AppResponseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -865,13 +1422,15 @@ type AppResponseRequest struct {
 	RequestId      uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	AppBytes       []byte `protobuf:"bytes,3,opt,name=app_bytes,json=appBytes,proto3" json:"app_bytes,omitempty"`
 	GzipCompressed bool   `protobuf:"varint,4,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
-	SerializedMsg  []byte `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	// Takes precedence over gzip_compressed when specified.
+	CompressionType CompressionType `protobuf:"varint,6,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+	SerializedMsg   []byte          `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *AppResponseRequest) Reset() {
 	*x = AppResponseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[12]
+		mi := &file_rpcpb_message_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -884,7 +1443,7 @@ func (x *AppResponseRequest) String() string {
 func (*AppResponseRequest) ProtoMessage() {}
 
 func (x *AppResponseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[12]
+	mi := &file_rpcpb_message_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1456,7 @@ func (x *AppResponseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppResponseRequest.ProtoReflect.Descriptor instead.
 func (*AppResponseRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{12}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AppResponseRequest) GetChainId() []byte {
@@ -928,6 +1487,13 @@ func (x *AppResponseRequest) GetGzipCompressed() bool {
 	return false
 }
 
+func (x *AppResponseRequest) GetCompressionType() CompressionType {
+	if x != nil {
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
 func (x *AppResponseRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -943,12 +1509,20 @@ type AppResponseResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the request was compressed.
+	CompressionStats *CompressionStats `protobuf:"bytes,4,opt,name=compression_stats,json=compressionStats,proto3" json:"compression_stats,omitempty"`
+	// Compression flag of the request that was honored.
+	CompressionFlag CompressionFlag `protobuf:"varint,6,opt,name=compression_flag,json=compressionFlag,proto3,enum=rpcpb.CompressionFlag" json:"compression_flag,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *AppResponseResponse) Reset() {
 	*x = AppResponseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[13]
+		mi := &file_rpcpb_message_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -961,7 +1535,7 @@ func (x *AppResponseResponse) String() string {
 func (*AppResponseResponse) ProtoMessage() {}
 
 func (x *AppResponseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[13]
+	mi := &file_rpcpb_message_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,7 +1548,7 @@ func (x *AppResponseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppResponseResponse.ProtoReflect.Descriptor instead.
 func (*AppResponseResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{13}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AppResponseResponse) GetExpectedSerializedMsg() []byte {
@@ -998,21 +1572,55 @@ func (x *AppResponseResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *AppResponseResponse) GetCompressionStats() *CompressionStats {
+	if x != nil {
+		return x.CompressionStats
+	}
+	return nil
+}
+
+func (x *AppResponseResponse) GetCompressionFlag() CompressionFlag {
+	if x != nil {
+		return x.CompressionFlag
+	}
+	return CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED
+}
+
+func (x *AppResponseResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AppResponseResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+// TODO: add the preferred_id, preferred_id_at_height and accepted_id fields
+// once avalanchego is upgraded past v1.10.1, which only has the repeated
+// frontiers.
 type ChitsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId       []byte   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	RequestId     uint32   `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	ContainerIds  [][]byte `protobuf:"bytes,3,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
-	SerializedMsg []byte   `protobuf:"bytes,4,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	ChainId   []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RequestId uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Preferred frontier ("preferred_container_ids").
+	ContainerIds [][]byte `protobuf:"bytes,3,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
+	// Accepted frontier ("accepted_container_ids").
+	AcceptedContainerIds [][]byte `protobuf:"bytes,5,rep,name=accepted_container_ids,json=acceptedContainerIds,proto3" json:"accepted_container_ids,omitempty"`
+	SerializedMsg        []byte   `protobuf:"bytes,4,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *ChitsRequest) Reset() {
 	*x = ChitsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[14]
+		mi := &file_rpcpb_message_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1025,7 +1633,7 @@ func (x *ChitsRequest) String() string {
 func (*ChitsRequest) ProtoMessage() {}
 
 func (x *ChitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[14]
+	mi := &file_rpcpb_message_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,7 +1646,7 @@ func (x *ChitsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChitsRequest.ProtoReflect.Descriptor instead.
 func (*ChitsRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{14}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ChitsRequest) GetChainId() []byte {
@@ -1062,6 +1670,13 @@ func (x *ChitsRequest) GetContainerIds() [][]byte {
 	return nil
 }
 
+func (x *ChitsRequest) GetAcceptedContainerIds() [][]byte {
+	if x != nil {
+		return x.AcceptedContainerIds
+	}
+	return nil
+}
+
 func (x *ChitsRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -1077,12 +1692,16 @@ type ChitsResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *ChitsResponse) Reset() {
 	*x = ChitsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[15]
+		mi := &file_rpcpb_message_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1095,7 +1714,7 @@ func (x *ChitsResponse) String() string {
 func (*ChitsResponse) ProtoMessage() {}
 
 func (x *ChitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[15]
+	mi := &file_rpcpb_message_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,7 +1727,7 @@ func (x *ChitsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChitsResponse.ProtoReflect.Descriptor instead.
 func (*ChitsResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{15}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ChitsResponse) GetExpectedSerializedMsg() []byte {
@@ -1132,21 +1751,41 @@ func (x *ChitsResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *ChitsResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *ChitsResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type GetAcceptedFrontierRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId       []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	RequestId     uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Deadline      uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	SerializedMsg []byte `protobuf:"bytes,4,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	ChainId   []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RequestId uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Deadline  uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Maximum message timeout of the receiving peer in nanoseconds, which
+	// clamps the deadline (see expected_timeout). Zero means the server
+	// default ("--max-message-timeout").
+	MaxTimeout uint64 `protobuf:"varint,5,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
+	// Snowman if unspecified.
+	EngineType    EngineType `protobuf:"varint,6,opt,name=engine_type,json=engineType,proto3,enum=rpcpb.EngineType" json:"engine_type,omitempty"`
+	SerializedMsg []byte     `protobuf:"bytes,4,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *GetAcceptedFrontierRequest) Reset() {
 	*x = GetAcceptedFrontierRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[16]
+		mi := &file_rpcpb_message_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1159,7 +1798,7 @@ func (x *GetAcceptedFrontierRequest) String() string {
 func (*GetAcceptedFrontierRequest) ProtoMessage() {}
 
 func (x *GetAcceptedFrontierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[16]
+	mi := &file_rpcpb_message_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,7 +1811,7 @@ func (x *GetAcceptedFrontierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAcceptedFrontierRequest.ProtoReflect.Descriptor instead.
 func (*GetAcceptedFrontierRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{16}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetAcceptedFrontierRequest) GetChainId() []byte {
@@ -1196,6 +1835,20 @@ func (x *GetAcceptedFrontierRequest) GetDeadline() uint64 {
 	return 0
 }
 
+func (x *GetAcceptedFrontierRequest) GetMaxTimeout() uint64 {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return 0
+}
+
+func (x *GetAcceptedFrontierRequest) GetEngineType() EngineType {
+	if x != nil {
+		return x.EngineType
+	}
+	return EngineType_ENGINE_TYPE_UNSPECIFIED
+}
+
 func (x *GetAcceptedFrontierRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -1211,12 +1864,21 @@ type GetAcceptedFrontierResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Timeout the receiving peer applies to the message in nanoseconds, the
+	// deadline clamped to max_timeout. Negative for deadlines above the max
+	// int64, which avalanchego handles as already expired.
+	// ref. "message.msgBuilder.parseInbound"
+	ExpectedTimeout int64 `protobuf:"varint,6,opt,name=expected_timeout,json=expectedTimeout,proto3" json:"expected_timeout,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *GetAcceptedFrontierResponse) Reset() {
 	*x = GetAcceptedFrontierResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[17]
+		mi := &file_rpcpb_message_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1229,7 +1891,7 @@ func (x *GetAcceptedFrontierResponse) String() string {
 func (*GetAcceptedFrontierResponse) ProtoMessage() {}
 
 func (x *GetAcceptedFrontierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[17]
+	mi := &file_rpcpb_message_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1242,7 +1904,7 @@ func (x *GetAcceptedFrontierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAcceptedFrontierResponse.ProtoReflect.Descriptor instead.
 func (*GetAcceptedFrontierResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{17}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetAcceptedFrontierResponse) GetExpectedSerializedMsg() []byte {
@@ -1266,23 +1928,50 @@ func (x *GetAcceptedFrontierResponse) GetSuccess() bool {
 	return false
 }
 
-type GetAcceptedStateSummaryRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ChainId        []byte   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	RequestId      uint32   `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Deadline       uint64   `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Heights        []uint64 `protobuf:"varint,4,rep,packed,name=heights,proto3" json:"heights,omitempty"`
-	GzipCompressed bool     `protobuf:"varint,5,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
-	SerializedMsg  []byte   `protobuf:"bytes,6,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+func (x *GetAcceptedFrontierResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
 }
 
-func (x *GetAcceptedStateSummaryRequest) Reset() {
-	*x = GetAcceptedStateSummaryRequest{}
+func (x *GetAcceptedFrontierResponse) GetExpectedTimeout() int64 {
+	if x != nil {
+		return x.ExpectedTimeout
+	}
+	return 0
+}
+
+func (x *GetAcceptedFrontierResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type GetAcceptedStateSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId   []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RequestId uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Deadline  uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Maximum message timeout of the receiving peer in nanoseconds, which
+	// clamps the deadline (see expected_timeout). Zero means the server
+	// default ("--max-message-timeout").
+	MaxTimeout     uint64   `protobuf:"varint,8,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
+	Heights        []uint64 `protobuf:"varint,4,rep,packed,name=heights,proto3" json:"heights,omitempty"`
+	GzipCompressed bool     `protobuf:"varint,5,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
+	// Takes precedence over gzip_compressed when specified.
+	CompressionType CompressionType `protobuf:"varint,7,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+	SerializedMsg   []byte          `protobuf:"bytes,6,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+}
+
+func (x *GetAcceptedStateSummaryRequest) Reset() {
+	*x = GetAcceptedStateSummaryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[18]
+		mi := &file_rpcpb_message_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1295,7 +1984,7 @@ func (x *GetAcceptedStateSummaryRequest) String() string {
 func (*GetAcceptedStateSummaryRequest) ProtoMessage() {}
 
 func (x *GetAcceptedStateSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[18]
+	mi := &file_rpcpb_message_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,7 +1997,7 @@ func (x *GetAcceptedStateSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAcceptedStateSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetAcceptedStateSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{18}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetAcceptedStateSummaryRequest) GetChainId() []byte {
@@ -1332,6 +2021,13 @@ func (x *GetAcceptedStateSummaryRequest) GetDeadline() uint64 {
 	return 0
 }
 
+func (x *GetAcceptedStateSummaryRequest) GetMaxTimeout() uint64 {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return 0
+}
+
 func (x *GetAcceptedStateSummaryRequest) GetHeights() []uint64 {
 	if x != nil {
 		return x.Heights
@@ -1346,6 +2042,13 @@ func (x *GetAcceptedStateSummaryRequest) GetGzipCompressed() bool {
 	return false
 }
 
+func (x *GetAcceptedStateSummaryRequest) GetCompressionType() CompressionType {
+	if x != nil {
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
 func (x *GetAcceptedStateSummaryRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -1361,12 +2064,25 @@ type GetAcceptedStateSummaryResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the request was compressed.
+	CompressionStats *CompressionStats `protobuf:"bytes,4,opt,name=compression_stats,json=compressionStats,proto3" json:"compression_stats,omitempty"`
+	// Compression flag of the request that was honored.
+	CompressionFlag CompressionFlag `protobuf:"varint,6,opt,name=compression_flag,json=compressionFlag,proto3,enum=rpcpb.CompressionFlag" json:"compression_flag,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Timeout the receiving peer applies to the message in nanoseconds, the
+	// deadline clamped to max_timeout. Negative for deadlines above the max
+	// int64, which avalanchego handles as already expired.
+	// ref. "message.msgBuilder.parseInbound"
+	ExpectedTimeout int64 `protobuf:"varint,8,opt,name=expected_timeout,json=expectedTimeout,proto3" json:"expected_timeout,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *GetAcceptedStateSummaryResponse) Reset() {
 	*x = GetAcceptedStateSummaryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[19]
+		mi := &file_rpcpb_message_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1379,7 +2095,7 @@ func (x *GetAcceptedStateSummaryResponse) String() string {
 func (*GetAcceptedStateSummaryResponse) ProtoMessage() {}
 
 func (x *GetAcceptedStateSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[19]
+	mi := &file_rpcpb_message_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,7 +2108,7 @@ func (x *GetAcceptedStateSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAcceptedStateSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetAcceptedStateSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{19}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetAcceptedStateSummaryResponse) GetExpectedSerializedMsg() []byte {
@@ -1416,22 +2132,63 @@ func (x *GetAcceptedStateSummaryResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *GetAcceptedStateSummaryResponse) GetCompressionStats() *CompressionStats {
+	if x != nil {
+		return x.CompressionStats
+	}
+	return nil
+}
+
+func (x *GetAcceptedStateSummaryResponse) GetCompressionFlag() CompressionFlag {
+	if x != nil {
+		return x.CompressionFlag
+	}
+	return CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED
+}
+
+func (x *GetAcceptedStateSummaryResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *GetAcceptedStateSummaryResponse) GetExpectedTimeout() int64 {
+	if x != nil {
+		return x.ExpectedTimeout
+	}
+	return 0
+}
+
+func (x *GetAcceptedStateSummaryResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type GetAcceptedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId       []byte   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	RequestId     uint32   `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Deadline      uint64   `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	ContainerIds  [][]byte `protobuf:"bytes,4,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
-	SerializedMsg []byte   `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	ChainId   []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RequestId uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Deadline  uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Maximum message timeout of the receiving peer in nanoseconds, which
+	// clamps the deadline (see expected_timeout). Zero means the server
+	// default ("--max-message-timeout").
+	MaxTimeout uint64 `protobuf:"varint,6,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
+	// Snowman if unspecified.
+	EngineType    EngineType `protobuf:"varint,7,opt,name=engine_type,json=engineType,proto3,enum=rpcpb.EngineType" json:"engine_type,omitempty"`
+	ContainerIds  [][]byte   `protobuf:"bytes,4,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
+	SerializedMsg []byte     `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *GetAcceptedRequest) Reset() {
 	*x = GetAcceptedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[20]
+		mi := &file_rpcpb_message_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1444,7 +2201,7 @@ func (x *GetAcceptedRequest) String() string {
 func (*GetAcceptedRequest) ProtoMessage() {}
 
 func (x *GetAcceptedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[20]
+	mi := &file_rpcpb_message_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +2214,7 @@ func (x *GetAcceptedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAcceptedRequest.ProtoReflect.Descriptor instead.
 func (*GetAcceptedRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{20}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetAcceptedRequest) GetChainId() []byte {
@@ -1481,6 +2238,20 @@ func (x *GetAcceptedRequest) GetDeadline() uint64 {
 	return 0
 }
 
+func (x *GetAcceptedRequest) GetMaxTimeout() uint64 {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return 0
+}
+
+func (x *GetAcceptedRequest) GetEngineType() EngineType {
+	if x != nil {
+		return x.EngineType
+	}
+	return EngineType_ENGINE_TYPE_UNSPECIFIED
+}
+
 func (x *GetAcceptedRequest) GetContainerIds() [][]byte {
 	if x != nil {
 		return x.ContainerIds
@@ -1503,12 +2274,21 @@ type GetAcceptedResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Timeout the receiving peer applies to the message in nanoseconds, the
+	// deadline clamped to max_timeout. Negative for deadlines above the max
+	// int64, which avalanchego handles as already expired.
+	// ref. "message.msgBuilder.parseInbound"
+	ExpectedTimeout int64 `protobuf:"varint,6,opt,name=expected_timeout,json=expectedTimeout,proto3" json:"expected_timeout,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *GetAcceptedResponse) Reset() {
 	*x = GetAcceptedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[21]
+		mi := &file_rpcpb_message_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1521,7 +2301,7 @@ func (x *GetAcceptedResponse) String() string {
 func (*GetAcceptedResponse) ProtoMessage() {}
 
 func (x *GetAcceptedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[21]
+	mi := &file_rpcpb_message_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,7 +2314,7 @@ func (x *GetAcceptedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAcceptedResponse.ProtoReflect.Descriptor instead.
 func (*GetAcceptedResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{21}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetAcceptedResponse) GetExpectedSerializedMsg() []byte {
@@ -1558,22 +2338,49 @@ func (x *GetAcceptedResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *GetAcceptedResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *GetAcceptedResponse) GetExpectedTimeout() int64 {
+	if x != nil {
+		return x.ExpectedTimeout
+	}
+	return 0
+}
+
+func (x *GetAcceptedResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type GetAncestorsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId       []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	RequestId     uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Deadline      uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	ContainerId   []byte `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	SerializedMsg []byte `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	ChainId   []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RequestId uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Deadline  uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Maximum message timeout of the receiving peer in nanoseconds, which
+	// clamps the deadline (see expected_timeout). Zero means the server
+	// default ("--max-message-timeout").
+	MaxTimeout uint64 `protobuf:"varint,6,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
+	// Snowman if unspecified.
+	EngineType    EngineType `protobuf:"varint,7,opt,name=engine_type,json=engineType,proto3,enum=rpcpb.EngineType" json:"engine_type,omitempty"`
+	ContainerId   []byte     `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	SerializedMsg []byte     `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *GetAncestorsRequest) Reset() {
 	*x = GetAncestorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[22]
+		mi := &file_rpcpb_message_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1586,7 +2393,7 @@ func (x *GetAncestorsRequest) String() string {
 func (*GetAncestorsRequest) ProtoMessage() {}
 
 func (x *GetAncestorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[22]
+	mi := &file_rpcpb_message_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1599,7 +2406,7 @@ func (x *GetAncestorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAncestorsRequest.ProtoReflect.Descriptor instead.
 func (*GetAncestorsRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{22}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetAncestorsRequest) GetChainId() []byte {
@@ -1623,6 +2430,20 @@ func (x *GetAncestorsRequest) GetDeadline() uint64 {
 	return 0
 }
 
+func (x *GetAncestorsRequest) GetMaxTimeout() uint64 {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return 0
+}
+
+func (x *GetAncestorsRequest) GetEngineType() EngineType {
+	if x != nil {
+		return x.EngineType
+	}
+	return EngineType_ENGINE_TYPE_UNSPECIFIED
+}
+
 func (x *GetAncestorsRequest) GetContainerId() []byte {
 	if x != nil {
 		return x.ContainerId
@@ -1645,12 +2466,21 @@ type GetAncestorsResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Timeout the receiving peer applies to the message in nanoseconds, the
+	// deadline clamped to max_timeout. Negative for deadlines above the max
+	// int64, which avalanchego handles as already expired.
+	// ref. "message.msgBuilder.parseInbound"
+	ExpectedTimeout int64 `protobuf:"varint,6,opt,name=expected_timeout,json=expectedTimeout,proto3" json:"expected_timeout,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *GetAncestorsResponse) Reset() {
 	*x = GetAncestorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[23]
+		mi := &file_rpcpb_message_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1663,7 +2493,7 @@ func (x *GetAncestorsResponse) String() string {
 func (*GetAncestorsResponse) ProtoMessage() {}
 
 func (x *GetAncestorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[23]
+	mi := &file_rpcpb_message_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1676,7 +2506,7 @@ func (x *GetAncestorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAncestorsResponse.ProtoReflect.Descriptor instead.
 func (*GetAncestorsResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{23}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetAncestorsResponse) GetExpectedSerializedMsg() []byte {
@@ -1700,21 +2530,46 @@ func (x *GetAncestorsResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *GetAncestorsResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *GetAncestorsResponse) GetExpectedTimeout() int64 {
+	if x != nil {
+		return x.ExpectedTimeout
+	}
+	return 0
+}
+
+func (x *GetAncestorsResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type GetStateSummaryFrontierRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId       []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	RequestId     uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Deadline      uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	ChainId   []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RequestId uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Deadline  uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Maximum message timeout of the receiving peer in nanoseconds, which
+	// clamps the deadline (see expected_timeout). Zero means the server
+	// default ("--max-message-timeout").
+	MaxTimeout    uint64 `protobuf:"varint,5,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
 	SerializedMsg []byte `protobuf:"bytes,4,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *GetStateSummaryFrontierRequest) Reset() {
 	*x = GetStateSummaryFrontierRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[24]
+		mi := &file_rpcpb_message_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1727,7 +2582,7 @@ func (x *GetStateSummaryFrontierRequest) String() string {
 func (*GetStateSummaryFrontierRequest) ProtoMessage() {}
 
 func (x *GetStateSummaryFrontierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[24]
+	mi := &file_rpcpb_message_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1740,7 +2595,7 @@ func (x *GetStateSummaryFrontierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStateSummaryFrontierRequest.ProtoReflect.Descriptor instead.
 func (*GetStateSummaryFrontierRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{24}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetStateSummaryFrontierRequest) GetChainId() []byte {
@@ -1764,6 +2619,13 @@ func (x *GetStateSummaryFrontierRequest) GetDeadline() uint64 {
 	return 0
 }
 
+func (x *GetStateSummaryFrontierRequest) GetMaxTimeout() uint64 {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return 0
+}
+
 func (x *GetStateSummaryFrontierRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -1779,12 +2641,21 @@ type GetStateSummaryFrontierResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Timeout the receiving peer applies to the message in nanoseconds, the
+	// deadline clamped to max_timeout. Negative for deadlines above the max
+	// int64, which avalanchego handles as already expired.
+	// ref. "message.msgBuilder.parseInbound"
+	ExpectedTimeout int64 `protobuf:"varint,6,opt,name=expected_timeout,json=expectedTimeout,proto3" json:"expected_timeout,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *GetStateSummaryFrontierResponse) Reset() {
 	*x = GetStateSummaryFrontierResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[25]
+		mi := &file_rpcpb_message_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1797,7 +2668,7 @@ func (x *GetStateSummaryFrontierResponse) String() string {
 func (*GetStateSummaryFrontierResponse) ProtoMessage() {}
 
 func (x *GetStateSummaryFrontierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[25]
+	mi := &file_rpcpb_message_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1810,7 +2681,7 @@ func (x *GetStateSummaryFrontierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStateSummaryFrontierResponse.ProtoReflect.Descriptor instead.
 func (*GetStateSummaryFrontierResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{25}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetStateSummaryFrontierResponse) GetExpectedSerializedMsg() []byte {
@@ -1834,22 +2705,49 @@ func (x *GetStateSummaryFrontierResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *GetStateSummaryFrontierResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *GetStateSummaryFrontierResponse) GetExpectedTimeout() int64 {
+	if x != nil {
+		return x.ExpectedTimeout
+	}
+	return 0
+}
+
+func (x *GetStateSummaryFrontierResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type GetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId       []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	RequestId     uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Deadline      uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	ContainerId   []byte `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	SerializedMsg []byte `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	ChainId   []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RequestId uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Deadline  uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Maximum message timeout of the receiving peer in nanoseconds, which
+	// clamps the deadline (see expected_timeout). Zero means the server
+	// default ("--max-message-timeout").
+	MaxTimeout uint64 `protobuf:"varint,6,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
+	// Snowman if unspecified.
+	EngineType    EngineType `protobuf:"varint,7,opt,name=engine_type,json=engineType,proto3,enum=rpcpb.EngineType" json:"engine_type,omitempty"`
+	ContainerId   []byte     `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	SerializedMsg []byte     `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *GetRequest) Reset() {
 	*x = GetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[26]
+		mi := &file_rpcpb_message_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1862,7 +2760,7 @@ func (x *GetRequest) String() string {
 func (*GetRequest) ProtoMessage() {}
 
 func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[26]
+	mi := &file_rpcpb_message_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,7 +2773,7 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{26}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetRequest) GetChainId() []byte {
@@ -1899,6 +2797,20 @@ func (x *GetRequest) GetDeadline() uint64 {
 	return 0
 }
 
+func (x *GetRequest) GetMaxTimeout() uint64 {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return 0
+}
+
+func (x *GetRequest) GetEngineType() EngineType {
+	if x != nil {
+		return x.EngineType
+	}
+	return EngineType_ENGINE_TYPE_UNSPECIFIED
+}
+
 func (x *GetRequest) GetContainerId() []byte {
 	if x != nil {
 		return x.ContainerId
@@ -1921,12 +2833,21 @@ type GetResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Timeout the receiving peer applies to the message in nanoseconds, the
+	// deadline clamped to max_timeout. Negative for deadlines above the max
+	// int64, which avalanchego handles as already expired.
+	// ref. "message.msgBuilder.parseInbound"
+	ExpectedTimeout int64 `protobuf:"varint,6,opt,name=expected_timeout,json=expectedTimeout,proto3" json:"expected_timeout,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *GetResponse) Reset() {
 	*x = GetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[27]
+		mi := &file_rpcpb_message_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1939,7 +2860,7 @@ func (x *GetResponse) String() string {
 func (*GetResponse) ProtoMessage() {}
 
 func (x *GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[27]
+	mi := &file_rpcpb_message_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1952,7 +2873,7 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{27}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetResponse) GetExpectedSerializedMsg() []byte {
@@ -1976,6 +2897,27 @@ func (x *GetResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *GetResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *GetResponse) GetExpectedTimeout() int64 {
+	if x != nil {
+		return x.ExpectedTimeout
+	}
+	return 0
+}
+
+func (x *GetResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type PeerlistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1983,13 +2925,15 @@ type PeerlistRequest struct {
 
 	Peers          []*Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
 	GzipCompressed bool    `protobuf:"varint,2,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
-	SerializedMsg  []byte  `protobuf:"bytes,3,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	// Takes precedence over gzip_compressed when specified.
+	CompressionType CompressionType `protobuf:"varint,4,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+	SerializedMsg   []byte          `protobuf:"bytes,3,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *PeerlistRequest) Reset() {
 	*x = PeerlistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[28]
+		mi := &file_rpcpb_message_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2002,7 +2946,7 @@ func (x *PeerlistRequest) String() string {
 func (*PeerlistRequest) ProtoMessage() {}
 
 func (x *PeerlistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[28]
+	mi := &file_rpcpb_message_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2015,7 +2959,7 @@ func (x *PeerlistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeerlistRequest.ProtoReflect.Descriptor instead.
 func (*PeerlistRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{28}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *PeerlistRequest) GetPeers() []*Peer {
@@ -2032,6 +2976,13 @@ func (x *PeerlistRequest) GetGzipCompressed() bool {
 	return false
 }
 
+func (x *PeerlistRequest) GetCompressionType() CompressionType {
+	if x != nil {
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
 func (x *PeerlistRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -2039,6 +2990,7 @@ func (x *PeerlistRequest) GetSerializedMsg() []byte {
 	return nil
 }
 
+// Peer mirrors avalanchego "p2p.ClaimedIpPort".
 type Peer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2049,12 +3001,14 @@ type Peer struct {
 	IpPort      uint32 `protobuf:"varint,3,opt,name=ip_port,json=ipPort,proto3" json:"ip_port,omitempty"`
 	Timestamp   uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Sig         []byte `protobuf:"bytes,5,opt,name=sig,proto3" json:"sig,omitempty"`
+	// ID of the tx that added the peer to the validator set.
+	TxId []byte `protobuf:"bytes,6,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
 }
 
 func (x *Peer) Reset() {
 	*x = Peer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[29]
+		mi := &file_rpcpb_message_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2067,7 +3021,7 @@ func (x *Peer) String() string {
 func (*Peer) ProtoMessage() {}
 
 func (x *Peer) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[29]
+	mi := &file_rpcpb_message_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2080,7 +3034,7 @@ func (x *Peer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Peer.ProtoReflect.Descriptor instead.
 func (*Peer) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{29}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Peer) GetCertificate() []byte {
@@ -2118,6 +3072,13 @@ func (x *Peer) GetSig() []byte {
 	return nil
 }
 
+func (x *Peer) GetTxId() []byte {
+	if x != nil {
+		return x.TxId
+	}
+	return nil
+}
+
 type PeerlistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2126,12 +3087,20 @@ type PeerlistResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the request was compressed.
+	CompressionStats *CompressionStats `protobuf:"bytes,4,opt,name=compression_stats,json=compressionStats,proto3" json:"compression_stats,omitempty"`
+	// Compression flag of the request that was honored.
+	CompressionFlag CompressionFlag `protobuf:"varint,6,opt,name=compression_flag,json=compressionFlag,proto3,enum=rpcpb.CompressionFlag" json:"compression_flag,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *PeerlistResponse) Reset() {
 	*x = PeerlistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[30]
+		mi := &file_rpcpb_message_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2144,7 +3113,7 @@ func (x *PeerlistResponse) String() string {
 func (*PeerlistResponse) ProtoMessage() {}
 
 func (x *PeerlistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[30]
+	mi := &file_rpcpb_message_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2157,7 +3126,7 @@ func (x *PeerlistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeerlistResponse.ProtoReflect.Descriptor instead.
 func (*PeerlistResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{30}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *PeerlistResponse) GetExpectedSerializedMsg() []byte {
@@ -2181,6 +3150,34 @@ func (x *PeerlistResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *PeerlistResponse) GetCompressionStats() *CompressionStats {
+	if x != nil {
+		return x.CompressionStats
+	}
+	return nil
+}
+
+func (x *PeerlistResponse) GetCompressionFlag() CompressionFlag {
+	if x != nil {
+		return x.CompressionFlag
+	}
+	return CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED
+}
+
+func (x *PeerlistResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *PeerlistResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type PingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2192,7 +3189,7 @@ type PingRequest struct {
 func (x *PingRequest) Reset() {
 	*x = PingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[31]
+		mi := &file_rpcpb_message_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2205,7 +3202,7 @@ func (x *PingRequest) String() string {
 func (*PingRequest) ProtoMessage() {}
 
 func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[31]
+	mi := &file_rpcpb_message_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,7 +3215,7 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{31}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *PingRequest) GetSerializedMsg() []byte {
@@ -2236,12 +3233,16 @@ type PingResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *PingResponse) Reset() {
 	*x = PingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[32]
+		mi := &file_rpcpb_message_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2254,7 +3255,7 @@ func (x *PingResponse) String() string {
 func (*PingResponse) ProtoMessage() {}
 
 func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[32]
+	mi := &file_rpcpb_message_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2267,7 +3268,7 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{32}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *PingResponse) GetExpectedSerializedMsg() []byte {
@@ -2291,6 +3292,20 @@ func (x *PingResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *PingResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *PingResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type PongRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2303,7 +3318,7 @@ type PongRequest struct {
 func (x *PongRequest) Reset() {
 	*x = PongRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[33]
+		mi := &file_rpcpb_message_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2316,7 +3331,7 @@ func (x *PongRequest) String() string {
 func (*PongRequest) ProtoMessage() {}
 
 func (x *PongRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[33]
+	mi := &file_rpcpb_message_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2329,7 +3344,7 @@ func (x *PongRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PongRequest.ProtoReflect.Descriptor instead.
 func (*PongRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{33}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *PongRequest) GetUptimePct() uint32 {
@@ -2354,12 +3369,16 @@ type PongResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *PongResponse) Reset() {
 	*x = PongResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[34]
+		mi := &file_rpcpb_message_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2372,7 +3391,7 @@ func (x *PongResponse) String() string {
 func (*PongResponse) ProtoMessage() {}
 
 func (x *PongResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[34]
+	mi := &file_rpcpb_message_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2385,7 +3404,7 @@ func (x *PongResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PongResponse.ProtoReflect.Descriptor instead.
 func (*PongResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{34}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *PongResponse) GetExpectedSerializedMsg() []byte {
@@ -2409,22 +3428,42 @@ func (x *PongResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *PongResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *PongResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type PullQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId       []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	RequestId     uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Deadline      uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	ContainerId   []byte `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	SerializedMsg []byte `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	ChainId   []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RequestId uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Deadline  uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Maximum message timeout of the receiving peer in nanoseconds, which
+	// clamps the deadline (see expected_timeout). Zero means the server
+	// default ("--max-message-timeout").
+	MaxTimeout uint64 `protobuf:"varint,6,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
+	// Snowman if unspecified.
+	EngineType    EngineType `protobuf:"varint,7,opt,name=engine_type,json=engineType,proto3,enum=rpcpb.EngineType" json:"engine_type,omitempty"`
+	ContainerId   []byte     `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	SerializedMsg []byte     `protobuf:"bytes,5,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *PullQueryRequest) Reset() {
 	*x = PullQueryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[35]
+		mi := &file_rpcpb_message_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2437,7 +3476,7 @@ func (x *PullQueryRequest) String() string {
 func (*PullQueryRequest) ProtoMessage() {}
 
 func (x *PullQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[35]
+	mi := &file_rpcpb_message_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2450,7 +3489,7 @@ func (x *PullQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullQueryRequest.ProtoReflect.Descriptor instead.
 func (*PullQueryRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{35}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *PullQueryRequest) GetChainId() []byte {
@@ -2474,6 +3513,20 @@ func (x *PullQueryRequest) GetDeadline() uint64 {
 	return 0
 }
 
+func (x *PullQueryRequest) GetMaxTimeout() uint64 {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return 0
+}
+
+func (x *PullQueryRequest) GetEngineType() EngineType {
+	if x != nil {
+		return x.EngineType
+	}
+	return EngineType_ENGINE_TYPE_UNSPECIFIED
+}
+
 func (x *PullQueryRequest) GetContainerId() []byte {
 	if x != nil {
 		return x.ContainerId
@@ -2496,12 +3549,21 @@ type PullQueryResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Timeout the receiving peer applies to the message in nanoseconds, the
+	// deadline clamped to max_timeout. Negative for deadlines above the max
+	// int64, which avalanchego handles as already expired.
+	// ref. "message.msgBuilder.parseInbound"
+	ExpectedTimeout int64 `protobuf:"varint,6,opt,name=expected_timeout,json=expectedTimeout,proto3" json:"expected_timeout,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *PullQueryResponse) Reset() {
 	*x = PullQueryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[36]
+		mi := &file_rpcpb_message_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2514,7 +3576,7 @@ func (x *PullQueryResponse) String() string {
 func (*PullQueryResponse) ProtoMessage() {}
 
 func (x *PullQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[36]
+	mi := &file_rpcpb_message_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2527,7 +3589,7 @@ func (x *PullQueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullQueryResponse.ProtoReflect.Descriptor instead.
 func (*PullQueryResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{36}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *PullQueryResponse) GetExpectedSerializedMsg() []byte {
@@ -2551,23 +3613,52 @@ func (x *PullQueryResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *PullQueryResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *PullQueryResponse) GetExpectedTimeout() int64 {
+	if x != nil {
+		return x.ExpectedTimeout
+	}
+	return 0
+}
+
+func (x *PullQueryResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type PushQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId        []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	RequestId      uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Deadline       uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	ContainerBytes []byte `protobuf:"bytes,5,opt,name=container_bytes,json=containerBytes,proto3" json:"container_bytes,omitempty"`
-	GzipCompressed bool   `protobuf:"varint,6,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
-	SerializedMsg  []byte `protobuf:"bytes,7,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	ChainId   []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RequestId uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Deadline  uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Maximum message timeout of the receiving peer in nanoseconds, which
+	// clamps the deadline (see expected_timeout). Zero means the server
+	// default ("--max-message-timeout").
+	MaxTimeout uint64 `protobuf:"varint,9,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
+	// Snowman if unspecified.
+	EngineType     EngineType `protobuf:"varint,10,opt,name=engine_type,json=engineType,proto3,enum=rpcpb.EngineType" json:"engine_type,omitempty"`
+	ContainerBytes []byte     `protobuf:"bytes,5,opt,name=container_bytes,json=containerBytes,proto3" json:"container_bytes,omitempty"`
+	GzipCompressed bool       `protobuf:"varint,6,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
+	// Takes precedence over gzip_compressed when specified.
+	CompressionType CompressionType `protobuf:"varint,8,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+	SerializedMsg   []byte          `protobuf:"bytes,7,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *PushQueryRequest) Reset() {
 	*x = PushQueryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[37]
+		mi := &file_rpcpb_message_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2580,7 +3671,7 @@ func (x *PushQueryRequest) String() string {
 func (*PushQueryRequest) ProtoMessage() {}
 
 func (x *PushQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[37]
+	mi := &file_rpcpb_message_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2593,7 +3684,7 @@ func (x *PushQueryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushQueryRequest.ProtoReflect.Descriptor instead.
 func (*PushQueryRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{37}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *PushQueryRequest) GetChainId() []byte {
@@ -2617,6 +3708,20 @@ func (x *PushQueryRequest) GetDeadline() uint64 {
 	return 0
 }
 
+func (x *PushQueryRequest) GetMaxTimeout() uint64 {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return 0
+}
+
+func (x *PushQueryRequest) GetEngineType() EngineType {
+	if x != nil {
+		return x.EngineType
+	}
+	return EngineType_ENGINE_TYPE_UNSPECIFIED
+}
+
 func (x *PushQueryRequest) GetContainerBytes() []byte {
 	if x != nil {
 		return x.ContainerBytes
@@ -2631,6 +3736,13 @@ func (x *PushQueryRequest) GetGzipCompressed() bool {
 	return false
 }
 
+func (x *PushQueryRequest) GetCompressionType() CompressionType {
+	if x != nil {
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
 func (x *PushQueryRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -2646,12 +3758,25 @@ type PushQueryResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the request was compressed.
+	CompressionStats *CompressionStats `protobuf:"bytes,4,opt,name=compression_stats,json=compressionStats,proto3" json:"compression_stats,omitempty"`
+	// Compression flag of the request that was honored.
+	CompressionFlag CompressionFlag `protobuf:"varint,6,opt,name=compression_flag,json=compressionFlag,proto3,enum=rpcpb.CompressionFlag" json:"compression_flag,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Timeout the receiving peer applies to the message in nanoseconds, the
+	// deadline clamped to max_timeout. Negative for deadlines above the max
+	// int64, which avalanchego handles as already expired.
+	// ref. "message.msgBuilder.parseInbound"
+	ExpectedTimeout int64 `protobuf:"varint,8,opt,name=expected_timeout,json=expectedTimeout,proto3" json:"expected_timeout,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *PushQueryResponse) Reset() {
 	*x = PushQueryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[38]
+		mi := &file_rpcpb_message_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2664,7 +3789,7 @@ func (x *PushQueryResponse) String() string {
 func (*PushQueryResponse) ProtoMessage() {}
 
 func (x *PushQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[38]
+	mi := &file_rpcpb_message_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2677,7 +3802,7 @@ func (x *PushQueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushQueryResponse.ProtoReflect.Descriptor instead.
 func (*PushQueryResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{38}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *PushQueryResponse) GetExpectedSerializedMsg() []byte {
@@ -2701,6 +3826,41 @@ func (x *PushQueryResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *PushQueryResponse) GetCompressionStats() *CompressionStats {
+	if x != nil {
+		return x.CompressionStats
+	}
+	return nil
+}
+
+func (x *PushQueryResponse) GetCompressionFlag() CompressionFlag {
+	if x != nil {
+		return x.CompressionFlag
+	}
+	return CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED
+}
+
+func (x *PushQueryResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *PushQueryResponse) GetExpectedTimeout() int64 {
+	if x != nil {
+		return x.ExpectedTimeout
+	}
+	return 0
+}
+
+func (x *PushQueryResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type PutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2709,14 +3869,18 @@ type PutRequest struct {
 	ChainId        []byte `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	RequestId      uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	ContainerBytes []byte `protobuf:"bytes,4,opt,name=container_bytes,json=containerBytes,proto3" json:"container_bytes,omitempty"`
-	GzipCompressed bool   `protobuf:"varint,5,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
-	SerializedMsg  []byte `protobuf:"bytes,6,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	// Snowman if unspecified.
+	EngineType     EngineType `protobuf:"varint,8,opt,name=engine_type,json=engineType,proto3,enum=rpcpb.EngineType" json:"engine_type,omitempty"`
+	GzipCompressed bool       `protobuf:"varint,5,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
+	// Takes precedence over gzip_compressed when specified.
+	CompressionType CompressionType `protobuf:"varint,7,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+	SerializedMsg   []byte          `protobuf:"bytes,6,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *PutRequest) Reset() {
 	*x = PutRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[39]
+		mi := &file_rpcpb_message_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2729,7 +3893,7 @@ func (x *PutRequest) String() string {
 func (*PutRequest) ProtoMessage() {}
 
 func (x *PutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[39]
+	mi := &file_rpcpb_message_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2742,7 +3906,7 @@ func (x *PutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutRequest.ProtoReflect.Descriptor instead.
 func (*PutRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{39}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *PutRequest) GetChainId() []byte {
@@ -2766,6 +3930,13 @@ func (x *PutRequest) GetContainerBytes() []byte {
 	return nil
 }
 
+func (x *PutRequest) GetEngineType() EngineType {
+	if x != nil {
+		return x.EngineType
+	}
+	return EngineType_ENGINE_TYPE_UNSPECIFIED
+}
+
 func (x *PutRequest) GetGzipCompressed() bool {
 	if x != nil {
 		return x.GzipCompressed
@@ -2773,6 +3944,13 @@ func (x *PutRequest) GetGzipCompressed() bool {
 	return false
 }
 
+func (x *PutRequest) GetCompressionType() CompressionType {
+	if x != nil {
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
 func (x *PutRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -2788,12 +3966,20 @@ type PutResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the request was compressed.
+	CompressionStats *CompressionStats `protobuf:"bytes,4,opt,name=compression_stats,json=compressionStats,proto3" json:"compression_stats,omitempty"`
+	// Compression flag of the request that was honored.
+	CompressionFlag CompressionFlag `protobuf:"varint,6,opt,name=compression_flag,json=compressionFlag,proto3,enum=rpcpb.CompressionFlag" json:"compression_flag,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *PutResponse) Reset() {
 	*x = PutResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[40]
+		mi := &file_rpcpb_message_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2806,7 +3992,7 @@ func (x *PutResponse) String() string {
 func (*PutResponse) ProtoMessage() {}
 
 func (x *PutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[40]
+	mi := &file_rpcpb_message_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2819,7 +4005,7 @@ func (x *PutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutResponse.ProtoReflect.Descriptor instead.
 func (*PutResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{40}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *PutResponse) GetExpectedSerializedMsg() []byte {
@@ -2843,6 +4029,34 @@ func (x *PutResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *PutResponse) GetCompressionStats() *CompressionStats {
+	if x != nil {
+		return x.CompressionStats
+	}
+	return nil
+}
+
+func (x *PutResponse) GetCompressionFlag() CompressionFlag {
+	if x != nil {
+		return x.CompressionFlag
+	}
+	return CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED
+}
+
+func (x *PutResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *PutResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 type StateSummaryFrontierRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2852,13 +4066,15 @@ type StateSummaryFrontierRequest struct {
 	RequestId      uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	Summary        []byte `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
 	GzipCompressed bool   `protobuf:"varint,5,opt,name=gzip_compressed,json=gzipCompressed,proto3" json:"gzip_compressed,omitempty"`
-	SerializedMsg  []byte `protobuf:"bytes,6,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	// Takes precedence over gzip_compressed when specified.
+	CompressionType CompressionType `protobuf:"varint,7,opt,name=compression_type,json=compressionType,proto3,enum=rpcpb.CompressionType" json:"compression_type,omitempty"`
+	SerializedMsg   []byte          `protobuf:"bytes,6,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
 }
 
 func (x *StateSummaryFrontierRequest) Reset() {
 	*x = StateSummaryFrontierRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[41]
+		mi := &file_rpcpb_message_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2871,7 +4087,7 @@ func (x *StateSummaryFrontierRequest) String() string {
 func (*StateSummaryFrontierRequest) ProtoMessage() {}
 
 func (x *StateSummaryFrontierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[41]
+	mi := &file_rpcpb_message_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2884,7 +4100,7 @@ func (x *StateSummaryFrontierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StateSummaryFrontierRequest.ProtoReflect.Descriptor instead.
 func (*StateSummaryFrontierRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{41}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *StateSummaryFrontierRequest) GetChainId() []byte {
@@ -2915,6 +4131,13 @@ func (x *StateSummaryFrontierRequest) GetGzipCompressed() bool {
 	return false
 }
 
+func (x *StateSummaryFrontierRequest) GetCompressionType() CompressionType {
+	if x != nil {
+		return x.CompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
 func (x *StateSummaryFrontierRequest) GetSerializedMsg() []byte {
 	if x != nil {
 		return x.SerializedMsg
@@ -2930,12 +4153,20 @@ type StateSummaryFrontierResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the request was compressed.
+	CompressionStats *CompressionStats `protobuf:"bytes,4,opt,name=compression_stats,json=compressionStats,proto3" json:"compression_stats,omitempty"`
+	// Compression flag of the request that was honored.
+	CompressionFlag CompressionFlag `protobuf:"varint,6,opt,name=compression_flag,json=compressionFlag,proto3,enum=rpcpb.CompressionFlag" json:"compression_flag,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *StateSummaryFrontierResponse) Reset() {
 	*x = StateSummaryFrontierResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[42]
+		mi := &file_rpcpb_message_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2948,7 +4179,7 @@ func (x *StateSummaryFrontierResponse) String() string {
 func (*StateSummaryFrontierResponse) ProtoMessage() {}
 
 func (x *StateSummaryFrontierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[42]
+	mi := &file_rpcpb_message_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2961,7 +4192,7 @@ func (x *StateSummaryFrontierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StateSummaryFrontierResponse.ProtoReflect.Descriptor instead.
 func (*StateSummaryFrontierResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{42}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *StateSummaryFrontierResponse) GetExpectedSerializedMsg() []byte {
@@ -2985,39 +4216,63 @@ func (x *StateSummaryFrontierResponse) GetSuccess() bool {
 	return false
 }
 
-type VersionRequest struct {
+func (x *StateSummaryFrontierResponse) GetCompressionStats() *CompressionStats {
+	if x != nil {
+		return x.CompressionStats
+	}
+	return nil
+}
+
+func (x *StateSummaryFrontierResponse) GetCompressionFlag() CompressionFlag {
+	if x != nil {
+		return x.CompressionFlag
+	}
+	return CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED
+}
+
+func (x *StateSummaryFrontierResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *StateSummaryFrontierResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type VerifyStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkId      uint32   `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	MyTime         uint64   `protobuf:"varint,2,opt,name=my_time,json=myTime,proto3" json:"my_time,omitempty"`
-	IpAddr         []byte   `protobuf:"bytes,3,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
-	IpPort         uint32   `protobuf:"varint,4,opt,name=ip_port,json=ipPort,proto3" json:"ip_port,omitempty"`
-	MyVersion      string   `protobuf:"bytes,5,opt,name=my_version,json=myVersion,proto3" json:"my_version,omitempty"`
-	MyVersionTime  uint64   `protobuf:"varint,6,opt,name=my_version_time,json=myVersionTime,proto3" json:"my_version_time,omitempty"`
-	Sig            []byte   `protobuf:"bytes,7,opt,name=sig,proto3" json:"sig,omitempty"`
-	TrackedSubnets [][]byte `protobuf:"bytes,8,rep,name=tracked_subnets,json=trackedSubnets,proto3" json:"tracked_subnets,omitempty"`
-	SerializedMsg  []byte   `protobuf:"bytes,9,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	// Echoed back in the result, to match results with requests.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Verification request of any MessageService method
+	// (e.g., rpcpb.AppGossipRequest).
+	Request *anypb.Any `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *VersionRequest) Reset() {
-	*x = VersionRequest{}
+func (x *VerifyStreamRequest) Reset() {
+	*x = VerifyStreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[43]
+		mi := &file_rpcpb_message_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VersionRequest) String() string {
+func (x *VerifyStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionRequest) ProtoMessage() {}
+func (*VerifyStreamRequest) ProtoMessage() {}
 
-func (x *VersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[43]
+func (x *VerifyStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3028,48 +4283,1276 @@ func (x *VersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
-func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use VerifyStreamRequest.ProtoReflect.Descriptor instead.
+func (*VerifyStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *VersionRequest) GetNetworkId() uint32 {
+func (x *VerifyStreamRequest) GetTag() string {
 	if x != nil {
-		return x.NetworkId
+		return x.Tag
 	}
-	return 0
+	return ""
 }
 
-func (x *VersionRequest) GetMyTime() uint64 {
+func (x *VerifyStreamRequest) GetRequest() *anypb.Any {
 	if x != nil {
-		return x.MyTime
+		return x.Request
 	}
-	return 0
+	return nil
 }
 
-func (x *VersionRequest) GetIpAddr() []byte {
-	if x != nil {
-		return x.IpAddr
-	}
-	return nil
+type VerifyStreamResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Response of the verification request (e.g., rpcpb.AppGossipResponse).
+	Response *anypb.Any `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Message  string     `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success  bool       `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the stream.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
-func (x *VersionRequest) GetIpPort() uint32 {
-	if x != nil {
-		return x.IpPort
+func (x *VerifyStreamResult) Reset() {
+	*x = VerifyStreamResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *VersionRequest) GetMyVersion() string {
-	if x != nil {
-		return x.MyVersion
-	}
-	return ""
+func (x *VerifyStreamResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *VersionRequest) GetMyVersionTime() uint64 {
-	if x != nil {
+func (*VerifyStreamResult) ProtoMessage() {}
+
+func (x *VerifyStreamResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyStreamResult.ProtoReflect.Descriptor instead.
+func (*VerifyStreamResult) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *VerifyStreamResult) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *VerifyStreamResult) GetResponse() *anypb.Any {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *VerifyStreamResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyStreamResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VerifyStreamResult) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BatchVerifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// At most 10000 items.
+	Items []*BatchVerifyItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BatchVerifyRequest) Reset() {
+	*x = BatchVerifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchVerifyRequest) ProtoMessage() {}
+
+func (x *BatchVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchVerifyRequest.ProtoReflect.Descriptor instead.
+func (*BatchVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BatchVerifyRequest) GetItems() []*BatchVerifyItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BatchVerifyItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Echoed back in the result, to match results with items.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Types that are assignable to Request:
+	//
+	//	*BatchVerifyItem_AcceptedFrontier
+	//	*BatchVerifyItem_AcceptedStateSummary
+	//	*BatchVerifyItem_Accepted
+	//	*BatchVerifyItem_Ancestors
+	//	*BatchVerifyItem_AppGossip
+	//	*BatchVerifyItem_AppRequest
+	//	*BatchVerifyItem_AppResponse
+	//	*BatchVerifyItem_Chits
+	//	*BatchVerifyItem_GetAcceptedFrontier_
+	//	*BatchVerifyItem_GetAcceptedStateSummary_
+	//	*BatchVerifyItem_GetAccepted_
+	//	*BatchVerifyItem_GetAncestors_
+	//	*BatchVerifyItem_GetStateSummaryFrontier
+	//	*BatchVerifyItem_Get
+	//	*BatchVerifyItem_Peerlist
+	//	*BatchVerifyItem_Ping
+	//	*BatchVerifyItem_Pong
+	//	*BatchVerifyItem_PullQuery
+	//	*BatchVerifyItem_PushQuery
+	//	*BatchVerifyItem_Put
+	//	*BatchVerifyItem_StateSummaryFrontier_
+	//	*BatchVerifyItem_Version
+	Request isBatchVerifyItem_Request `protobuf_oneof:"request"`
+}
+
+func (x *BatchVerifyItem) Reset() {
+	*x = BatchVerifyItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchVerifyItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchVerifyItem) ProtoMessage() {}
+
+func (x *BatchVerifyItem) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchVerifyItem.ProtoReflect.Descriptor instead.
+func (*BatchVerifyItem) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BatchVerifyItem) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (m *BatchVerifyItem) GetRequest() isBatchVerifyItem_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetAcceptedFrontier() *AcceptedFrontierRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_AcceptedFrontier); ok {
+		return x.AcceptedFrontier
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetAcceptedStateSummary() *AcceptedStateSummaryRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_AcceptedStateSummary); ok {
+		return x.AcceptedStateSummary
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetAccepted() *AcceptedRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_Accepted); ok {
+		return x.Accepted
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetAncestors() *AncestorsRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_Ancestors); ok {
+		return x.Ancestors
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetAppGossip() *AppGossipRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_AppGossip); ok {
+		return x.AppGossip
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetAppRequest() *AppRequestRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_AppRequest); ok {
+		return x.AppRequest
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetAppResponse() *AppResponseRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_AppResponse); ok {
+		return x.AppResponse
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetChits() *ChitsRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_Chits); ok {
+		return x.Chits
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetGetAcceptedFrontier_() *GetAcceptedFrontierRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_GetAcceptedFrontier_); ok {
+		return x.GetAcceptedFrontier_
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetGetAcceptedStateSummary_() *GetAcceptedStateSummaryRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_GetAcceptedStateSummary_); ok {
+		return x.GetAcceptedStateSummary_
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetGetAccepted_() *GetAcceptedRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_GetAccepted_); ok {
+		return x.GetAccepted_
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetGetAncestors_() *GetAncestorsRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_GetAncestors_); ok {
+		return x.GetAncestors_
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetGetStateSummaryFrontier() *GetStateSummaryFrontierRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_GetStateSummaryFrontier); ok {
+		return x.GetStateSummaryFrontier
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetGet() *GetRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_Get); ok {
+		return x.Get
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetPeerlist() *PeerlistRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_Peerlist); ok {
+		return x.Peerlist
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetPing() *PingRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_Ping); ok {
+		return x.Ping
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetPong() *PongRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_Pong); ok {
+		return x.Pong
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetPullQuery() *PullQueryRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_PullQuery); ok {
+		return x.PullQuery
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetPushQuery() *PushQueryRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_PushQuery); ok {
+		return x.PushQuery
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetPut() *PutRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_Put); ok {
+		return x.Put
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetStateSummaryFrontier_() *StateSummaryFrontierRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_StateSummaryFrontier_); ok {
+		return x.StateSummaryFrontier_
+	}
+	return nil
+}
+
+func (x *BatchVerifyItem) GetVersion() *VersionRequest {
+	if x, ok := x.GetRequest().(*BatchVerifyItem_Version); ok {
+		return x.Version
+	}
+	return nil
+}
+
+type isBatchVerifyItem_Request interface {
+	isBatchVerifyItem_Request()
+}
+
+type BatchVerifyItem_AcceptedFrontier struct {
+	AcceptedFrontier *AcceptedFrontierRequest `protobuf:"bytes,2,opt,name=accepted_frontier,json=acceptedFrontier,proto3,oneof"`
+}
+
+type BatchVerifyItem_AcceptedStateSummary struct {
+	AcceptedStateSummary *AcceptedStateSummaryRequest `protobuf:"bytes,3,opt,name=accepted_state_summary,json=acceptedStateSummary,proto3,oneof"`
+}
+
+type BatchVerifyItem_Accepted struct {
+	Accepted *AcceptedRequest `protobuf:"bytes,4,opt,name=accepted,proto3,oneof"`
+}
+
+type BatchVerifyItem_Ancestors struct {
+	Ancestors *AncestorsRequest `protobuf:"bytes,5,opt,name=ancestors,proto3,oneof"`
+}
+
+type BatchVerifyItem_AppGossip struct {
+	AppGossip *AppGossipRequest `protobuf:"bytes,6,opt,name=app_gossip,json=appGossip,proto3,oneof"`
+}
+
+type BatchVerifyItem_AppRequest struct {
+	AppRequest *AppRequestRequest `protobuf:"bytes,7,opt,name=app_request,json=appRequest,proto3,oneof"`
+}
+
+type BatchVerifyItem_AppResponse struct {
+	AppResponse *AppResponseRequest `protobuf:"bytes,8,opt,name=app_response,json=appResponse,proto3,oneof"`
+}
+
+type BatchVerifyItem_Chits struct {
+	Chits *ChitsRequest `protobuf:"bytes,9,opt,name=chits,proto3,oneof"`
+}
+
+type BatchVerifyItem_GetAcceptedFrontier_ struct {
+	GetAcceptedFrontier_ *GetAcceptedFrontierRequest `protobuf:"bytes,10,opt,name=get_accepted_frontier,json=getAcceptedFrontier,proto3,oneof"`
+}
+
+type BatchVerifyItem_GetAcceptedStateSummary_ struct {
+	GetAcceptedStateSummary_ *GetAcceptedStateSummaryRequest `protobuf:"bytes,11,opt,name=get_accepted_state_summary,json=getAcceptedStateSummary,proto3,oneof"`
+}
+
+type BatchVerifyItem_GetAccepted_ struct {
+	GetAccepted_ *GetAcceptedRequest `protobuf:"bytes,12,opt,name=get_accepted,json=getAccepted,proto3,oneof"`
+}
+
+type BatchVerifyItem_GetAncestors_ struct {
+	GetAncestors_ *GetAncestorsRequest `protobuf:"bytes,13,opt,name=get_ancestors,json=getAncestors,proto3,oneof"`
+}
+
+type BatchVerifyItem_GetStateSummaryFrontier struct {
+	GetStateSummaryFrontier *GetStateSummaryFrontierRequest `protobuf:"bytes,14,opt,name=get_state_summary_frontier,json=getStateSummaryFrontier,proto3,oneof"`
+}
+
+type BatchVerifyItem_Get struct {
+	Get *GetRequest `protobuf:"bytes,15,opt,name=get,proto3,oneof"`
+}
+
+type BatchVerifyItem_Peerlist struct {
+	Peerlist *PeerlistRequest `protobuf:"bytes,16,opt,name=peerlist,proto3,oneof"`
+}
+
+type BatchVerifyItem_Ping struct {
+	Ping *PingRequest `protobuf:"bytes,17,opt,name=ping,proto3,oneof"`
+}
+
+type BatchVerifyItem_Pong struct {
+	Pong *PongRequest `protobuf:"bytes,18,opt,name=pong,proto3,oneof"`
+}
+
+type BatchVerifyItem_PullQuery struct {
+	PullQuery *PullQueryRequest `protobuf:"bytes,19,opt,name=pull_query,json=pullQuery,proto3,oneof"`
+}
+
+type BatchVerifyItem_PushQuery struct {
+	PushQuery *PushQueryRequest `protobuf:"bytes,20,opt,name=push_query,json=pushQuery,proto3,oneof"`
+}
+
+type BatchVerifyItem_Put struct {
+	Put *PutRequest `protobuf:"bytes,21,opt,name=put,proto3,oneof"`
+}
+
+type BatchVerifyItem_StateSummaryFrontier_ struct {
+	StateSummaryFrontier_ *StateSummaryFrontierRequest `protobuf:"bytes,22,opt,name=state_summary_frontier,json=stateSummaryFrontier,proto3,oneof"`
+}
+
+type BatchVerifyItem_Version struct {
+	Version *VersionRequest `protobuf:"bytes,23,opt,name=version,proto3,oneof"`
+}
+
+func (*BatchVerifyItem_AcceptedFrontier) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_AcceptedStateSummary) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_Accepted) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_Ancestors) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_AppGossip) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_AppRequest) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_AppResponse) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_Chits) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_GetAcceptedFrontier_) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_GetAcceptedStateSummary_) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_GetAccepted_) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_GetAncestors_) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_GetStateSummaryFrontier) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_Get) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_Peerlist) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_Ping) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_Pong) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_PullQuery) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_PushQuery) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_Put) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_StateSummaryFrontier_) isBatchVerifyItem_Request() {}
+
+func (*BatchVerifyItem_Version) isBatchVerifyItem_Request() {}
+
+type BatchVerifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Result of each item, in the order of the items.
+	Results []*BatchVerifyResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Number of items that failed verification or could not be verified.
+	Failed uint32 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Time spent by the server handling the batch.
+	ServerDurationMs uint64 `protobuf:"varint,3,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BatchVerifyResponse) Reset() {
+	*x = BatchVerifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchVerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchVerifyResponse) ProtoMessage() {}
+
+func (x *BatchVerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchVerifyResponse.ProtoReflect.Descriptor instead.
+func (*BatchVerifyResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BatchVerifyResponse) GetResults() []*BatchVerifyResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchVerifyResponse) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchVerifyResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BatchVerifyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Response of the verification request (e.g., rpcpb.AppGossipResponse),
+	// unset if the item could not be verified.
+	Response *anypb.Any `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Message  string     `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success  bool       `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Why the item could not be verified, in which case success is false.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchVerifyResult) Reset() {
+	*x = BatchVerifyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchVerifyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchVerifyResult) ProtoMessage() {}
+
+func (x *BatchVerifyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchVerifyResult.ProtoReflect.Descriptor instead.
+func (*BatchVerifyResult) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchVerifyResult) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *BatchVerifyResult) GetResponse() *anypb.Any {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *BatchVerifyResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchVerifyResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchVerifyResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WriteFrameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Payload to frame, not necessarily a valid message.
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Frame written by the client, unless it rejects the payload.
+	SerializedFrame []byte `protobuf:"bytes,2,opt,name=serialized_frame,json=serializedFrame,proto3" json:"serialized_frame,omitempty"`
+	Rejected        bool   `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *WriteFrameRequest) Reset() {
+	*x = WriteFrameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteFrameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteFrameRequest) ProtoMessage() {}
+
+func (x *WriteFrameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteFrameRequest.ProtoReflect.Descriptor instead.
+func (*WriteFrameRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *WriteFrameRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *WriteFrameRequest) GetSerializedFrame() []byte {
+	if x != nil {
+		return x.SerializedFrame
+	}
+	return nil
+}
+
+func (x *WriteFrameRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type WriteFrameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 4-byte big-endian payload length followed by the payload.
+	ExpectedSerializedFrame []byte `protobuf:"bytes,1,opt,name=expected_serialized_frame,json=expectedSerializedFrame,proto3" json:"expected_serialized_frame,omitempty"`
+	// Error avalanchego rejects the payload with (e.g., when above the
+	// maximum message size).
+	ExpectedError string `protobuf:"bytes,2,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	// Maximum payload length of a frame.
+	MaxMsgLength uint32 `protobuf:"varint,3,opt,name=max_msg_length,json=maxMsgLength,proto3" json:"max_msg_length,omitempty"`
+	Message      string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the frames differ.
+	Diff *Diff `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,7,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *WriteFrameResponse) Reset() {
+	*x = WriteFrameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteFrameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteFrameResponse) ProtoMessage() {}
+
+func (x *WriteFrameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteFrameResponse.ProtoReflect.Descriptor instead.
+func (*WriteFrameResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WriteFrameResponse) GetExpectedSerializedFrame() []byte {
+	if x != nil {
+		return x.ExpectedSerializedFrame
+	}
+	return nil
+}
+
+func (x *WriteFrameResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *WriteFrameResponse) GetMaxMsgLength() uint32 {
+	if x != nil {
+		return x.MaxMsgLength
+	}
+	return 0
+}
+
+func (x *WriteFrameResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WriteFrameResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *WriteFrameResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *WriteFrameResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type ReadFrameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bytes read from the connection, starting at a frame.
+	Stream []byte `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// Payload of the first frame read by the client, unless it rejects the
+	// frame.
+	Payload  []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Rejected bool   `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *ReadFrameRequest) Reset() {
+	*x = ReadFrameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadFrameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadFrameRequest) ProtoMessage() {}
+
+func (x *ReadFrameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadFrameRequest.ProtoReflect.Descriptor instead.
+func (*ReadFrameRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReadFrameRequest) GetStream() []byte {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+func (x *ReadFrameRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ReadFrameRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type ReadFrameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedPayload []byte `protobuf:"bytes,1,opt,name=expected_payload,json=expectedPayload,proto3" json:"expected_payload,omitempty"`
+	// Bytes of the stream the frame spans (i.e., the length prefix and the
+	// payload), after which the next frame starts.
+	ExpectedFrameLength uint32 `protobuf:"varint,2,opt,name=expected_frame_length,json=expectedFrameLength,proto3" json:"expected_frame_length,omitempty"`
+	// Error avalanchego rejects the frame with (e.g., when truncated or
+	// above the maximum message size).
+	ExpectedError string `protobuf:"bytes,3,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	// Maximum payload length of a frame.
+	MaxMsgLength uint32 `protobuf:"varint,4,opt,name=max_msg_length,json=maxMsgLength,proto3" json:"max_msg_length,omitempty"`
+	Message      string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the payloads differ.
+	Diff *Diff `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,8,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *ReadFrameResponse) Reset() {
+	*x = ReadFrameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadFrameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadFrameResponse) ProtoMessage() {}
+
+func (x *ReadFrameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadFrameResponse.ProtoReflect.Descriptor instead.
+func (*ReadFrameResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReadFrameResponse) GetExpectedPayload() []byte {
+	if x != nil {
+		return x.ExpectedPayload
+	}
+	return nil
+}
+
+func (x *ReadFrameResponse) GetExpectedFrameLength() uint32 {
+	if x != nil {
+		return x.ExpectedFrameLength
+	}
+	return 0
+}
+
+func (x *ReadFrameResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *ReadFrameResponse) GetMaxMsgLength() uint32 {
+	if x != nil {
+		return x.MaxMsgLength
+	}
+	return 0
+}
+
+func (x *ReadFrameResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReadFrameResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReadFrameResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *ReadFrameResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type ParseMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Framed message bytes (i.e., prefixed with the 4-byte message length),
+	// optionally compressed.
+	SerializedMsg []byte `protobuf:"bytes,1,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	// Fields parsed by the client, as the verification request of the op
+	// (e.g., rpcpb.AppGossipRequest). Its serialized_msg and compression
+	// fields are ignored.
+	Parsed *anypb.Any `protobuf:"bytes,2,opt,name=parsed,proto3" json:"parsed,omitempty"`
+	// Set if the client rejects the message.
+	Rejected bool `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *ParseMessageRequest) Reset() {
+	*x = ParseMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseMessageRequest) ProtoMessage() {}
+
+func (x *ParseMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseMessageRequest.ProtoReflect.Descriptor instead.
+func (*ParseMessageRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ParseMessageRequest) GetSerializedMsg() []byte {
+	if x != nil {
+		return x.SerializedMsg
+	}
+	return nil
+}
+
+func (x *ParseMessageRequest) GetParsed() *anypb.Any {
+	if x != nil {
+		return x.Parsed
+	}
+	return nil
+}
+
+func (x *ParseMessageRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type ParseMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fields parsed by avalanchego, as the verification request of the op.
+	ExpectedParsed *anypb.Any `protobuf:"bytes,1,opt,name=expected_parsed,json=expectedParsed,proto3" json:"expected_parsed,omitempty"`
+	// e.g., "app_gossip"
+	ExpectedOp              string          `protobuf:"bytes,2,opt,name=expected_op,json=expectedOp,proto3" json:"expected_op,omitempty"`
+	ExpectedCompressionType CompressionType `protobuf:"varint,3,opt,name=expected_compression_type,json=expectedCompressionType,proto3,enum=rpcpb.CompressionType" json:"expected_compression_type,omitempty"`
+	// Error avalanchego rejects the message with.
+	ExpectedError string `protobuf:"bytes,4,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	// Names of the fields parsed differently by the client.
+	MismatchedFields []string `protobuf:"bytes,5,rep,name=mismatched_fields,json=mismatchedFields,proto3" json:"mismatched_fields,omitempty"`
+	Message          string   `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Success          bool     `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,8,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *ParseMessageResponse) Reset() {
+	*x = ParseMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseMessageResponse) ProtoMessage() {}
+
+func (x *ParseMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseMessageResponse.ProtoReflect.Descriptor instead.
+func (*ParseMessageResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ParseMessageResponse) GetExpectedParsed() *anypb.Any {
+	if x != nil {
+		return x.ExpectedParsed
+	}
+	return nil
+}
+
+func (x *ParseMessageResponse) GetExpectedOp() string {
+	if x != nil {
+		return x.ExpectedOp
+	}
+	return ""
+}
+
+func (x *ParseMessageResponse) GetExpectedCompressionType() CompressionType {
+	if x != nil {
+		return x.ExpectedCompressionType
+	}
+	return CompressionType_COMPRESSION_TYPE_UNSPECIFIED
+}
+
+func (x *ParseMessageResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *ParseMessageResponse) GetMismatchedFields() []string {
+	if x != nil {
+		return x.MismatchedFields
+	}
+	return nil
+}
+
+func (x *ParseMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ParseMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ParseMessageResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type VersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId      uint32   `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	MyTime         uint64   `protobuf:"varint,2,opt,name=my_time,json=myTime,proto3" json:"my_time,omitempty"`
+	IpAddr         []byte   `protobuf:"bytes,3,opt,name=ip_addr,json=ipAddr,proto3" json:"ip_addr,omitempty"`
+	IpPort         uint32   `protobuf:"varint,4,opt,name=ip_port,json=ipPort,proto3" json:"ip_port,omitempty"`
+	MyVersion      string   `protobuf:"bytes,5,opt,name=my_version,json=myVersion,proto3" json:"my_version,omitempty"`
+	MyVersionTime  uint64   `protobuf:"varint,6,opt,name=my_version_time,json=myVersionTime,proto3" json:"my_version_time,omitempty"`
+	Sig            []byte   `protobuf:"bytes,7,opt,name=sig,proto3" json:"sig,omitempty"`
+	TrackedSubnets [][]byte `protobuf:"bytes,8,rep,name=tracked_subnets,json=trackedSubnets,proto3" json:"tracked_subnets,omitempty"`
+	SerializedMsg  []byte   `protobuf:"bytes,9,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+}
+
+func (x *VersionRequest) Reset() {
+	*x = VersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_message_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionRequest) ProtoMessage() {}
+
+func (x *VersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_message_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *VersionRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *VersionRequest) GetMyTime() uint64 {
+	if x != nil {
+		return x.MyTime
+	}
+	return 0
+}
+
+func (x *VersionRequest) GetIpAddr() []byte {
+	if x != nil {
+		return x.IpAddr
+	}
+	return nil
+}
+
+func (x *VersionRequest) GetIpPort() uint32 {
+	if x != nil {
+		return x.IpPort
+	}
+	return 0
+}
+
+func (x *VersionRequest) GetMyVersion() string {
+	if x != nil {
+		return x.MyVersion
+	}
+	return ""
+}
+
+func (x *VersionRequest) GetMyVersionTime() uint64 {
+	if x != nil {
 		return x.MyVersionTime
 	}
 	return 0
@@ -3104,12 +5587,16 @@ type VersionResponse struct {
 	ExpectedSerializedMsg []byte `protobuf:"bytes,1,opt,name=expected_serialized_msg,json=expectedSerializedMsg,proto3" json:"expected_serialized_msg,omitempty"`
 	Message               string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success               bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *VersionResponse) Reset() {
 	*x = VersionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpcpb_message_proto_msgTypes[44]
+		mi := &file_rpcpb_message_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3122,7 +5609,7 @@ func (x *VersionResponse) String() string {
 func (*VersionResponse) ProtoMessage() {}
 
 func (x *VersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpcpb_message_proto_msgTypes[44]
+	mi := &file_rpcpb_message_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3135,7 +5622,7 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return file_rpcpb_message_proto_rawDescGZIP(), []int{44}
+	return file_rpcpb_message_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *VersionResponse) GetExpectedSerializedMsg() []byte {
@@ -3159,121 +5646,338 @@ func (x *VersionResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *VersionResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *VersionResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
 var File_rpcpb_message_proto protoreflect.FileDescriptor
 
 var file_rpcpb_message_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x22, 0x9f, 0x01, 0x0a,
-	0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x86,
-	0x01, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74,
-	0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x1a, 0x19, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x64,
+	0x69, 0x66, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x01, 0x0a, 0x10, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x75, 0x6e, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x9f, 0x01, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e,
+	0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x22, 0xd5, 0x01, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x1b, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67,
+	0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x41, 0x0a,
+	0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d,
+	0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0xe2, 0x02, 0x0a, 0x1c, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1f, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x97, 0x01, 0x0a,
+	0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0xcd, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65,
 	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
 	0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78,
 	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
 	0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a,
 	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x1b, 0x41, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x10, 0x41, 0x6e, 0x63, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x41,
+	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0xd7, 0x02, 0x0a, 0x11, 0x41, 0x6e, 0x63,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x10,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x66, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c,
+	0x61, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x0e, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d,
+	0x73, 0x67, 0x22, 0xdd, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x49,
-	0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69,
-	0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d,
-	0x73, 0x67, 0x22, 0x8a, 0x01, 0x0a, 0x1c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
-	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
-	0x97, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a,
-	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
-	0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x7e, 0x0a, 0x10, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x10, 0x41, 0x6e,
-	0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70,
-	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f,
-	0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x7f, 0x0a, 0x11, 0x41, 0x6e, 0x63, 0x65,
-	0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x10, 0x41, 0x70,
-	0x70, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x70,
+	0x73, 0x67, 0x22, 0xd7, 0x02, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1f, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xba, 0x02, 0x0a,
+	0x11, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d,
+	0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x70,
 	0x70, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63,
-	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12,
-	0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73,
-	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x7f, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x47, 0x6f, 0x73,
-	0x73, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65,
+	0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x83, 0x03, 0x0a, 0x12, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x46, 0x6c, 0x61, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0xfe, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x22, 0xd9, 0x02, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1f, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xca, 0x01, 0x0a,
+	0x0c, 0x43, 0x68, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x16,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x14, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0xca, 0x01, 0x0a, 0x0d, 0x43, 0x68,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65,
 	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
 	0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78,
 	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
 	0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a,
 	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x12, 0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43,
-	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67,
-	0x22, 0x80, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x0b,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d,
+	0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x83, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63,
 	0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d,
 	0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
@@ -3281,77 +5985,35 @@ var file_rpcpb_message_proto_rawDesc = []byte{
 	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
 	0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x42, 0x79, 0x74, 0x65,
+	0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xc4, 0x02,
+	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
 	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70,
-	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73,
-	0x67, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73,
-	0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x43, 0x68, 0x69, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x7b, 0x0a, 0x0d,
-	0x43, 0x68, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x25,
-	0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x89, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0xe0, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
-	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d,
-	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67,
-	0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
 	0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18,
 	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x64, 0x4d, 0x73, 0x67, 0x22, 0x8d, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x22, 0x90, 0x03, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65,
 	0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65,
 	0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f,
@@ -3360,19 +6022,172 @@ var file_rpcpb_message_proto_rawDesc = []byte{
 	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
 	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1f, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x29,
+	0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0xfb, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63,
 	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63,
 	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
 	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
-	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x81, 0x01,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x22, 0xfc, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0xbe, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d,
+	0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x22, 0x87, 0x02, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x32, 0x0a, 0x0b, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0xf3,
+	0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x29, 0x0a, 0x10, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x65, 0x72, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67,
+	0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x9f,
+	0x01, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x69, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x12, 0x13, 0x0a, 0x05, 0x74,
+	0x78, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64,
+	0x22, 0xd6, 0x02, 0x0a, 0x10, 0x50, 0x65, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
 	0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
@@ -3380,327 +6195,616 @@ var file_rpcpb_message_proto_rawDesc = []byte{
 	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0xb5, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x9d,
-	0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x73, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x34, 0x0a, 0x0b, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22,
+	0xc9, 0x01, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a,
+	0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x53, 0x0a, 0x0b, 0x50,
+	0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x22, 0xc9, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x87, 0x02, 0x0a,
+	0x10, 0x50, 0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
 	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64,
 	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x8d,
-	0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xac,
-	0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
-	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x79, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x0b, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0xf9, 0x01, 0x0a, 0x11, 0x50, 0x75, 0x6c, 0x6c, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17,
 	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
 	0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65,
 	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
 	0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
 	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x65,
-	0x72, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x05,
-	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f,
-	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22,
-	0x8a, 0x01, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x69, 0x70, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69,
-	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0x7e, 0x0a, 0x10,
-	0x50, 0x65, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x34, 0x0a, 0x0b,
-	0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d,
-	0x73, 0x67, 0x22, 0x7a, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x53,
-	0x0a, 0x0b, 0x50, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x09, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e,
-	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x4d, 0x73, 0x67, 0x22, 0x7a, 0x0a, 0x0c, 0x50, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
-	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
-	0xb2, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a,
-	0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x64, 0x4d, 0x73, 0x67, 0x22, 0x7f, 0x0a, 0x11, 0x50, 0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73,
-	0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
-	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69,
-	0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x7f, 0x0a, 0x11, 0x50, 0x75, 0x73,
-	0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
-	0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0a, 0x50,
-	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f,
-	0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x79, 0x0a, 0x0b,
-	0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78,
-	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x1b, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x22, 0xf9, 0x02, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x67,
-	0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32,
+	0x0a, 0x0b, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x67,
+	0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x8a, 0x01, 0x0a, 0x1c,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e,
-	0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17,
+	0x73, 0x73, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x82,
+	0x03, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x44, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x22, 0xb6, 0x02, 0x0a, 0x0a, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69,
+	0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x67, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0xd1, 0x02, 0x0a,
+	0x0b, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17,
 	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
 	0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65,
 	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x0e, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x79,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x79, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07,
-	0x69, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69,
-	0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x79, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d,
-	0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x73, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x12, 0x27,
-	0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
-	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0x7d,
-	0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x32, 0xc3, 0x0c,
-	0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x55, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e,
-	0x74, 0x69, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
-	0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x41,
+	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6c,
+	0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67,
+	0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61,
+	0x67, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x22, 0x84, 0x02, 0x0a, 0x1b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x67,
+	0x7a, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x41, 0x0a,
+	0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d,
+	0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x22, 0xe2, 0x02, 0x0a, 0x1c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1f, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x57, 0x0a, 0x13,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x30,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xaf, 0x0b, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x4d, 0x0a, 0x11,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x16, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a,
+	0x09, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x67, 0x6f,
+	0x73, 0x73, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x61, 0x70, 0x70, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
+	0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a,
+	0x0c, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x0b, 0x61, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x05, 0x63, 0x68, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x69, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x67, 0x65,
+	0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74,
+	0x69, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x13,
+	0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74,
+	0x69, 0x65, 0x72, 0x12, 0x64, 0x0a, 0x1a, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x17, 0x67, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0c, 0x67, 0x65, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x0d, 0x67, 0x65, 0x74,
+	0x5f, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c,
+	0x67, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x64, 0x0a, 0x1a,
+	0x67, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x17, 0x67, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x03, 0x67, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x67, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x65, 0x65,
+	0x72, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x6f, 0x6e,
+	0x67, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x50, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x70,
+	0x6f, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x50, 0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x09, 0x70, 0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x38, 0x0a,
+	0x0a, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x70, 0x75,
+	0x73, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x03, 0x70, 0x75, 0x74, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x70, 0x75, 0x74, 0x12, 0x5a,
+	0x0a, 0x16, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x11, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x61, 0x67, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x74,
+	0x0a, 0x11, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x22, 0xa0, 0x02, 0x0a, 0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24,
+	0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4d, 0x73, 0x67, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x60, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xc2, 0x02, 0x0a, 0x11, 0x52, 0x65,
+	0x61, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x25,
+	0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x73, 0x67,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d,
+	0x61, 0x78, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x86,
+	0x01, 0x0a, 0x13, 0x50, 0x61, 0x72, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x80, 0x03, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x73,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3d, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72,
+	0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70,
+	0x12, 0x52, 0x0a, 0x19, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x17, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x6d,
+	0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xa3, 0x02, 0x0a, 0x0e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x6d, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17,
+	0x0a, 0x07, 0x69, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x69, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x79, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x79, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x79, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x6d, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67,
+	0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x22, 0xcc, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x2a,
+	0x84, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01,
+	0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x5a, 0x49, 0x50, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x43,
+	0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x5a, 0x53, 0x54, 0x44, 0x10, 0x03, 0x2a, 0x5e, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f, 0x4d,
+	0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x47, 0x5a,
+	0x49, 0x50, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x25, 0x0a, 0x21, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46,
+	0x4c, 0x41, 0x47, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x2a, 0x76, 0x0a, 0x0a, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4f, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x4e,
+	0x47, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x56, 0x41, 0x4c, 0x41, 0x4e,
+	0x43, 0x48, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x4d, 0x41, 0x4e, 0x10, 0x03, 0x32, 0xf3,
+	0x0f, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x55, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6e, 0x74, 0x69, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65,
 	0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x41, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x41, 0x6e, 0x63,
-	0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41,
-	0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x41,
-	0x70, 0x70, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x41, 0x70, 0x70, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x47, 0x6f, 0x73,
-	0x73, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a,
-	0x0a, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x70,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72,
-	0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x05, 0x43, 0x68,
-	0x69, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x69, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x43, 0x68, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46,
-	0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74,
-	0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72,
-	0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x6a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x19, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x12, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x41, 0x6e,
+	0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
 	0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65,
-	0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x6a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x03, 0x47,
-	0x65, 0x74, 0x12, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x50,
-	0x65, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x50, 0x65, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x04, 0x50, 0x69,
-	0x6e, 0x67, 0x12, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a,
-	0x04, 0x50, 0x6f, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6f,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x50, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x40, 0x0a, 0x09, 0x50, 0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x2e,
-	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50,
-	0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x03, 0x50, 0x75, 0x74, 0x12, 0x11, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x72,
-	0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x75, 0x73, 0x74, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
-	0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x10,
+	0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64,
+	0x12, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x40, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x47, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x47, 0x6f,
+	0x73, 0x73, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b,
+	0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41,
+	0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x05, 0x43, 0x68, 0x69, 0x74, 0x73, 0x12, 0x13, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65,
+	0x72, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1a,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e,
+	0x74, 0x69, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e,
+	0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x11, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x04, 0x50, 0x6f, 0x6e, 0x67, 0x12,
+	0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x50, 0x75,
+	0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x50, 0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09,
+	0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e,
+	0x0a, 0x03, 0x50, 0x75, 0x74, 0x12, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61,
+	0x0a, 0x14, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74,
+	0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3a, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x46, 0x0a, 0x0b, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x52, 0x65, 0x61, 0x64, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x50, 0x61, 0x72,
+	0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3715,105 +6819,229 @@ func file_rpcpb_message_proto_rawDescGZIP() []byte {
 	return file_rpcpb_message_proto_rawDescData
 }
 
-var file_rpcpb_message_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_rpcpb_message_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_rpcpb_message_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_rpcpb_message_proto_goTypes = []interface{}{
-	(*AcceptedFrontierRequest)(nil),         // 0: rpcpb.AcceptedFrontierRequest
-	(*AcceptedFrontierResponse)(nil),        // 1: rpcpb.AcceptedFrontierResponse
-	(*AcceptedStateSummaryRequest)(nil),     // 2: rpcpb.AcceptedStateSummaryRequest
-	(*AcceptedStateSummaryResponse)(nil),    // 3: rpcpb.AcceptedStateSummaryResponse
-	(*AcceptedRequest)(nil),                 // 4: rpcpb.AcceptedRequest
-	(*AcceptedResponse)(nil),                // 5: rpcpb.AcceptedResponse
-	(*AncestorsRequest)(nil),                // 6: rpcpb.AncestorsRequest
-	(*AncestorsResponse)(nil),               // 7: rpcpb.AncestorsResponse
-	(*AppGossipRequest)(nil),                // 8: rpcpb.AppGossipRequest
-	(*AppGossipResponse)(nil),               // 9: rpcpb.AppGossipResponse
-	(*AppRequestRequest)(nil),               // 10: rpcpb.AppRequestRequest
-	(*AppRequestResponse)(nil),              // 11: rpcpb.AppRequestResponse
-	(*AppResponseRequest)(nil),              // 12: rpcpb.AppResponseRequest
-	(*AppResponseResponse)(nil),             // 13: rpcpb.AppResponseResponse
-	(*ChitsRequest)(nil),                    // 14: rpcpb.ChitsRequest
-	(*ChitsResponse)(nil),                   // 15: rpcpb.ChitsResponse
-	(*GetAcceptedFrontierRequest)(nil),      // 16: rpcpb.GetAcceptedFrontierRequest
-	(*GetAcceptedFrontierResponse)(nil),     // 17: rpcpb.GetAcceptedFrontierResponse
-	(*GetAcceptedStateSummaryRequest)(nil),  // 18: rpcpb.GetAcceptedStateSummaryRequest
-	(*GetAcceptedStateSummaryResponse)(nil), // 19: rpcpb.GetAcceptedStateSummaryResponse
-	(*GetAcceptedRequest)(nil),              // 20: rpcpb.GetAcceptedRequest
-	(*GetAcceptedResponse)(nil),             // 21: rpcpb.GetAcceptedResponse
-	(*GetAncestorsRequest)(nil),             // 22: rpcpb.GetAncestorsRequest
-	(*GetAncestorsResponse)(nil),            // 23: rpcpb.GetAncestorsResponse
-	(*GetStateSummaryFrontierRequest)(nil),  // 24: rpcpb.GetStateSummaryFrontierRequest
-	(*GetStateSummaryFrontierResponse)(nil), // 25: rpcpb.GetStateSummaryFrontierResponse
-	(*GetRequest)(nil),                      // 26: rpcpb.GetRequest
-	(*GetResponse)(nil),                     // 27: rpcpb.GetResponse
-	(*PeerlistRequest)(nil),                 // 28: rpcpb.PeerlistRequest
-	(*Peer)(nil),                            // 29: rpcpb.Peer
-	(*PeerlistResponse)(nil),                // 30: rpcpb.PeerlistResponse
-	(*PingRequest)(nil),                     // 31: rpcpb.PingRequest
-	(*PingResponse)(nil),                    // 32: rpcpb.PingResponse
-	(*PongRequest)(nil),                     // 33: rpcpb.PongRequest
-	(*PongResponse)(nil),                    // 34: rpcpb.PongResponse
-	(*PullQueryRequest)(nil),                // 35: rpcpb.PullQueryRequest
-	(*PullQueryResponse)(nil),               // 36: rpcpb.PullQueryResponse
-	(*PushQueryRequest)(nil),                // 37: rpcpb.PushQueryRequest
-	(*PushQueryResponse)(nil),               // 38: rpcpb.PushQueryResponse
-	(*PutRequest)(nil),                      // 39: rpcpb.PutRequest
-	(*PutResponse)(nil),                     // 40: rpcpb.PutResponse
-	(*StateSummaryFrontierRequest)(nil),     // 41: rpcpb.StateSummaryFrontierRequest
-	(*StateSummaryFrontierResponse)(nil),    // 42: rpcpb.StateSummaryFrontierResponse
-	(*VersionRequest)(nil),                  // 43: rpcpb.VersionRequest
-	(*VersionResponse)(nil),                 // 44: rpcpb.VersionResponse
+	(CompressionType)(0),                    // 0: rpcpb.CompressionType
+	(CompressionFlag)(0),                    // 1: rpcpb.CompressionFlag
+	(EngineType)(0),                         // 2: rpcpb.EngineType
+	(*CompressionStats)(nil),                // 3: rpcpb.CompressionStats
+	(*AcceptedFrontierRequest)(nil),         // 4: rpcpb.AcceptedFrontierRequest
+	(*AcceptedFrontierResponse)(nil),        // 5: rpcpb.AcceptedFrontierResponse
+	(*AcceptedStateSummaryRequest)(nil),     // 6: rpcpb.AcceptedStateSummaryRequest
+	(*AcceptedStateSummaryResponse)(nil),    // 7: rpcpb.AcceptedStateSummaryResponse
+	(*AcceptedRequest)(nil),                 // 8: rpcpb.AcceptedRequest
+	(*AcceptedResponse)(nil),                // 9: rpcpb.AcceptedResponse
+	(*AncestorsRequest)(nil),                // 10: rpcpb.AncestorsRequest
+	(*AncestorsResponse)(nil),               // 11: rpcpb.AncestorsResponse
+	(*AncestorsChunk)(nil),                  // 12: rpcpb.AncestorsChunk
+	(*AppGossipRequest)(nil),                // 13: rpcpb.AppGossipRequest
+	(*AppGossipResponse)(nil),               // 14: rpcpb.AppGossipResponse
+	(*AppRequestRequest)(nil),               // 15: rpcpb.AppRequestRequest
+	(*AppRequestResponse)(nil),              // 16: rpcpb.AppRequestResponse
+	(*AppResponseRequest)(nil),              // 17: rpcpb.AppResponseRequest
+	(*AppResponseResponse)(nil),             // 18: rpcpb.AppResponseResponse
+	(*ChitsRequest)(nil),                    // 19: rpcpb.ChitsRequest
+	(*ChitsResponse)(nil),                   // 20: rpcpb.ChitsResponse
+	(*GetAcceptedFrontierRequest)(nil),      // 21: rpcpb.GetAcceptedFrontierRequest
+	(*GetAcceptedFrontierResponse)(nil),     // 22: rpcpb.GetAcceptedFrontierResponse
+	(*GetAcceptedStateSummaryRequest)(nil),  // 23: rpcpb.GetAcceptedStateSummaryRequest
+	(*GetAcceptedStateSummaryResponse)(nil), // 24: rpcpb.GetAcceptedStateSummaryResponse
+	(*GetAcceptedRequest)(nil),              // 25: rpcpb.GetAcceptedRequest
+	(*GetAcceptedResponse)(nil),             // 26: rpcpb.GetAcceptedResponse
+	(*GetAncestorsRequest)(nil),             // 27: rpcpb.GetAncestorsRequest
+	(*GetAncestorsResponse)(nil),            // 28: rpcpb.GetAncestorsResponse
+	(*GetStateSummaryFrontierRequest)(nil),  // 29: rpcpb.GetStateSummaryFrontierRequest
+	(*GetStateSummaryFrontierResponse)(nil), // 30: rpcpb.GetStateSummaryFrontierResponse
+	(*GetRequest)(nil),                      // 31: rpcpb.GetRequest
+	(*GetResponse)(nil),                     // 32: rpcpb.GetResponse
+	(*PeerlistRequest)(nil),                 // 33: rpcpb.PeerlistRequest
+	(*Peer)(nil),                            // 34: rpcpb.Peer
+	(*PeerlistResponse)(nil),                // 35: rpcpb.PeerlistResponse
+	(*PingRequest)(nil),                     // 36: rpcpb.PingRequest
+	(*PingResponse)(nil),                    // 37: rpcpb.PingResponse
+	(*PongRequest)(nil),                     // 38: rpcpb.PongRequest
+	(*PongResponse)(nil),                    // 39: rpcpb.PongResponse
+	(*PullQueryRequest)(nil),                // 40: rpcpb.PullQueryRequest
+	(*PullQueryResponse)(nil),               // 41: rpcpb.PullQueryResponse
+	(*PushQueryRequest)(nil),                // 42: rpcpb.PushQueryRequest
+	(*PushQueryResponse)(nil),               // 43: rpcpb.PushQueryResponse
+	(*PutRequest)(nil),                      // 44: rpcpb.PutRequest
+	(*PutResponse)(nil),                     // 45: rpcpb.PutResponse
+	(*StateSummaryFrontierRequest)(nil),     // 46: rpcpb.StateSummaryFrontierRequest
+	(*StateSummaryFrontierResponse)(nil),    // 47: rpcpb.StateSummaryFrontierResponse
+	(*VerifyStreamRequest)(nil),             // 48: rpcpb.VerifyStreamRequest
+	(*VerifyStreamResult)(nil),              // 49: rpcpb.VerifyStreamResult
+	(*BatchVerifyRequest)(nil),              // 50: rpcpb.BatchVerifyRequest
+	(*BatchVerifyItem)(nil),                 // 51: rpcpb.BatchVerifyItem
+	(*BatchVerifyResponse)(nil),             // 52: rpcpb.BatchVerifyResponse
+	(*BatchVerifyResult)(nil),               // 53: rpcpb.BatchVerifyResult
+	(*WriteFrameRequest)(nil),               // 54: rpcpb.WriteFrameRequest
+	(*WriteFrameResponse)(nil),              // 55: rpcpb.WriteFrameResponse
+	(*ReadFrameRequest)(nil),                // 56: rpcpb.ReadFrameRequest
+	(*ReadFrameResponse)(nil),               // 57: rpcpb.ReadFrameResponse
+	(*ParseMessageRequest)(nil),             // 58: rpcpb.ParseMessageRequest
+	(*ParseMessageResponse)(nil),            // 59: rpcpb.ParseMessageResponse
+	(*VersionRequest)(nil),                  // 60: rpcpb.VersionRequest
+	(*VersionResponse)(nil),                 // 61: rpcpb.VersionResponse
+	(*Diff)(nil),                            // 62: rpcpb.Diff
+	(*anypb.Any)(nil),                       // 63: google.protobuf.Any
 }
 var file_rpcpb_message_proto_depIdxs = []int32{
-	29, // 0: rpcpb.PeerlistRequest.peers:type_name -> rpcpb.Peer
-	0,  // 1: rpcpb.MessageService.AcceptedFrontier:input_type -> rpcpb.AcceptedFrontierRequest
-	2,  // 2: rpcpb.MessageService.AcceptedStateSummary:input_type -> rpcpb.AcceptedStateSummaryRequest
-	4,  // 3: rpcpb.MessageService.Accepted:input_type -> rpcpb.AcceptedRequest
-	6,  // 4: rpcpb.MessageService.Ancestors:input_type -> rpcpb.AncestorsRequest
-	8,  // 5: rpcpb.MessageService.AppGossip:input_type -> rpcpb.AppGossipRequest
-	10, // 6: rpcpb.MessageService.AppRequest:input_type -> rpcpb.AppRequestRequest
-	12, // 7: rpcpb.MessageService.AppResponse:input_type -> rpcpb.AppResponseRequest
-	14, // 8: rpcpb.MessageService.Chits:input_type -> rpcpb.ChitsRequest
-	16, // 9: rpcpb.MessageService.GetAcceptedFrontier:input_type -> rpcpb.GetAcceptedFrontierRequest
-	18, // 10: rpcpb.MessageService.GetAcceptedStateSummary:input_type -> rpcpb.GetAcceptedStateSummaryRequest
-	20, // 11: rpcpb.MessageService.GetAccepted:input_type -> rpcpb.GetAcceptedRequest
-	22, // 12: rpcpb.MessageService.GetAncestors:input_type -> rpcpb.GetAncestorsRequest
-	24, // 13: rpcpb.MessageService.GetStateSummaryFrontier:input_type -> rpcpb.GetStateSummaryFrontierRequest
-	26, // 14: rpcpb.MessageService.Get:input_type -> rpcpb.GetRequest
-	28, // 15: rpcpb.MessageService.Peerlist:input_type -> rpcpb.PeerlistRequest
-	31, // 16: rpcpb.MessageService.Ping:input_type -> rpcpb.PingRequest
-	33, // 17: rpcpb.MessageService.Pong:input_type -> rpcpb.PongRequest
-	35, // 18: rpcpb.MessageService.PullQuery:input_type -> rpcpb.PullQueryRequest
-	37, // 19: rpcpb.MessageService.PushQuery:input_type -> rpcpb.PushQueryRequest
-	39, // 20: rpcpb.MessageService.Put:input_type -> rpcpb.PutRequest
-	41, // 21: rpcpb.MessageService.StateSummaryFrontier:input_type -> rpcpb.StateSummaryFrontierRequest
-	43, // 22: rpcpb.MessageService.Version:input_type -> rpcpb.VersionRequest
-	1,  // 23: rpcpb.MessageService.AcceptedFrontier:output_type -> rpcpb.AcceptedFrontierResponse
-	3,  // 24: rpcpb.MessageService.AcceptedStateSummary:output_type -> rpcpb.AcceptedStateSummaryResponse
-	5,  // 25: rpcpb.MessageService.Accepted:output_type -> rpcpb.AcceptedResponse
-	7,  // 26: rpcpb.MessageService.Ancestors:output_type -> rpcpb.AncestorsResponse
-	9,  // 27: rpcpb.MessageService.AppGossip:output_type -> rpcpb.AppGossipResponse
-	11, // 28: rpcpb.MessageService.AppRequest:output_type -> rpcpb.AppRequestResponse
-	13, // 29: rpcpb.MessageService.AppResponse:output_type -> rpcpb.AppResponseResponse
-	15, // 30: rpcpb.MessageService.Chits:output_type -> rpcpb.ChitsResponse
-	17, // 31: rpcpb.MessageService.GetAcceptedFrontier:output_type -> rpcpb.GetAcceptedFrontierResponse
-	19, // 32: rpcpb.MessageService.GetAcceptedStateSummary:output_type -> rpcpb.GetAcceptedStateSummaryResponse
-	21, // 33: rpcpb.MessageService.GetAccepted:output_type -> rpcpb.GetAcceptedResponse
-	23, // 34: rpcpb.MessageService.GetAncestors:output_type -> rpcpb.GetAncestorsResponse
-	25, // 35: rpcpb.MessageService.GetStateSummaryFrontier:output_type -> rpcpb.GetStateSummaryFrontierResponse
-	27, // 36: rpcpb.MessageService.Get:output_type -> rpcpb.GetResponse
-	30, // 37: rpcpb.MessageService.Peerlist:output_type -> rpcpb.PeerlistResponse
-	32, // 38: rpcpb.MessageService.Ping:output_type -> rpcpb.PingResponse
-	34, // 39: rpcpb.MessageService.Pong:output_type -> rpcpb.PongResponse
-	36, // 40: rpcpb.MessageService.PullQuery:output_type -> rpcpb.PullQueryResponse
-	38, // 41: rpcpb.MessageService.PushQuery:output_type -> rpcpb.PushQueryResponse
-	40, // 42: rpcpb.MessageService.Put:output_type -> rpcpb.PutResponse
-	42, // 43: rpcpb.MessageService.StateSummaryFrontier:output_type -> rpcpb.StateSummaryFrontierResponse
-	44, // 44: rpcpb.MessageService.Version:output_type -> rpcpb.VersionResponse
-	23, // [23:45] is the sub-list for method output_type
-	1,  // [1:23] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	62,  // 0: rpcpb.AcceptedFrontierResponse.diff:type_name -> rpcpb.Diff
+	0,   // 1: rpcpb.AcceptedStateSummaryRequest.compression_type:type_name -> rpcpb.CompressionType
+	3,   // 2: rpcpb.AcceptedStateSummaryResponse.compression_stats:type_name -> rpcpb.CompressionStats
+	1,   // 3: rpcpb.AcceptedStateSummaryResponse.compression_flag:type_name -> rpcpb.CompressionFlag
+	62,  // 4: rpcpb.AcceptedStateSummaryResponse.diff:type_name -> rpcpb.Diff
+	62,  // 5: rpcpb.AcceptedResponse.diff:type_name -> rpcpb.Diff
+	0,   // 6: rpcpb.AncestorsRequest.compression_type:type_name -> rpcpb.CompressionType
+	3,   // 7: rpcpb.AncestorsResponse.compression_stats:type_name -> rpcpb.CompressionStats
+	1,   // 8: rpcpb.AncestorsResponse.compression_flag:type_name -> rpcpb.CompressionFlag
+	62,  // 9: rpcpb.AncestorsResponse.diff:type_name -> rpcpb.Diff
+	0,   // 10: rpcpb.AncestorsChunk.compression_type:type_name -> rpcpb.CompressionType
+	0,   // 11: rpcpb.AppGossipRequest.compression_type:type_name -> rpcpb.CompressionType
+	3,   // 12: rpcpb.AppGossipResponse.compression_stats:type_name -> rpcpb.CompressionStats
+	1,   // 13: rpcpb.AppGossipResponse.compression_flag:type_name -> rpcpb.CompressionFlag
+	62,  // 14: rpcpb.AppGossipResponse.diff:type_name -> rpcpb.Diff
+	0,   // 15: rpcpb.AppRequestRequest.compression_type:type_name -> rpcpb.CompressionType
+	3,   // 16: rpcpb.AppRequestResponse.compression_stats:type_name -> rpcpb.CompressionStats
+	1,   // 17: rpcpb.AppRequestResponse.compression_flag:type_name -> rpcpb.CompressionFlag
+	62,  // 18: rpcpb.AppRequestResponse.diff:type_name -> rpcpb.Diff
+	0,   // 19: rpcpb.AppResponseRequest.compression_type:type_name -> rpcpb.CompressionType
+	3,   // 20: rpcpb.AppResponseResponse.compression_stats:type_name -> rpcpb.CompressionStats
+	1,   // 21: rpcpb.AppResponseResponse.compression_flag:type_name -> rpcpb.CompressionFlag
+	62,  // 22: rpcpb.AppResponseResponse.diff:type_name -> rpcpb.Diff
+	62,  // 23: rpcpb.ChitsResponse.diff:type_name -> rpcpb.Diff
+	2,   // 24: rpcpb.GetAcceptedFrontierRequest.engine_type:type_name -> rpcpb.EngineType
+	62,  // 25: rpcpb.GetAcceptedFrontierResponse.diff:type_name -> rpcpb.Diff
+	0,   // 26: rpcpb.GetAcceptedStateSummaryRequest.compression_type:type_name -> rpcpb.CompressionType
+	3,   // 27: rpcpb.GetAcceptedStateSummaryResponse.compression_stats:type_name -> rpcpb.CompressionStats
+	1,   // 28: rpcpb.GetAcceptedStateSummaryResponse.compression_flag:type_name -> rpcpb.CompressionFlag
+	62,  // 29: rpcpb.GetAcceptedStateSummaryResponse.diff:type_name -> rpcpb.Diff
+	2,   // 30: rpcpb.GetAcceptedRequest.engine_type:type_name -> rpcpb.EngineType
+	62,  // 31: rpcpb.GetAcceptedResponse.diff:type_name -> rpcpb.Diff
+	2,   // 32: rpcpb.GetAncestorsRequest.engine_type:type_name -> rpcpb.EngineType
+	62,  // 33: rpcpb.GetAncestorsResponse.diff:type_name -> rpcpb.Diff
+	62,  // 34: rpcpb.GetStateSummaryFrontierResponse.diff:type_name -> rpcpb.Diff
+	2,   // 35: rpcpb.GetRequest.engine_type:type_name -> rpcpb.EngineType
+	62,  // 36: rpcpb.GetResponse.diff:type_name -> rpcpb.Diff
+	34,  // 37: rpcpb.PeerlistRequest.peers:type_name -> rpcpb.Peer
+	0,   // 38: rpcpb.PeerlistRequest.compression_type:type_name -> rpcpb.CompressionType
+	3,   // 39: rpcpb.PeerlistResponse.compression_stats:type_name -> rpcpb.CompressionStats
+	1,   // 40: rpcpb.PeerlistResponse.compression_flag:type_name -> rpcpb.CompressionFlag
+	62,  // 41: rpcpb.PeerlistResponse.diff:type_name -> rpcpb.Diff
+	62,  // 42: rpcpb.PingResponse.diff:type_name -> rpcpb.Diff
+	62,  // 43: rpcpb.PongResponse.diff:type_name -> rpcpb.Diff
+	2,   // 44: rpcpb.PullQueryRequest.engine_type:type_name -> rpcpb.EngineType
+	62,  // 45: rpcpb.PullQueryResponse.diff:type_name -> rpcpb.Diff
+	2,   // 46: rpcpb.PushQueryRequest.engine_type:type_name -> rpcpb.EngineType
+	0,   // 47: rpcpb.PushQueryRequest.compression_type:type_name -> rpcpb.CompressionType
+	3,   // 48: rpcpb.PushQueryResponse.compression_stats:type_name -> rpcpb.CompressionStats
+	1,   // 49: rpcpb.PushQueryResponse.compression_flag:type_name -> rpcpb.CompressionFlag
+	62,  // 50: rpcpb.PushQueryResponse.diff:type_name -> rpcpb.Diff
+	2,   // 51: rpcpb.PutRequest.engine_type:type_name -> rpcpb.EngineType
+	0,   // 52: rpcpb.PutRequest.compression_type:type_name -> rpcpb.CompressionType
+	3,   // 53: rpcpb.PutResponse.compression_stats:type_name -> rpcpb.CompressionStats
+	1,   // 54: rpcpb.PutResponse.compression_flag:type_name -> rpcpb.CompressionFlag
+	62,  // 55: rpcpb.PutResponse.diff:type_name -> rpcpb.Diff
+	0,   // 56: rpcpb.StateSummaryFrontierRequest.compression_type:type_name -> rpcpb.CompressionType
+	3,   // 57: rpcpb.StateSummaryFrontierResponse.compression_stats:type_name -> rpcpb.CompressionStats
+	1,   // 58: rpcpb.StateSummaryFrontierResponse.compression_flag:type_name -> rpcpb.CompressionFlag
+	62,  // 59: rpcpb.StateSummaryFrontierResponse.diff:type_name -> rpcpb.Diff
+	63,  // 60: rpcpb.VerifyStreamRequest.request:type_name -> google.protobuf.Any
+	63,  // 61: rpcpb.VerifyStreamResult.response:type_name -> google.protobuf.Any
+	51,  // 62: rpcpb.BatchVerifyRequest.items:type_name -> rpcpb.BatchVerifyItem
+	4,   // 63: rpcpb.BatchVerifyItem.accepted_frontier:type_name -> rpcpb.AcceptedFrontierRequest
+	6,   // 64: rpcpb.BatchVerifyItem.accepted_state_summary:type_name -> rpcpb.AcceptedStateSummaryRequest
+	8,   // 65: rpcpb.BatchVerifyItem.accepted:type_name -> rpcpb.AcceptedRequest
+	10,  // 66: rpcpb.BatchVerifyItem.ancestors:type_name -> rpcpb.AncestorsRequest
+	13,  // 67: rpcpb.BatchVerifyItem.app_gossip:type_name -> rpcpb.AppGossipRequest
+	15,  // 68: rpcpb.BatchVerifyItem.app_request:type_name -> rpcpb.AppRequestRequest
+	17,  // 69: rpcpb.BatchVerifyItem.app_response:type_name -> rpcpb.AppResponseRequest
+	19,  // 70: rpcpb.BatchVerifyItem.chits:type_name -> rpcpb.ChitsRequest
+	21,  // 71: rpcpb.BatchVerifyItem.get_accepted_frontier:type_name -> rpcpb.GetAcceptedFrontierRequest
+	23,  // 72: rpcpb.BatchVerifyItem.get_accepted_state_summary:type_name -> rpcpb.GetAcceptedStateSummaryRequest
+	25,  // 73: rpcpb.BatchVerifyItem.get_accepted:type_name -> rpcpb.GetAcceptedRequest
+	27,  // 74: rpcpb.BatchVerifyItem.get_ancestors:type_name -> rpcpb.GetAncestorsRequest
+	29,  // 75: rpcpb.BatchVerifyItem.get_state_summary_frontier:type_name -> rpcpb.GetStateSummaryFrontierRequest
+	31,  // 76: rpcpb.BatchVerifyItem.get:type_name -> rpcpb.GetRequest
+	33,  // 77: rpcpb.BatchVerifyItem.peerlist:type_name -> rpcpb.PeerlistRequest
+	36,  // 78: rpcpb.BatchVerifyItem.ping:type_name -> rpcpb.PingRequest
+	38,  // 79: rpcpb.BatchVerifyItem.pong:type_name -> rpcpb.PongRequest
+	40,  // 80: rpcpb.BatchVerifyItem.pull_query:type_name -> rpcpb.PullQueryRequest
+	42,  // 81: rpcpb.BatchVerifyItem.push_query:type_name -> rpcpb.PushQueryRequest
+	44,  // 82: rpcpb.BatchVerifyItem.put:type_name -> rpcpb.PutRequest
+	46,  // 83: rpcpb.BatchVerifyItem.state_summary_frontier:type_name -> rpcpb.StateSummaryFrontierRequest
+	60,  // 84: rpcpb.BatchVerifyItem.version:type_name -> rpcpb.VersionRequest
+	53,  // 85: rpcpb.BatchVerifyResponse.results:type_name -> rpcpb.BatchVerifyResult
+	63,  // 86: rpcpb.BatchVerifyResult.response:type_name -> google.protobuf.Any
+	62,  // 87: rpcpb.WriteFrameResponse.diff:type_name -> rpcpb.Diff
+	62,  // 88: rpcpb.ReadFrameResponse.diff:type_name -> rpcpb.Diff
+	63,  // 89: rpcpb.ParseMessageRequest.parsed:type_name -> google.protobuf.Any
+	63,  // 90: rpcpb.ParseMessageResponse.expected_parsed:type_name -> google.protobuf.Any
+	0,   // 91: rpcpb.ParseMessageResponse.expected_compression_type:type_name -> rpcpb.CompressionType
+	62,  // 92: rpcpb.VersionResponse.diff:type_name -> rpcpb.Diff
+	4,   // 93: rpcpb.MessageService.AcceptedFrontier:input_type -> rpcpb.AcceptedFrontierRequest
+	6,   // 94: rpcpb.MessageService.AcceptedStateSummary:input_type -> rpcpb.AcceptedStateSummaryRequest
+	8,   // 95: rpcpb.MessageService.Accepted:input_type -> rpcpb.AcceptedRequest
+	10,  // 96: rpcpb.MessageService.Ancestors:input_type -> rpcpb.AncestorsRequest
+	12,  // 97: rpcpb.MessageService.AncestorsChunked:input_type -> rpcpb.AncestorsChunk
+	13,  // 98: rpcpb.MessageService.AppGossip:input_type -> rpcpb.AppGossipRequest
+	15,  // 99: rpcpb.MessageService.AppRequest:input_type -> rpcpb.AppRequestRequest
+	17,  // 100: rpcpb.MessageService.AppResponse:input_type -> rpcpb.AppResponseRequest
+	19,  // 101: rpcpb.MessageService.Chits:input_type -> rpcpb.ChitsRequest
+	21,  // 102: rpcpb.MessageService.GetAcceptedFrontier:input_type -> rpcpb.GetAcceptedFrontierRequest
+	23,  // 103: rpcpb.MessageService.GetAcceptedStateSummary:input_type -> rpcpb.GetAcceptedStateSummaryRequest
+	25,  // 104: rpcpb.MessageService.GetAccepted:input_type -> rpcpb.GetAcceptedRequest
+	27,  // 105: rpcpb.MessageService.GetAncestors:input_type -> rpcpb.GetAncestorsRequest
+	29,  // 106: rpcpb.MessageService.GetStateSummaryFrontier:input_type -> rpcpb.GetStateSummaryFrontierRequest
+	31,  // 107: rpcpb.MessageService.Get:input_type -> rpcpb.GetRequest
+	33,  // 108: rpcpb.MessageService.Peerlist:input_type -> rpcpb.PeerlistRequest
+	36,  // 109: rpcpb.MessageService.Ping:input_type -> rpcpb.PingRequest
+	38,  // 110: rpcpb.MessageService.Pong:input_type -> rpcpb.PongRequest
+	40,  // 111: rpcpb.MessageService.PullQuery:input_type -> rpcpb.PullQueryRequest
+	42,  // 112: rpcpb.MessageService.PushQuery:input_type -> rpcpb.PushQueryRequest
+	44,  // 113: rpcpb.MessageService.Put:input_type -> rpcpb.PutRequest
+	46,  // 114: rpcpb.MessageService.StateSummaryFrontier:input_type -> rpcpb.StateSummaryFrontierRequest
+	60,  // 115: rpcpb.MessageService.Version:input_type -> rpcpb.VersionRequest
+	48,  // 116: rpcpb.MessageService.VerifyStream:input_type -> rpcpb.VerifyStreamRequest
+	50,  // 117: rpcpb.MessageService.BatchVerify:input_type -> rpcpb.BatchVerifyRequest
+	54,  // 118: rpcpb.MessageService.WriteFrame:input_type -> rpcpb.WriteFrameRequest
+	56,  // 119: rpcpb.MessageService.ReadFrame:input_type -> rpcpb.ReadFrameRequest
+	58,  // 120: rpcpb.MessageService.ParseMessage:input_type -> rpcpb.ParseMessageRequest
+	5,   // 121: rpcpb.MessageService.AcceptedFrontier:output_type -> rpcpb.AcceptedFrontierResponse
+	7,   // 122: rpcpb.MessageService.AcceptedStateSummary:output_type -> rpcpb.AcceptedStateSummaryResponse
+	9,   // 123: rpcpb.MessageService.Accepted:output_type -> rpcpb.AcceptedResponse
+	11,  // 124: rpcpb.MessageService.Ancestors:output_type -> rpcpb.AncestorsResponse
+	11,  // 125: rpcpb.MessageService.AncestorsChunked:output_type -> rpcpb.AncestorsResponse
+	14,  // 126: rpcpb.MessageService.AppGossip:output_type -> rpcpb.AppGossipResponse
+	16,  // 127: rpcpb.MessageService.AppRequest:output_type -> rpcpb.AppRequestResponse
+	18,  // 128: rpcpb.MessageService.AppResponse:output_type -> rpcpb.AppResponseResponse
+	20,  // 129: rpcpb.MessageService.Chits:output_type -> rpcpb.ChitsResponse
+	22,  // 130: rpcpb.MessageService.GetAcceptedFrontier:output_type -> rpcpb.GetAcceptedFrontierResponse
+	24,  // 131: rpcpb.MessageService.GetAcceptedStateSummary:output_type -> rpcpb.GetAcceptedStateSummaryResponse
+	26,  // 132: rpcpb.MessageService.GetAccepted:output_type -> rpcpb.GetAcceptedResponse
+	28,  // 133: rpcpb.MessageService.GetAncestors:output_type -> rpcpb.GetAncestorsResponse
+	30,  // 134: rpcpb.MessageService.GetStateSummaryFrontier:output_type -> rpcpb.GetStateSummaryFrontierResponse
+	32,  // 135: rpcpb.MessageService.Get:output_type -> rpcpb.GetResponse
+	35,  // 136: rpcpb.MessageService.Peerlist:output_type -> rpcpb.PeerlistResponse
+	37,  // 137: rpcpb.MessageService.Ping:output_type -> rpcpb.PingResponse
+	39,  // 138: rpcpb.MessageService.Pong:output_type -> rpcpb.PongResponse
+	41,  // 139: rpcpb.MessageService.PullQuery:output_type -> rpcpb.PullQueryResponse
+	43,  // 140: rpcpb.MessageService.PushQuery:output_type -> rpcpb.PushQueryResponse
+	45,  // 141: rpcpb.MessageService.Put:output_type -> rpcpb.PutResponse
+	47,  // 142: rpcpb.MessageService.StateSummaryFrontier:output_type -> rpcpb.StateSummaryFrontierResponse
+	61,  // 143: rpcpb.MessageService.Version:output_type -> rpcpb.VersionResponse
+	49,  // 144: rpcpb.MessageService.VerifyStream:output_type -> rpcpb.VerifyStreamResult
+	52,  // 145: rpcpb.MessageService.BatchVerify:output_type -> rpcpb.BatchVerifyResponse
+	55,  // 146: rpcpb.MessageService.WriteFrame:output_type -> rpcpb.WriteFrameResponse
+	57,  // 147: rpcpb.MessageService.ReadFrame:output_type -> rpcpb.ReadFrameResponse
+	59,  // 148: rpcpb.MessageService.ParseMessage:output_type -> rpcpb.ParseMessageResponse
+	121, // [121:149] is the sub-list for method output_type
+	93,  // [93:121] is the sub-list for method input_type
+	93,  // [93:93] is the sub-list for extension type_name
+	93,  // [93:93] is the sub-list for extension extendee
+	0,   // [0:93] is the sub-list for field type_name
 }
 
 func init() { file_rpcpb_message_proto_init() }
@@ -3821,9 +7049,10 @@ func file_rpcpb_message_proto_init() {
 	if File_rpcpb_message_proto != nil {
 		return
 	}
+	file_rpcpb_diff_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_rpcpb_message_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptedFrontierRequest); i {
+			switch v := v.(*CompressionStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3835,7 +7064,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptedFrontierResponse); i {
+			switch v := v.(*AcceptedFrontierRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3847,7 +7076,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptedStateSummaryRequest); i {
+			switch v := v.(*AcceptedFrontierResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3859,7 +7088,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptedStateSummaryResponse); i {
+			switch v := v.(*AcceptedStateSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3871,7 +7100,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptedRequest); i {
+			switch v := v.(*AcceptedStateSummaryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3883,7 +7112,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptedResponse); i {
+			switch v := v.(*AcceptedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3895,7 +7124,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AncestorsRequest); i {
+			switch v := v.(*AcceptedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3907,7 +7136,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AncestorsResponse); i {
+			switch v := v.(*AncestorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3919,7 +7148,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppGossipRequest); i {
+			switch v := v.(*AncestorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3931,7 +7160,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppGossipResponse); i {
+			switch v := v.(*AncestorsChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3943,7 +7172,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppRequestRequest); i {
+			switch v := v.(*AppGossipRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3955,7 +7184,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppRequestResponse); i {
+			switch v := v.(*AppGossipResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3967,7 +7196,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppResponseRequest); i {
+			switch v := v.(*AppRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3979,7 +7208,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppResponseResponse); i {
+			switch v := v.(*AppRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3991,7 +7220,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChitsRequest); i {
+			switch v := v.(*AppResponseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4003,7 +7232,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChitsResponse); i {
+			switch v := v.(*AppResponseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4015,7 +7244,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAcceptedFrontierRequest); i {
+			switch v := v.(*ChitsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4027,7 +7256,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAcceptedFrontierResponse); i {
+			switch v := v.(*ChitsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4039,7 +7268,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAcceptedStateSummaryRequest); i {
+			switch v := v.(*GetAcceptedFrontierRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4051,7 +7280,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAcceptedStateSummaryResponse); i {
+			switch v := v.(*GetAcceptedFrontierResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4063,7 +7292,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAcceptedRequest); i {
+			switch v := v.(*GetAcceptedStateSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4075,7 +7304,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAcceptedResponse); i {
+			switch v := v.(*GetAcceptedStateSummaryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4087,7 +7316,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAncestorsRequest); i {
+			switch v := v.(*GetAcceptedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4099,7 +7328,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAncestorsResponse); i {
+			switch v := v.(*GetAcceptedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4111,7 +7340,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStateSummaryFrontierRequest); i {
+			switch v := v.(*GetAncestorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4123,7 +7352,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStateSummaryFrontierResponse); i {
+			switch v := v.(*GetAncestorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4135,7 +7364,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRequest); i {
+			switch v := v.(*GetStateSummaryFrontierRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4147,7 +7376,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetResponse); i {
+			switch v := v.(*GetStateSummaryFrontierResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4159,7 +7388,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeerlistRequest); i {
+			switch v := v.(*GetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4171,7 +7400,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Peer); i {
+			switch v := v.(*GetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4183,7 +7412,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeerlistResponse); i {
+			switch v := v.(*PeerlistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4195,7 +7424,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingRequest); i {
+			switch v := v.(*Peer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4207,7 +7436,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+			switch v := v.(*PeerlistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4219,7 +7448,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PongRequest); i {
+			switch v := v.(*PingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4231,7 +7460,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PongResponse); i {
+			switch v := v.(*PingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4243,7 +7472,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullQueryRequest); i {
+			switch v := v.(*PongRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4255,7 +7484,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullQueryResponse); i {
+			switch v := v.(*PongResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4267,7 +7496,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushQueryRequest); i {
+			switch v := v.(*PullQueryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4279,7 +7508,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushQueryResponse); i {
+			switch v := v.(*PullQueryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4291,7 +7520,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutRequest); i {
+			switch v := v.(*PushQueryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4303,7 +7532,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutResponse); i {
+			switch v := v.(*PushQueryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4315,7 +7544,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateSummaryFrontierRequest); i {
+			switch v := v.(*PutRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4327,7 +7556,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StateSummaryFrontierResponse); i {
+			switch v := v.(*PutResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4339,7 +7568,7 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionRequest); i {
+			switch v := v.(*StateSummaryFrontierRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4351,6 +7580,174 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 		file_rpcpb_message_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateSummaryFrontierResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyStreamResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchVerifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchVerifyItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchVerifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchVerifyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteFrameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteFrameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadFrameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadFrameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_message_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VersionResponse); i {
 			case 0:
 				return &v.state
@@ -4363,18 +7760,43 @@ func file_rpcpb_message_proto_init() {
 			}
 		}
 	}
+	file_rpcpb_message_proto_msgTypes[48].OneofWrappers = []interface{}{
+		(*BatchVerifyItem_AcceptedFrontier)(nil),
+		(*BatchVerifyItem_AcceptedStateSummary)(nil),
+		(*BatchVerifyItem_Accepted)(nil),
+		(*BatchVerifyItem_Ancestors)(nil),
+		(*BatchVerifyItem_AppGossip)(nil),
+		(*BatchVerifyItem_AppRequest)(nil),
+		(*BatchVerifyItem_AppResponse)(nil),
+		(*BatchVerifyItem_Chits)(nil),
+		(*BatchVerifyItem_GetAcceptedFrontier_)(nil),
+		(*BatchVerifyItem_GetAcceptedStateSummary_)(nil),
+		(*BatchVerifyItem_GetAccepted_)(nil),
+		(*BatchVerifyItem_GetAncestors_)(nil),
+		(*BatchVerifyItem_GetStateSummaryFrontier)(nil),
+		(*BatchVerifyItem_Get)(nil),
+		(*BatchVerifyItem_Peerlist)(nil),
+		(*BatchVerifyItem_Ping)(nil),
+		(*BatchVerifyItem_Pong)(nil),
+		(*BatchVerifyItem_PullQuery)(nil),
+		(*BatchVerifyItem_PushQuery)(nil),
+		(*BatchVerifyItem_Put)(nil),
+		(*BatchVerifyItem_StateSummaryFrontier_)(nil),
+		(*BatchVerifyItem_Version)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpcpb_message_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   45,
+			NumEnums:      3,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_rpcpb_message_proto_goTypes,
 		DependencyIndexes: file_rpcpb_message_proto_depIdxs,
+		EnumInfos:         file_rpcpb_message_proto_enumTypes,
 		MessageInfos:      file_rpcpb_message_proto_msgTypes,
 	}.Build()
 	File_rpcpb_message_proto = out.File
@@ -23,6 +23,7 @@ const (
 	MessageService_AcceptedStateSummary_FullMethodName    = "/rpcpb.MessageService/AcceptedStateSummary"
 	MessageService_Accepted_FullMethodName                = "/rpcpb.MessageService/Accepted"
 	MessageService_Ancestors_FullMethodName               = "/rpcpb.MessageService/Ancestors"
+	MessageService_AncestorsChunked_FullMethodName        = "/rpcpb.MessageService/AncestorsChunked"
 	MessageService_AppGossip_FullMethodName               = "/rpcpb.MessageService/AppGossip"
 	MessageService_AppRequest_FullMethodName              = "/rpcpb.MessageService/AppRequest"
 	MessageService_AppResponse_FullMethodName             = "/rpcpb.MessageService/AppResponse"
@@ -41,6 +42,11 @@ const (
 	MessageService_Put_FullMethodName                     = "/rpcpb.MessageService/Put"
 	MessageService_StateSummaryFrontier_FullMethodName    = "/rpcpb.MessageService/StateSummaryFrontier"
 	MessageService_Version_FullMethodName                 = "/rpcpb.MessageService/Version"
+	MessageService_VerifyStream_FullMethodName            = "/rpcpb.MessageService/VerifyStream"
+	MessageService_BatchVerify_FullMethodName             = "/rpcpb.MessageService/BatchVerify"
+	MessageService_WriteFrame_FullMethodName              = "/rpcpb.MessageService/WriteFrame"
+	MessageService_ReadFrame_FullMethodName               = "/rpcpb.MessageService/ReadFrame"
+	MessageService_ParseMessage_FullMethodName            = "/rpcpb.MessageService/ParseMessage"
 )
 
 // MessageServiceClient is the client API for MessageService service.
@@ -51,6 +57,11 @@ type MessageServiceClient interface {
 	AcceptedStateSummary(ctx context.Context, in *AcceptedStateSummaryRequest, opts ...grpc.CallOption) (*AcceptedStateSummaryResponse, error)
 	Accepted(ctx context.Context, in *AcceptedRequest, opts ...grpc.CallOption) (*AcceptedResponse, error)
 	Ancestors(ctx context.Context, in *AncestorsRequest, opts ...grpc.CallOption) (*AncestorsResponse, error)
+	// AncestorsChunked is the client-streaming variant of Ancestors for
+	// container sets too large to send in a single gRPC message. Like the
+	// Ancestors avalanchego sends, they are bounded to 2000 containers and
+	// "constants.MaxContainersLen" bytes, length prefixes included.
+	AncestorsChunked(ctx context.Context, opts ...grpc.CallOption) (MessageService_AncestorsChunkedClient, error)
 	AppGossip(ctx context.Context, in *AppGossipRequest, opts ...grpc.CallOption) (*AppGossipResponse, error)
 	AppRequest(ctx context.Context, in *AppRequestRequest, opts ...grpc.CallOption) (*AppRequestResponse, error)
 	AppResponse(ctx context.Context, in *AppResponseRequest, opts ...grpc.CallOption) (*AppResponseResponse, error)
@@ -69,6 +80,29 @@ type MessageServiceClient interface {
 	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
 	StateSummaryFrontier(ctx context.Context, in *StateSummaryFrontierRequest, opts ...grpc.CallOption) (*StateSummaryFrontierResponse, error)
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	// VerifyStream verifies a stream of tagged MessageService requests,
+	// streaming back a result per request in the order received, so that
+	// clients can pipeline a whole corpus over a single stream.
+	VerifyStream(ctx context.Context, opts ...grpc.CallOption) (MessageService_VerifyStreamClient, error)
+	// BatchVerify verifies a batch of MessageService requests, for clients
+	// that cannot use VerifyStream, and returns a result per item in order.
+	// Items that cannot be verified (e.g., missing or invalid fields) do not
+	// fail the batch, but set the error of their result.
+	BatchVerify(ctx context.Context, in *BatchVerifyRequest, opts ...grpc.CallOption) (*BatchVerifyResponse, error)
+	// WriteFrame frames a payload as a peer writes it, so that framing can be
+	// checked independently of the message contents (e.g., empty payloads,
+	// or payloads of exactly the maximum message size).
+	// ref. "peer.writeMessages"
+	WriteFrame(ctx context.Context, in *WriteFrameRequest, opts ...grpc.CallOption) (*WriteFrameResponse, error)
+	// ReadFrame reads the first frame of a byte stream as a peer reads it
+	// (e.g., to check that oversized or truncated frames are rejected).
+	// ref. "peer.readMessages"
+	ReadFrame(ctx context.Context, in *ReadFrameRequest, opts ...grpc.CallOption) (*ReadFrameResponse, error)
+	// ParseMessage is the reverse of the verification requests above: it
+	// parses a framed message as an inbound message, and compares the fields
+	// with the ones parsed by the client.
+	// ref. "message.InboundMsgBuilder.Parse"
+	ParseMessage(ctx context.Context, in *ParseMessageRequest, opts ...grpc.CallOption) (*ParseMessageResponse, error)
 }
 
 type messageServiceClient struct {
@@ -115,6 +149,40 @@ func (c *messageServiceClient) Ancestors(ctx context.Context, in *AncestorsReque
 	return out, nil
 }
 
+func (c *messageServiceClient) AncestorsChunked(ctx context.Context, opts ...grpc.CallOption) (MessageService_AncestorsChunkedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MessageService_ServiceDesc.Streams[0], MessageService_AncestorsChunked_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &messageServiceAncestorsChunkedClient{stream}
+	return x, nil
+}
+
+type MessageService_AncestorsChunkedClient interface {
+	Send(*AncestorsChunk) error
+	CloseAndRecv() (*AncestorsResponse, error)
+	grpc.ClientStream
+}
+
+type messageServiceAncestorsChunkedClient struct {
+	grpc.ClientStream
+}
+
+func (x *messageServiceAncestorsChunkedClient) Send(m *AncestorsChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *messageServiceAncestorsChunkedClient) CloseAndRecv() (*AncestorsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(AncestorsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *messageServiceClient) AppGossip(ctx context.Context, in *AppGossipRequest, opts ...grpc.CallOption) (*AppGossipResponse, error) {
 	out := new(AppGossipResponse)
 	err := c.cc.Invoke(ctx, MessageService_AppGossip_FullMethodName, in, out, opts...)
@@ -277,6 +345,73 @@ func (c *messageServiceClient) Version(ctx context.Context, in *VersionRequest,
 	return out, nil
 }
 
+func (c *messageServiceClient) VerifyStream(ctx context.Context, opts ...grpc.CallOption) (MessageService_VerifyStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MessageService_ServiceDesc.Streams[1], MessageService_VerifyStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &messageServiceVerifyStreamClient{stream}
+	return x, nil
+}
+
+type MessageService_VerifyStreamClient interface {
+	Send(*VerifyStreamRequest) error
+	Recv() (*VerifyStreamResult, error)
+	grpc.ClientStream
+}
+
+type messageServiceVerifyStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *messageServiceVerifyStreamClient) Send(m *VerifyStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *messageServiceVerifyStreamClient) Recv() (*VerifyStreamResult, error) {
+	m := new(VerifyStreamResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *messageServiceClient) BatchVerify(ctx context.Context, in *BatchVerifyRequest, opts ...grpc.CallOption) (*BatchVerifyResponse, error) {
+	out := new(BatchVerifyResponse)
+	err := c.cc.Invoke(ctx, MessageService_BatchVerify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) WriteFrame(ctx context.Context, in *WriteFrameRequest, opts ...grpc.CallOption) (*WriteFrameResponse, error) {
+	out := new(WriteFrameResponse)
+	err := c.cc.Invoke(ctx, MessageService_WriteFrame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ReadFrame(ctx context.Context, in *ReadFrameRequest, opts ...grpc.CallOption) (*ReadFrameResponse, error) {
+	out := new(ReadFrameResponse)
+	err := c.cc.Invoke(ctx, MessageService_ReadFrame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ParseMessage(ctx context.Context, in *ParseMessageRequest, opts ...grpc.CallOption) (*ParseMessageResponse, error) {
+	out := new(ParseMessageResponse)
+	err := c.cc.Invoke(ctx, MessageService_ParseMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility
@@ -285,6 +420,11 @@ type MessageServiceServer interface {
 	AcceptedStateSummary(context.Context, *AcceptedStateSummaryRequest) (*AcceptedStateSummaryResponse, error)
 	Accepted(context.Context, *AcceptedRequest) (*AcceptedResponse, error)
 	Ancestors(context.Context, *AncestorsRequest) (*AncestorsResponse, error)
+	// AncestorsChunked is the client-streaming variant of Ancestors for
+	// container sets too large to send in a single gRPC message. Like the
+	// Ancestors avalanchego sends, they are bounded to 2000 containers and
+	// "constants.MaxContainersLen" bytes, length prefixes included.
+	AncestorsChunked(MessageService_AncestorsChunkedServer) error
 	AppGossip(context.Context, *AppGossipRequest) (*AppGossipResponse, error)
 	AppRequest(context.Context, *AppRequestRequest) (*AppRequestResponse, error)
 	AppResponse(context.Context, *AppResponseRequest) (*AppResponseResponse, error)
@@ -303,6 +443,29 @@ type MessageServiceServer interface {
 	Put(context.Context, *PutRequest) (*PutResponse, error)
 	StateSummaryFrontier(context.Context, *StateSummaryFrontierRequest) (*StateSummaryFrontierResponse, error)
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	// VerifyStream verifies a stream of tagged MessageService requests,
+	// streaming back a result per request in the order received, so that
+	// clients can pipeline a whole corpus over a single stream.
+	VerifyStream(MessageService_VerifyStreamServer) error
+	// BatchVerify verifies a batch of MessageService requests, for clients
+	// that cannot use VerifyStream, and returns a result per item in order.
+	// Items that cannot be verified (e.g., missing or invalid fields) do not
+	// fail the batch, but set the error of their result.
+	BatchVerify(context.Context, *BatchVerifyRequest) (*BatchVerifyResponse, error)
+	// WriteFrame frames a payload as a peer writes it, so that framing can be
+	// checked independently of the message contents (e.g., empty payloads,
+	// or payloads of exactly the maximum message size).
+	// ref. "peer.writeMessages"
+	WriteFrame(context.Context, *WriteFrameRequest) (*WriteFrameResponse, error)
+	// ReadFrame reads the first frame of a byte stream as a peer reads it
+	// (e.g., to check that oversized or truncated frames are rejected).
+	// ref. "peer.readMessages"
+	ReadFrame(context.Context, *ReadFrameRequest) (*ReadFrameResponse, error)
+	// ParseMessage is the reverse of the verification requests above: it
+	// parses a framed message as an inbound message, and compares the fields
+	// with the ones parsed by the client.
+	// ref. "message.InboundMsgBuilder.Parse"
+	ParseMessage(context.Context, *ParseMessageRequest) (*ParseMessageResponse, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -322,6 +485,9 @@ func (UnimplementedMessageServiceServer) Accepted(context.Context, *AcceptedRequ
 func (UnimplementedMessageServiceServer) Ancestors(context.Context, *AncestorsRequest) (*AncestorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ancestors not implemented")
 }
+func (UnimplementedMessageServiceServer) AncestorsChunked(MessageService_AncestorsChunkedServer) error {
+	return status.Errorf(codes.Unimplemented, "method AncestorsChunked not implemented")
+}
 func (UnimplementedMessageServiceServer) AppGossip(context.Context, *AppGossipRequest) (*AppGossipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppGossip not implemented")
 }
@@ -376,6 +542,21 @@ func (UnimplementedMessageServiceServer) StateSummaryFrontier(context.Context, *
 func (UnimplementedMessageServiceServer) Version(context.Context, *VersionRequest) (*VersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
+func (UnimplementedMessageServiceServer) VerifyStream(MessageService_VerifyStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method VerifyStream not implemented")
+}
+func (UnimplementedMessageServiceServer) BatchVerify(context.Context, *BatchVerifyRequest) (*BatchVerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchVerify not implemented")
+}
+func (UnimplementedMessageServiceServer) WriteFrame(context.Context, *WriteFrameRequest) (*WriteFrameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteFrame not implemented")
+}
+func (UnimplementedMessageServiceServer) ReadFrame(context.Context, *ReadFrameRequest) (*ReadFrameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadFrame not implemented")
+}
+func (UnimplementedMessageServiceServer) ParseMessage(context.Context, *ParseMessageRequest) (*ParseMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseMessage not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 
 // UnsafeMessageServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -461,6 +642,32 @@ func _MessageService_Ancestors_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_AncestorsChunked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MessageServiceServer).AncestorsChunked(&messageServiceAncestorsChunkedServer{stream})
+}
+
+type MessageService_AncestorsChunkedServer interface {
+	SendAndClose(*AncestorsResponse) error
+	Recv() (*AncestorsChunk, error)
+	grpc.ServerStream
+}
+
+type messageServiceAncestorsChunkedServer struct {
+	grpc.ServerStream
+}
+
+func (x *messageServiceAncestorsChunkedServer) SendAndClose(m *AncestorsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *messageServiceAncestorsChunkedServer) Recv() (*AncestorsChunk, error) {
+	m := new(AncestorsChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _MessageService_AppGossip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AppGossipRequest)
 	if err := dec(in); err != nil {
@@ -785,6 +992,104 @@ func _MessageService_Version_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_VerifyStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MessageServiceServer).VerifyStream(&messageServiceVerifyStreamServer{stream})
+}
+
+type MessageService_VerifyStreamServer interface {
+	Send(*VerifyStreamResult) error
+	Recv() (*VerifyStreamRequest, error)
+	grpc.ServerStream
+}
+
+type messageServiceVerifyStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *messageServiceVerifyStreamServer) Send(m *VerifyStreamResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *messageServiceVerifyStreamServer) Recv() (*VerifyStreamRequest, error) {
+	m := new(VerifyStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MessageService_BatchVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).BatchVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_BatchVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).BatchVerify(ctx, req.(*BatchVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_WriteFrame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteFrameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).WriteFrame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_WriteFrame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).WriteFrame(ctx, req.(*WriteFrameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ReadFrame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadFrameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ReadFrame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ReadFrame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ReadFrame(ctx, req.(*ReadFrameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ParseMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ParseMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ParseMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ParseMessage(ctx, req.(*ParseMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -880,7 +1185,35 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Version",
 			Handler:    _MessageService_Version_Handler,
 		},
+		{
+			MethodName: "BatchVerify",
+			Handler:    _MessageService_BatchVerify_Handler,
+		},
+		{
+			MethodName: "WriteFrame",
+			Handler:    _MessageService_WriteFrame_Handler,
+		},
+		{
+			MethodName: "ReadFrame",
+			Handler:    _MessageService_ReadFrame_Handler,
+		},
+		{
+			MethodName: "ParseMessage",
+			Handler:    _MessageService_ParseMessage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AncestorsChunked",
+			Handler:       _MessageService_AncestorsChunked_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "VerifyStream",
+			Handler:       _MessageService_VerifyStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpcpb/message.proto",
 }
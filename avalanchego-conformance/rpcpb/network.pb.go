@@ -0,0 +1,1520 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/network.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PeerPhase is the progress of the exchange with the client peer.
+type PeerPhase int32
+
+const (
+	PeerPhase_PEER_PHASE_UNSPECIFIED PeerPhase = 0
+	// Waiting for the client peer to connect.
+	PeerPhase_PEER_PHASE_LISTENING PeerPhase = 1
+	// TLS upgraded, waiting for the Version of the client peer.
+	PeerPhase_PEER_PHASE_CONNECTED PeerPhase = 2
+	// Version accepted, waiting for the PeerList finishing the handshake.
+	PeerPhase_PEER_PHASE_VERSION_RECEIVED PeerPhase = 3
+	// Handshake finished, waiting for the responses to the script (see
+	// DialPeer) and the pongs of the server pings.
+	PeerPhase_PEER_PHASE_HANDSHAKE_FINISHED PeerPhase = 4
+	// Every ping answered, after which the server closes the connection.
+	PeerPhase_PEER_PHASE_COMPLETED PeerPhase = 5
+	// Dialing the client peer (DialPeer).
+	PeerPhase_PEER_PHASE_DIALING PeerPhase = 6
+)
+
+// Enum value maps for PeerPhase.
+var (
+	PeerPhase_name = map[int32]string{
+		0: "PEER_PHASE_UNSPECIFIED",
+		1: "PEER_PHASE_LISTENING",
+		2: "PEER_PHASE_CONNECTED",
+		3: "PEER_PHASE_VERSION_RECEIVED",
+		4: "PEER_PHASE_HANDSHAKE_FINISHED",
+		5: "PEER_PHASE_COMPLETED",
+		6: "PEER_PHASE_DIALING",
+	}
+	PeerPhase_value = map[string]int32{
+		"PEER_PHASE_UNSPECIFIED":        0,
+		"PEER_PHASE_LISTENING":          1,
+		"PEER_PHASE_CONNECTED":          2,
+		"PEER_PHASE_VERSION_RECEIVED":   3,
+		"PEER_PHASE_HANDSHAKE_FINISHED": 4,
+		"PEER_PHASE_COMPLETED":          5,
+		"PEER_PHASE_DIALING":            6,
+	}
+)
+
+func (x PeerPhase) Enum() *PeerPhase {
+	p := new(PeerPhase)
+	*p = x
+	return p
+}
+
+func (x PeerPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_network_proto_enumTypes[0].Descriptor()
+}
+
+func (PeerPhase) Type() protoreflect.EnumType {
+	return &file_rpcpb_network_proto_enumTypes[0]
+}
+
+func (x PeerPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerPhase.Descriptor instead.
+func (PeerPhase) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{0}
+}
+
+type PeerDirection int32
+
+const (
+	PeerDirection_PEER_DIRECTION_UNSPECIFIED PeerDirection = 0
+	// Sent by the server peer.
+	PeerDirection_PEER_DIRECTION_SENT PeerDirection = 1
+	// Received from the client peer.
+	PeerDirection_PEER_DIRECTION_RECEIVED PeerDirection = 2
+)
+
+// Enum value maps for PeerDirection.
+var (
+	PeerDirection_name = map[int32]string{
+		0: "PEER_DIRECTION_UNSPECIFIED",
+		1: "PEER_DIRECTION_SENT",
+		2: "PEER_DIRECTION_RECEIVED",
+	}
+	PeerDirection_value = map[string]int32{
+		"PEER_DIRECTION_UNSPECIFIED": 0,
+		"PEER_DIRECTION_SENT":        1,
+		"PEER_DIRECTION_RECEIVED":    2,
+	}
+)
+
+func (x PeerDirection) Enum() *PeerDirection {
+	p := new(PeerDirection)
+	*p = x
+	return p
+}
+
+func (x PeerDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_network_proto_enumTypes[1].Descriptor()
+}
+
+func (PeerDirection) Type() protoreflect.EnumType {
+	return &file_rpcpb_network_proto_enumTypes[1]
+}
+
+func (x PeerDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerDirection.Descriptor instead.
+func (PeerDirection) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{1}
+}
+
+type StartPeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Network ID of the server peer, the local network (12345) if zero.
+	NetworkId uint32 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// PEM staking certificate and key of the server peer. A certificate is
+	// generated if unset.
+	StakingCertificate []byte `protobuf:"bytes,2,opt,name=staking_certificate,json=stakingCertificate,proto3" json:"staking_certificate,omitempty"`
+	StakingKey         []byte `protobuf:"bytes,3,opt,name=staking_key,json=stakingKey,proto3" json:"staking_key,omitempty"`
+	// Time the client peer has to connect and complete the exchange, in
+	// milliseconds. 10s if zero, and at most 5m.
+	TimeoutMs uint64 `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// Number of pings the server peer sends once the handshake finished, each
+	// once the previous one is answered. 1 if zero.
+	Pings uint32 `protobuf:"varint,5,opt,name=pings,proto3" json:"pings,omitempty"`
+	// Maximum difference of the clock of the client peer (its Version
+	// "my_time") with the server clock, in milliseconds. 1m (the avalanchego
+	// default) if zero.
+	MaxClockDifferenceMs uint64 `protobuf:"varint,6,opt,name=max_clock_difference_ms,json=maxClockDifferenceMs,proto3" json:"max_clock_difference_ms,omitempty"`
+}
+
+func (x *StartPeerRequest) Reset() {
+	*x = StartPeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_network_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPeerRequest) ProtoMessage() {}
+
+func (x *StartPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_network_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPeerRequest.ProtoReflect.Descriptor instead.
+func (*StartPeerRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StartPeerRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *StartPeerRequest) GetStakingCertificate() []byte {
+	if x != nil {
+		return x.StakingCertificate
+	}
+	return nil
+}
+
+func (x *StartPeerRequest) GetStakingKey() []byte {
+	if x != nil {
+		return x.StakingKey
+	}
+	return nil
+}
+
+func (x *StartPeerRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *StartPeerRequest) GetPings() uint32 {
+	if x != nil {
+		return x.Pings
+	}
+	return 0
+}
+
+func (x *StartPeerRequest) GetMaxClockDifferenceMs() uint64 {
+	if x != nil {
+		return x.MaxClockDifferenceMs
+	}
+	return 0
+}
+
+type StartPeerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Address the server peer listens on (e.g., "127.0.0.1:40123").
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Node ID and DER staking certificate of the server peer.
+	NodeId             string `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	StakingCertificate []byte `protobuf:"bytes,4,opt,name=staking_certificate,json=stakingCertificate,proto3" json:"staking_certificate,omitempty"`
+	NetworkId          uint32 `protobuf:"varint,5,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// Application version the server peer claims (e.g., "avalanche/1.10.1").
+	Version string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,7,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *StartPeerResponse) Reset() {
+	*x = StartPeerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_network_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPeerResponse) ProtoMessage() {}
+
+func (x *StartPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_network_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPeerResponse.ProtoReflect.Descriptor instead.
+func (*StartPeerResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StartPeerResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StartPeerResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StartPeerResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *StartPeerResponse) GetStakingCertificate() []byte {
+	if x != nil {
+		return x.StakingCertificate
+	}
+	return nil
+}
+
+func (x *StartPeerResponse) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *StartPeerResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *StartPeerResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type PeerTranscriptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Waits for the exchange to end (or the request deadline) before
+	// reporting it.
+	Wait bool `protobuf:"varint,2,opt,name=wait,proto3" json:"wait,omitempty"`
+	// Closes the session, and its connection, after reporting it.
+	CloseSession bool `protobuf:"varint,3,opt,name=close_session,json=closeSession,proto3" json:"close_session,omitempty"`
+}
+
+func (x *PeerTranscriptRequest) Reset() {
+	*x = PeerTranscriptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_network_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerTranscriptRequest) ProtoMessage() {}
+
+func (x *PeerTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_network_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*PeerTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PeerTranscriptRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PeerTranscriptRequest) GetWait() bool {
+	if x != nil {
+		return x.Wait
+	}
+	return false
+}
+
+func (x *PeerTranscriptRequest) GetCloseSession() bool {
+	if x != nil {
+		return x.CloseSession
+	}
+	return false
+}
+
+type PeerEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time since the client peer connected, in milliseconds.
+	OffsetMs  uint64        `protobuf:"varint,1,opt,name=offset_ms,json=offsetMs,proto3" json:"offset_ms,omitempty"`
+	Direction PeerDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=rpcpb.PeerDirection" json:"direction,omitempty"`
+	// Op of the message (e.g., "version"), empty if it failed to parse.
+	Op string `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`
+	// Framed message, as written to the connection.
+	SerializedMsg []byte `protobuf:"bytes,4,opt,name=serialized_msg,json=serializedMsg,proto3" json:"serialized_msg,omitempty"`
+	// Parsed p2p message (e.g., "p2p.Version").
+	Parsed *anypb.Any `protobuf:"bytes,5,opt,name=parsed,proto3" json:"parsed,omitempty"`
+	// Why the message failed to parse.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PeerEvent) Reset() {
+	*x = PeerEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_network_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerEvent) ProtoMessage() {}
+
+func (x *PeerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_network_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerEvent.ProtoReflect.Descriptor instead.
+func (*PeerEvent) Descriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PeerEvent) GetOffsetMs() uint64 {
+	if x != nil {
+		return x.OffsetMs
+	}
+	return 0
+}
+
+func (x *PeerEvent) GetDirection() PeerDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return PeerDirection_PEER_DIRECTION_UNSPECIFIED
+}
+
+func (x *PeerEvent) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *PeerEvent) GetSerializedMsg() []byte {
+	if x != nil {
+		return x.SerializedMsg
+	}
+	return nil
+}
+
+func (x *PeerEvent) GetParsed() *anypb.Any {
+	if x != nil {
+		return x.Parsed
+	}
+	return nil
+}
+
+func (x *PeerEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PeerDeviation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Phase the exchange was in.
+	Phase   PeerPhase `protobuf:"varint,1,opt,name=phase,proto3,enum=rpcpb.PeerPhase" json:"phase,omitempty"`
+	Message string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Whether avalanchego closes the connection on it, in which case the
+	// exchange ends. Other deviations are dropped messages.
+	Fatal bool `protobuf:"varint,3,opt,name=fatal,proto3" json:"fatal,omitempty"`
+}
+
+func (x *PeerDeviation) Reset() {
+	*x = PeerDeviation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_network_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerDeviation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerDeviation) ProtoMessage() {}
+
+func (x *PeerDeviation) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_network_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerDeviation.ProtoReflect.Descriptor instead.
+func (*PeerDeviation) Descriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PeerDeviation) GetPhase() PeerPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return PeerPhase_PEER_PHASE_UNSPECIFIED
+}
+
+func (x *PeerDeviation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PeerDeviation) GetFatal() bool {
+	if x != nil {
+		return x.Fatal
+	}
+	return false
+}
+
+type PeerTranscriptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase PeerPhase `protobuf:"varint,1,opt,name=phase,proto3,enum=rpcpb.PeerPhase" json:"phase,omitempty"`
+	// Whether the exchange ended: completed, closed (by either peer), or timed
+	// out.
+	Done bool `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	// Node ID of the client peer, from its TLS certificate.
+	PeerNodeId string           `protobuf:"bytes,3,opt,name=peer_node_id,json=peerNodeId,proto3" json:"peer_node_id,omitempty"`
+	Events     []*PeerEvent     `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	Deviations []*PeerDeviation `protobuf:"bytes,5,rep,name=deviations,proto3" json:"deviations,omitempty"`
+	// Successful once completed without deviations.
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Success bool   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,8,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *PeerTranscriptResponse) Reset() {
+	*x = PeerTranscriptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_network_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerTranscriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerTranscriptResponse) ProtoMessage() {}
+
+func (x *PeerTranscriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_network_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerTranscriptResponse.ProtoReflect.Descriptor instead.
+func (*PeerTranscriptResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PeerTranscriptResponse) GetPhase() PeerPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return PeerPhase_PEER_PHASE_UNSPECIFIED
+}
+
+func (x *PeerTranscriptResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *PeerTranscriptResponse) GetPeerNodeId() string {
+	if x != nil {
+		return x.PeerNodeId
+	}
+	return ""
+}
+
+func (x *PeerTranscriptResponse) GetEvents() []*PeerEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *PeerTranscriptResponse) GetDeviations() []*PeerDeviation {
+	if x != nil {
+		return x.Deviations
+	}
+	return nil
+}
+
+func (x *PeerTranscriptResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PeerTranscriptResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PeerTranscriptResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type PeerScriptMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*PeerScriptMessage_Request
+	//	*PeerScriptMessage_SerializedMsg
+	Message isPeerScriptMessage_Message `protobuf_oneof:"message"`
+	// Op of the message the client peer is expected to answer with (e.g.,
+	// "app_response", as in the transcript), before the next message is sent.
+	// Empty to send the next message right away.
+	ExpectedResponseOp string `protobuf:"bytes,3,opt,name=expected_response_op,json=expectedResponseOp,proto3" json:"expected_response_op,omitempty"`
+}
+
+func (x *PeerScriptMessage) Reset() {
+	*x = PeerScriptMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_network_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerScriptMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerScriptMessage) ProtoMessage() {}
+
+func (x *PeerScriptMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_network_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerScriptMessage.ProtoReflect.Descriptor instead.
+func (*PeerScriptMessage) Descriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *PeerScriptMessage) GetMessage() isPeerScriptMessage_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *PeerScriptMessage) GetRequest() *anypb.Any {
+	if x, ok := x.GetMessage().(*PeerScriptMessage_Request); ok {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *PeerScriptMessage) GetSerializedMsg() []byte {
+	if x, ok := x.GetMessage().(*PeerScriptMessage_SerializedMsg); ok {
+		return x.SerializedMsg
+	}
+	return nil
+}
+
+func (x *PeerScriptMessage) GetExpectedResponseOp() string {
+	if x != nil {
+		return x.ExpectedResponseOp
+	}
+	return ""
+}
+
+type isPeerScriptMessage_Message interface {
+	isPeerScriptMessage_Message()
+}
+
+type PeerScriptMessage_Request struct {
+	// Message check request (e.g., "rpcpb.AppRequestRequest"), whose
+	// canonical message ("expected_serialized_msg") is sent.
+	Request *anypb.Any `protobuf:"bytes,1,opt,name=request,proto3,oneof"`
+}
+
+type PeerScriptMessage_SerializedMsg struct {
+	// Framed message, sent as is (e.g., to check how the client peer handles
+	// malformed messages).
+	SerializedMsg []byte `protobuf:"bytes,2,opt,name=serialized_msg,json=serializedMsg,proto3,oneof"`
+}
+
+func (*PeerScriptMessage_Request) isPeerScriptMessage_Message() {}
+
+func (*PeerScriptMessage_SerializedMsg) isPeerScriptMessage_Message() {}
+
+type DialPeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address the client peer listens on (e.g., "127.0.0.1:9651").
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Network ID of the server peer, the local network (12345) if zero.
+	NetworkId uint32 `protobuf:"varint,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// PEM staking certificate and key of the server peer. A certificate is
+	// generated if unset.
+	StakingCertificate []byte `protobuf:"bytes,3,opt,name=staking_certificate,json=stakingCertificate,proto3" json:"staking_certificate,omitempty"`
+	StakingKey         []byte `protobuf:"bytes,4,opt,name=staking_key,json=stakingKey,proto3" json:"staking_key,omitempty"`
+	// Time the exchange may take, from dialing to the last pong, in
+	// milliseconds. 10s if zero, and at most 5m.
+	TimeoutMs uint64 `protobuf:"varint,5,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// Number of pings the server peer sends once the script is sent (and
+	// answered), each once the previous one is answered. 1 if zero.
+	Pings uint32 `protobuf:"varint,6,opt,name=pings,proto3" json:"pings,omitempty"`
+	// Maximum difference of the clock of the client peer with the server
+	// clock, in milliseconds. 1m (the avalanchego default) if zero.
+	MaxClockDifferenceMs uint64 `protobuf:"varint,7,opt,name=max_clock_difference_ms,json=maxClockDifferenceMs,proto3" json:"max_clock_difference_ms,omitempty"`
+	// Messages sent in order once the handshake finished, at most 1000.
+	Script []*PeerScriptMessage `protobuf:"bytes,8,rep,name=script,proto3" json:"script,omitempty"`
+}
+
+func (x *DialPeerRequest) Reset() {
+	*x = DialPeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_network_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DialPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DialPeerRequest) ProtoMessage() {}
+
+func (x *DialPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_network_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DialPeerRequest.ProtoReflect.Descriptor instead.
+func (*DialPeerRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DialPeerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DialPeerRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *DialPeerRequest) GetStakingCertificate() []byte {
+	if x != nil {
+		return x.StakingCertificate
+	}
+	return nil
+}
+
+func (x *DialPeerRequest) GetStakingKey() []byte {
+	if x != nil {
+		return x.StakingKey
+	}
+	return nil
+}
+
+func (x *DialPeerRequest) GetTimeoutMs() uint64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *DialPeerRequest) GetPings() uint32 {
+	if x != nil {
+		return x.Pings
+	}
+	return 0
+}
+
+func (x *DialPeerRequest) GetMaxClockDifferenceMs() uint64 {
+	if x != nil {
+		return x.MaxClockDifferenceMs
+	}
+	return 0
+}
+
+func (x *DialPeerRequest) GetScript() []*PeerScriptMessage {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+type HandshakeTimestampsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version "my_time" of the peer, in unix seconds.
+	MyTime uint64 `protobuf:"varint,1,opt,name=my_time,json=myTime,proto3" json:"my_time,omitempty"`
+	// Version "my_version_time" of the peer, the timestamp its IP is signed
+	// with, in unix seconds.
+	MyVersionTime uint64 `protobuf:"varint,2,opt,name=my_version_time,json=myVersionTime,proto3" json:"my_version_time,omitempty"`
+	// Time of the receiving node, in unix seconds. The server time (see
+	// "conformance-now-unix") if zero.
+	Now uint64 `protobuf:"varint,3,opt,name=now,proto3" json:"now,omitempty"`
+	// Maximum difference of the clocks, in milliseconds. 1m (the avalanchego
+	// default) if zero.
+	MaxClockDifferenceMs uint64 `protobuf:"varint,4,opt,name=max_clock_difference_ms,json=maxClockDifferenceMs,proto3" json:"max_clock_difference_ms,omitempty"`
+	// Timestamp of the signed IP the receiving node tracks for the peer, zero
+	// if it tracks none.
+	TrackedIpTimestamp uint64 `protobuf:"varint,5,opt,name=tracked_ip_timestamp,json=trackedIpTimestamp,proto3" json:"tracked_ip_timestamp,omitempty"`
+	// Whether the client expects the connection to be closed.
+	Rejected bool `protobuf:"varint,6,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// Whether the client expects the signed IP to replace the tracked one.
+	ReplacesTrackedIp bool `protobuf:"varint,7,opt,name=replaces_tracked_ip,json=replacesTrackedIp,proto3" json:"replaces_tracked_ip,omitempty"`
+}
+
+func (x *HandshakeTimestampsRequest) Reset() {
+	*x = HandshakeTimestampsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_network_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandshakeTimestampsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandshakeTimestampsRequest) ProtoMessage() {}
+
+func (x *HandshakeTimestampsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_network_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandshakeTimestampsRequest.ProtoReflect.Descriptor instead.
+func (*HandshakeTimestampsRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HandshakeTimestampsRequest) GetMyTime() uint64 {
+	if x != nil {
+		return x.MyTime
+	}
+	return 0
+}
+
+func (x *HandshakeTimestampsRequest) GetMyVersionTime() uint64 {
+	if x != nil {
+		return x.MyVersionTime
+	}
+	return 0
+}
+
+func (x *HandshakeTimestampsRequest) GetNow() uint64 {
+	if x != nil {
+		return x.Now
+	}
+	return 0
+}
+
+func (x *HandshakeTimestampsRequest) GetMaxClockDifferenceMs() uint64 {
+	if x != nil {
+		return x.MaxClockDifferenceMs
+	}
+	return 0
+}
+
+func (x *HandshakeTimestampsRequest) GetTrackedIpTimestamp() uint64 {
+	if x != nil {
+		return x.TrackedIpTimestamp
+	}
+	return 0
+}
+
+func (x *HandshakeTimestampsRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+func (x *HandshakeTimestampsRequest) GetReplacesTrackedIp() bool {
+	if x != nil {
+		return x.ReplacesTrackedIp
+	}
+	return false
+}
+
+type HandshakeTimestampsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time and maximum clock difference the times were checked against.
+	ExpectedNow                  uint64 `protobuf:"varint,1,opt,name=expected_now,json=expectedNow,proto3" json:"expected_now,omitempty"`
+	ExpectedMaxClockDifferenceMs uint64 `protobuf:"varint,2,opt,name=expected_max_clock_difference_ms,json=expectedMaxClockDifferenceMs,proto3" json:"expected_max_clock_difference_ms,omitempty"`
+	// Seconds the peer clock is ahead of the receiving node (negative if
+	// behind), and the signed IP timestamp is ahead of it.
+	ExpectedClockDifference       int64 `protobuf:"varint,3,opt,name=expected_clock_difference,json=expectedClockDifference,proto3" json:"expected_clock_difference,omitempty"`
+	ExpectedVersionTimeDifference int64 `protobuf:"varint,4,opt,name=expected_version_time_difference,json=expectedVersionTimeDifference,proto3" json:"expected_version_time_difference,omitempty"`
+	// Reason the connection is closed, empty if it is not.
+	ExpectedError string `protobuf:"bytes,5,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	// Whether the signed IP replaces the tracked one: it is newer, or none is
+	// tracked. Older and equally fresh IPs are kept out of gossip.
+	ExpectedReplacesTrackedIp bool   `protobuf:"varint,6,opt,name=expected_replaces_tracked_ip,json=expectedReplacesTrackedIp,proto3" json:"expected_replaces_tracked_ip,omitempty"`
+	Message                   string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Success                   bool   `protobuf:"varint,8,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,9,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *HandshakeTimestampsResponse) Reset() {
+	*x = HandshakeTimestampsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_network_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandshakeTimestampsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandshakeTimestampsResponse) ProtoMessage() {}
+
+func (x *HandshakeTimestampsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_network_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandshakeTimestampsResponse.ProtoReflect.Descriptor instead.
+func (*HandshakeTimestampsResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_network_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HandshakeTimestampsResponse) GetExpectedNow() uint64 {
+	if x != nil {
+		return x.ExpectedNow
+	}
+	return 0
+}
+
+func (x *HandshakeTimestampsResponse) GetExpectedMaxClockDifferenceMs() uint64 {
+	if x != nil {
+		return x.ExpectedMaxClockDifferenceMs
+	}
+	return 0
+}
+
+func (x *HandshakeTimestampsResponse) GetExpectedClockDifference() int64 {
+	if x != nil {
+		return x.ExpectedClockDifference
+	}
+	return 0
+}
+
+func (x *HandshakeTimestampsResponse) GetExpectedVersionTimeDifference() int64 {
+	if x != nil {
+		return x.ExpectedVersionTimeDifference
+	}
+	return 0
+}
+
+func (x *HandshakeTimestampsResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *HandshakeTimestampsResponse) GetExpectedReplacesTrackedIp() bool {
+	if x != nil {
+		return x.ExpectedReplacesTrackedIp
+	}
+	return false
+}
+
+func (x *HandshakeTimestampsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HandshakeTimestampsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HandshakeTimestampsResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_network_proto protoreflect.FileDescriptor
+
+var file_rpcpb_network_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x1a, 0x19, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x66,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x11, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x6f, 0x0a, 0x15, 0x50, 0x65, 0x65,
+	0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x77, 0x61, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd7, 0x01, 0x0a, 0x09, 0x50,
+	0x65, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x4d, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x12, 0x2c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x0d, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x61, 0x74, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x22, 0xb8, 0x02,
+	0x0a, 0x16, 0x50, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x64, 0x6f, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x34, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x50, 0x65, 0x65,
+	0x72, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6f,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4f, 0x70, 0x42, 0x09, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xba, 0x02, 0x0a, 0x0f, 0x44, 0x69, 0x61, 0x6c, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x12, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x61, 0x78,
+	0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d,
+	0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x22, 0xa4, 0x02, 0x0a, 0x1a, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
+	0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x6e, 0x6f, 0x77, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x14,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x49, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x69,
+	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x70, 0x22, 0xd7, 0x03, 0x0a, 0x1b, 0x48,
+	0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x77, 0x12, 0x46, 0x0a,
+	0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x4d, 0x61, 0x78, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x4d, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x47, 0x0a, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1d, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x69,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x49, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x2a, 0xd1, 0x01, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x53,
+	0x54, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x45, 0x45, 0x52,
+	0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45,
+	0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x41, 0x53,
+	0x45, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x53, 0x48, 0x41, 0x4b, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x49,
+	0x53, 0x48, 0x45, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x50,
+	0x48, 0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05,
+	0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x44,
+	0x49, 0x41, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x2a, 0x65, 0x0a, 0x0d, 0x50, 0x65, 0x65, 0x72,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x45, 0x45,
+	0x52, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x45, 0x45,
+	0x52, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x4e, 0x54,
+	0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x02, 0x32,
+	0xc8, 0x02, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x65, 0x72, 0x12,
+	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x50, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x44, 0x69, 0x61, 0x6c, 0x50, 0x65, 0x65,
+	0x72, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x61, 0x6c, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x13, 0x48, 0x61,
+	0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x73, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68,
+	0x61, 0x6b, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x48, 0x61, 0x6e,
+	0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73, 0x2f, 0x61,
+	0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_network_proto_rawDescOnce sync.Once
+	file_rpcpb_network_proto_rawDescData = file_rpcpb_network_proto_rawDesc
+)
+
+func file_rpcpb_network_proto_rawDescGZIP() []byte {
+	file_rpcpb_network_proto_rawDescOnce.Do(func() {
+		file_rpcpb_network_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_network_proto_rawDescData)
+	})
+	return file_rpcpb_network_proto_rawDescData
+}
+
+var file_rpcpb_network_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_rpcpb_network_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_rpcpb_network_proto_goTypes = []interface{}{
+	(PeerPhase)(0),                      // 0: rpcpb.PeerPhase
+	(PeerDirection)(0),                  // 1: rpcpb.PeerDirection
+	(*StartPeerRequest)(nil),            // 2: rpcpb.StartPeerRequest
+	(*StartPeerResponse)(nil),           // 3: rpcpb.StartPeerResponse
+	(*PeerTranscriptRequest)(nil),       // 4: rpcpb.PeerTranscriptRequest
+	(*PeerEvent)(nil),                   // 5: rpcpb.PeerEvent
+	(*PeerDeviation)(nil),               // 6: rpcpb.PeerDeviation
+	(*PeerTranscriptResponse)(nil),      // 7: rpcpb.PeerTranscriptResponse
+	(*PeerScriptMessage)(nil),           // 8: rpcpb.PeerScriptMessage
+	(*DialPeerRequest)(nil),             // 9: rpcpb.DialPeerRequest
+	(*HandshakeTimestampsRequest)(nil),  // 10: rpcpb.HandshakeTimestampsRequest
+	(*HandshakeTimestampsResponse)(nil), // 11: rpcpb.HandshakeTimestampsResponse
+	(*anypb.Any)(nil),                   // 12: google.protobuf.Any
+}
+var file_rpcpb_network_proto_depIdxs = []int32{
+	1,  // 0: rpcpb.PeerEvent.direction:type_name -> rpcpb.PeerDirection
+	12, // 1: rpcpb.PeerEvent.parsed:type_name -> google.protobuf.Any
+	0,  // 2: rpcpb.PeerDeviation.phase:type_name -> rpcpb.PeerPhase
+	0,  // 3: rpcpb.PeerTranscriptResponse.phase:type_name -> rpcpb.PeerPhase
+	5,  // 4: rpcpb.PeerTranscriptResponse.events:type_name -> rpcpb.PeerEvent
+	6,  // 5: rpcpb.PeerTranscriptResponse.deviations:type_name -> rpcpb.PeerDeviation
+	12, // 6: rpcpb.PeerScriptMessage.request:type_name -> google.protobuf.Any
+	8,  // 7: rpcpb.DialPeerRequest.script:type_name -> rpcpb.PeerScriptMessage
+	2,  // 8: rpcpb.NetworkService.StartPeer:input_type -> rpcpb.StartPeerRequest
+	4,  // 9: rpcpb.NetworkService.PeerTranscript:input_type -> rpcpb.PeerTranscriptRequest
+	9,  // 10: rpcpb.NetworkService.DialPeer:input_type -> rpcpb.DialPeerRequest
+	10, // 11: rpcpb.NetworkService.HandshakeTimestamps:input_type -> rpcpb.HandshakeTimestampsRequest
+	3,  // 12: rpcpb.NetworkService.StartPeer:output_type -> rpcpb.StartPeerResponse
+	7,  // 13: rpcpb.NetworkService.PeerTranscript:output_type -> rpcpb.PeerTranscriptResponse
+	7,  // 14: rpcpb.NetworkService.DialPeer:output_type -> rpcpb.PeerTranscriptResponse
+	11, // 15: rpcpb.NetworkService.HandshakeTimestamps:output_type -> rpcpb.HandshakeTimestampsResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_network_proto_init() }
+func file_rpcpb_network_proto_init() {
+	if File_rpcpb_network_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_network_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_network_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPeerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_network_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerTranscriptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_network_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_network_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerDeviation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_network_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerTranscriptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_network_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerScriptMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_network_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DialPeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_network_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandshakeTimestampsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_network_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandshakeTimestampsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpcpb_network_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*PeerScriptMessage_Request)(nil),
+		(*PeerScriptMessage_SerializedMsg)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_network_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_network_proto_goTypes,
+		DependencyIndexes: file_rpcpb_network_proto_depIdxs,
+		EnumInfos:         file_rpcpb_network_proto_enumTypes,
+		MessageInfos:      file_rpcpb_network_proto_msgTypes,
+	}.Build()
+	File_rpcpb_network_proto = out.File
+	file_rpcpb_network_proto_rawDesc = nil
+	file_rpcpb_network_proto_goTypes = nil
+	file_rpcpb_network_proto_depIdxs = nil
+}
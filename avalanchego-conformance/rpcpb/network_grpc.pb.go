@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/network.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	NetworkService_StartPeer_FullMethodName           = "/rpcpb.NetworkService/StartPeer"
+	NetworkService_PeerTranscript_FullMethodName      = "/rpcpb.NetworkService/PeerTranscript"
+	NetworkService_DialPeer_FullMethodName            = "/rpcpb.NetworkService/DialPeer"
+	NetworkService_HandshakeTimestamps_FullMethodName = "/rpcpb.NetworkService/HandshakeTimestamps"
+)
+
+// NetworkServiceClient is the client API for NetworkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NetworkServiceClient interface {
+	// StartPeer opens a TLS listener of a simulated peer, for a single client
+	// peer to connect to.
+	StartPeer(ctx context.Context, in *StartPeerRequest, opts ...grpc.CallOption) (*StartPeerResponse, error)
+	// PeerTranscript returns the messages exchanged with the client peer and
+	// its deviations.
+	PeerTranscript(ctx context.Context, in *PeerTranscriptRequest, opts ...grpc.CallOption) (*PeerTranscriptResponse, error)
+	// DialPeer dials a client peer listening at the address, speaks the
+	// handshake with it as avalanchego, sends a script of messages, and
+	// returns the transcript once the exchange ended.
+	DialPeer(ctx context.Context, in *DialPeerRequest, opts ...grpc.CallOption) (*PeerTranscriptResponse, error)
+	// HandshakeTimestamps checks whether avalanchego would close the
+	// connection on the times of a Version message, with the exact reason,
+	// and whether its signed IP would replace the one tracked for the peer,
+	// so that peers can pre-validate their handshake without connecting.
+	// ref. "peer.handleVersion", "network.Connected"
+	HandshakeTimestamps(ctx context.Context, in *HandshakeTimestampsRequest, opts ...grpc.CallOption) (*HandshakeTimestampsResponse, error)
+}
+
+type networkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNetworkServiceClient(cc grpc.ClientConnInterface) NetworkServiceClient {
+	return &networkServiceClient{cc}
+}
+
+func (c *networkServiceClient) StartPeer(ctx context.Context, in *StartPeerRequest, opts ...grpc.CallOption) (*StartPeerResponse, error) {
+	out := new(StartPeerResponse)
+	err := c.cc.Invoke(ctx, NetworkService_StartPeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) PeerTranscript(ctx context.Context, in *PeerTranscriptRequest, opts ...grpc.CallOption) (*PeerTranscriptResponse, error) {
+	out := new(PeerTranscriptResponse)
+	err := c.cc.Invoke(ctx, NetworkService_PeerTranscript_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) DialPeer(ctx context.Context, in *DialPeerRequest, opts ...grpc.CallOption) (*PeerTranscriptResponse, error) {
+	out := new(PeerTranscriptResponse)
+	err := c.cc.Invoke(ctx, NetworkService_DialPeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkServiceClient) HandshakeTimestamps(ctx context.Context, in *HandshakeTimestampsRequest, opts ...grpc.CallOption) (*HandshakeTimestampsResponse, error) {
+	out := new(HandshakeTimestampsResponse)
+	err := c.cc.Invoke(ctx, NetworkService_HandshakeTimestamps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NetworkServiceServer is the server API for NetworkService service.
+// All implementations must embed UnimplementedNetworkServiceServer
+// for forward compatibility
+type NetworkServiceServer interface {
+	// StartPeer opens a TLS listener of a simulated peer, for a single client
+	// peer to connect to.
+	StartPeer(context.Context, *StartPeerRequest) (*StartPeerResponse, error)
+	// PeerTranscript returns the messages exchanged with the client peer and
+	// its deviations.
+	PeerTranscript(context.Context, *PeerTranscriptRequest) (*PeerTranscriptResponse, error)
+	// DialPeer dials a client peer listening at the address, speaks the
+	// handshake with it as avalanchego, sends a script of messages, and
+	// returns the transcript once the exchange ended.
+	DialPeer(context.Context, *DialPeerRequest) (*PeerTranscriptResponse, error)
+	// HandshakeTimestamps checks whether avalanchego would close the
+	// connection on the times of a Version message, with the exact reason,
+	// and whether its signed IP would replace the one tracked for the peer,
+	// so that peers can pre-validate their handshake without connecting.
+	// ref. "peer.handleVersion", "network.Connected"
+	HandshakeTimestamps(context.Context, *HandshakeTimestampsRequest) (*HandshakeTimestampsResponse, error)
+	mustEmbedUnimplementedNetworkServiceServer()
+}
+
+// UnimplementedNetworkServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNetworkServiceServer struct {
+}
+
+func (UnimplementedNetworkServiceServer) StartPeer(context.Context, *StartPeerRequest) (*StartPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPeer not implemented")
+}
+func (UnimplementedNetworkServiceServer) PeerTranscript(context.Context, *PeerTranscriptRequest) (*PeerTranscriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeerTranscript not implemented")
+}
+func (UnimplementedNetworkServiceServer) DialPeer(context.Context, *DialPeerRequest) (*PeerTranscriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DialPeer not implemented")
+}
+func (UnimplementedNetworkServiceServer) HandshakeTimestamps(context.Context, *HandshakeTimestampsRequest) (*HandshakeTimestampsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandshakeTimestamps not implemented")
+}
+func (UnimplementedNetworkServiceServer) mustEmbedUnimplementedNetworkServiceServer() {}
+
+// UnsafeNetworkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NetworkServiceServer will
+// result in compilation errors.
+type UnsafeNetworkServiceServer interface {
+	mustEmbedUnimplementedNetworkServiceServer()
+}
+
+func RegisterNetworkServiceServer(s grpc.ServiceRegistrar, srv NetworkServiceServer) {
+	s.RegisterService(&NetworkService_ServiceDesc, srv)
+}
+
+func _NetworkService_StartPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).StartPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_StartPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).StartPeer(ctx, req.(*StartPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_PeerTranscript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerTranscriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).PeerTranscript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_PeerTranscript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).PeerTranscript(ctx, req.(*PeerTranscriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_DialPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DialPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).DialPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_DialPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).DialPeer(ctx, req.(*DialPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkService_HandshakeTimestamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandshakeTimestampsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkServiceServer).HandshakeTimestamps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkService_HandshakeTimestamps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkServiceServer).HandshakeTimestamps(ctx, req.(*HandshakeTimestampsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NetworkService_ServiceDesc is the grpc.ServiceDesc for NetworkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NetworkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.NetworkService",
+	HandlerType: (*NetworkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartPeer",
+			Handler:    _NetworkService_StartPeer_Handler,
+		},
+		{
+			MethodName: "PeerTranscript",
+			Handler:    _NetworkService_PeerTranscript_Handler,
+		},
+		{
+			MethodName: "DialPeer",
+			Handler:    _NetworkService_DialPeer_Handler,
+		},
+		{
+			MethodName: "HandshakeTimestamps",
+			Handler:    _NetworkService_HandshakeTimestamps_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/network.proto",
+}
@@ -20,6 +20,129 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PlatformBlockType is the type of a stateless P-chain block.
+type PlatformBlockType int32
+
+const (
+	PlatformBlockType_PLATFORM_BLOCK_TYPE_UNSPECIFIED      PlatformBlockType = 0
+	PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_PROPOSAL PlatformBlockType = 1
+	PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_ABORT    PlatformBlockType = 2
+	PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_COMMIT   PlatformBlockType = 3
+	PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_STANDARD PlatformBlockType = 4
+	PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_ATOMIC   PlatformBlockType = 5
+	PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_PROPOSAL   PlatformBlockType = 6
+	PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_ABORT      PlatformBlockType = 7
+	PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_COMMIT     PlatformBlockType = 8
+	PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_STANDARD   PlatformBlockType = 9
+)
+
+// Enum value maps for PlatformBlockType.
+var (
+	PlatformBlockType_name = map[int32]string{
+		0: "PLATFORM_BLOCK_TYPE_UNSPECIFIED",
+		1: "PLATFORM_BLOCK_TYPE_APRICOT_PROPOSAL",
+		2: "PLATFORM_BLOCK_TYPE_APRICOT_ABORT",
+		3: "PLATFORM_BLOCK_TYPE_APRICOT_COMMIT",
+		4: "PLATFORM_BLOCK_TYPE_APRICOT_STANDARD",
+		5: "PLATFORM_BLOCK_TYPE_APRICOT_ATOMIC",
+		6: "PLATFORM_BLOCK_TYPE_BANFF_PROPOSAL",
+		7: "PLATFORM_BLOCK_TYPE_BANFF_ABORT",
+		8: "PLATFORM_BLOCK_TYPE_BANFF_COMMIT",
+		9: "PLATFORM_BLOCK_TYPE_BANFF_STANDARD",
+	}
+	PlatformBlockType_value = map[string]int32{
+		"PLATFORM_BLOCK_TYPE_UNSPECIFIED":      0,
+		"PLATFORM_BLOCK_TYPE_APRICOT_PROPOSAL": 1,
+		"PLATFORM_BLOCK_TYPE_APRICOT_ABORT":    2,
+		"PLATFORM_BLOCK_TYPE_APRICOT_COMMIT":   3,
+		"PLATFORM_BLOCK_TYPE_APRICOT_STANDARD": 4,
+		"PLATFORM_BLOCK_TYPE_APRICOT_ATOMIC":   5,
+		"PLATFORM_BLOCK_TYPE_BANFF_PROPOSAL":   6,
+		"PLATFORM_BLOCK_TYPE_BANFF_ABORT":      7,
+		"PLATFORM_BLOCK_TYPE_BANFF_COMMIT":     8,
+		"PLATFORM_BLOCK_TYPE_BANFF_STANDARD":   9,
+	}
+)
+
+func (x PlatformBlockType) Enum() *PlatformBlockType {
+	p := new(PlatformBlockType)
+	*p = x
+	return p
+}
+
+func (x PlatformBlockType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlatformBlockType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_packer_proto_enumTypes[0].Descriptor()
+}
+
+func (PlatformBlockType) Type() protoreflect.EnumType {
+	return &file_rpcpb_packer_proto_enumTypes[0]
+}
+
+func (x PlatformBlockType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlatformBlockType.Descriptor instead.
+func (PlatformBlockType) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{0}
+}
+
+// PlatformBlockFork is the fork whose encoding a P-chain block follows.
+// Banff blocks are timestamped, and the only ones accepted once banff is
+// activated.
+type PlatformBlockFork int32
+
+const (
+	PlatformBlockFork_PLATFORM_BLOCK_FORK_UNSPECIFIED PlatformBlockFork = 0
+	PlatformBlockFork_PLATFORM_BLOCK_FORK_APRICOT     PlatformBlockFork = 1
+	PlatformBlockFork_PLATFORM_BLOCK_FORK_BANFF       PlatformBlockFork = 2
+)
+
+// Enum value maps for PlatformBlockFork.
+var (
+	PlatformBlockFork_name = map[int32]string{
+		0: "PLATFORM_BLOCK_FORK_UNSPECIFIED",
+		1: "PLATFORM_BLOCK_FORK_APRICOT",
+		2: "PLATFORM_BLOCK_FORK_BANFF",
+	}
+	PlatformBlockFork_value = map[string]int32{
+		"PLATFORM_BLOCK_FORK_UNSPECIFIED": 0,
+		"PLATFORM_BLOCK_FORK_APRICOT":     1,
+		"PLATFORM_BLOCK_FORK_BANFF":       2,
+	}
+)
+
+func (x PlatformBlockFork) Enum() *PlatformBlockFork {
+	p := new(PlatformBlockFork)
+	*p = x
+	return p
+}
+
+func (x PlatformBlockFork) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlatformBlockFork) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_packer_proto_enumTypes[1].Descriptor()
+}
+
+func (PlatformBlockFork) Type() protoreflect.EnumType {
+	return &file_rpcpb_packer_proto_enumTypes[1]
+}
+
+func (x PlatformBlockFork) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlatformBlockFork.Descriptor instead.
+func (PlatformBlockFork) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{1}
+}
+
 type BuildVertexRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -32,6 +155,9 @@ type BuildVertexRequest struct {
 	ParentIds    [][]byte `protobuf:"bytes,5,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
 	Txs          [][]byte `protobuf:"bytes,6,rep,name=txs,proto3" json:"txs,omitempty"`
 	VtxBytes     []byte   `protobuf:"bytes,7,opt,name=vtx_bytes,json=vtxBytes,proto3" json:"vtx_bytes,omitempty"`
+	// Cortina linearizes the X-chain, after which only stop vertices (with no
+	// transactions) are built.
+	UpgradeEra UpgradeEra `protobuf:"varint,8,opt,name=upgrade_era,json=upgradeEra,proto3,enum=rpcpb.UpgradeEra" json:"upgrade_era,omitempty"`
 }
 
 func (x *BuildVertexRequest) Reset() {
@@ -115,6 +241,13 @@ func (x *BuildVertexRequest) GetVtxBytes() []byte {
 	return nil
 }
 
+func (x *BuildVertexRequest) GetUpgradeEra() UpgradeEra {
+	if x != nil {
+		return x.UpgradeEra
+	}
+	return UpgradeEra_UPGRADE_ERA_UNSPECIFIED
+}
+
 type BuildVertexResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -123,6 +256,10 @@ type BuildVertexResponse struct {
 	ExpectedBytes []byte `protobuf:"bytes,1,opt,name=expected_bytes,json=expectedBytes,proto3" json:"expected_bytes,omitempty"`
 	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Success       bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,4,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
 }
 
 func (x *BuildVertexResponse) Reset() {
@@ -178,114 +315,1776 @@ func (x *BuildVertexResponse) GetSuccess() bool {
 	return false
 }
 
-var File_rpcpb_packer_proto protoreflect.FileDescriptor
+func (x *BuildVertexResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
 
-var file_rpcpb_packer_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x22, 0xd0, 0x01, 0x0a, 0x12,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x63,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x70,
-	0x0a, 0x13, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x32, 0x57, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x46, 0x0a, 0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78,
-	0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65,
-	0x72, 0x74, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73,
-	0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x75, 0x73, 0x74, 0x2f,
-	0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *BuildVertexResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
 }
 
-var (
-	file_rpcpb_packer_proto_rawDescOnce sync.Once
-	file_rpcpb_packer_proto_rawDescData = file_rpcpb_packer_proto_rawDesc
-)
+// Vertex is the decoded fields of a vertex.
+type Vertex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_rpcpb_packer_proto_rawDescGZIP() []byte {
-	file_rpcpb_packer_proto_rawDescOnce.Do(func() {
-		file_rpcpb_packer_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_packer_proto_rawDescData)
-	})
-	return file_rpcpb_packer_proto_rawDescData
+	// 0 for vertices with transactions, 1 for stop vertices.
+	CodecVersion uint32   `protobuf:"varint,1,opt,name=codec_version,json=codecVersion,proto3" json:"codec_version,omitempty"`
+	ChainId      []byte   `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height       uint64   `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Epoch        uint32   `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ParentIds    [][]byte `protobuf:"bytes,5,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	Txs          [][]byte `protobuf:"bytes,6,rep,name=txs,proto3" json:"txs,omitempty"`
+	// SHA-256 of the vertex bytes.
+	Id         []byte `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	StopVertex bool   `protobuf:"varint,8,opt,name=stop_vertex,json=stopVertex,proto3" json:"stop_vertex,omitempty"`
 }
 
-var file_rpcpb_packer_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_rpcpb_packer_proto_goTypes = []interface{}{
-	(*BuildVertexRequest)(nil),  // 0: rpcpb.BuildVertexRequest
-	(*BuildVertexResponse)(nil), // 1: rpcpb.BuildVertexResponse
+func (x *Vertex) Reset() {
+	*x = Vertex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_rpcpb_packer_proto_depIdxs = []int32{
-	0, // 0: rpcpb.PackerService.BuildVertex:input_type -> rpcpb.BuildVertexRequest
-	1, // 1: rpcpb.PackerService.BuildVertex:output_type -> rpcpb.BuildVertexResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *Vertex) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_rpcpb_packer_proto_init() }
-func file_rpcpb_packer_proto_init() {
-	if File_rpcpb_packer_proto != nil {
-		return
+func (*Vertex) ProtoMessage() {}
+
+func (x *Vertex) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_rpcpb_packer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildVertexRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vertex.ProtoReflect.Descriptor instead.
+func (*Vertex) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Vertex) GetCodecVersion() uint32 {
+	if x != nil {
+		return x.CodecVersion
+	}
+	return 0
+}
+
+func (x *Vertex) GetChainId() []byte {
+	if x != nil {
+		return x.ChainId
+	}
+	return nil
+}
+
+func (x *Vertex) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Vertex) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Vertex) GetParentIds() [][]byte {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *Vertex) GetTxs() [][]byte {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *Vertex) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Vertex) GetStopVertex() bool {
+	if x != nil {
+		return x.StopVertex
+	}
+	return false
+}
+
+type ParseVertexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VtxBytes []byte `protobuf:"bytes,1,opt,name=vtx_bytes,json=vtxBytes,proto3" json:"vtx_bytes,omitempty"`
+	// Fields decoded by the client.
+	Vertex *Vertex `protobuf:"bytes,2,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	// Whether the client rejects the vertex, failing to parse or verify it.
+	Rejected bool `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *ParseVertexRequest) Reset() {
+	*x = ParseVertexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseVertexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseVertexRequest) ProtoMessage() {}
+
+func (x *ParseVertexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_rpcpb_packer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildVertexResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseVertexRequest.ProtoReflect.Descriptor instead.
+func (*ParseVertexRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ParseVertexRequest) GetVtxBytes() []byte {
+	if x != nil {
+		return x.VtxBytes
+	}
+	return nil
+}
+
+func (x *ParseVertexRequest) GetVertex() *Vertex {
+	if x != nil {
+		return x.Vertex
+	}
+	return nil
+}
+
+func (x *ParseVertexRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type ParseVertexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedVertex *Vertex `protobuf:"bytes,1,opt,name=expected_vertex,json=expectedVertex,proto3" json:"expected_vertex,omitempty"`
+	// SHA-256 of each transaction, by which transactions are ordered.
+	ExpectedTxHashes            [][]byte `protobuf:"bytes,2,rep,name=expected_tx_hashes,json=expectedTxHashes,proto3" json:"expected_tx_hashes,omitempty"`
+	ExpectedParentsSortedUnique bool     `protobuf:"varint,3,opt,name=expected_parents_sorted_unique,json=expectedParentsSortedUnique,proto3" json:"expected_parents_sorted_unique,omitempty"`
+	ExpectedTxsSortedUnique     bool     `protobuf:"varint,4,opt,name=expected_txs_sorted_unique,json=expectedTxsSortedUnique,proto3" json:"expected_txs_sorted_unique,omitempty"`
+	// Error the vertex fails to parse or verify with, empty if it is valid.
+	ExpectedError string `protobuf:"bytes,5,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	// Names of the fields decoded differently by the client.
+	MismatchedFields []string `protobuf:"bytes,6,rep,name=mismatched_fields,json=mismatchedFields,proto3" json:"mismatched_fields,omitempty"`
+	Message          string   `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Success          bool     `protobuf:"varint,8,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,9,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *ParseVertexResponse) Reset() {
+	*x = ParseVertexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseVertexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseVertexResponse) ProtoMessage() {}
+
+func (x *ParseVertexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseVertexResponse.ProtoReflect.Descriptor instead.
+func (*ParseVertexResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ParseVertexResponse) GetExpectedVertex() *Vertex {
+	if x != nil {
+		return x.ExpectedVertex
+	}
+	return nil
+}
+
+func (x *ParseVertexResponse) GetExpectedTxHashes() [][]byte {
+	if x != nil {
+		return x.ExpectedTxHashes
+	}
+	return nil
+}
+
+func (x *ParseVertexResponse) GetExpectedParentsSortedUnique() bool {
+	if x != nil {
+		return x.ExpectedParentsSortedUnique
+	}
+	return false
+}
+
+func (x *ParseVertexResponse) GetExpectedTxsSortedUnique() bool {
+	if x != nil {
+		return x.ExpectedTxsSortedUnique
+	}
+	return false
+}
+
+func (x *ParseVertexResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *ParseVertexResponse) GetMismatchedFields() []string {
+	if x != nil {
+		return x.MismatchedFields
+	}
+	return nil
+}
+
+func (x *ParseVertexResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ParseVertexResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ParseVertexResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BuildBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParentId []byte `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Unix time in seconds.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// P-chain height the proposer was selected at.
+	PChainHeight uint64 `protobuf:"varint,3,opt,name=p_chain_height,json=pChainHeight,proto3" json:"p_chain_height,omitempty"`
+	// Block bytes of the wrapped VM.
+	Block []byte `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	// When set, the block is signed by the PEM-encoded staking certificate and
+	// key over its header for the chain. Otherwise, the block is unsigned.
+	StakingCertPem []byte `protobuf:"bytes,5,opt,name=staking_cert_pem,json=stakingCertPem,proto3" json:"staking_cert_pem,omitempty"`
+	StakingKeyPem  []byte `protobuf:"bytes,6,opt,name=staking_key_pem,json=stakingKeyPem,proto3" json:"staking_key_pem,omitempty"`
+	ChainId        []byte `protobuf:"bytes,7,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BlockBytes     []byte `protobuf:"bytes,8,opt,name=block_bytes,json=blockBytes,proto3" json:"block_bytes,omitempty"`
+}
+
+func (x *BuildBlockRequest) Reset() {
+	*x = BuildBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildBlockRequest) ProtoMessage() {}
+
+func (x *BuildBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildBlockRequest.ProtoReflect.Descriptor instead.
+func (*BuildBlockRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BuildBlockRequest) GetParentId() []byte {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *BuildBlockRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BuildBlockRequest) GetPChainHeight() uint64 {
+	if x != nil {
+		return x.PChainHeight
+	}
+	return 0
+}
+
+func (x *BuildBlockRequest) GetBlock() []byte {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *BuildBlockRequest) GetStakingCertPem() []byte {
+	if x != nil {
+		return x.StakingCertPem
+	}
+	return nil
+}
+
+func (x *BuildBlockRequest) GetStakingKeyPem() []byte {
+	if x != nil {
+		return x.StakingKeyPem
+	}
+	return nil
+}
+
+func (x *BuildBlockRequest) GetChainId() []byte {
+	if x != nil {
+		return x.ChainId
+	}
+	return nil
+}
+
+func (x *BuildBlockRequest) GetBlockBytes() []byte {
+	if x != nil {
+		return x.BlockBytes
+	}
+	return nil
+}
+
+type BuildBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedBytes   []byte `protobuf:"bytes,1,opt,name=expected_bytes,json=expectedBytes,proto3" json:"expected_bytes,omitempty"`
+	ExpectedBlockId []byte `protobuf:"bytes,2,opt,name=expected_block_id,json=expectedBlockId,proto3" json:"expected_block_id,omitempty"`
+	Message         string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success         bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BuildBlockResponse) Reset() {
+	*x = BuildBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildBlockResponse) ProtoMessage() {}
+
+func (x *BuildBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildBlockResponse.ProtoReflect.Descriptor instead.
+func (*BuildBlockResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BuildBlockResponse) GetExpectedBytes() []byte {
+	if x != nil {
+		return x.ExpectedBytes
+	}
+	return nil
+}
+
+func (x *BuildBlockResponse) GetExpectedBlockId() []byte {
+	if x != nil {
+		return x.ExpectedBlockId
+	}
+	return nil
+}
+
+func (x *BuildBlockResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BuildBlockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BuildBlockResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *BuildBlockResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type BuildPlatformBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockType PlatformBlockType `protobuf:"varint,1,opt,name=block_type,json=blockType,proto3,enum=rpcpb.PlatformBlockType" json:"block_type,omitempty"`
+	ParentId  []byte            `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Height    uint64            `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Unix time in seconds, of banff blocks.
+	Timestamp uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Signed tx bytes, prefixed with the codec version and type ID. Proposal
+	// and atomic blocks have exactly one, abort and commit blocks none.
+	Txs [][]byte `protobuf:"bytes,5,rep,name=txs,proto3" json:"txs,omitempty"`
+	// Apricot blocks are not built past banff.
+	UpgradeEra UpgradeEra `protobuf:"varint,6,opt,name=upgrade_era,json=upgradeEra,proto3,enum=rpcpb.UpgradeEra" json:"upgrade_era,omitempty"`
+	// Block bytes, prefixed with the codec version and type ID.
+	BlockBytes []byte `protobuf:"bytes,7,opt,name=block_bytes,json=blockBytes,proto3" json:"block_bytes,omitempty"`
+	BlockId    []byte `protobuf:"bytes,8,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+}
+
+func (x *BuildPlatformBlockRequest) Reset() {
+	*x = BuildPlatformBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildPlatformBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildPlatformBlockRequest) ProtoMessage() {}
+
+func (x *BuildPlatformBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildPlatformBlockRequest.ProtoReflect.Descriptor instead.
+func (*BuildPlatformBlockRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BuildPlatformBlockRequest) GetBlockType() PlatformBlockType {
+	if x != nil {
+		return x.BlockType
+	}
+	return PlatformBlockType_PLATFORM_BLOCK_TYPE_UNSPECIFIED
+}
+
+func (x *BuildPlatformBlockRequest) GetParentId() []byte {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *BuildPlatformBlockRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BuildPlatformBlockRequest) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BuildPlatformBlockRequest) GetTxs() [][]byte {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+func (x *BuildPlatformBlockRequest) GetUpgradeEra() UpgradeEra {
+	if x != nil {
+		return x.UpgradeEra
+	}
+	return UpgradeEra_UPGRADE_ERA_UNSPECIFIED
+}
+
+func (x *BuildPlatformBlockRequest) GetBlockBytes() []byte {
+	if x != nil {
+		return x.BlockBytes
+	}
+	return nil
+}
+
+func (x *BuildPlatformBlockRequest) GetBlockId() []byte {
+	if x != nil {
+		return x.BlockId
+	}
+	return nil
+}
+
+type BuildPlatformBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedBytes   []byte            `protobuf:"bytes,1,opt,name=expected_bytes,json=expectedBytes,proto3" json:"expected_bytes,omitempty"`
+	ExpectedBlockId []byte            `protobuf:"bytes,2,opt,name=expected_block_id,json=expectedBlockId,proto3" json:"expected_block_id,omitempty"`
+	ExpectedTypeId  uint32            `protobuf:"varint,3,opt,name=expected_type_id,json=expectedTypeId,proto3" json:"expected_type_id,omitempty"`
+	ExpectedFork    PlatformBlockFork `protobuf:"varint,4,opt,name=expected_fork,json=expectedFork,proto3,enum=rpcpb.PlatformBlockFork" json:"expected_fork,omitempty"`
+	// Type and fork of the block bytes as parsed by avalanchego, unset if
+	// they fail to parse.
+	ParsedBlockType PlatformBlockType `protobuf:"varint,5,opt,name=parsed_block_type,json=parsedBlockType,proto3,enum=rpcpb.PlatformBlockType" json:"parsed_block_type,omitempty"`
+	ParsedFork      PlatformBlockFork `protobuf:"varint,6,opt,name=parsed_fork,json=parsedFork,proto3,enum=rpcpb.PlatformBlockFork" json:"parsed_fork,omitempty"`
+	ParseError      string            `protobuf:"bytes,7,opt,name=parse_error,json=parseError,proto3" json:"parse_error,omitempty"`
+	Message         string            `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Success         bool              `protobuf:"varint,9,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,10,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,11,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *BuildPlatformBlockResponse) Reset() {
+	*x = BuildPlatformBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildPlatformBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildPlatformBlockResponse) ProtoMessage() {}
+
+func (x *BuildPlatformBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildPlatformBlockResponse.ProtoReflect.Descriptor instead.
+func (*BuildPlatformBlockResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BuildPlatformBlockResponse) GetExpectedBytes() []byte {
+	if x != nil {
+		return x.ExpectedBytes
+	}
+	return nil
+}
+
+func (x *BuildPlatformBlockResponse) GetExpectedBlockId() []byte {
+	if x != nil {
+		return x.ExpectedBlockId
+	}
+	return nil
+}
+
+func (x *BuildPlatformBlockResponse) GetExpectedTypeId() uint32 {
+	if x != nil {
+		return x.ExpectedTypeId
+	}
+	return 0
+}
+
+func (x *BuildPlatformBlockResponse) GetExpectedFork() PlatformBlockFork {
+	if x != nil {
+		return x.ExpectedFork
+	}
+	return PlatformBlockFork_PLATFORM_BLOCK_FORK_UNSPECIFIED
+}
+
+func (x *BuildPlatformBlockResponse) GetParsedBlockType() PlatformBlockType {
+	if x != nil {
+		return x.ParsedBlockType
+	}
+	return PlatformBlockType_PLATFORM_BLOCK_TYPE_UNSPECIFIED
+}
+
+func (x *BuildPlatformBlockResponse) GetParsedFork() PlatformBlockFork {
+	if x != nil {
+		return x.ParsedFork
+	}
+	return PlatformBlockFork_PLATFORM_BLOCK_FORK_UNSPECIFIED
+}
+
+func (x *BuildPlatformBlockResponse) GetParseError() string {
+	if x != nil {
+		return x.ParseError
+	}
+	return ""
+}
+
+func (x *BuildPlatformBlockResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BuildPlatformBlockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BuildPlatformBlockResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *BuildPlatformBlockResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+// PackerOp is a primitive of avalanchego "wrappers.Packer".
+type PackerOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Op:
+	//
+	//	*PackerOp_PackByte
+	//	*PackerOp_PackShort
+	//	*PackerOp_PackInt
+	//	*PackerOp_PackLong
+	//	*PackerOp_PackBool
+	//	*PackerOp_PackFixedBytes
+	//	*PackerOp_PackBytes
+	//	*PackerOp_PackStr
+	//	*PackerOp_PackByteSlices
+	//	*PackerOp_PackIp
+	Op isPackerOp_Op `protobuf_oneof:"op"`
+}
+
+func (x *PackerOp) Reset() {
+	*x = PackerOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackerOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackerOp) ProtoMessage() {}
+
+func (x *PackerOp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackerOp.ProtoReflect.Descriptor instead.
+func (*PackerOp) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *PackerOp) GetOp() isPackerOp_Op {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
+func (x *PackerOp) GetPackByte() uint32 {
+	if x, ok := x.GetOp().(*PackerOp_PackByte); ok {
+		return x.PackByte
+	}
+	return 0
+}
+
+func (x *PackerOp) GetPackShort() uint32 {
+	if x, ok := x.GetOp().(*PackerOp_PackShort); ok {
+		return x.PackShort
+	}
+	return 0
+}
+
+func (x *PackerOp) GetPackInt() uint32 {
+	if x, ok := x.GetOp().(*PackerOp_PackInt); ok {
+		return x.PackInt
+	}
+	return 0
+}
+
+func (x *PackerOp) GetPackLong() uint64 {
+	if x, ok := x.GetOp().(*PackerOp_PackLong); ok {
+		return x.PackLong
+	}
+	return 0
+}
+
+func (x *PackerOp) GetPackBool() bool {
+	if x, ok := x.GetOp().(*PackerOp_PackBool); ok {
+		return x.PackBool
+	}
+	return false
+}
+
+func (x *PackerOp) GetPackFixedBytes() []byte {
+	if x, ok := x.GetOp().(*PackerOp_PackFixedBytes); ok {
+		return x.PackFixedBytes
+	}
+	return nil
+}
+
+func (x *PackerOp) GetPackBytes() []byte {
+	if x, ok := x.GetOp().(*PackerOp_PackBytes); ok {
+		return x.PackBytes
+	}
+	return nil
+}
+
+func (x *PackerOp) GetPackStr() string {
+	if x, ok := x.GetOp().(*PackerOp_PackStr); ok {
+		return x.PackStr
+	}
+	return ""
+}
+
+func (x *PackerOp) GetPackByteSlices() *PackerByteSlices {
+	if x, ok := x.GetOp().(*PackerOp_PackByteSlices); ok {
+		return x.PackByteSlices
+	}
+	return nil
+}
+
+func (x *PackerOp) GetPackIp() *PackerIP {
+	if x, ok := x.GetOp().(*PackerOp_PackIp); ok {
+		return x.PackIp
+	}
+	return nil
+}
+
+type isPackerOp_Op interface {
+	isPackerOp_Op()
+}
+
+type PackerOp_PackByte struct {
+	// Must fit in a byte.
+	PackByte uint32 `protobuf:"varint,1,opt,name=pack_byte,json=packByte,proto3,oneof"`
+}
+
+type PackerOp_PackShort struct {
+	// Must fit in 16 bits.
+	PackShort uint32 `protobuf:"varint,2,opt,name=pack_short,json=packShort,proto3,oneof"`
+}
+
+type PackerOp_PackInt struct {
+	PackInt uint32 `protobuf:"varint,3,opt,name=pack_int,json=packInt,proto3,oneof"`
+}
+
+type PackerOp_PackLong struct {
+	PackLong uint64 `protobuf:"varint,4,opt,name=pack_long,json=packLong,proto3,oneof"`
+}
+
+type PackerOp_PackBool struct {
+	PackBool bool `protobuf:"varint,5,opt,name=pack_bool,json=packBool,proto3,oneof"`
+}
+
+type PackerOp_PackFixedBytes struct {
+	PackFixedBytes []byte `protobuf:"bytes,6,opt,name=pack_fixed_bytes,json=packFixedBytes,proto3,oneof"`
+}
+
+type PackerOp_PackBytes struct {
+	PackBytes []byte `protobuf:"bytes,7,opt,name=pack_bytes,json=packBytes,proto3,oneof"`
+}
+
+type PackerOp_PackStr struct {
+	PackStr string `protobuf:"bytes,8,opt,name=pack_str,json=packStr,proto3,oneof"`
+}
+
+type PackerOp_PackByteSlices struct {
+	// Packed as a 4-byte count followed by each length-prefixed slice.
+	PackByteSlices *PackerByteSlices `protobuf:"bytes,9,opt,name=pack_byte_slices,json=packByteSlices,proto3,oneof"`
+}
+
+type PackerOp_PackIp struct {
+	PackIp *PackerIP `protobuf:"bytes,10,opt,name=pack_ip,json=packIp,proto3,oneof"`
+}
+
+func (*PackerOp_PackByte) isPackerOp_Op() {}
+
+func (*PackerOp_PackShort) isPackerOp_Op() {}
+
+func (*PackerOp_PackInt) isPackerOp_Op() {}
+
+func (*PackerOp_PackLong) isPackerOp_Op() {}
+
+func (*PackerOp_PackBool) isPackerOp_Op() {}
+
+func (*PackerOp_PackFixedBytes) isPackerOp_Op() {}
+
+func (*PackerOp_PackBytes) isPackerOp_Op() {}
+
+func (*PackerOp_PackStr) isPackerOp_Op() {}
+
+func (*PackerOp_PackByteSlices) isPackerOp_Op() {}
+
+func (*PackerOp_PackIp) isPackerOp_Op() {}
+
+type PackerByteSlices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slices [][]byte `protobuf:"bytes,1,rep,name=slices,proto3" json:"slices,omitempty"`
+}
+
+func (x *PackerByteSlices) Reset() {
+	*x = PackerByteSlices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackerByteSlices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackerByteSlices) ProtoMessage() {}
+
+func (x *PackerByteSlices) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackerByteSlices.ProtoReflect.Descriptor instead.
+func (*PackerByteSlices) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PackerByteSlices) GetSlices() [][]byte {
+	if x != nil {
+		return x.Slices
+	}
+	return nil
+}
+
+type PackerIP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 4-byte IPv4 or 16-byte IPv6 address.
+	Ip []byte `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	// Must fit in 16 bits.
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *PackerIP) Reset() {
+	*x = PackerIP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackerIP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackerIP) ProtoMessage() {}
+
+func (x *PackerIP) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackerIP.ProtoReflect.Descriptor instead.
+func (*PackerIP) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PackerIP) GetIp() []byte {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+func (x *PackerIP) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type PackPrimitivesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Largest size the packer may grow to. Zero means no limit.
+	MaxSize     uint32      `protobuf:"varint,1,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	Ops         []*PackerOp `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops,omitempty"`
+	PackedBytes []byte      `protobuf:"bytes,3,opt,name=packed_bytes,json=packedBytes,proto3" json:"packed_bytes,omitempty"`
+	// Whether the packer under test failed. The packed bytes are not compared
+	// when the packer fails.
+	Errored bool `protobuf:"varint,4,opt,name=errored,proto3" json:"errored,omitempty"`
+}
+
+func (x *PackPrimitivesRequest) Reset() {
+	*x = PackPrimitivesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackPrimitivesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackPrimitivesRequest) ProtoMessage() {}
+
+func (x *PackPrimitivesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackPrimitivesRequest.ProtoReflect.Descriptor instead.
+func (*PackPrimitivesRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PackPrimitivesRequest) GetMaxSize() uint32 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *PackPrimitivesRequest) GetOps() []*PackerOp {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *PackPrimitivesRequest) GetPackedBytes() []byte {
+	if x != nil {
+		return x.PackedBytes
+	}
+	return nil
+}
+
+func (x *PackPrimitivesRequest) GetErrored() bool {
+	if x != nil {
+		return x.Errored
+	}
+	return false
+}
+
+type PackPrimitivesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedBytes []byte `protobuf:"bytes,1,opt,name=expected_bytes,json=expectedBytes,proto3" json:"expected_bytes,omitempty"`
+	// Error of the packer, if it failed.
+	ExpectedError string `protobuf:"bytes,2,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	// Index of the op that failed the packer.
+	ExpectedErroredOp uint32 `protobuf:"varint,3,opt,name=expected_errored_op,json=expectedErroredOp,proto3" json:"expected_errored_op,omitempty"`
+	Message           string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success           bool   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,7,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *PackPrimitivesResponse) Reset() {
+	*x = PackPrimitivesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_packer_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackPrimitivesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackPrimitivesResponse) ProtoMessage() {}
+
+func (x *PackPrimitivesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_packer_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackPrimitivesResponse.ProtoReflect.Descriptor instead.
+func (*PackPrimitivesResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_packer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PackPrimitivesResponse) GetExpectedBytes() []byte {
+	if x != nil {
+		return x.ExpectedBytes
+	}
+	return nil
+}
+
+func (x *PackPrimitivesResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *PackPrimitivesResponse) GetExpectedErroredOp() uint32 {
+	if x != nil {
+		return x.ExpectedErroredOp
+	}
+	return 0
+}
+
+func (x *PackPrimitivesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PackPrimitivesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PackPrimitivesResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *PackPrimitivesResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_packer_proto protoreflect.FileDescriptor
+
+var file_rpcpb_packer_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x1a, 0x10, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2f, 0x64, 0x69, 0x66, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x84, 0x02, 0x0a, 0x12, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x74, 0x78, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x74, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x5f, 0x65, 0x72, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x45, 0x72, 0x61, 0x52, 0x0a, 0x75,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x45, 0x72, 0x61, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a,
+	0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x06,
+	0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63,
+	0x6f, 0x64, 0x65, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x70,
+	0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x22, 0x74, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x73, 0x65, 0x56,
+	0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x76, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x76, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x06, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xb3, 0x03, 0x0a,
+	0x13, 0x50, 0x61, 0x72, 0x73, 0x65, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x12,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x1e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x1b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12,
+	0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x73, 0x5f,
+	0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x73,
+	0x53, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x22, 0x98, 0x02, 0x0a, 0x11, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f,
+	0x70, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x50, 0x65, 0x6d, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x50, 0x65,
+	0x6d, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xea, 0x01,
+	0x0a, 0x12, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64,
+	0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x19, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x5f, 0x65, 0x72, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x45, 0x72, 0x61, 0x52, 0x0a,
+	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x45, 0x72, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x22, 0xfd, 0x03, 0x0a, 0x1a, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66,
+	0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46,
+	0x6f, 0x72, 0x6b, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x6f, 0x72,
+	0x6b, 0x12, 0x44, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x73, 0x65,
+	0x64, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x46, 0x6f,
+	0x72, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x73, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x86, 0x03, 0x0a, 0x08, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x4f, 0x70, 0x12, 0x1d, 0x0a, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x42, 0x79,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x4c, 0x6f, 0x6e, 0x67, 0x12,
+	0x1d, 0x0a, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2a,
+	0x0a, 0x10, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0a, 0x70, 0x61,
+	0x63, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x08, 0x70,
+	0x61, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x70, 0x61, 0x63, 0x6b,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x42, 0x79, 0x74, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x70,
+	0x61, 0x63, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x50, 0x48,
+	0x00, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x49, 0x70, 0x42, 0x04, 0x0a, 0x02, 0x6f, 0x70, 0x22,
+	0x2a, 0x0a, 0x10, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x53, 0x6c, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x08, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x50, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x15,
+	0x50, 0x61, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x21, 0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x52, 0x03,
+	0x6f, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64,
+	0x22, 0x99, 0x02, 0x0a, 0x16, 0x50, 0x61, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x6f, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x4f, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x2a, 0x9e, 0x03, 0x0a,
+	0x11, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x50, 0x4c, 0x41, 0x54, 0x46,
+	0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x50, 0x52, 0x49, 0x43, 0x4f, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x10,
+	0x01, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x52, 0x49, 0x43, 0x4f, 0x54,
+	0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x4c, 0x41, 0x54,
+	0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x41, 0x50, 0x52, 0x49, 0x43, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x03,
+	0x12, 0x28, 0x0a, 0x24, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x52, 0x49, 0x43, 0x4f, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x4c,
+	0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x41, 0x50, 0x52, 0x49, 0x43, 0x4f, 0x54, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43,
+	0x10, 0x05, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x4e, 0x46, 0x46, 0x5f,
+	0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x10, 0x06, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x4c,
+	0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x42, 0x41, 0x4e, 0x46, 0x46, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x10, 0x07, 0x12,
+	0x24, 0x0a, 0x20, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x4c, 0x4f, 0x43,
+	0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x4e, 0x46, 0x46, 0x5f, 0x43, 0x4f, 0x4d,
+	0x4d, 0x49, 0x54, 0x10, 0x08, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52,
+	0x4d, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x4e,
+	0x46, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x09, 0x2a, 0x78, 0x0a,
+	0x11, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x6f,
+	0x72, 0x6b, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x4b, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x4c, 0x41, 0x54, 0x46,
+	0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x4b, 0x5f, 0x41,
+	0x50, 0x52, 0x49, 0x43, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x4c, 0x41, 0x54,
+	0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x4b, 0x5f,
+	0x42, 0x41, 0x4e, 0x46, 0x46, 0x10, 0x02, 0x32, 0x92, 0x03, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x73, 0x65, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78,
+	0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x56, 0x65,
+	0x72, 0x74, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b,
+	0x0a, 0x12, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x50,
+	0x61, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x1c, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73,
+	0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_packer_proto_rawDescOnce sync.Once
+	file_rpcpb_packer_proto_rawDescData = file_rpcpb_packer_proto_rawDesc
+)
+
+func file_rpcpb_packer_proto_rawDescGZIP() []byte {
+	file_rpcpb_packer_proto_rawDescOnce.Do(func() {
+		file_rpcpb_packer_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_packer_proto_rawDescData)
+	})
+	return file_rpcpb_packer_proto_rawDescData
+}
+
+var file_rpcpb_packer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_rpcpb_packer_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_rpcpb_packer_proto_goTypes = []interface{}{
+	(PlatformBlockType)(0),             // 0: rpcpb.PlatformBlockType
+	(PlatformBlockFork)(0),             // 1: rpcpb.PlatformBlockFork
+	(*BuildVertexRequest)(nil),         // 2: rpcpb.BuildVertexRequest
+	(*BuildVertexResponse)(nil),        // 3: rpcpb.BuildVertexResponse
+	(*Vertex)(nil),                     // 4: rpcpb.Vertex
+	(*ParseVertexRequest)(nil),         // 5: rpcpb.ParseVertexRequest
+	(*ParseVertexResponse)(nil),        // 6: rpcpb.ParseVertexResponse
+	(*BuildBlockRequest)(nil),          // 7: rpcpb.BuildBlockRequest
+	(*BuildBlockResponse)(nil),         // 8: rpcpb.BuildBlockResponse
+	(*BuildPlatformBlockRequest)(nil),  // 9: rpcpb.BuildPlatformBlockRequest
+	(*BuildPlatformBlockResponse)(nil), // 10: rpcpb.BuildPlatformBlockResponse
+	(*PackerOp)(nil),                   // 11: rpcpb.PackerOp
+	(*PackerByteSlices)(nil),           // 12: rpcpb.PackerByteSlices
+	(*PackerIP)(nil),                   // 13: rpcpb.PackerIP
+	(*PackPrimitivesRequest)(nil),      // 14: rpcpb.PackPrimitivesRequest
+	(*PackPrimitivesResponse)(nil),     // 15: rpcpb.PackPrimitivesResponse
+	(UpgradeEra)(0),                    // 16: rpcpb.UpgradeEra
+	(*Diff)(nil),                       // 17: rpcpb.Diff
+}
+var file_rpcpb_packer_proto_depIdxs = []int32{
+	16, // 0: rpcpb.BuildVertexRequest.upgrade_era:type_name -> rpcpb.UpgradeEra
+	17, // 1: rpcpb.BuildVertexResponse.diff:type_name -> rpcpb.Diff
+	4,  // 2: rpcpb.ParseVertexRequest.vertex:type_name -> rpcpb.Vertex
+	4,  // 3: rpcpb.ParseVertexResponse.expected_vertex:type_name -> rpcpb.Vertex
+	17, // 4: rpcpb.BuildBlockResponse.diff:type_name -> rpcpb.Diff
+	0,  // 5: rpcpb.BuildPlatformBlockRequest.block_type:type_name -> rpcpb.PlatformBlockType
+	16, // 6: rpcpb.BuildPlatformBlockRequest.upgrade_era:type_name -> rpcpb.UpgradeEra
+	1,  // 7: rpcpb.BuildPlatformBlockResponse.expected_fork:type_name -> rpcpb.PlatformBlockFork
+	0,  // 8: rpcpb.BuildPlatformBlockResponse.parsed_block_type:type_name -> rpcpb.PlatformBlockType
+	1,  // 9: rpcpb.BuildPlatformBlockResponse.parsed_fork:type_name -> rpcpb.PlatformBlockFork
+	17, // 10: rpcpb.BuildPlatformBlockResponse.diff:type_name -> rpcpb.Diff
+	12, // 11: rpcpb.PackerOp.pack_byte_slices:type_name -> rpcpb.PackerByteSlices
+	13, // 12: rpcpb.PackerOp.pack_ip:type_name -> rpcpb.PackerIP
+	11, // 13: rpcpb.PackPrimitivesRequest.ops:type_name -> rpcpb.PackerOp
+	17, // 14: rpcpb.PackPrimitivesResponse.diff:type_name -> rpcpb.Diff
+	2,  // 15: rpcpb.PackerService.BuildVertex:input_type -> rpcpb.BuildVertexRequest
+	5,  // 16: rpcpb.PackerService.ParseVertex:input_type -> rpcpb.ParseVertexRequest
+	7,  // 17: rpcpb.PackerService.BuildBlock:input_type -> rpcpb.BuildBlockRequest
+	9,  // 18: rpcpb.PackerService.BuildPlatformBlock:input_type -> rpcpb.BuildPlatformBlockRequest
+	14, // 19: rpcpb.PackerService.PackPrimitives:input_type -> rpcpb.PackPrimitivesRequest
+	3,  // 20: rpcpb.PackerService.BuildVertex:output_type -> rpcpb.BuildVertexResponse
+	6,  // 21: rpcpb.PackerService.ParseVertex:output_type -> rpcpb.ParseVertexResponse
+	8,  // 22: rpcpb.PackerService.BuildBlock:output_type -> rpcpb.BuildBlockResponse
+	10, // 23: rpcpb.PackerService.BuildPlatformBlock:output_type -> rpcpb.BuildPlatformBlockResponse
+	15, // 24: rpcpb.PackerService.PackPrimitives:output_type -> rpcpb.PackPrimitivesResponse
+	20, // [20:25] is the sub-list for method output_type
+	15, // [15:20] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_packer_proto_init() }
+func file_rpcpb_packer_proto_init() {
+	if File_rpcpb_packer_proto != nil {
+		return
+	}
+	file_rpcpb_diff_proto_init()
+	file_rpcpb_upgrade_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_packer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildVertexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildVertexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vertex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseVertexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseVertexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildPlatformBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildPlatformBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackerOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackerByteSlices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackerIP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackPrimitivesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_packer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackPrimitivesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpcpb_packer_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*PackerOp_PackByte)(nil),
+		(*PackerOp_PackShort)(nil),
+		(*PackerOp_PackInt)(nil),
+		(*PackerOp_PackLong)(nil),
+		(*PackerOp_PackBool)(nil),
+		(*PackerOp_PackFixedBytes)(nil),
+		(*PackerOp_PackBytes)(nil),
+		(*PackerOp_PackStr)(nil),
+		(*PackerOp_PackByteSlices)(nil),
+		(*PackerOp_PackIp)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpcpb_packer_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_rpcpb_packer_proto_goTypes,
 		DependencyIndexes: file_rpcpb_packer_proto_depIdxs,
+		EnumInfos:         file_rpcpb_packer_proto_enumTypes,
 		MessageInfos:      file_rpcpb_packer_proto_msgTypes,
 	}.Build()
 	File_rpcpb_packer_proto = out.File
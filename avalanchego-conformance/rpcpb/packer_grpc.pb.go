@@ -19,7 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PackerService_BuildVertex_FullMethodName = "/rpcpb.PackerService/BuildVertex"
+	PackerService_BuildVertex_FullMethodName        = "/rpcpb.PackerService/BuildVertex"
+	PackerService_ParseVertex_FullMethodName        = "/rpcpb.PackerService/ParseVertex"
+	PackerService_BuildBlock_FullMethodName         = "/rpcpb.PackerService/BuildBlock"
+	PackerService_BuildPlatformBlock_FullMethodName = "/rpcpb.PackerService/BuildPlatformBlock"
+	PackerService_PackPrimitives_FullMethodName     = "/rpcpb.PackerService/PackPrimitives"
 )
 
 // PackerServiceClient is the client API for PackerService service.
@@ -27,6 +31,19 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PackerServiceClient interface {
 	BuildVertex(ctx context.Context, in *BuildVertexRequest, opts ...grpc.CallOption) (*BuildVertexResponse, error)
+	// Parses vertex bytes, as avalanchego does on receiving a vertex, and
+	// checks the decoded fields and whether the vertex verifies: parents
+	// sorted and unique, and transactions sorted and unique by hash.
+	// ref. "vertex.Parse", "vertex.innerStatelessVertex.Verify"
+	ParseVertex(ctx context.Context, in *ParseVertexRequest, opts ...grpc.CallOption) (*ParseVertexResponse, error)
+	// Builds a snowman block as wrapped by avalanchego "proposervm".
+	BuildBlock(ctx context.Context, in *BuildBlockRequest, opts ...grpc.CallOption) (*BuildBlockResponse, error)
+	// Builds a stateless P-chain block with avalanchego's blocks codec, and
+	// checks its bytes and ID, and the fork the client encoding belongs to.
+	// ref. "blocks.Codec", "blocks.Parse"
+	BuildPlatformBlock(ctx context.Context, in *BuildPlatformBlockRequest, opts ...grpc.CallOption) (*BuildPlatformBlockResponse, error)
+	// Packs a sequence of avalanchego "wrappers.Packer" primitives.
+	PackPrimitives(ctx context.Context, in *PackPrimitivesRequest, opts ...grpc.CallOption) (*PackPrimitivesResponse, error)
 }
 
 type packerServiceClient struct {
@@ -46,11 +63,60 @@ func (c *packerServiceClient) BuildVertex(ctx context.Context, in *BuildVertexRe
 	return out, nil
 }
 
+func (c *packerServiceClient) ParseVertex(ctx context.Context, in *ParseVertexRequest, opts ...grpc.CallOption) (*ParseVertexResponse, error) {
+	out := new(ParseVertexResponse)
+	err := c.cc.Invoke(ctx, PackerService_ParseVertex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packerServiceClient) BuildBlock(ctx context.Context, in *BuildBlockRequest, opts ...grpc.CallOption) (*BuildBlockResponse, error) {
+	out := new(BuildBlockResponse)
+	err := c.cc.Invoke(ctx, PackerService_BuildBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packerServiceClient) BuildPlatformBlock(ctx context.Context, in *BuildPlatformBlockRequest, opts ...grpc.CallOption) (*BuildPlatformBlockResponse, error) {
+	out := new(BuildPlatformBlockResponse)
+	err := c.cc.Invoke(ctx, PackerService_BuildPlatformBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *packerServiceClient) PackPrimitives(ctx context.Context, in *PackPrimitivesRequest, opts ...grpc.CallOption) (*PackPrimitivesResponse, error) {
+	out := new(PackPrimitivesResponse)
+	err := c.cc.Invoke(ctx, PackerService_PackPrimitives_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PackerServiceServer is the server API for PackerService service.
 // All implementations must embed UnimplementedPackerServiceServer
 // for forward compatibility
 type PackerServiceServer interface {
 	BuildVertex(context.Context, *BuildVertexRequest) (*BuildVertexResponse, error)
+	// Parses vertex bytes, as avalanchego does on receiving a vertex, and
+	// checks the decoded fields and whether the vertex verifies: parents
+	// sorted and unique, and transactions sorted and unique by hash.
+	// ref. "vertex.Parse", "vertex.innerStatelessVertex.Verify"
+	ParseVertex(context.Context, *ParseVertexRequest) (*ParseVertexResponse, error)
+	// Builds a snowman block as wrapped by avalanchego "proposervm".
+	BuildBlock(context.Context, *BuildBlockRequest) (*BuildBlockResponse, error)
+	// Builds a stateless P-chain block with avalanchego's blocks codec, and
+	// checks its bytes and ID, and the fork the client encoding belongs to.
+	// ref. "blocks.Codec", "blocks.Parse"
+	BuildPlatformBlock(context.Context, *BuildPlatformBlockRequest) (*BuildPlatformBlockResponse, error)
+	// Packs a sequence of avalanchego "wrappers.Packer" primitives.
+	PackPrimitives(context.Context, *PackPrimitivesRequest) (*PackPrimitivesResponse, error)
 	mustEmbedUnimplementedPackerServiceServer()
 }
 
@@ -61,6 +127,18 @@ type UnimplementedPackerServiceServer struct {
 func (UnimplementedPackerServiceServer) BuildVertex(context.Context, *BuildVertexRequest) (*BuildVertexResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BuildVertex not implemented")
 }
+func (UnimplementedPackerServiceServer) ParseVertex(context.Context, *ParseVertexRequest) (*ParseVertexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseVertex not implemented")
+}
+func (UnimplementedPackerServiceServer) BuildBlock(context.Context, *BuildBlockRequest) (*BuildBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildBlock not implemented")
+}
+func (UnimplementedPackerServiceServer) BuildPlatformBlock(context.Context, *BuildPlatformBlockRequest) (*BuildPlatformBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildPlatformBlock not implemented")
+}
+func (UnimplementedPackerServiceServer) PackPrimitives(context.Context, *PackPrimitivesRequest) (*PackPrimitivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PackPrimitives not implemented")
+}
 func (UnimplementedPackerServiceServer) mustEmbedUnimplementedPackerServiceServer() {}
 
 // UnsafePackerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +170,78 @@ func _PackerService_BuildVertex_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PackerService_ParseVertex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseVertexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackerServiceServer).ParseVertex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackerService_ParseVertex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackerServiceServer).ParseVertex(ctx, req.(*ParseVertexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackerService_BuildBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackerServiceServer).BuildBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackerService_BuildBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackerServiceServer).BuildBlock(ctx, req.(*BuildBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackerService_BuildPlatformBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildPlatformBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackerServiceServer).BuildPlatformBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackerService_BuildPlatformBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackerServiceServer).BuildPlatformBlock(ctx, req.(*BuildPlatformBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PackerService_PackPrimitives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PackPrimitivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PackerServiceServer).PackPrimitives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PackerService_PackPrimitives_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PackerServiceServer).PackPrimitives(ctx, req.(*PackPrimitivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PackerService_ServiceDesc is the grpc.ServiceDesc for PackerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +253,22 @@ var PackerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BuildVertex",
 			Handler:    _PackerService_BuildVertex_Handler,
 		},
+		{
+			MethodName: "ParseVertex",
+			Handler:    _PackerService_ParseVertex_Handler,
+		},
+		{
+			MethodName: "BuildBlock",
+			Handler:    _PackerService_BuildBlock_Handler,
+		},
+		{
+			MethodName: "BuildPlatformBlock",
+			Handler:    _PackerService_BuildPlatformBlock_Handler,
+		},
+		{
+			MethodName: "PackPrimitives",
+			Handler:    _PackerService_PackPrimitives_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpcpb/packer.proto",
@@ -19,7 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PingService_PingService_FullMethodName = "/rpcpb.PingService/PingService"
+	PingService_PingService_FullMethodName  = "/rpcpb.PingService/PingService"
+	PingService_ServiceUsage_FullMethodName = "/rpcpb.PingService/ServiceUsage"
+	PingService_Capabilities_FullMethodName = "/rpcpb.PingService/Capabilities"
+	PingService_Shutdown_FullMethodName     = "/rpcpb.PingService/Shutdown"
+	PingService_Listeners_FullMethodName    = "/rpcpb.PingService/Listeners"
 )
 
 // PingServiceClient is the client API for PingService service.
@@ -27,6 +31,18 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PingServiceClient interface {
 	PingService(ctx context.Context, in *PingServiceRequest, opts ...grpc.CallOption) (*PingServiceResponse, error)
+	ServiceUsage(ctx context.Context, in *ServiceUsageRequest, opts ...grpc.CallOption) (*ServiceUsageResponse, error)
+	// Reports what the server can validate, so that test harnesses can skip
+	// the checks it does not support.
+	Capabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error)
+	// Shuts the server down once in-flight requests are drained, so that
+	// harnesses can terminate it cleanly when a test run completes. Only
+	// enabled when the server is started with a shutdown token.
+	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error)
+	// Lists the gRPC listeners of the process, i.e., every instance of a
+	// "server multi" process, so that parallel test shards can each pick
+	// their own endpoint. A single server lists itself.
+	Listeners(ctx context.Context, in *ListenersRequest, opts ...grpc.CallOption) (*ListenersResponse, error)
 }
 
 type pingServiceClient struct {
@@ -46,11 +62,59 @@ func (c *pingServiceClient) PingService(ctx context.Context, in *PingServiceRequ
 	return out, nil
 }
 
+func (c *pingServiceClient) ServiceUsage(ctx context.Context, in *ServiceUsageRequest, opts ...grpc.CallOption) (*ServiceUsageResponse, error) {
+	out := new(ServiceUsageResponse)
+	err := c.cc.Invoke(ctx, PingService_ServiceUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pingServiceClient) Capabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error) {
+	out := new(CapabilitiesResponse)
+	err := c.cc.Invoke(ctx, PingService_Capabilities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pingServiceClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error) {
+	out := new(ShutdownResponse)
+	err := c.cc.Invoke(ctx, PingService_Shutdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pingServiceClient) Listeners(ctx context.Context, in *ListenersRequest, opts ...grpc.CallOption) (*ListenersResponse, error) {
+	out := new(ListenersResponse)
+	err := c.cc.Invoke(ctx, PingService_Listeners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PingServiceServer is the server API for PingService service.
 // All implementations must embed UnimplementedPingServiceServer
 // for forward compatibility
 type PingServiceServer interface {
 	PingService(context.Context, *PingServiceRequest) (*PingServiceResponse, error)
+	ServiceUsage(context.Context, *ServiceUsageRequest) (*ServiceUsageResponse, error)
+	// Reports what the server can validate, so that test harnesses can skip
+	// the checks it does not support.
+	Capabilities(context.Context, *CapabilitiesRequest) (*CapabilitiesResponse, error)
+	// Shuts the server down once in-flight requests are drained, so that
+	// harnesses can terminate it cleanly when a test run completes. Only
+	// enabled when the server is started with a shutdown token.
+	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
+	// Lists the gRPC listeners of the process, i.e., every instance of a
+	// "server multi" process, so that parallel test shards can each pick
+	// their own endpoint. A single server lists itself.
+	Listeners(context.Context, *ListenersRequest) (*ListenersResponse, error)
 	mustEmbedUnimplementedPingServiceServer()
 }
 
@@ -61,6 +125,18 @@ type UnimplementedPingServiceServer struct {
 func (UnimplementedPingServiceServer) PingService(context.Context, *PingServiceRequest) (*PingServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PingService not implemented")
 }
+func (UnimplementedPingServiceServer) ServiceUsage(context.Context, *ServiceUsageRequest) (*ServiceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceUsage not implemented")
+}
+func (UnimplementedPingServiceServer) Capabilities(context.Context, *CapabilitiesRequest) (*CapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Capabilities not implemented")
+}
+func (UnimplementedPingServiceServer) Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+}
+func (UnimplementedPingServiceServer) Listeners(context.Context, *ListenersRequest) (*ListenersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Listeners not implemented")
+}
 func (UnimplementedPingServiceServer) mustEmbedUnimplementedPingServiceServer() {}
 
 // UnsafePingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +168,78 @@ func _PingService_PingService_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PingService_ServiceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingServiceServer).ServiceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PingService_ServiceUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingServiceServer).ServiceUsage(ctx, req.(*ServiceUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PingService_Capabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingServiceServer).Capabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PingService_Capabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingServiceServer).Capabilities(ctx, req.(*CapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PingService_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingServiceServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PingService_Shutdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingServiceServer).Shutdown(ctx, req.(*ShutdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PingService_Listeners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListenersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingServiceServer).Listeners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PingService_Listeners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingServiceServer).Listeners(ctx, req.(*ListenersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PingService_ServiceDesc is the grpc.ServiceDesc for PingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +251,22 @@ var PingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PingService",
 			Handler:    _PingService_PingService_Handler,
 		},
+		{
+			MethodName: "ServiceUsage",
+			Handler:    _PingService_ServiceUsage_Handler,
+		},
+		{
+			MethodName: "Capabilities",
+			Handler:    _PingService_Capabilities_Handler,
+		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _PingService_Shutdown_Handler,
+		},
+		{
+			MethodName: "Listeners",
+			Handler:    _PingService_Listeners_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpcpb/ping.proto",
@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/proof.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ProofService_MerkleRoot_FullMethodName  = "/rpcpb.ProofService/MerkleRoot"
+	ProofService_RangeProof_FullMethodName  = "/rpcpb.ProofService/RangeProof"
+	ProofService_ChangeProof_FullMethodName = "/rpcpb.ProofService/ChangeProof"
+)
+
+// ProofServiceClient is the client API for ProofService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProofServiceClient interface {
+	// MerkleRoot computes the root ID of the trie written by the operations.
+	// ref. "merkledb.Database.GetMerkleRoot"
+	MerkleRoot(ctx context.Context, in *MerkleRootRequest, opts ...grpc.CallOption) (*MerkleRootResponse, error)
+	// RangeProof checks a serialized range proof of the key/values of a range
+	// of the trie, and whether it verifies against the root ID.
+	// ref. "merkledb.Database.GetRangeProof", "merkledb.RangeProof.Verify"
+	RangeProof(ctx context.Context, in *RangeProofRequest, opts ...grpc.CallOption) (*RangeProofResponse, error)
+	// ChangeProof checks a serialized change proof of the keys of a range
+	// changed by a batch of operations, and whether it verifies against the
+	// root ID of the changed trie.
+	// ref. "merkledb.Database.GetChangeProof", "merkledb.ChangeProof.Verify"
+	ChangeProof(ctx context.Context, in *ChangeProofRequest, opts ...grpc.CallOption) (*ChangeProofResponse, error)
+}
+
+type proofServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProofServiceClient(cc grpc.ClientConnInterface) ProofServiceClient {
+	return &proofServiceClient{cc}
+}
+
+func (c *proofServiceClient) MerkleRoot(ctx context.Context, in *MerkleRootRequest, opts ...grpc.CallOption) (*MerkleRootResponse, error) {
+	out := new(MerkleRootResponse)
+	err := c.cc.Invoke(ctx, ProofService_MerkleRoot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proofServiceClient) RangeProof(ctx context.Context, in *RangeProofRequest, opts ...grpc.CallOption) (*RangeProofResponse, error) {
+	out := new(RangeProofResponse)
+	err := c.cc.Invoke(ctx, ProofService_RangeProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proofServiceClient) ChangeProof(ctx context.Context, in *ChangeProofRequest, opts ...grpc.CallOption) (*ChangeProofResponse, error) {
+	out := new(ChangeProofResponse)
+	err := c.cc.Invoke(ctx, ProofService_ChangeProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProofServiceServer is the server API for ProofService service.
+// All implementations must embed UnimplementedProofServiceServer
+// for forward compatibility
+type ProofServiceServer interface {
+	// MerkleRoot computes the root ID of the trie written by the operations.
+	// ref. "merkledb.Database.GetMerkleRoot"
+	MerkleRoot(context.Context, *MerkleRootRequest) (*MerkleRootResponse, error)
+	// RangeProof checks a serialized range proof of the key/values of a range
+	// of the trie, and whether it verifies against the root ID.
+	// ref. "merkledb.Database.GetRangeProof", "merkledb.RangeProof.Verify"
+	RangeProof(context.Context, *RangeProofRequest) (*RangeProofResponse, error)
+	// ChangeProof checks a serialized change proof of the keys of a range
+	// changed by a batch of operations, and whether it verifies against the
+	// root ID of the changed trie.
+	// ref. "merkledb.Database.GetChangeProof", "merkledb.ChangeProof.Verify"
+	ChangeProof(context.Context, *ChangeProofRequest) (*ChangeProofResponse, error)
+	mustEmbedUnimplementedProofServiceServer()
+}
+
+// UnimplementedProofServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProofServiceServer struct {
+}
+
+func (UnimplementedProofServiceServer) MerkleRoot(context.Context, *MerkleRootRequest) (*MerkleRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleRoot not implemented")
+}
+func (UnimplementedProofServiceServer) RangeProof(context.Context, *RangeProofRequest) (*RangeProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RangeProof not implemented")
+}
+func (UnimplementedProofServiceServer) ChangeProof(context.Context, *ChangeProofRequest) (*ChangeProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeProof not implemented")
+}
+func (UnimplementedProofServiceServer) mustEmbedUnimplementedProofServiceServer() {}
+
+// UnsafeProofServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProofServiceServer will
+// result in compilation errors.
+type UnsafeProofServiceServer interface {
+	mustEmbedUnimplementedProofServiceServer()
+}
+
+func RegisterProofServiceServer(s grpc.ServiceRegistrar, srv ProofServiceServer) {
+	s.RegisterService(&ProofService_ServiceDesc, srv)
+}
+
+func _ProofService_MerkleRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MerkleRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofServiceServer).MerkleRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProofService_MerkleRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofServiceServer).MerkleRoot(ctx, req.(*MerkleRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProofService_RangeProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofServiceServer).RangeProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProofService_RangeProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofServiceServer).RangeProof(ctx, req.(*RangeProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProofService_ChangeProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofServiceServer).ChangeProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProofService_ChangeProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofServiceServer).ChangeProof(ctx, req.(*ChangeProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProofService_ServiceDesc is the grpc.ServiceDesc for ProofService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProofService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.ProofService",
+	HandlerType: (*ProofServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MerkleRoot",
+			Handler:    _ProofService_MerkleRoot_Handler,
+		},
+		{
+			MethodName: "RangeProof",
+			Handler:    _ProofService_RangeProof_Handler,
+		},
+		{
+			MethodName: "ChangeProof",
+			Handler:    _ProofService_ChangeProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/proof.proto",
+}
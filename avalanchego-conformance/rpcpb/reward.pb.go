@@ -0,0 +1,1119 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/reward.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StakerKind int32
+
+const (
+	StakerKind_STAKER_KIND_UNSPECIFIED StakerKind = 0
+	StakerKind_STAKER_KIND_VALIDATOR   StakerKind = 1
+	StakerKind_STAKER_KIND_DELEGATOR   StakerKind = 2
+)
+
+// Enum value maps for StakerKind.
+var (
+	StakerKind_name = map[int32]string{
+		0: "STAKER_KIND_UNSPECIFIED",
+		1: "STAKER_KIND_VALIDATOR",
+		2: "STAKER_KIND_DELEGATOR",
+	}
+	StakerKind_value = map[string]int32{
+		"STAKER_KIND_UNSPECIFIED": 0,
+		"STAKER_KIND_VALIDATOR":   1,
+		"STAKER_KIND_DELEGATOR":   2,
+	}
+)
+
+func (x StakerKind) Enum() *StakerKind {
+	p := new(StakerKind)
+	*p = x
+	return p
+}
+
+func (x StakerKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StakerKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_reward_proto_enumTypes[0].Descriptor()
+}
+
+func (StakerKind) Type() protoreflect.EnumType {
+	return &file_rpcpb_reward_proto_enumTypes[0]
+}
+
+func (x StakerKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StakerKind.Descriptor instead.
+func (StakerKind) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_reward_proto_rawDescGZIP(), []int{0}
+}
+
+// RewardConfig is the config of the reward function.
+// ref. "reward.Config"
+type RewardConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rates are in parts per 1000000 ("reward.PercentDenominator").
+	MaxConsumptionRate uint64 `protobuf:"varint,1,opt,name=max_consumption_rate,json=maxConsumptionRate,proto3" json:"max_consumption_rate,omitempty"`
+	MinConsumptionRate uint64 `protobuf:"varint,2,opt,name=min_consumption_rate,json=minConsumptionRate,proto3" json:"min_consumption_rate,omitempty"`
+	// In nanoseconds.
+	MintingPeriod uint64 `protobuf:"varint,3,opt,name=minting_period,json=mintingPeriod,proto3" json:"minting_period,omitempty"`
+	SupplyCap     uint64 `protobuf:"varint,4,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap,omitempty"`
+}
+
+func (x *RewardConfig) Reset() {
+	*x = RewardConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_reward_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardConfig) ProtoMessage() {}
+
+func (x *RewardConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_reward_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardConfig.ProtoReflect.Descriptor instead.
+func (*RewardConfig) Descriptor() ([]byte, []int) {
+	return file_rpcpb_reward_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RewardConfig) GetMaxConsumptionRate() uint64 {
+	if x != nil {
+		return x.MaxConsumptionRate
+	}
+	return 0
+}
+
+func (x *RewardConfig) GetMinConsumptionRate() uint64 {
+	if x != nil {
+		return x.MinConsumptionRate
+	}
+	return 0
+}
+
+func (x *RewardConfig) GetMintingPeriod() uint64 {
+	if x != nil {
+		return x.MintingPeriod
+	}
+	return 0
+}
+
+func (x *RewardConfig) GetSupplyCap() uint64 {
+	if x != nil {
+		return x.SupplyCap
+	}
+	return 0
+}
+
+// StakingConfig is the staking config of the primary network.
+// ref. "genesis.StakingConfig"
+type StakingConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinValidatorStake uint64 `protobuf:"varint,1,opt,name=min_validator_stake,json=minValidatorStake,proto3" json:"min_validator_stake,omitempty"`
+	MaxValidatorStake uint64 `protobuf:"varint,2,opt,name=max_validator_stake,json=maxValidatorStake,proto3" json:"max_validator_stake,omitempty"`
+	MinDelegatorStake uint64 `protobuf:"varint,3,opt,name=min_delegator_stake,json=minDelegatorStake,proto3" json:"min_delegator_stake,omitempty"`
+	// In parts per 1000000.
+	MinDelegationFee uint32 `protobuf:"varint,4,opt,name=min_delegation_fee,json=minDelegationFee,proto3" json:"min_delegation_fee,omitempty"`
+	// In nanoseconds.
+	MinStakeDuration uint64        `protobuf:"varint,5,opt,name=min_stake_duration,json=minStakeDuration,proto3" json:"min_stake_duration,omitempty"`
+	MaxStakeDuration uint64        `protobuf:"varint,6,opt,name=max_stake_duration,json=maxStakeDuration,proto3" json:"max_stake_duration,omitempty"`
+	RewardConfig     *RewardConfig `protobuf:"bytes,7,opt,name=reward_config,json=rewardConfig,proto3" json:"reward_config,omitempty"`
+}
+
+func (x *StakingConfig) Reset() {
+	*x = StakingConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_reward_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingConfig) ProtoMessage() {}
+
+func (x *StakingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_reward_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingConfig.ProtoReflect.Descriptor instead.
+func (*StakingConfig) Descriptor() ([]byte, []int) {
+	return file_rpcpb_reward_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StakingConfig) GetMinValidatorStake() uint64 {
+	if x != nil {
+		return x.MinValidatorStake
+	}
+	return 0
+}
+
+func (x *StakingConfig) GetMaxValidatorStake() uint64 {
+	if x != nil {
+		return x.MaxValidatorStake
+	}
+	return 0
+}
+
+func (x *StakingConfig) GetMinDelegatorStake() uint64 {
+	if x != nil {
+		return x.MinDelegatorStake
+	}
+	return 0
+}
+
+func (x *StakingConfig) GetMinDelegationFee() uint32 {
+	if x != nil {
+		return x.MinDelegationFee
+	}
+	return 0
+}
+
+func (x *StakingConfig) GetMinStakeDuration() uint64 {
+	if x != nil {
+		return x.MinStakeDuration
+	}
+	return 0
+}
+
+func (x *StakingConfig) GetMaxStakeDuration() uint64 {
+	if x != nil {
+		return x.MaxStakeDuration
+	}
+	return 0
+}
+
+func (x *StakingConfig) GetRewardConfig() *RewardConfig {
+	if x != nil {
+		return x.RewardConfig
+	}
+	return nil
+}
+
+type StakingRewardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Network whose reward config applies (the local network's for networks
+	// other than mainnet and fuji), unless the reward config is set.
+	NetworkId    uint32        `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	RewardConfig *RewardConfig `protobuf:"bytes,2,opt,name=reward_config,json=rewardConfig,proto3" json:"reward_config,omitempty"`
+	StakedAmount uint64        `protobuf:"varint,3,opt,name=staked_amount,json=stakedAmount,proto3" json:"staked_amount,omitempty"`
+	// In nanoseconds.
+	StakedDuration uint64 `protobuf:"varint,4,opt,name=staked_duration,json=stakedDuration,proto3" json:"staked_duration,omitempty"`
+	// Current supply of the primary network, at most the supply cap.
+	CurrentSupply uint64 `protobuf:"varint,5,opt,name=current_supply,json=currentSupply,proto3" json:"current_supply,omitempty"`
+	// Reward computed by the client.
+	Reward uint64 `protobuf:"varint,6,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (x *StakingRewardRequest) Reset() {
+	*x = StakingRewardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_reward_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingRewardRequest) ProtoMessage() {}
+
+func (x *StakingRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_reward_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingRewardRequest.ProtoReflect.Descriptor instead.
+func (*StakingRewardRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_reward_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StakingRewardRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *StakingRewardRequest) GetRewardConfig() *RewardConfig {
+	if x != nil {
+		return x.RewardConfig
+	}
+	return nil
+}
+
+func (x *StakingRewardRequest) GetStakedAmount() uint64 {
+	if x != nil {
+		return x.StakedAmount
+	}
+	return 0
+}
+
+func (x *StakingRewardRequest) GetStakedDuration() uint64 {
+	if x != nil {
+		return x.StakedDuration
+	}
+	return 0
+}
+
+func (x *StakingRewardRequest) GetCurrentSupply() uint64 {
+	if x != nil {
+		return x.CurrentSupply
+	}
+	return 0
+}
+
+func (x *StakingRewardRequest) GetReward() uint64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+type StakingRewardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedReward uint64 `protobuf:"varint,1,opt,name=expected_reward,json=expectedReward,proto3" json:"expected_reward,omitempty"`
+	// Reward config the reward was computed with.
+	ExpectedRewardConfig *RewardConfig `protobuf:"bytes,2,opt,name=expected_reward_config,json=expectedRewardConfig,proto3" json:"expected_reward_config,omitempty"`
+	Message              string        `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success              bool          `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *StakingRewardResponse) Reset() {
+	*x = StakingRewardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_reward_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingRewardResponse) ProtoMessage() {}
+
+func (x *StakingRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_reward_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingRewardResponse.ProtoReflect.Descriptor instead.
+func (*StakingRewardResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_reward_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StakingRewardResponse) GetExpectedReward() uint64 {
+	if x != nil {
+		return x.ExpectedReward
+	}
+	return 0
+}
+
+func (x *StakingRewardResponse) GetExpectedRewardConfig() *RewardConfig {
+	if x != nil {
+		return x.ExpectedRewardConfig
+	}
+	return nil
+}
+
+func (x *StakingRewardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StakingRewardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StakingRewardResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type DelegationRewardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Potential reward of the delegator.
+	PotentialReward uint64 `protobuf:"varint,1,opt,name=potential_reward,json=potentialReward,proto3" json:"potential_reward,omitempty"`
+	// Delegation fee of the validator, in parts per 1000000.
+	DelegationShares uint32 `protobuf:"varint,2,opt,name=delegation_shares,json=delegationShares,proto3" json:"delegation_shares,omitempty"`
+	// Rewards computed by the client.
+	DelegatorReward uint64 `protobuf:"varint,3,opt,name=delegator_reward,json=delegatorReward,proto3" json:"delegator_reward,omitempty"`
+	DelegateeReward uint64 `protobuf:"varint,4,opt,name=delegatee_reward,json=delegateeReward,proto3" json:"delegatee_reward,omitempty"`
+}
+
+func (x *DelegationRewardRequest) Reset() {
+	*x = DelegationRewardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_reward_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegationRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationRewardRequest) ProtoMessage() {}
+
+func (x *DelegationRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_reward_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationRewardRequest.ProtoReflect.Descriptor instead.
+func (*DelegationRewardRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_reward_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DelegationRewardRequest) GetPotentialReward() uint64 {
+	if x != nil {
+		return x.PotentialReward
+	}
+	return 0
+}
+
+func (x *DelegationRewardRequest) GetDelegationShares() uint32 {
+	if x != nil {
+		return x.DelegationShares
+	}
+	return 0
+}
+
+func (x *DelegationRewardRequest) GetDelegatorReward() uint64 {
+	if x != nil {
+		return x.DelegatorReward
+	}
+	return 0
+}
+
+func (x *DelegationRewardRequest) GetDelegateeReward() uint64 {
+	if x != nil {
+		return x.DelegateeReward
+	}
+	return 0
+}
+
+type DelegationRewardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedDelegatorReward uint64 `protobuf:"varint,1,opt,name=expected_delegator_reward,json=expectedDelegatorReward,proto3" json:"expected_delegator_reward,omitempty"`
+	ExpectedDelegateeReward uint64 `protobuf:"varint,2,opt,name=expected_delegatee_reward,json=expectedDelegateeReward,proto3" json:"expected_delegatee_reward,omitempty"`
+	Message                 string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success                 bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *DelegationRewardResponse) Reset() {
+	*x = DelegationRewardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_reward_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegationRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationRewardResponse) ProtoMessage() {}
+
+func (x *DelegationRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_reward_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationRewardResponse.ProtoReflect.Descriptor instead.
+func (*DelegationRewardResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_reward_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DelegationRewardResponse) GetExpectedDelegatorReward() uint64 {
+	if x != nil {
+		return x.ExpectedDelegatorReward
+	}
+	return 0
+}
+
+func (x *DelegationRewardResponse) GetExpectedDelegateeReward() uint64 {
+	if x != nil {
+		return x.ExpectedDelegateeReward
+	}
+	return 0
+}
+
+func (x *DelegationRewardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DelegationRewardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DelegationRewardResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type StakingLimitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Network whose staking config applies (the local network's for networks
+	// other than mainnet and fuji), unless the staking config is set.
+	NetworkId     uint32         `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	StakingConfig *StakingConfig `protobuf:"bytes,2,opt,name=staking_config,json=stakingConfig,proto3" json:"staking_config,omitempty"`
+	Kind          StakerKind     `protobuf:"varint,3,opt,name=kind,proto3,enum=rpcpb.StakerKind" json:"kind,omitempty"`
+	Weight        uint64         `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// In nanoseconds.
+	Duration uint64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Delegation fee of a validator, in parts per 1000000.
+	DelegationShares uint32 `protobuf:"varint,6,opt,name=delegation_shares,json=delegationShares,proto3" json:"delegation_shares,omitempty"`
+	// Stake of the validator of a delegator.
+	ValidatorWeight uint64 `protobuf:"varint,7,opt,name=validator_weight,json=validatorWeight,proto3" json:"validator_weight,omitempty"`
+	// Peak weight of the validator and its current delegators over the
+	// staking period of the delegator.
+	DelegatedWeight uint64 `protobuf:"varint,8,opt,name=delegated_weight,json=delegatedWeight,proto3" json:"delegated_weight,omitempty"`
+	// Whether the client rejects the staker.
+	Rejected bool `protobuf:"varint,9,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *StakingLimitsRequest) Reset() {
+	*x = StakingLimitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_reward_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingLimitsRequest) ProtoMessage() {}
+
+func (x *StakingLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_reward_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingLimitsRequest.ProtoReflect.Descriptor instead.
+func (*StakingLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_reward_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StakingLimitsRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *StakingLimitsRequest) GetStakingConfig() *StakingConfig {
+	if x != nil {
+		return x.StakingConfig
+	}
+	return nil
+}
+
+func (x *StakingLimitsRequest) GetKind() StakerKind {
+	if x != nil {
+		return x.Kind
+	}
+	return StakerKind_STAKER_KIND_UNSPECIFIED
+}
+
+func (x *StakingLimitsRequest) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *StakingLimitsRequest) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *StakingLimitsRequest) GetDelegationShares() uint32 {
+	if x != nil {
+		return x.DelegationShares
+	}
+	return 0
+}
+
+func (x *StakingLimitsRequest) GetValidatorWeight() uint64 {
+	if x != nil {
+		return x.ValidatorWeight
+	}
+	return 0
+}
+
+func (x *StakingLimitsRequest) GetDelegatedWeight() uint64 {
+	if x != nil {
+		return x.DelegatedWeight
+	}
+	return 0
+}
+
+func (x *StakingLimitsRequest) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+type StakingLimitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Staking config the staker was checked against.
+	ExpectedStakingConfig *StakingConfig `protobuf:"bytes,1,opt,name=expected_staking_config,json=expectedStakingConfig,proto3" json:"expected_staking_config,omitempty"`
+	// Maximum weight of the validator of a delegator with all its delegators:
+	// 5 times its stake, at most the maximum validator stake.
+	ExpectedMaxDelegatedWeight uint64 `protobuf:"varint,2,opt,name=expected_max_delegated_weight,json=expectedMaxDelegatedWeight,proto3" json:"expected_max_delegated_weight,omitempty"`
+	ExpectedError              string `protobuf:"bytes,3,opt,name=expected_error,json=expectedError,proto3" json:"expected_error,omitempty"`
+	Message                    string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success                    bool   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *StakingLimitsResponse) Reset() {
+	*x = StakingLimitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_reward_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingLimitsResponse) ProtoMessage() {}
+
+func (x *StakingLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_reward_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingLimitsResponse.ProtoReflect.Descriptor instead.
+func (*StakingLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_reward_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StakingLimitsResponse) GetExpectedStakingConfig() *StakingConfig {
+	if x != nil {
+		return x.ExpectedStakingConfig
+	}
+	return nil
+}
+
+func (x *StakingLimitsResponse) GetExpectedMaxDelegatedWeight() uint64 {
+	if x != nil {
+		return x.ExpectedMaxDelegatedWeight
+	}
+	return 0
+}
+
+func (x *StakingLimitsResponse) GetExpectedError() string {
+	if x != nil {
+		return x.ExpectedError
+	}
+	return ""
+}
+
+func (x *StakingLimitsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StakingLimitsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StakingLimitsResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_reward_proto protoreflect.FileDescriptor
+
+var file_rpcpb_reward_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x22, 0xb8, 0x01, 0x0a, 0x0c,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x14,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x30,
+	0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x43, 0x61, 0x70, 0x22, 0xe3, 0x02, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xfc, 0x01, 0x0a,
+	0x14, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xed, 0x01, 0x0a, 0x15,
+	0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x49,
+	0x0a, 0x16, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x17,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xf4, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x3a,
+	0x0a, 0x19, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xec, 0x02, 0x0a,
+	0x14, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xb1, 0x02, 0x0a, 0x15,
+	0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x15, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x1d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x2a,
+	0x5f, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a,
+	0x17, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54,
+	0x41, 0x4b, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41,
+	0x54, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x52, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x02,
+	0x32, 0x82, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x55, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x2d, 0x72, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
+	0x65, 0x3b, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_reward_proto_rawDescOnce sync.Once
+	file_rpcpb_reward_proto_rawDescData = file_rpcpb_reward_proto_rawDesc
+)
+
+func file_rpcpb_reward_proto_rawDescGZIP() []byte {
+	file_rpcpb_reward_proto_rawDescOnce.Do(func() {
+		file_rpcpb_reward_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_reward_proto_rawDescData)
+	})
+	return file_rpcpb_reward_proto_rawDescData
+}
+
+var file_rpcpb_reward_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpcpb_reward_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_rpcpb_reward_proto_goTypes = []interface{}{
+	(StakerKind)(0),                  // 0: rpcpb.StakerKind
+	(*RewardConfig)(nil),             // 1: rpcpb.RewardConfig
+	(*StakingConfig)(nil),            // 2: rpcpb.StakingConfig
+	(*StakingRewardRequest)(nil),     // 3: rpcpb.StakingRewardRequest
+	(*StakingRewardResponse)(nil),    // 4: rpcpb.StakingRewardResponse
+	(*DelegationRewardRequest)(nil),  // 5: rpcpb.DelegationRewardRequest
+	(*DelegationRewardResponse)(nil), // 6: rpcpb.DelegationRewardResponse
+	(*StakingLimitsRequest)(nil),     // 7: rpcpb.StakingLimitsRequest
+	(*StakingLimitsResponse)(nil),    // 8: rpcpb.StakingLimitsResponse
+}
+var file_rpcpb_reward_proto_depIdxs = []int32{
+	1, // 0: rpcpb.StakingConfig.reward_config:type_name -> rpcpb.RewardConfig
+	1, // 1: rpcpb.StakingRewardRequest.reward_config:type_name -> rpcpb.RewardConfig
+	1, // 2: rpcpb.StakingRewardResponse.expected_reward_config:type_name -> rpcpb.RewardConfig
+	2, // 3: rpcpb.StakingLimitsRequest.staking_config:type_name -> rpcpb.StakingConfig
+	0, // 4: rpcpb.StakingLimitsRequest.kind:type_name -> rpcpb.StakerKind
+	2, // 5: rpcpb.StakingLimitsResponse.expected_staking_config:type_name -> rpcpb.StakingConfig
+	3, // 6: rpcpb.RewardService.StakingReward:input_type -> rpcpb.StakingRewardRequest
+	5, // 7: rpcpb.RewardService.DelegationReward:input_type -> rpcpb.DelegationRewardRequest
+	7, // 8: rpcpb.RewardService.StakingLimits:input_type -> rpcpb.StakingLimitsRequest
+	4, // 9: rpcpb.RewardService.StakingReward:output_type -> rpcpb.StakingRewardResponse
+	6, // 10: rpcpb.RewardService.DelegationReward:output_type -> rpcpb.DelegationRewardResponse
+	8, // 11: rpcpb.RewardService.StakingLimits:output_type -> rpcpb.StakingLimitsResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_reward_proto_init() }
+func file_rpcpb_reward_proto_init() {
+	if File_rpcpb_reward_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_reward_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_reward_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_reward_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingRewardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_reward_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingRewardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_reward_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegationRewardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_reward_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegationRewardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_reward_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingLimitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_reward_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingLimitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_reward_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_reward_proto_goTypes,
+		DependencyIndexes: file_rpcpb_reward_proto_depIdxs,
+		EnumInfos:         file_rpcpb_reward_proto_enumTypes,
+		MessageInfos:      file_rpcpb_reward_proto_msgTypes,
+	}.Build()
+	File_rpcpb_reward_proto = out.File
+	file_rpcpb_reward_proto_rawDesc = nil
+	file_rpcpb_reward_proto_goTypes = nil
+	file_rpcpb_reward_proto_depIdxs = nil
+}
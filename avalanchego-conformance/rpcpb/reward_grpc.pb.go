@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/reward.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RewardService_StakingReward_FullMethodName    = "/rpcpb.RewardService/StakingReward"
+	RewardService_DelegationReward_FullMethodName = "/rpcpb.RewardService/DelegationReward"
+	RewardService_StakingLimits_FullMethodName    = "/rpcpb.RewardService/StakingLimits"
+)
+
+// RewardServiceClient is the client API for RewardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RewardServiceClient interface {
+	// StakingReward computes the potential reward of a staker when it is
+	// added.
+	// ref. "reward.Calculator.Calculate"
+	StakingReward(ctx context.Context, in *StakingRewardRequest, opts ...grpc.CallOption) (*StakingRewardResponse, error)
+	// DelegationReward splits the potential reward of a delegator between the
+	// delegator and its validator by the delegation fee of the validator.
+	// ref. "executor.ProposalTxExecutor.RewardValidatorTx"
+	DelegationReward(ctx context.Context, in *DelegationRewardRequest, opts ...grpc.CallOption) (*DelegationRewardResponse, error)
+	// StakingLimits checks whether a validator or delegator is within the
+	// limits of the staking config, or the error it is rejected with.
+	// ref. "executor.verifyAddValidatorTx", "executor.verifyAddDelegatorTx"
+	StakingLimits(ctx context.Context, in *StakingLimitsRequest, opts ...grpc.CallOption) (*StakingLimitsResponse, error)
+}
+
+type rewardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRewardServiceClient(cc grpc.ClientConnInterface) RewardServiceClient {
+	return &rewardServiceClient{cc}
+}
+
+func (c *rewardServiceClient) StakingReward(ctx context.Context, in *StakingRewardRequest, opts ...grpc.CallOption) (*StakingRewardResponse, error) {
+	out := new(StakingRewardResponse)
+	err := c.cc.Invoke(ctx, RewardService_StakingReward_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) DelegationReward(ctx context.Context, in *DelegationRewardRequest, opts ...grpc.CallOption) (*DelegationRewardResponse, error) {
+	out := new(DelegationRewardResponse)
+	err := c.cc.Invoke(ctx, RewardService_DelegationReward_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) StakingLimits(ctx context.Context, in *StakingLimitsRequest, opts ...grpc.CallOption) (*StakingLimitsResponse, error) {
+	out := new(StakingLimitsResponse)
+	err := c.cc.Invoke(ctx, RewardService_StakingLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RewardServiceServer is the server API for RewardService service.
+// All implementations must embed UnimplementedRewardServiceServer
+// for forward compatibility
+type RewardServiceServer interface {
+	// StakingReward computes the potential reward of a staker when it is
+	// added.
+	// ref. "reward.Calculator.Calculate"
+	StakingReward(context.Context, *StakingRewardRequest) (*StakingRewardResponse, error)
+	// DelegationReward splits the potential reward of a delegator between the
+	// delegator and its validator by the delegation fee of the validator.
+	// ref. "executor.ProposalTxExecutor.RewardValidatorTx"
+	DelegationReward(context.Context, *DelegationRewardRequest) (*DelegationRewardResponse, error)
+	// StakingLimits checks whether a validator or delegator is within the
+	// limits of the staking config, or the error it is rejected with.
+	// ref. "executor.verifyAddValidatorTx", "executor.verifyAddDelegatorTx"
+	StakingLimits(context.Context, *StakingLimitsRequest) (*StakingLimitsResponse, error)
+	mustEmbedUnimplementedRewardServiceServer()
+}
+
+// UnimplementedRewardServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRewardServiceServer struct {
+}
+
+func (UnimplementedRewardServiceServer) StakingReward(context.Context, *StakingRewardRequest) (*StakingRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingReward not implemented")
+}
+func (UnimplementedRewardServiceServer) DelegationReward(context.Context, *DelegationRewardRequest) (*DelegationRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationReward not implemented")
+}
+func (UnimplementedRewardServiceServer) StakingLimits(context.Context, *StakingLimitsRequest) (*StakingLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingLimits not implemented")
+}
+func (UnimplementedRewardServiceServer) mustEmbedUnimplementedRewardServiceServer() {}
+
+// UnsafeRewardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RewardServiceServer will
+// result in compilation errors.
+type UnsafeRewardServiceServer interface {
+	mustEmbedUnimplementedRewardServiceServer()
+}
+
+func RegisterRewardServiceServer(s grpc.ServiceRegistrar, srv RewardServiceServer) {
+	s.RegisterService(&RewardService_ServiceDesc, srv)
+}
+
+func _RewardService_StakingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StakingRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).StakingReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RewardService_StakingReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).StakingReward(ctx, req.(*StakingRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_DelegationReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegationRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).DelegationReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RewardService_DelegationReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).DelegationReward(ctx, req.(*DelegationRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_StakingLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StakingLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).StakingLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RewardService_StakingLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).StakingLimits(ctx, req.(*StakingLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RewardService_ServiceDesc is the grpc.ServiceDesc for RewardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RewardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.RewardService",
+	HandlerType: (*RewardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StakingReward",
+			Handler:    _RewardService_StakingReward_Handler,
+		},
+		{
+			MethodName: "DelegationReward",
+			Handler:    _RewardService_DelegationReward_Handler,
+		},
+		{
+			MethodName: "StakingLimits",
+			Handler:    _RewardService_StakingLimits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/reward.proto",
+}
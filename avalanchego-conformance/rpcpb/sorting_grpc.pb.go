@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/sorting.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SortingService_SortTransferableOutputs_FullMethodName = "/rpcpb.SortingService/SortTransferableOutputs"
+	SortingService_SortTransferableInputs_FullMethodName  = "/rpcpb.SortingService/SortTransferableInputs"
+	SortingService_SortIds_FullMethodName                 = "/rpcpb.SortingService/SortIds"
+	SortingService_SortAddresses_FullMethodName           = "/rpcpb.SortingService/SortAddresses"
+)
+
+// SortingServiceClient is the client API for SortingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SortingServiceClient interface {
+	// Sorts outputs by asset ID, then by the bytes of the output.
+	// ref. "avax.SortTransferableOutputs"
+	SortTransferableOutputs(ctx context.Context, in *SortTransferableOutputsRequest, opts ...grpc.CallOption) (*SortTransferableOutputsResponse, error)
+	// Sorts inputs by UTXO ID.
+	// ref. "avax.TransferableInput.Less"
+	SortTransferableInputs(ctx context.Context, in *SortTransferableInputsRequest, opts ...grpc.CallOption) (*SortTransferableInputsResponse, error)
+	// Sorts 32-byte IDs lexicographically.
+	// ref. "ids.ID.Less"
+	SortIds(ctx context.Context, in *SortIdsRequest, opts ...grpc.CallOption) (*SortIdsResponse, error)
+	// Sorts 20-byte addresses lexicographically (e.g., the addresses of
+	// output owners, to which signature indices refer).
+	// ref. "ids.ShortID.Less"
+	SortAddresses(ctx context.Context, in *SortAddressesRequest, opts ...grpc.CallOption) (*SortAddressesResponse, error)
+}
+
+type sortingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSortingServiceClient(cc grpc.ClientConnInterface) SortingServiceClient {
+	return &sortingServiceClient{cc}
+}
+
+func (c *sortingServiceClient) SortTransferableOutputs(ctx context.Context, in *SortTransferableOutputsRequest, opts ...grpc.CallOption) (*SortTransferableOutputsResponse, error) {
+	out := new(SortTransferableOutputsResponse)
+	err := c.cc.Invoke(ctx, SortingService_SortTransferableOutputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortingServiceClient) SortTransferableInputs(ctx context.Context, in *SortTransferableInputsRequest, opts ...grpc.CallOption) (*SortTransferableInputsResponse, error) {
+	out := new(SortTransferableInputsResponse)
+	err := c.cc.Invoke(ctx, SortingService_SortTransferableInputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortingServiceClient) SortIds(ctx context.Context, in *SortIdsRequest, opts ...grpc.CallOption) (*SortIdsResponse, error) {
+	out := new(SortIdsResponse)
+	err := c.cc.Invoke(ctx, SortingService_SortIds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sortingServiceClient) SortAddresses(ctx context.Context, in *SortAddressesRequest, opts ...grpc.CallOption) (*SortAddressesResponse, error) {
+	out := new(SortAddressesResponse)
+	err := c.cc.Invoke(ctx, SortingService_SortAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SortingServiceServer is the server API for SortingService service.
+// All implementations must embed UnimplementedSortingServiceServer
+// for forward compatibility
+type SortingServiceServer interface {
+	// Sorts outputs by asset ID, then by the bytes of the output.
+	// ref. "avax.SortTransferableOutputs"
+	SortTransferableOutputs(context.Context, *SortTransferableOutputsRequest) (*SortTransferableOutputsResponse, error)
+	// Sorts inputs by UTXO ID.
+	// ref. "avax.TransferableInput.Less"
+	SortTransferableInputs(context.Context, *SortTransferableInputsRequest) (*SortTransferableInputsResponse, error)
+	// Sorts 32-byte IDs lexicographically.
+	// ref. "ids.ID.Less"
+	SortIds(context.Context, *SortIdsRequest) (*SortIdsResponse, error)
+	// Sorts 20-byte addresses lexicographically (e.g., the addresses of
+	// output owners, to which signature indices refer).
+	// ref. "ids.ShortID.Less"
+	SortAddresses(context.Context, *SortAddressesRequest) (*SortAddressesResponse, error)
+	mustEmbedUnimplementedSortingServiceServer()
+}
+
+// UnimplementedSortingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSortingServiceServer struct {
+}
+
+func (UnimplementedSortingServiceServer) SortTransferableOutputs(context.Context, *SortTransferableOutputsRequest) (*SortTransferableOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortTransferableOutputs not implemented")
+}
+func (UnimplementedSortingServiceServer) SortTransferableInputs(context.Context, *SortTransferableInputsRequest) (*SortTransferableInputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortTransferableInputs not implemented")
+}
+func (UnimplementedSortingServiceServer) SortIds(context.Context, *SortIdsRequest) (*SortIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortIds not implemented")
+}
+func (UnimplementedSortingServiceServer) SortAddresses(context.Context, *SortAddressesRequest) (*SortAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortAddresses not implemented")
+}
+func (UnimplementedSortingServiceServer) mustEmbedUnimplementedSortingServiceServer() {}
+
+// UnsafeSortingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SortingServiceServer will
+// result in compilation errors.
+type UnsafeSortingServiceServer interface {
+	mustEmbedUnimplementedSortingServiceServer()
+}
+
+func RegisterSortingServiceServer(s grpc.ServiceRegistrar, srv SortingServiceServer) {
+	s.RegisterService(&SortingService_ServiceDesc, srv)
+}
+
+func _SortingService_SortTransferableOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortTransferableOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortingServiceServer).SortTransferableOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SortingService_SortTransferableOutputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortingServiceServer).SortTransferableOutputs(ctx, req.(*SortTransferableOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortingService_SortTransferableInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortTransferableInputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortingServiceServer).SortTransferableInputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SortingService_SortTransferableInputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortingServiceServer).SortTransferableInputs(ctx, req.(*SortTransferableInputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortingService_SortIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortingServiceServer).SortIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SortingService_SortIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortingServiceServer).SortIds(ctx, req.(*SortIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SortingService_SortAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SortingServiceServer).SortAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SortingService_SortAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SortingServiceServer).SortAddresses(ctx, req.(*SortAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SortingService_ServiceDesc is the grpc.ServiceDesc for SortingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SortingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.SortingService",
+	HandlerType: (*SortingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SortTransferableOutputs",
+			Handler:    _SortingService_SortTransferableOutputs_Handler,
+		},
+		{
+			MethodName: "SortTransferableInputs",
+			Handler:    _SortingService_SortTransferableInputs_Handler,
+		},
+		{
+			MethodName: "SortIds",
+			Handler:    _SortingService_SortIds_Handler,
+		},
+		{
+			MethodName: "SortAddresses",
+			Handler:    _SortingService_SortAddresses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/sorting.proto",
+}
@@ -0,0 +1,140 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/stress.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	StressService_Stress_FullMethodName = "/rpcpb.StressService/Stress"
+)
+
+// StressServiceClient is the client API for StressService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StressServiceClient interface {
+	// Stress executes generated checks at the target rate for the duration,
+	// streaming progress reports until it is done.
+	Stress(ctx context.Context, in *StressRequest, opts ...grpc.CallOption) (StressService_StressClient, error)
+}
+
+type stressServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStressServiceClient(cc grpc.ClientConnInterface) StressServiceClient {
+	return &stressServiceClient{cc}
+}
+
+func (c *stressServiceClient) Stress(ctx context.Context, in *StressRequest, opts ...grpc.CallOption) (StressService_StressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StressService_ServiceDesc.Streams[0], StressService_Stress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stressServiceStressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StressService_StressClient interface {
+	Recv() (*StressProgress, error)
+	grpc.ClientStream
+}
+
+type stressServiceStressClient struct {
+	grpc.ClientStream
+}
+
+func (x *stressServiceStressClient) Recv() (*StressProgress, error) {
+	m := new(StressProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StressServiceServer is the server API for StressService service.
+// All implementations must embed UnimplementedStressServiceServer
+// for forward compatibility
+type StressServiceServer interface {
+	// Stress executes generated checks at the target rate for the duration,
+	// streaming progress reports until it is done.
+	Stress(*StressRequest, StressService_StressServer) error
+	mustEmbedUnimplementedStressServiceServer()
+}
+
+// UnimplementedStressServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStressServiceServer struct {
+}
+
+func (UnimplementedStressServiceServer) Stress(*StressRequest, StressService_StressServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stress not implemented")
+}
+func (UnimplementedStressServiceServer) mustEmbedUnimplementedStressServiceServer() {}
+
+// UnsafeStressServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StressServiceServer will
+// result in compilation errors.
+type UnsafeStressServiceServer interface {
+	mustEmbedUnimplementedStressServiceServer()
+}
+
+func RegisterStressServiceServer(s grpc.ServiceRegistrar, srv StressServiceServer) {
+	s.RegisterService(&StressService_ServiceDesc, srv)
+}
+
+func _StressService_Stress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StressServiceServer).Stress(m, &stressServiceStressServer{stream})
+}
+
+type StressService_StressServer interface {
+	Send(*StressProgress) error
+	grpc.ServerStream
+}
+
+type stressServiceStressServer struct {
+	grpc.ServerStream
+}
+
+func (x *stressServiceStressServer) Send(m *StressProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// StressService_ServiceDesc is the grpc.ServiceDesc for StressService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StressService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.StressService",
+	HandlerType: (*StressServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stress",
+			Handler:       _StressService_Stress_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpcpb/stress.proto",
+}
@@ -0,0 +1,6187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/tx.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TxChain selects the codec of a tx.
+type TxChain int32
+
+const (
+	TxChain_TX_CHAIN_UNSPECIFIED TxChain = 0
+	TxChain_TX_CHAIN_PLATFORM    TxChain = 1
+	TxChain_TX_CHAIN_AVM         TxChain = 2
+	TxChain_TX_CHAIN_CORETH      TxChain = 3
+)
+
+// Enum value maps for TxChain.
+var (
+	TxChain_name = map[int32]string{
+		0: "TX_CHAIN_UNSPECIFIED",
+		1: "TX_CHAIN_PLATFORM",
+		2: "TX_CHAIN_AVM",
+		3: "TX_CHAIN_CORETH",
+	}
+	TxChain_value = map[string]int32{
+		"TX_CHAIN_UNSPECIFIED": 0,
+		"TX_CHAIN_PLATFORM":    1,
+		"TX_CHAIN_AVM":         2,
+		"TX_CHAIN_CORETH":      3,
+	}
+)
+
+func (x TxChain) Enum() *TxChain {
+	p := new(TxChain)
+	*p = x
+	return p
+}
+
+func (x TxChain) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TxChain) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_tx_proto_enumTypes[0].Descriptor()
+}
+
+func (TxChain) Type() protoreflect.EnumType {
+	return &file_rpcpb_tx_proto_enumTypes[0]
+}
+
+func (x TxChain) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TxChain.Descriptor instead.
+func (TxChain) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{0}
+}
+
+// OutputOwners mirrors avalanchego "secp256k1fx.OutputOwners".
+type OutputOwners struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locktime  uint64   `protobuf:"varint,1,opt,name=locktime,proto3" json:"locktime,omitempty"`
+	Threshold uint32   `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Addresses [][]byte `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *OutputOwners) Reset() {
+	*x = OutputOwners{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutputOwners) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputOwners) ProtoMessage() {}
+
+func (x *OutputOwners) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputOwners.ProtoReflect.Descriptor instead.
+func (*OutputOwners) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OutputOwners) GetLocktime() uint64 {
+	if x != nil {
+		return x.Locktime
+	}
+	return 0
+}
+
+func (x *OutputOwners) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *OutputOwners) GetAddresses() [][]byte {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// TransferableOutput mirrors avalanchego "avax.TransferableOutput" of a
+// "secp256k1fx.TransferOutput".
+type TransferableOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetId []byte        `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount  uint64        `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Owners  *OutputOwners `protobuf:"bytes,3,opt,name=owners,proto3" json:"owners,omitempty"`
+	// Wraps the output in a "stakeable.LockOut" when non-zero (P-chain only).
+	StakeableLocktime uint64 `protobuf:"varint,4,opt,name=stakeable_locktime,json=stakeableLocktime,proto3" json:"stakeable_locktime,omitempty"`
+}
+
+func (x *TransferableOutput) Reset() {
+	*x = TransferableOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferableOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferableOutput) ProtoMessage() {}
+
+func (x *TransferableOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferableOutput.ProtoReflect.Descriptor instead.
+func (*TransferableOutput) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferableOutput) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *TransferableOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferableOutput) GetOwners() *OutputOwners {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *TransferableOutput) GetStakeableLocktime() uint64 {
+	if x != nil {
+		return x.StakeableLocktime
+	}
+	return 0
+}
+
+// TransferableInput mirrors avalanchego "avax.TransferableInput" of a
+// "secp256k1fx.TransferInput".
+type TransferableInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId        []byte   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	OutputIndex uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	AssetId     []byte   `protobuf:"bytes,3,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Amount      uint64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	SigIndices  []uint32 `protobuf:"varint,5,rep,packed,name=sig_indices,json=sigIndices,proto3" json:"sig_indices,omitempty"`
+	// Wraps the input in a "stakeable.LockIn" when non-zero (P-chain only).
+	StakeableLocktime uint64 `protobuf:"varint,6,opt,name=stakeable_locktime,json=stakeableLocktime,proto3" json:"stakeable_locktime,omitempty"`
+}
+
+func (x *TransferableInput) Reset() {
+	*x = TransferableInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferableInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferableInput) ProtoMessage() {}
+
+func (x *TransferableInput) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferableInput.ProtoReflect.Descriptor instead.
+func (*TransferableInput) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransferableInput) GetTxId() []byte {
+	if x != nil {
+		return x.TxId
+	}
+	return nil
+}
+
+func (x *TransferableInput) GetOutputIndex() uint32 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+func (x *TransferableInput) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *TransferableInput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferableInput) GetSigIndices() []uint32 {
+	if x != nil {
+		return x.SigIndices
+	}
+	return nil
+}
+
+func (x *TransferableInput) GetStakeableLocktime() uint64 {
+	if x != nil {
+		return x.StakeableLocktime
+	}
+	return 0
+}
+
+// BaseTx mirrors avalanchego "avax.BaseTx".
+type BaseTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId    uint32                `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	BlockchainId []byte                `protobuf:"bytes,2,opt,name=blockchain_id,json=blockchainId,proto3" json:"blockchain_id,omitempty"`
+	Outputs      []*TransferableOutput `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Inputs       []*TransferableInput  `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Memo         []byte                `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *BaseTx) Reset() {
+	*x = BaseTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaseTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseTx) ProtoMessage() {}
+
+func (x *BaseTx) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseTx.ProtoReflect.Descriptor instead.
+func (*BaseTx) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BaseTx) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *BaseTx) GetBlockchainId() []byte {
+	if x != nil {
+		return x.BlockchainId
+	}
+	return nil
+}
+
+func (x *BaseTx) GetOutputs() []*TransferableOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *BaseTx) GetInputs() []*TransferableInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *BaseTx) GetMemo() []byte {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+// StakerValidator mirrors avalanchego "txs.Validator".
+type StakerValidator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId    []byte `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	StartTime uint64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   uint64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Weight    uint64 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *StakerValidator) Reset() {
+	*x = StakerValidator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakerValidator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakerValidator) ProtoMessage() {}
+
+func (x *StakerValidator) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakerValidator.ProtoReflect.Descriptor instead.
+func (*StakerValidator) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StakerValidator) GetNodeId() []byte {
+	if x != nil {
+		return x.NodeId
+	}
+	return nil
+}
+
+func (x *StakerValidator) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *StakerValidator) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *StakerValidator) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// ProofOfPossession mirrors avalanchego "signer.ProofOfPossession".
+type ProofOfPossession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ProofOfPossession) Reset() {
+	*x = ProofOfPossession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofOfPossession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofOfPossession) ProtoMessage() {}
+
+func (x *ProofOfPossession) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofOfPossession.ProtoReflect.Descriptor instead.
+func (*ProofOfPossession) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProofOfPossession) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ProofOfPossession) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type AddPermissionlessValidatorTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx    *BaseTx          `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	Validator *StakerValidator `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	SubnetId  []byte           `protobuf:"bytes,3,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	// Unset for the empty signer of subnet validators.
+	Signer                *ProofOfPossession    `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	StakeOuts             []*TransferableOutput `protobuf:"bytes,5,rep,name=stake_outs,json=stakeOuts,proto3" json:"stake_outs,omitempty"`
+	ValidatorRewardsOwner *OutputOwners         `protobuf:"bytes,6,opt,name=validator_rewards_owner,json=validatorRewardsOwner,proto3" json:"validator_rewards_owner,omitempty"`
+	DelegatorRewardsOwner *OutputOwners         `protobuf:"bytes,7,opt,name=delegator_rewards_owner,json=delegatorRewardsOwner,proto3" json:"delegator_rewards_owner,omitempty"`
+	DelegationShares      uint32                `protobuf:"varint,8,opt,name=delegation_shares,json=delegationShares,proto3" json:"delegation_shares,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,9,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *AddPermissionlessValidatorTxRequest) Reset() {
+	*x = AddPermissionlessValidatorTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPermissionlessValidatorTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPermissionlessValidatorTxRequest) ProtoMessage() {}
+
+func (x *AddPermissionlessValidatorTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPermissionlessValidatorTxRequest.ProtoReflect.Descriptor instead.
+func (*AddPermissionlessValidatorTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddPermissionlessValidatorTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *AddPermissionlessValidatorTxRequest) GetValidator() *StakerValidator {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *AddPermissionlessValidatorTxRequest) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+func (x *AddPermissionlessValidatorTxRequest) GetSigner() *ProofOfPossession {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *AddPermissionlessValidatorTxRequest) GetStakeOuts() []*TransferableOutput {
+	if x != nil {
+		return x.StakeOuts
+	}
+	return nil
+}
+
+func (x *AddPermissionlessValidatorTxRequest) GetValidatorRewardsOwner() *OutputOwners {
+	if x != nil {
+		return x.ValidatorRewardsOwner
+	}
+	return nil
+}
+
+func (x *AddPermissionlessValidatorTxRequest) GetDelegatorRewardsOwner() *OutputOwners {
+	if x != nil {
+		return x.DelegatorRewardsOwner
+	}
+	return nil
+}
+
+func (x *AddPermissionlessValidatorTxRequest) GetDelegationShares() uint32 {
+	if x != nil {
+		return x.DelegationShares
+	}
+	return 0
+}
+
+func (x *AddPermissionlessValidatorTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type AddPermissionlessValidatorTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *AddPermissionlessValidatorTxResponse) Reset() {
+	*x = AddPermissionlessValidatorTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPermissionlessValidatorTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPermissionlessValidatorTxResponse) ProtoMessage() {}
+
+func (x *AddPermissionlessValidatorTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPermissionlessValidatorTxResponse.ProtoReflect.Descriptor instead.
+func (*AddPermissionlessValidatorTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddPermissionlessValidatorTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *AddPermissionlessValidatorTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *AddPermissionlessValidatorTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddPermissionlessValidatorTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddPermissionlessValidatorTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AddPermissionlessValidatorTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type AddValidatorTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx           *BaseTx               `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	Validator        *StakerValidator      `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	StakeOuts        []*TransferableOutput `protobuf:"bytes,3,rep,name=stake_outs,json=stakeOuts,proto3" json:"stake_outs,omitempty"`
+	RewardsOwner     *OutputOwners         `protobuf:"bytes,4,opt,name=rewards_owner,json=rewardsOwner,proto3" json:"rewards_owner,omitempty"`
+	DelegationShares uint32                `protobuf:"varint,5,opt,name=delegation_shares,json=delegationShares,proto3" json:"delegation_shares,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,6,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *AddValidatorTxRequest) Reset() {
+	*x = AddValidatorTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddValidatorTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddValidatorTxRequest) ProtoMessage() {}
+
+func (x *AddValidatorTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddValidatorTxRequest.ProtoReflect.Descriptor instead.
+func (*AddValidatorTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddValidatorTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *AddValidatorTxRequest) GetValidator() *StakerValidator {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *AddValidatorTxRequest) GetStakeOuts() []*TransferableOutput {
+	if x != nil {
+		return x.StakeOuts
+	}
+	return nil
+}
+
+func (x *AddValidatorTxRequest) GetRewardsOwner() *OutputOwners {
+	if x != nil {
+		return x.RewardsOwner
+	}
+	return nil
+}
+
+func (x *AddValidatorTxRequest) GetDelegationShares() uint32 {
+	if x != nil {
+		return x.DelegationShares
+	}
+	return 0
+}
+
+func (x *AddValidatorTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type AddValidatorTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *AddValidatorTxResponse) Reset() {
+	*x = AddValidatorTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddValidatorTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddValidatorTxResponse) ProtoMessage() {}
+
+func (x *AddValidatorTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddValidatorTxResponse.ProtoReflect.Descriptor instead.
+func (*AddValidatorTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddValidatorTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *AddValidatorTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *AddValidatorTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddValidatorTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddValidatorTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AddValidatorTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type AddDelegatorTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx       *BaseTx               `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	Validator    *StakerValidator      `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	StakeOuts    []*TransferableOutput `protobuf:"bytes,3,rep,name=stake_outs,json=stakeOuts,proto3" json:"stake_outs,omitempty"`
+	RewardsOwner *OutputOwners         `protobuf:"bytes,4,opt,name=rewards_owner,json=rewardsOwner,proto3" json:"rewards_owner,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,5,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *AddDelegatorTxRequest) Reset() {
+	*x = AddDelegatorTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddDelegatorTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDelegatorTxRequest) ProtoMessage() {}
+
+func (x *AddDelegatorTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDelegatorTxRequest.ProtoReflect.Descriptor instead.
+func (*AddDelegatorTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddDelegatorTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *AddDelegatorTxRequest) GetValidator() *StakerValidator {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *AddDelegatorTxRequest) GetStakeOuts() []*TransferableOutput {
+	if x != nil {
+		return x.StakeOuts
+	}
+	return nil
+}
+
+func (x *AddDelegatorTxRequest) GetRewardsOwner() *OutputOwners {
+	if x != nil {
+		return x.RewardsOwner
+	}
+	return nil
+}
+
+func (x *AddDelegatorTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type AddDelegatorTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *AddDelegatorTxResponse) Reset() {
+	*x = AddDelegatorTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddDelegatorTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDelegatorTxResponse) ProtoMessage() {}
+
+func (x *AddDelegatorTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDelegatorTxResponse.ProtoReflect.Descriptor instead.
+func (*AddDelegatorTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddDelegatorTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *AddDelegatorTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *AddDelegatorTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddDelegatorTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddDelegatorTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AddDelegatorTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type AddSubnetValidatorTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx    *BaseTx          `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	Validator *StakerValidator `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	SubnetId  []byte           `protobuf:"bytes,3,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	// Signature indices of the subnet owners authorizing the tx.
+	SubnetAuthSigIndices []uint32 `protobuf:"varint,4,rep,packed,name=subnet_auth_sig_indices,json=subnetAuthSigIndices,proto3" json:"subnet_auth_sig_indices,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,5,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *AddSubnetValidatorTxRequest) Reset() {
+	*x = AddSubnetValidatorTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSubnetValidatorTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubnetValidatorTxRequest) ProtoMessage() {}
+
+func (x *AddSubnetValidatorTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubnetValidatorTxRequest.ProtoReflect.Descriptor instead.
+func (*AddSubnetValidatorTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddSubnetValidatorTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *AddSubnetValidatorTxRequest) GetValidator() *StakerValidator {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *AddSubnetValidatorTxRequest) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+func (x *AddSubnetValidatorTxRequest) GetSubnetAuthSigIndices() []uint32 {
+	if x != nil {
+		return x.SubnetAuthSigIndices
+	}
+	return nil
+}
+
+func (x *AddSubnetValidatorTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type AddSubnetValidatorTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *AddSubnetValidatorTxResponse) Reset() {
+	*x = AddSubnetValidatorTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSubnetValidatorTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSubnetValidatorTxResponse) ProtoMessage() {}
+
+func (x *AddSubnetValidatorTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSubnetValidatorTxResponse.ProtoReflect.Descriptor instead.
+func (*AddSubnetValidatorTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddSubnetValidatorTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *AddSubnetValidatorTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *AddSubnetValidatorTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddSubnetValidatorTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddSubnetValidatorTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AddSubnetValidatorTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type CreateSubnetTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx *BaseTx       `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	Owner  *OutputOwners `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,3,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *CreateSubnetTxRequest) Reset() {
+	*x = CreateSubnetTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubnetTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubnetTxRequest) ProtoMessage() {}
+
+func (x *CreateSubnetTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubnetTxRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubnetTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateSubnetTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *CreateSubnetTxRequest) GetOwner() *OutputOwners {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *CreateSubnetTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type CreateSubnetTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *CreateSubnetTxResponse) Reset() {
+	*x = CreateSubnetTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubnetTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubnetTxResponse) ProtoMessage() {}
+
+func (x *CreateSubnetTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubnetTxResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubnetTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateSubnetTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *CreateSubnetTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *CreateSubnetTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateSubnetTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateSubnetTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *CreateSubnetTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type CreateChainTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx      *BaseTx  `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	SubnetId    []byte   `protobuf:"bytes,2,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	ChainName   string   `protobuf:"bytes,3,opt,name=chain_name,json=chainName,proto3" json:"chain_name,omitempty"`
+	VmId        []byte   `protobuf:"bytes,4,opt,name=vm_id,json=vmId,proto3" json:"vm_id,omitempty"`
+	FxIds       [][]byte `protobuf:"bytes,5,rep,name=fx_ids,json=fxIds,proto3" json:"fx_ids,omitempty"`
+	GenesisData []byte   `protobuf:"bytes,6,opt,name=genesis_data,json=genesisData,proto3" json:"genesis_data,omitempty"`
+	// Signature indices of the subnet owners authorizing the tx.
+	SubnetAuthSigIndices []uint32 `protobuf:"varint,7,rep,packed,name=subnet_auth_sig_indices,json=subnetAuthSigIndices,proto3" json:"subnet_auth_sig_indices,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,8,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *CreateChainTxRequest) Reset() {
+	*x = CreateChainTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateChainTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChainTxRequest) ProtoMessage() {}
+
+func (x *CreateChainTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChainTxRequest.ProtoReflect.Descriptor instead.
+func (*CreateChainTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateChainTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *CreateChainTxRequest) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+func (x *CreateChainTxRequest) GetChainName() string {
+	if x != nil {
+		return x.ChainName
+	}
+	return ""
+}
+
+func (x *CreateChainTxRequest) GetVmId() []byte {
+	if x != nil {
+		return x.VmId
+	}
+	return nil
+}
+
+func (x *CreateChainTxRequest) GetFxIds() [][]byte {
+	if x != nil {
+		return x.FxIds
+	}
+	return nil
+}
+
+func (x *CreateChainTxRequest) GetGenesisData() []byte {
+	if x != nil {
+		return x.GenesisData
+	}
+	return nil
+}
+
+func (x *CreateChainTxRequest) GetSubnetAuthSigIndices() []uint32 {
+	if x != nil {
+		return x.SubnetAuthSigIndices
+	}
+	return nil
+}
+
+func (x *CreateChainTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type CreateChainTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *CreateChainTxResponse) Reset() {
+	*x = CreateChainTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateChainTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChainTxResponse) ProtoMessage() {}
+
+func (x *CreateChainTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChainTxResponse.ProtoReflect.Descriptor instead.
+func (*CreateChainTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateChainTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *CreateChainTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *CreateChainTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateChainTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateChainTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *CreateChainTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type ChainIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed CreateSubnetTx or CreateChainTx bytes, prefixed with the codec
+	// version and type ID.
+	SignedTxBytes []byte `protobuf:"bytes,1,opt,name=signed_tx_bytes,json=signedTxBytes,proto3" json:"signed_tx_bytes,omitempty"`
+	// Optional. Alias of the VM (e.g., "subnetevm"), to derive the VM ID from.
+	VmName   string `protobuf:"bytes,2,opt,name=vm_name,json=vmName,proto3" json:"vm_name,omitempty"`
+	SubnetId []byte `protobuf:"bytes,3,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	// Empty for CreateSubnetTx.
+	BlockchainId []byte `protobuf:"bytes,4,opt,name=blockchain_id,json=blockchainId,proto3" json:"blockchain_id,omitempty"`
+	// Default alias of the blockchain (i.e., the cb58 blockchain ID). Empty
+	// for CreateSubnetTx.
+	ChainAlias string `protobuf:"bytes,5,opt,name=chain_alias,json=chainAlias,proto3" json:"chain_alias,omitempty"`
+	VmId       []byte `protobuf:"bytes,6,opt,name=vm_id,json=vmId,proto3" json:"vm_id,omitempty"`
+}
+
+func (x *ChainIdsRequest) Reset() {
+	*x = ChainIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainIdsRequest) ProtoMessage() {}
+
+func (x *ChainIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainIdsRequest.ProtoReflect.Descriptor instead.
+func (*ChainIdsRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChainIdsRequest) GetSignedTxBytes() []byte {
+	if x != nil {
+		return x.SignedTxBytes
+	}
+	return nil
+}
+
+func (x *ChainIdsRequest) GetVmName() string {
+	if x != nil {
+		return x.VmName
+	}
+	return ""
+}
+
+func (x *ChainIdsRequest) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+func (x *ChainIdsRequest) GetBlockchainId() []byte {
+	if x != nil {
+		return x.BlockchainId
+	}
+	return nil
+}
+
+func (x *ChainIdsRequest) GetChainAlias() string {
+	if x != nil {
+		return x.ChainAlias
+	}
+	return ""
+}
+
+func (x *ChainIdsRequest) GetVmId() []byte {
+	if x != nil {
+		return x.VmId
+	}
+	return nil
+}
+
+type ChainIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedSubnetId     []byte `protobuf:"bytes,1,opt,name=expected_subnet_id,json=expectedSubnetId,proto3" json:"expected_subnet_id,omitempty"`
+	ExpectedBlockchainId []byte `protobuf:"bytes,2,opt,name=expected_blockchain_id,json=expectedBlockchainId,proto3" json:"expected_blockchain_id,omitempty"`
+	ExpectedChainAlias   string `protobuf:"bytes,3,opt,name=expected_chain_alias,json=expectedChainAlias,proto3" json:"expected_chain_alias,omitempty"`
+	ExpectedVmId         []byte `protobuf:"bytes,4,opt,name=expected_vm_id,json=expectedVmId,proto3" json:"expected_vm_id,omitempty"`
+	Message              string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Success              bool   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,7,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *ChainIdsResponse) Reset() {
+	*x = ChainIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainIdsResponse) ProtoMessage() {}
+
+func (x *ChainIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainIdsResponse.ProtoReflect.Descriptor instead.
+func (*ChainIdsResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ChainIdsResponse) GetExpectedSubnetId() []byte {
+	if x != nil {
+		return x.ExpectedSubnetId
+	}
+	return nil
+}
+
+func (x *ChainIdsResponse) GetExpectedBlockchainId() []byte {
+	if x != nil {
+		return x.ExpectedBlockchainId
+	}
+	return nil
+}
+
+func (x *ChainIdsResponse) GetExpectedChainAlias() string {
+	if x != nil {
+		return x.ExpectedChainAlias
+	}
+	return ""
+}
+
+func (x *ChainIdsResponse) GetExpectedVmId() []byte {
+	if x != nil {
+		return x.ExpectedVmId
+	}
+	return nil
+}
+
+func (x *ChainIdsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChainIdsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ChainIdsResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type ImportTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx         *BaseTx              `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	SourceChain    []byte               `protobuf:"bytes,2,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	ImportedInputs []*TransferableInput `protobuf:"bytes,3,rep,name=imported_inputs,json=importedInputs,proto3" json:"imported_inputs,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,4,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *ImportTxRequest) Reset() {
+	*x = ImportTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTxRequest) ProtoMessage() {}
+
+func (x *ImportTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTxRequest.ProtoReflect.Descriptor instead.
+func (*ImportTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *ImportTxRequest) GetSourceChain() []byte {
+	if x != nil {
+		return x.SourceChain
+	}
+	return nil
+}
+
+func (x *ImportTxRequest) GetImportedInputs() []*TransferableInput {
+	if x != nil {
+		return x.ImportedInputs
+	}
+	return nil
+}
+
+func (x *ImportTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type ImportTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *ImportTxResponse) Reset() {
+	*x = ImportTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTxResponse) ProtoMessage() {}
+
+func (x *ImportTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTxResponse.ProtoReflect.Descriptor instead.
+func (*ImportTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *ImportTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *ImportTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *ImportTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type ExportTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx           *BaseTx               `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	DestinationChain []byte                `protobuf:"bytes,2,opt,name=destination_chain,json=destinationChain,proto3" json:"destination_chain,omitempty"`
+	ExportedOutputs  []*TransferableOutput `protobuf:"bytes,3,rep,name=exported_outputs,json=exportedOutputs,proto3" json:"exported_outputs,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,4,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *ExportTxRequest) Reset() {
+	*x = ExportTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTxRequest) ProtoMessage() {}
+
+func (x *ExportTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTxRequest.ProtoReflect.Descriptor instead.
+func (*ExportTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *ExportTxRequest) GetDestinationChain() []byte {
+	if x != nil {
+		return x.DestinationChain
+	}
+	return nil
+}
+
+func (x *ExportTxRequest) GetExportedOutputs() []*TransferableOutput {
+	if x != nil {
+		return x.ExportedOutputs
+	}
+	return nil
+}
+
+func (x *ExportTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type ExportTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *ExportTxResponse) Reset() {
+	*x = ExportTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTxResponse) ProtoMessage() {}
+
+func (x *ExportTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTxResponse.ProtoReflect.Descriptor instead.
+func (*ExportTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExportTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *ExportTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *ExportTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExportTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExportTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *ExportTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type ProofOfPossessionVerifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProofOfPossession *ProofOfPossession `protobuf:"bytes,1,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"`
+	// 144-byte public key and signature, as packed in a tx after the signer
+	// type ID.
+	ProofOfPossessionBytes []byte `protobuf:"bytes,2,opt,name=proof_of_possession_bytes,json=proofOfPossessionBytes,proto3" json:"proof_of_possession_bytes,omitempty"`
+}
+
+func (x *ProofOfPossessionVerifyRequest) Reset() {
+	*x = ProofOfPossessionVerifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofOfPossessionVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofOfPossessionVerifyRequest) ProtoMessage() {}
+
+func (x *ProofOfPossessionVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofOfPossessionVerifyRequest.ProtoReflect.Descriptor instead.
+func (*ProofOfPossessionVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProofOfPossessionVerifyRequest) GetProofOfPossession() *ProofOfPossession {
+	if x != nil {
+		return x.ProofOfPossession
+	}
+	return nil
+}
+
+func (x *ProofOfPossessionVerifyRequest) GetProofOfPossessionBytes() []byte {
+	if x != nil {
+		return x.ProofOfPossessionBytes
+	}
+	return nil
+}
+
+type ProofOfPossessionVerifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedBytes []byte `protobuf:"bytes,1,opt,name=expected_bytes,json=expectedBytes,proto3" json:"expected_bytes,omitempty"`
+	// Set to the verification error.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,5,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *ProofOfPossessionVerifyResponse) Reset() {
+	*x = ProofOfPossessionVerifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofOfPossessionVerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofOfPossessionVerifyResponse) ProtoMessage() {}
+
+func (x *ProofOfPossessionVerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofOfPossessionVerifyResponse.ProtoReflect.Descriptor instead.
+func (*ProofOfPossessionVerifyResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ProofOfPossessionVerifyResponse) GetExpectedBytes() []byte {
+	if x != nil {
+		return x.ExpectedBytes
+	}
+	return nil
+}
+
+func (x *ProofOfPossessionVerifyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProofOfPossessionVerifyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProofOfPossessionVerifyResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *ProofOfPossessionVerifyResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+// SecpTransferOutput mirrors avalanchego "secp256k1fx.TransferOutput".
+type SecpTransferOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount uint64        `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Owners *OutputOwners `protobuf:"bytes,2,opt,name=owners,proto3" json:"owners,omitempty"`
+}
+
+func (x *SecpTransferOutput) Reset() {
+	*x = SecpTransferOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecpTransferOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecpTransferOutput) ProtoMessage() {}
+
+func (x *SecpTransferOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecpTransferOutput.ProtoReflect.Descriptor instead.
+func (*SecpTransferOutput) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SecpTransferOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SecpTransferOutput) GetOwners() *OutputOwners {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+// SecpOutput is a "secp256k1fx.TransferOutput" or "secp256k1fx.MintOutput".
+type SecpOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Output:
+	//
+	//	*SecpOutput_Transfer
+	//	*SecpOutput_Mint
+	Output isSecpOutput_Output `protobuf_oneof:"output"`
+}
+
+func (x *SecpOutput) Reset() {
+	*x = SecpOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecpOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecpOutput) ProtoMessage() {}
+
+func (x *SecpOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecpOutput.ProtoReflect.Descriptor instead.
+func (*SecpOutput) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{27}
+}
+
+func (m *SecpOutput) GetOutput() isSecpOutput_Output {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (x *SecpOutput) GetTransfer() *SecpTransferOutput {
+	if x, ok := x.GetOutput().(*SecpOutput_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *SecpOutput) GetMint() *OutputOwners {
+	if x, ok := x.GetOutput().(*SecpOutput_Mint); ok {
+		return x.Mint
+	}
+	return nil
+}
+
+type isSecpOutput_Output interface {
+	isSecpOutput_Output()
+}
+
+type SecpOutput_Transfer struct {
+	Transfer *SecpTransferOutput `protobuf:"bytes,1,opt,name=transfer,proto3,oneof"`
+}
+
+type SecpOutput_Mint struct {
+	Mint *OutputOwners `protobuf:"bytes,2,opt,name=mint,proto3,oneof"`
+}
+
+func (*SecpOutput_Transfer) isSecpOutput_Output() {}
+
+func (*SecpOutput_Mint) isSecpOutput_Output() {}
+
+// InitialState mirrors avalanchego "txs.InitialState" of secp256k1fx
+// outputs.
+type InitialState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FxIndex uint32        `protobuf:"varint,1,opt,name=fx_index,json=fxIndex,proto3" json:"fx_index,omitempty"`
+	Outputs []*SecpOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *InitialState) Reset() {
+	*x = InitialState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitialState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitialState) ProtoMessage() {}
+
+func (x *InitialState) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitialState.ProtoReflect.Descriptor instead.
+func (*InitialState) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *InitialState) GetFxIndex() uint32 {
+	if x != nil {
+		return x.FxIndex
+	}
+	return 0
+}
+
+func (x *InitialState) GetOutputs() []*SecpOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+// UtxoId mirrors avalanchego "avax.UTXOID".
+type UtxoId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId        []byte `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	OutputIndex uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+}
+
+func (x *UtxoId) Reset() {
+	*x = UtxoId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoId) ProtoMessage() {}
+
+func (x *UtxoId) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoId.ProtoReflect.Descriptor instead.
+func (*UtxoId) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UtxoId) GetTxId() []byte {
+	if x != nil {
+		return x.TxId
+	}
+	return nil
+}
+
+func (x *UtxoId) GetOutputIndex() uint32 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+// SecpMintOperation mirrors avalanchego "secp256k1fx.MintOperation".
+type SecpMintOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MintInputSigIndices []uint32            `protobuf:"varint,1,rep,packed,name=mint_input_sig_indices,json=mintInputSigIndices,proto3" json:"mint_input_sig_indices,omitempty"`
+	MintOutput          *OutputOwners       `protobuf:"bytes,2,opt,name=mint_output,json=mintOutput,proto3" json:"mint_output,omitempty"`
+	TransferOutput      *SecpTransferOutput `protobuf:"bytes,3,opt,name=transfer_output,json=transferOutput,proto3" json:"transfer_output,omitempty"`
+}
+
+func (x *SecpMintOperation) Reset() {
+	*x = SecpMintOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecpMintOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecpMintOperation) ProtoMessage() {}
+
+func (x *SecpMintOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecpMintOperation.ProtoReflect.Descriptor instead.
+func (*SecpMintOperation) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SecpMintOperation) GetMintInputSigIndices() []uint32 {
+	if x != nil {
+		return x.MintInputSigIndices
+	}
+	return nil
+}
+
+func (x *SecpMintOperation) GetMintOutput() *OutputOwners {
+	if x != nil {
+		return x.MintOutput
+	}
+	return nil
+}
+
+func (x *SecpMintOperation) GetTransferOutput() *SecpTransferOutput {
+	if x != nil {
+		return x.TransferOutput
+	}
+	return nil
+}
+
+// AvmOperation mirrors avalanchego "txs.Operation" of a secp256k1fx mint
+// operation.
+type AvmOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetId []byte             `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	UtxoIds []*UtxoId          `protobuf:"bytes,2,rep,name=utxo_ids,json=utxoIds,proto3" json:"utxo_ids,omitempty"`
+	Mint    *SecpMintOperation `protobuf:"bytes,3,opt,name=mint,proto3" json:"mint,omitempty"`
+}
+
+func (x *AvmOperation) Reset() {
+	*x = AvmOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvmOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvmOperation) ProtoMessage() {}
+
+func (x *AvmOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvmOperation.ProtoReflect.Descriptor instead.
+func (*AvmOperation) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AvmOperation) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AvmOperation) GetUtxoIds() []*UtxoId {
+	if x != nil {
+		return x.UtxoIds
+	}
+	return nil
+}
+
+func (x *AvmOperation) GetMint() *SecpMintOperation {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+type AvmBaseTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx *BaseTx `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,2,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *AvmBaseTxRequest) Reset() {
+	*x = AvmBaseTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvmBaseTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvmBaseTxRequest) ProtoMessage() {}
+
+func (x *AvmBaseTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvmBaseTxRequest.ProtoReflect.Descriptor instead.
+func (*AvmBaseTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AvmBaseTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *AvmBaseTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type AvmBaseTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *AvmBaseTxResponse) Reset() {
+	*x = AvmBaseTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvmBaseTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvmBaseTxResponse) ProtoMessage() {}
+
+func (x *AvmBaseTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvmBaseTxResponse.ProtoReflect.Descriptor instead.
+func (*AvmBaseTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AvmBaseTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *AvmBaseTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *AvmBaseTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AvmBaseTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AvmBaseTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AvmBaseTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type AvmCreateAssetTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx        *BaseTx         `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	Name          string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol        string          `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Denomination  uint32          `protobuf:"varint,4,opt,name=denomination,proto3" json:"denomination,omitempty"`
+	InitialStates []*InitialState `protobuf:"bytes,5,rep,name=initial_states,json=initialStates,proto3" json:"initial_states,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,6,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *AvmCreateAssetTxRequest) Reset() {
+	*x = AvmCreateAssetTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvmCreateAssetTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvmCreateAssetTxRequest) ProtoMessage() {}
+
+func (x *AvmCreateAssetTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvmCreateAssetTxRequest.ProtoReflect.Descriptor instead.
+func (*AvmCreateAssetTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AvmCreateAssetTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *AvmCreateAssetTxRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AvmCreateAssetTxRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *AvmCreateAssetTxRequest) GetDenomination() uint32 {
+	if x != nil {
+		return x.Denomination
+	}
+	return 0
+}
+
+func (x *AvmCreateAssetTxRequest) GetInitialStates() []*InitialState {
+	if x != nil {
+		return x.InitialStates
+	}
+	return nil
+}
+
+func (x *AvmCreateAssetTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type AvmCreateAssetTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *AvmCreateAssetTxResponse) Reset() {
+	*x = AvmCreateAssetTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvmCreateAssetTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvmCreateAssetTxResponse) ProtoMessage() {}
+
+func (x *AvmCreateAssetTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvmCreateAssetTxResponse.ProtoReflect.Descriptor instead.
+func (*AvmCreateAssetTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AvmCreateAssetTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *AvmCreateAssetTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *AvmCreateAssetTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AvmCreateAssetTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AvmCreateAssetTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AvmCreateAssetTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type AvmOperationTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx     *BaseTx         `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	Operations []*AvmOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,3,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *AvmOperationTxRequest) Reset() {
+	*x = AvmOperationTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvmOperationTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvmOperationTxRequest) ProtoMessage() {}
+
+func (x *AvmOperationTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvmOperationTxRequest.ProtoReflect.Descriptor instead.
+func (*AvmOperationTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AvmOperationTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *AvmOperationTxRequest) GetOperations() []*AvmOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *AvmOperationTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type AvmOperationTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *AvmOperationTxResponse) Reset() {
+	*x = AvmOperationTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvmOperationTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvmOperationTxResponse) ProtoMessage() {}
+
+func (x *AvmOperationTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvmOperationTxResponse.ProtoReflect.Descriptor instead.
+func (*AvmOperationTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AvmOperationTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *AvmOperationTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *AvmOperationTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AvmOperationTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AvmOperationTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AvmOperationTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type AvmImportTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx         *BaseTx              `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	SourceChain    []byte               `protobuf:"bytes,2,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	ImportedInputs []*TransferableInput `protobuf:"bytes,3,rep,name=imported_inputs,json=importedInputs,proto3" json:"imported_inputs,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,4,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *AvmImportTxRequest) Reset() {
+	*x = AvmImportTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvmImportTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvmImportTxRequest) ProtoMessage() {}
+
+func (x *AvmImportTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvmImportTxRequest.ProtoReflect.Descriptor instead.
+func (*AvmImportTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AvmImportTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *AvmImportTxRequest) GetSourceChain() []byte {
+	if x != nil {
+		return x.SourceChain
+	}
+	return nil
+}
+
+func (x *AvmImportTxRequest) GetImportedInputs() []*TransferableInput {
+	if x != nil {
+		return x.ImportedInputs
+	}
+	return nil
+}
+
+func (x *AvmImportTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type AvmImportTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *AvmImportTxResponse) Reset() {
+	*x = AvmImportTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvmImportTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvmImportTxResponse) ProtoMessage() {}
+
+func (x *AvmImportTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvmImportTxResponse.ProtoReflect.Descriptor instead.
+func (*AvmImportTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AvmImportTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *AvmImportTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *AvmImportTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AvmImportTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AvmImportTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AvmImportTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type AvmExportTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseTx           *BaseTx               `protobuf:"bytes,1,opt,name=base_tx,json=baseTx,proto3" json:"base_tx,omitempty"`
+	DestinationChain []byte                `protobuf:"bytes,2,opt,name=destination_chain,json=destinationChain,proto3" json:"destination_chain,omitempty"`
+	ExportedOutputs  []*TransferableOutput `protobuf:"bytes,3,rep,name=exported_outputs,json=exportedOutputs,proto3" json:"exported_outputs,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,4,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+}
+
+func (x *AvmExportTxRequest) Reset() {
+	*x = AvmExportTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvmExportTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvmExportTxRequest) ProtoMessage() {}
+
+func (x *AvmExportTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvmExportTxRequest.ProtoReflect.Descriptor instead.
+func (*AvmExportTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AvmExportTxRequest) GetBaseTx() *BaseTx {
+	if x != nil {
+		return x.BaseTx
+	}
+	return nil
+}
+
+func (x *AvmExportTxRequest) GetDestinationChain() []byte {
+	if x != nil {
+		return x.DestinationChain
+	}
+	return nil
+}
+
+func (x *AvmExportTxRequest) GetExportedOutputs() []*TransferableOutput {
+	if x != nil {
+		return x.ExportedOutputs
+	}
+	return nil
+}
+
+func (x *AvmExportTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+type AvmExportTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedUnsignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_unsigned_tx_bytes,json=expectedUnsignedTxBytes,proto3" json:"expected_unsigned_tx_bytes,omitempty"`
+	// ID of the tx without credentials.
+	ExpectedTxId []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *AvmExportTxResponse) Reset() {
+	*x = AvmExportTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvmExportTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvmExportTxResponse) ProtoMessage() {}
+
+func (x *AvmExportTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvmExportTxResponse.ProtoReflect.Descriptor instead.
+func (*AvmExportTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AvmExportTxResponse) GetExpectedUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedUnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *AvmExportTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *AvmExportTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AvmExportTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AvmExportTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AvmExportTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+// Credential mirrors avalanchego "secp256k1fx.Credential".
+type Credential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 65-byte recoverable signatures of the unsigned tx hash.
+	Signatures [][]byte `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *Credential) Reset() {
+	*x = Credential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Credential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credential) ProtoMessage() {}
+
+func (x *Credential) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Credential.ProtoReflect.Descriptor instead.
+func (*Credential) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Credential) GetSignatures() [][]byte {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// CredentialSigners are the addresses recovered from the signatures of a
+// credential, in order.
+type CredentialSigners struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *CredentialSigners) Reset() {
+	*x = CredentialSigners{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CredentialSigners) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialSigners) ProtoMessage() {}
+
+func (x *CredentialSigners) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialSigners.ProtoReflect.Descriptor instead.
+func (*CredentialSigners) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CredentialSigners) GetAddresses() [][]byte {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type SignedTxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain TxChain `protobuf:"varint,1,opt,name=chain,proto3,enum=rpcpb.TxChain" json:"chain,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte        `protobuf:"bytes,2,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+	Credentials     []*Credential `protobuf:"bytes,3,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	// Signed tx bytes, prefixed with the codec version and type ID.
+	SignedTxBytes []byte `protobuf:"bytes,4,opt,name=signed_tx_bytes,json=signedTxBytes,proto3" json:"signed_tx_bytes,omitempty"`
+}
+
+func (x *SignedTxRequest) Reset() {
+	*x = SignedTxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedTxRequest) ProtoMessage() {}
+
+func (x *SignedTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedTxRequest.ProtoReflect.Descriptor instead.
+func (*SignedTxRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SignedTxRequest) GetChain() TxChain {
+	if x != nil {
+		return x.Chain
+	}
+	return TxChain_TX_CHAIN_UNSPECIFIED
+}
+
+func (x *SignedTxRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *SignedTxRequest) GetCredentials() []*Credential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *SignedTxRequest) GetSignedTxBytes() []byte {
+	if x != nil {
+		return x.SignedTxBytes
+	}
+	return nil
+}
+
+type SignedTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedSignedTxBytes []byte `protobuf:"bytes,1,opt,name=expected_signed_tx_bytes,json=expectedSignedTxBytes,proto3" json:"expected_signed_tx_bytes,omitempty"`
+	ExpectedTxId          []byte `protobuf:"bytes,2,opt,name=expected_tx_id,json=expectedTxId,proto3" json:"expected_tx_id,omitempty"`
+	// Signers of each credential, so that their order can be checked against
+	// the inputs.
+	Signers []*CredentialSigners `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	Message string               `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success bool                 `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,7,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *SignedTxResponse) Reset() {
+	*x = SignedTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedTxResponse) ProtoMessage() {}
+
+func (x *SignedTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedTxResponse.ProtoReflect.Descriptor instead.
+func (*SignedTxResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SignedTxResponse) GetExpectedSignedTxBytes() []byte {
+	if x != nil {
+		return x.ExpectedSignedTxBytes
+	}
+	return nil
+}
+
+func (x *SignedTxResponse) GetExpectedTxId() []byte {
+	if x != nil {
+		return x.ExpectedTxId
+	}
+	return nil
+}
+
+func (x *SignedTxResponse) GetSigners() []*CredentialSigners {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *SignedTxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SignedTxResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SignedTxResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *SignedTxResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+// Mirrors avalanchego "avax.UTXO" of a "secp256k1fx.TransferOutput".
+type UtxoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain  TxChain             `protobuf:"varint,1,opt,name=chain,proto3,enum=rpcpb.TxChain" json:"chain,omitempty"`
+	UtxoId *UtxoId             `protobuf:"bytes,2,opt,name=utxo_id,json=utxoId,proto3" json:"utxo_id,omitempty"`
+	Output *TransferableOutput `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	// UTXO bytes, prefixed with the codec version.
+	UtxoBytes []byte `protobuf:"bytes,4,opt,name=utxo_bytes,json=utxoBytes,proto3" json:"utxo_bytes,omitempty"`
+}
+
+func (x *UtxoRequest) Reset() {
+	*x = UtxoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoRequest) ProtoMessage() {}
+
+func (x *UtxoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoRequest.ProtoReflect.Descriptor instead.
+func (*UtxoRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UtxoRequest) GetChain() TxChain {
+	if x != nil {
+		return x.Chain
+	}
+	return TxChain_TX_CHAIN_UNSPECIFIED
+}
+
+func (x *UtxoRequest) GetUtxoId() *UtxoId {
+	if x != nil {
+		return x.UtxoId
+	}
+	return nil
+}
+
+func (x *UtxoRequest) GetOutput() *TransferableOutput {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *UtxoRequest) GetUtxoBytes() []byte {
+	if x != nil {
+		return x.UtxoBytes
+	}
+	return nil
+}
+
+type UtxoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedBytes []byte `protobuf:"bytes,1,opt,name=expected_bytes,json=expectedBytes,proto3" json:"expected_bytes,omitempty"`
+	// ref. "avax.UTXOID.InputID"
+	ExpectedInputId []byte `protobuf:"bytes,2,opt,name=expected_input_id,json=expectedInputId,proto3" json:"expected_input_id,omitempty"`
+	Message         string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success         bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *UtxoResponse) Reset() {
+	*x = UtxoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoResponse) ProtoMessage() {}
+
+func (x *UtxoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoResponse.ProtoReflect.Descriptor instead.
+func (*UtxoResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UtxoResponse) GetExpectedBytes() []byte {
+	if x != nil {
+		return x.ExpectedBytes
+	}
+	return nil
+}
+
+func (x *UtxoResponse) GetExpectedInputId() []byte {
+	if x != nil {
+		return x.ExpectedInputId
+	}
+	return nil
+}
+
+func (x *UtxoResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UtxoResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UtxoResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *UtxoResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type TransferableOutputsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain   TxChain               `protobuf:"varint,1,opt,name=chain,proto3,enum=rpcpb.TxChain" json:"chain,omitempty"`
+	Outputs []*TransferableOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Length-prefixed outputs, prefixed with the codec version.
+	OutputsBytes []byte `protobuf:"bytes,3,opt,name=outputs_bytes,json=outputsBytes,proto3" json:"outputs_bytes,omitempty"`
+}
+
+func (x *TransferableOutputsRequest) Reset() {
+	*x = TransferableOutputsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferableOutputsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferableOutputsRequest) ProtoMessage() {}
+
+func (x *TransferableOutputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferableOutputsRequest.ProtoReflect.Descriptor instead.
+func (*TransferableOutputsRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TransferableOutputsRequest) GetChain() TxChain {
+	if x != nil {
+		return x.Chain
+	}
+	return TxChain_TX_CHAIN_UNSPECIFIED
+}
+
+func (x *TransferableOutputsRequest) GetOutputs() []*TransferableOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *TransferableOutputsRequest) GetOutputsBytes() []byte {
+	if x != nil {
+		return x.OutputsBytes
+	}
+	return nil
+}
+
+type TransferableOutputsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedBytes []byte `protobuf:"bytes,1,opt,name=expected_bytes,json=expectedBytes,proto3" json:"expected_bytes,omitempty"`
+	// Whether the outputs are in canonical order.
+	// ref. "avax.IsSortedTransferableOutputs"
+	Sorted  bool   `protobuf:"varint,2,opt,name=sorted,proto3" json:"sorted,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *TransferableOutputsResponse) Reset() {
+	*x = TransferableOutputsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferableOutputsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferableOutputsResponse) ProtoMessage() {}
+
+func (x *TransferableOutputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferableOutputsResponse.ProtoReflect.Descriptor instead.
+func (*TransferableOutputsResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TransferableOutputsResponse) GetExpectedBytes() []byte {
+	if x != nil {
+		return x.ExpectedBytes
+	}
+	return nil
+}
+
+func (x *TransferableOutputsResponse) GetSorted() bool {
+	if x != nil {
+		return x.Sorted
+	}
+	return false
+}
+
+func (x *TransferableOutputsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TransferableOutputsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TransferableOutputsResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *TransferableOutputsResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+type TransferableInputsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain  TxChain              `protobuf:"varint,1,opt,name=chain,proto3,enum=rpcpb.TxChain" json:"chain,omitempty"`
+	Inputs []*TransferableInput `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// Length-prefixed inputs, prefixed with the codec version.
+	InputsBytes []byte `protobuf:"bytes,3,opt,name=inputs_bytes,json=inputsBytes,proto3" json:"inputs_bytes,omitempty"`
+}
+
+func (x *TransferableInputsRequest) Reset() {
+	*x = TransferableInputsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferableInputsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferableInputsRequest) ProtoMessage() {}
+
+func (x *TransferableInputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferableInputsRequest.ProtoReflect.Descriptor instead.
+func (*TransferableInputsRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TransferableInputsRequest) GetChain() TxChain {
+	if x != nil {
+		return x.Chain
+	}
+	return TxChain_TX_CHAIN_UNSPECIFIED
+}
+
+func (x *TransferableInputsRequest) GetInputs() []*TransferableInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *TransferableInputsRequest) GetInputsBytes() []byte {
+	if x != nil {
+		return x.InputsBytes
+	}
+	return nil
+}
+
+type TransferableInputsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedBytes []byte `protobuf:"bytes,1,opt,name=expected_bytes,json=expectedBytes,proto3" json:"expected_bytes,omitempty"`
+	// Whether the inputs are in canonical order, without duplicates.
+	// ref. "utils.IsSortedAndUniqueSortable"
+	SortedUnique bool   `protobuf:"varint,2,opt,name=sorted_unique,json=sortedUnique,proto3" json:"sorted_unique,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Set when the serialized bytes differ.
+	Diff *Diff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *TransferableInputsResponse) Reset() {
+	*x = TransferableInputsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferableInputsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferableInputsResponse) ProtoMessage() {}
+
+func (x *TransferableInputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferableInputsResponse.ProtoReflect.Descriptor instead.
+func (*TransferableInputsResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TransferableInputsResponse) GetExpectedBytes() []byte {
+	if x != nil {
+		return x.ExpectedBytes
+	}
+	return nil
+}
+
+func (x *TransferableInputsResponse) GetSortedUnique() bool {
+	if x != nil {
+		return x.SortedUnique
+	}
+	return false
+}
+
+func (x *TransferableInputsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TransferableInputsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TransferableInputsResponse) GetDiff() *Diff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *TransferableInputsResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+// StaticFeeConfig mirrors avalanchego "genesis.TxFeeConfig".
+type StaticFeeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxFee                         uint64 `protobuf:"varint,1,opt,name=tx_fee,json=txFee,proto3" json:"tx_fee,omitempty"`
+	CreateAssetTxFee              uint64 `protobuf:"varint,2,opt,name=create_asset_tx_fee,json=createAssetTxFee,proto3" json:"create_asset_tx_fee,omitempty"`
+	CreateSubnetTxFee             uint64 `protobuf:"varint,3,opt,name=create_subnet_tx_fee,json=createSubnetTxFee,proto3" json:"create_subnet_tx_fee,omitempty"`
+	TransformSubnetTxFee          uint64 `protobuf:"varint,4,opt,name=transform_subnet_tx_fee,json=transformSubnetTxFee,proto3" json:"transform_subnet_tx_fee,omitempty"`
+	CreateBlockchainTxFee         uint64 `protobuf:"varint,5,opt,name=create_blockchain_tx_fee,json=createBlockchainTxFee,proto3" json:"create_blockchain_tx_fee,omitempty"`
+	AddPrimaryNetworkValidatorFee uint64 `protobuf:"varint,6,opt,name=add_primary_network_validator_fee,json=addPrimaryNetworkValidatorFee,proto3" json:"add_primary_network_validator_fee,omitempty"`
+	AddPrimaryNetworkDelegatorFee uint64 `protobuf:"varint,7,opt,name=add_primary_network_delegator_fee,json=addPrimaryNetworkDelegatorFee,proto3" json:"add_primary_network_delegator_fee,omitempty"`
+	AddSubnetValidatorFee         uint64 `protobuf:"varint,8,opt,name=add_subnet_validator_fee,json=addSubnetValidatorFee,proto3" json:"add_subnet_validator_fee,omitempty"`
+	AddSubnetDelegatorFee         uint64 `protobuf:"varint,9,opt,name=add_subnet_delegator_fee,json=addSubnetDelegatorFee,proto3" json:"add_subnet_delegator_fee,omitempty"`
+}
+
+func (x *StaticFeeConfig) Reset() {
+	*x = StaticFeeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaticFeeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticFeeConfig) ProtoMessage() {}
+
+func (x *StaticFeeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticFeeConfig.ProtoReflect.Descriptor instead.
+func (*StaticFeeConfig) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StaticFeeConfig) GetTxFee() uint64 {
+	if x != nil {
+		return x.TxFee
+	}
+	return 0
+}
+
+func (x *StaticFeeConfig) GetCreateAssetTxFee() uint64 {
+	if x != nil {
+		return x.CreateAssetTxFee
+	}
+	return 0
+}
+
+func (x *StaticFeeConfig) GetCreateSubnetTxFee() uint64 {
+	if x != nil {
+		return x.CreateSubnetTxFee
+	}
+	return 0
+}
+
+func (x *StaticFeeConfig) GetTransformSubnetTxFee() uint64 {
+	if x != nil {
+		return x.TransformSubnetTxFee
+	}
+	return 0
+}
+
+func (x *StaticFeeConfig) GetCreateBlockchainTxFee() uint64 {
+	if x != nil {
+		return x.CreateBlockchainTxFee
+	}
+	return 0
+}
+
+func (x *StaticFeeConfig) GetAddPrimaryNetworkValidatorFee() uint64 {
+	if x != nil {
+		return x.AddPrimaryNetworkValidatorFee
+	}
+	return 0
+}
+
+func (x *StaticFeeConfig) GetAddPrimaryNetworkDelegatorFee() uint64 {
+	if x != nil {
+		return x.AddPrimaryNetworkDelegatorFee
+	}
+	return 0
+}
+
+func (x *StaticFeeConfig) GetAddSubnetValidatorFee() uint64 {
+	if x != nil {
+		return x.AddSubnetValidatorFee
+	}
+	return 0
+}
+
+func (x *StaticFeeConfig) GetAddSubnetDelegatorFee() uint64 {
+	if x != nil {
+		return x.AddSubnetDelegatorFee
+	}
+	return 0
+}
+
+type StaticFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain TxChain `protobuf:"varint,1,opt,name=chain,proto3,enum=rpcpb.TxChain" json:"chain,omitempty"`
+	// Unsigned tx bytes, prefixed with the codec version and type ID.
+	UnsignedTxBytes []byte `protobuf:"bytes,2,opt,name=unsigned_tx_bytes,json=unsignedTxBytes,proto3" json:"unsigned_tx_bytes,omitempty"`
+	// Network whose fee config applies (the local network's for networks other
+	// than mainnet and fuji), unless the fee config is set.
+	NetworkId uint32           `protobuf:"varint,3,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	FeeConfig *StaticFeeConfig `protobuf:"bytes,4,opt,name=fee_config,json=feeConfig,proto3" json:"fee_config,omitempty"`
+	// Fee computed by the client.
+	Fee uint64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *StaticFeeRequest) Reset() {
+	*x = StaticFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaticFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticFeeRequest) ProtoMessage() {}
+
+func (x *StaticFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticFeeRequest.ProtoReflect.Descriptor instead.
+func (*StaticFeeRequest) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *StaticFeeRequest) GetChain() TxChain {
+	if x != nil {
+		return x.Chain
+	}
+	return TxChain_TX_CHAIN_UNSPECIFIED
+}
+
+func (x *StaticFeeRequest) GetUnsignedTxBytes() []byte {
+	if x != nil {
+		return x.UnsignedTxBytes
+	}
+	return nil
+}
+
+func (x *StaticFeeRequest) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *StaticFeeRequest) GetFeeConfig() *StaticFeeConfig {
+	if x != nil {
+		return x.FeeConfig
+	}
+	return nil
+}
+
+func (x *StaticFeeRequest) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+type StaticFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpectedFee uint64 `protobuf:"varint,1,opt,name=expected_fee,json=expectedFee,proto3" json:"expected_fee,omitempty"`
+	// Type of the tx (e.g., "AddSubnetValidatorTx").
+	ExpectedTxType string `protobuf:"bytes,2,opt,name=expected_tx_type,json=expectedTxType,proto3" json:"expected_tx_type,omitempty"`
+	// Fee config the fee was computed with.
+	ExpectedFeeConfig *StaticFeeConfig `protobuf:"bytes,3,opt,name=expected_fee_config,json=expectedFeeConfig,proto3" json:"expected_fee_config,omitempty"`
+	Message           string           `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Success           bool             `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// Time spent by the server handling the request.
+	ServerDurationMs uint64 `protobuf:"varint,6,opt,name=server_duration_ms,json=serverDurationMs,proto3" json:"server_duration_ms,omitempty"`
+}
+
+func (x *StaticFeeResponse) Reset() {
+	*x = StaticFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpcpb_tx_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaticFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticFeeResponse) ProtoMessage() {}
+
+func (x *StaticFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpcpb_tx_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticFeeResponse.ProtoReflect.Descriptor instead.
+func (*StaticFeeResponse) Descriptor() ([]byte, []int) {
+	return file_rpcpb_tx_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StaticFeeResponse) GetExpectedFee() uint64 {
+	if x != nil {
+		return x.ExpectedFee
+	}
+	return 0
+}
+
+func (x *StaticFeeResponse) GetExpectedTxType() string {
+	if x != nil {
+		return x.ExpectedTxType
+	}
+	return ""
+}
+
+func (x *StaticFeeResponse) GetExpectedFeeConfig() *StaticFeeConfig {
+	if x != nil {
+		return x.ExpectedFeeConfig
+	}
+	return nil
+}
+
+func (x *StaticFeeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StaticFeeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StaticFeeResponse) GetServerDurationMs() uint64 {
+	if x != nil {
+		return x.ServerDurationMs
+	}
+	return 0
+}
+
+var File_rpcpb_tx_proto protoreflect.FileDescriptor
+
+var file_rpcpb_tx_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x1a, 0x10, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x64,
+	0x69, 0x66, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x66, 0x0a, 0x0c, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x6b, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x6b, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x22, 0xa3, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x4c,
+	0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x13, 0x0a,
+	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x5f,
+	0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x73,
+	0x69, 0x67, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x61, 0x62, 0x6c, 0x65,
+	0x4c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x06, 0x42, 0x61, 0x73,
+	0x65, 0x54, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x06,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65,
+	0x6d, 0x6f, 0x22, 0x7c, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x50, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0xff, 0x03, 0x0a, 0x23, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x78, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65,
+	0x54, 0x78, 0x12, 0x34, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x5f, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4f, 0x75, 0x74,
+	0x73, 0x12, 0x4b, 0x0a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x4b,
+	0x0a, 0x17, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x52, 0x15, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x24, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x22, 0xc2, 0x02, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x07, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x78, 0x52, 0x06, 0x62,
+	0x61, 0x73, 0x65, 0x54, 0x78, 0x12, 0x34, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x64,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x15, 0x41, 0x64,
+	0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x54, 0x78, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x54, 0x78, 0x12, 0x34, 0x0a, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x38, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4f, 0x75, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0xfe, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x1a,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x54, 0x78, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x54, 0x78, 0x12, 0x34, 0x0a, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a,
+	0x17, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x69, 0x67,
+	0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x14,
+	0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x69, 0x67, 0x49, 0x6e, 0x64,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0x84, 0x02, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55,
+	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x54, 0x78, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54,
+	0x78, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x54, 0x78, 0x12, 0x29, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0xfe, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64,
+	0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x22, 0xac, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x78, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65,
+	0x54, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x13,
+	0x0a, 0x05, 0x76, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x76,
+	0x6d, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x05, 0x66, 0x78, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a,
+	0x17, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x73, 0x69, 0x67,
+	0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x14,
+	0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x69, 0x67, 0x49, 0x6e, 0x64,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0xfd, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x22, 0xca, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74,
+	0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x76, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76,
+	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x76, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x76, 0x6d, 0x49, 0x64, 0x22, 0xb0, 0x02,
+	0x0a, 0x10, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x6d, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x54, 0x78, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x54, 0x78, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x41, 0x0a, 0x0f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74,
+	0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x75,
+	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xf8,
+	0x01, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x0f, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x07, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x78, 0x52, 0x06, 0x62,
+	0x61, 0x73, 0x65, 0x54, 0x78, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x12, 0x44, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0xf8, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74,
+	0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54,
+	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0xa5, 0x01, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x48, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x6f, 0x66, 0x5f, 0x70,
+	0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x50,
+	0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x16, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x1f, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x59, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x70, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x22, 0x7a, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x70, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x37,
+	0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x08, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x69,
+	0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x56, 0x0a, 0x0c,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x66, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x66, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x63, 0x70, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x22, 0x40, 0x0a, 0x06, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x64, 0x12, 0x13,
+	0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74,
+	0x78, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xc2, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x63, 0x70, 0x4d,
+	0x69, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x16,
+	0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x5f, 0x69,
+	0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x13, 0x6d, 0x69,
+	0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x53, 0x69, 0x67, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x6d, 0x69, 0x6e,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x0c,
+	0x41, 0x76, 0x6d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x75, 0x74, 0x78, 0x6f, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x64, 0x52, 0x07, 0x75, 0x74, 0x78, 0x6f, 0x49, 0x64,
+	0x73, 0x12, 0x2c, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x70, 0x4d, 0x69, 0x6e, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x22,
+	0x66, 0x0a, 0x10, 0x41, 0x76, 0x6d, 0x42, 0x61, 0x73, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x54, 0x78, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x54, 0x78, 0x12, 0x2a, 0x0a, 0x11, 0x75,
+	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x11, 0x41, 0x76, 0x6d, 0x42,
+	0x61, 0x73, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x17, 0x41, 0x76, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x07, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x78, 0x52,
+	0x06, 0x62, 0x61, 0x73, 0x65, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0x80, 0x02, 0x0a, 0x18, 0x41, 0x76, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x1a,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04,
+	0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x15, 0x41, 0x76, 0x6d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x07,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x78, 0x52, 0x06, 0x62, 0x61,
+	0x73, 0x65, 0x54, 0x78, 0x12, 0x33, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x41, 0x76, 0x6d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x16, 0x41, 0x76, 0x6d, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54,
+	0x78, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x12, 0x41, 0x76, 0x6d, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x07, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x78, 0x52, 0x06, 0x62,
+	0x61, 0x73, 0x65, 0x54, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x41, 0x0a, 0x0f, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x0e, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75,
+	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x13, 0x41, 0x76, 0x6d, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x12, 0x41, 0x76, 0x6d, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x07,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x54, 0x78, 0x52, 0x06, 0x62, 0x61,
+	0x73, 0x65, 0x54, 0x78, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x12, 0x44, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x13, 0x41, 0x76, 0x6d, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x17, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64,
+	0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x22, 0x2c, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22,
+	0x31, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2a, 0x0a, 0x11,
+	0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xa8, 0x02, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x22, 0xad, 0x01, 0x0a, 0x0b, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52,
+	0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x55, 0x74, 0x78, 0x6f, 0x49, 0x64, 0x52, 0x06, 0x75, 0x74, 0x78, 0x6f, 0x49, 0x64, 0x12, 0x31,
+	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x75, 0x74, 0x78, 0x6f, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0xe4, 0x01, 0x0a, 0x0c, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x07,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x19, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x30, 0x0a, 0x06,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0xeb, 0x01, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x73, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0xfe, 0x03, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x78, 0x46, 0x65, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x54, 0x78, 0x46, 0x65, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x46, 0x65, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x74,
+	0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x46, 0x65,
+	0x65, 0x12, 0x37, 0x0a, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x78, 0x46, 0x65, 0x65, 0x12, 0x48, 0x0a, 0x21, 0x61, 0x64,
+	0x64, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1d, 0x61, 0x64, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x46, 0x65, 0x65, 0x12, 0x48, 0x0a, 0x21, 0x61, 0x64, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x1d, 0x61, 0x64, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x65, 0x65, 0x12, 0x37,
+	0x0a, 0x18, 0x61, 0x64, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x15, 0x61, 0x64, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x46, 0x65, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x64, 0x64, 0x5f, 0x73,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x61, 0x64, 0x64, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x65, 0x65,
+	0x22, 0xcc, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x75,
+	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x09, 0x66, 0x65, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a,
+	0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22,
+	0x8a, 0x02, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x46, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x46, 0x65,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x2a, 0x61, 0x0a, 0x07,
+	0x54, 0x78, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x58, 0x5f, 0x43, 0x48,
+	0x41, 0x49, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x58, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x4c,
+	0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x58, 0x5f, 0x43,
+	0x48, 0x41, 0x49, 0x4e, 0x5f, 0x41, 0x56, 0x4d, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x58,
+	0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x52, 0x45, 0x54, 0x48, 0x10, 0x03, 0x32,
+	0xbe, 0x0c, 0x0a, 0x09, 0x54, 0x78, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x79, 0x0a,
+	0x1c, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65,
+	0x73, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x78, 0x12, 0x2a, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c,
+	0x65, 0x73, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x78, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54,
+	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x41, 0x64, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x41, 0x64, 0x64,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x78, 0x12, 0x1c, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x78,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x41, 0x64,
+	0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x54, 0x78, 0x12, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x78, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x12,
+	0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x78, 0x12,
+	0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x17, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x12, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4f, 0x66, 0x50, 0x6f, 0x73, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x41, 0x76, 0x6d, 0x42, 0x61, 0x73, 0x65,
+	0x54, 0x78, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x76, 0x6d, 0x42, 0x61,
+	0x73, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x41, 0x76, 0x6d, 0x42, 0x61, 0x73, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x10, 0x41, 0x76, 0x6d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x78, 0x12, 0x1e, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x41, 0x76, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x41, 0x76, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f,
+	0x0a, 0x0e, 0x41, 0x76, 0x6d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78,
+	0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x76, 0x6d, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x76, 0x6d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x0b, 0x41, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x12, 0x19,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x41, 0x76, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x41, 0x76, 0x6d, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41,
+	0x76, 0x6d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x76, 0x6d, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x12, 0x16, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31,
+	0x0a, 0x04, 0x55, 0x74, 0x78, 0x6f, 0x12, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x55,
+	0x74, 0x78, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5e, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5b, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x46, 0x65, 0x65, 0x12, 0x17, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x76, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x2d, 0x72, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f,
+	0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_tx_proto_rawDescOnce sync.Once
+	file_rpcpb_tx_proto_rawDescData = file_rpcpb_tx_proto_rawDesc
+)
+
+func file_rpcpb_tx_proto_rawDescGZIP() []byte {
+	file_rpcpb_tx_proto_rawDescOnce.Do(func() {
+		file_rpcpb_tx_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_tx_proto_rawDescData)
+	})
+	return file_rpcpb_tx_proto_rawDescData
+}
+
+var file_rpcpb_tx_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpcpb_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_rpcpb_tx_proto_goTypes = []interface{}{
+	(TxChain)(0),                                 // 0: rpcpb.TxChain
+	(*OutputOwners)(nil),                         // 1: rpcpb.OutputOwners
+	(*TransferableOutput)(nil),                   // 2: rpcpb.TransferableOutput
+	(*TransferableInput)(nil),                    // 3: rpcpb.TransferableInput
+	(*BaseTx)(nil),                               // 4: rpcpb.BaseTx
+	(*StakerValidator)(nil),                      // 5: rpcpb.StakerValidator
+	(*ProofOfPossession)(nil),                    // 6: rpcpb.ProofOfPossession
+	(*AddPermissionlessValidatorTxRequest)(nil),  // 7: rpcpb.AddPermissionlessValidatorTxRequest
+	(*AddPermissionlessValidatorTxResponse)(nil), // 8: rpcpb.AddPermissionlessValidatorTxResponse
+	(*AddValidatorTxRequest)(nil),                // 9: rpcpb.AddValidatorTxRequest
+	(*AddValidatorTxResponse)(nil),               // 10: rpcpb.AddValidatorTxResponse
+	(*AddDelegatorTxRequest)(nil),                // 11: rpcpb.AddDelegatorTxRequest
+	(*AddDelegatorTxResponse)(nil),               // 12: rpcpb.AddDelegatorTxResponse
+	(*AddSubnetValidatorTxRequest)(nil),          // 13: rpcpb.AddSubnetValidatorTxRequest
+	(*AddSubnetValidatorTxResponse)(nil),         // 14: rpcpb.AddSubnetValidatorTxResponse
+	(*CreateSubnetTxRequest)(nil),                // 15: rpcpb.CreateSubnetTxRequest
+	(*CreateSubnetTxResponse)(nil),               // 16: rpcpb.CreateSubnetTxResponse
+	(*CreateChainTxRequest)(nil),                 // 17: rpcpb.CreateChainTxRequest
+	(*CreateChainTxResponse)(nil),                // 18: rpcpb.CreateChainTxResponse
+	(*ChainIdsRequest)(nil),                      // 19: rpcpb.ChainIdsRequest
+	(*ChainIdsResponse)(nil),                     // 20: rpcpb.ChainIdsResponse
+	(*ImportTxRequest)(nil),                      // 21: rpcpb.ImportTxRequest
+	(*ImportTxResponse)(nil),                     // 22: rpcpb.ImportTxResponse
+	(*ExportTxRequest)(nil),                      // 23: rpcpb.ExportTxRequest
+	(*ExportTxResponse)(nil),                     // 24: rpcpb.ExportTxResponse
+	(*ProofOfPossessionVerifyRequest)(nil),       // 25: rpcpb.ProofOfPossessionVerifyRequest
+	(*ProofOfPossessionVerifyResponse)(nil),      // 26: rpcpb.ProofOfPossessionVerifyResponse
+	(*SecpTransferOutput)(nil),                   // 27: rpcpb.SecpTransferOutput
+	(*SecpOutput)(nil),                           // 28: rpcpb.SecpOutput
+	(*InitialState)(nil),                         // 29: rpcpb.InitialState
+	(*UtxoId)(nil),                               // 30: rpcpb.UtxoId
+	(*SecpMintOperation)(nil),                    // 31: rpcpb.SecpMintOperation
+	(*AvmOperation)(nil),                         // 32: rpcpb.AvmOperation
+	(*AvmBaseTxRequest)(nil),                     // 33: rpcpb.AvmBaseTxRequest
+	(*AvmBaseTxResponse)(nil),                    // 34: rpcpb.AvmBaseTxResponse
+	(*AvmCreateAssetTxRequest)(nil),              // 35: rpcpb.AvmCreateAssetTxRequest
+	(*AvmCreateAssetTxResponse)(nil),             // 36: rpcpb.AvmCreateAssetTxResponse
+	(*AvmOperationTxRequest)(nil),                // 37: rpcpb.AvmOperationTxRequest
+	(*AvmOperationTxResponse)(nil),               // 38: rpcpb.AvmOperationTxResponse
+	(*AvmImportTxRequest)(nil),                   // 39: rpcpb.AvmImportTxRequest
+	(*AvmImportTxResponse)(nil),                  // 40: rpcpb.AvmImportTxResponse
+	(*AvmExportTxRequest)(nil),                   // 41: rpcpb.AvmExportTxRequest
+	(*AvmExportTxResponse)(nil),                  // 42: rpcpb.AvmExportTxResponse
+	(*Credential)(nil),                           // 43: rpcpb.Credential
+	(*CredentialSigners)(nil),                    // 44: rpcpb.CredentialSigners
+	(*SignedTxRequest)(nil),                      // 45: rpcpb.SignedTxRequest
+	(*SignedTxResponse)(nil),                     // 46: rpcpb.SignedTxResponse
+	(*UtxoRequest)(nil),                          // 47: rpcpb.UtxoRequest
+	(*UtxoResponse)(nil),                         // 48: rpcpb.UtxoResponse
+	(*TransferableOutputsRequest)(nil),           // 49: rpcpb.TransferableOutputsRequest
+	(*TransferableOutputsResponse)(nil),          // 50: rpcpb.TransferableOutputsResponse
+	(*TransferableInputsRequest)(nil),            // 51: rpcpb.TransferableInputsRequest
+	(*TransferableInputsResponse)(nil),           // 52: rpcpb.TransferableInputsResponse
+	(*StaticFeeConfig)(nil),                      // 53: rpcpb.StaticFeeConfig
+	(*StaticFeeRequest)(nil),                     // 54: rpcpb.StaticFeeRequest
+	(*StaticFeeResponse)(nil),                    // 55: rpcpb.StaticFeeResponse
+	(*Diff)(nil),                                 // 56: rpcpb.Diff
+}
+var file_rpcpb_tx_proto_depIdxs = []int32{
+	1,  // 0: rpcpb.TransferableOutput.owners:type_name -> rpcpb.OutputOwners
+	2,  // 1: rpcpb.BaseTx.outputs:type_name -> rpcpb.TransferableOutput
+	3,  // 2: rpcpb.BaseTx.inputs:type_name -> rpcpb.TransferableInput
+	4,  // 3: rpcpb.AddPermissionlessValidatorTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	5,  // 4: rpcpb.AddPermissionlessValidatorTxRequest.validator:type_name -> rpcpb.StakerValidator
+	6,  // 5: rpcpb.AddPermissionlessValidatorTxRequest.signer:type_name -> rpcpb.ProofOfPossession
+	2,  // 6: rpcpb.AddPermissionlessValidatorTxRequest.stake_outs:type_name -> rpcpb.TransferableOutput
+	1,  // 7: rpcpb.AddPermissionlessValidatorTxRequest.validator_rewards_owner:type_name -> rpcpb.OutputOwners
+	1,  // 8: rpcpb.AddPermissionlessValidatorTxRequest.delegator_rewards_owner:type_name -> rpcpb.OutputOwners
+	56, // 9: rpcpb.AddPermissionlessValidatorTxResponse.diff:type_name -> rpcpb.Diff
+	4,  // 10: rpcpb.AddValidatorTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	5,  // 11: rpcpb.AddValidatorTxRequest.validator:type_name -> rpcpb.StakerValidator
+	2,  // 12: rpcpb.AddValidatorTxRequest.stake_outs:type_name -> rpcpb.TransferableOutput
+	1,  // 13: rpcpb.AddValidatorTxRequest.rewards_owner:type_name -> rpcpb.OutputOwners
+	56, // 14: rpcpb.AddValidatorTxResponse.diff:type_name -> rpcpb.Diff
+	4,  // 15: rpcpb.AddDelegatorTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	5,  // 16: rpcpb.AddDelegatorTxRequest.validator:type_name -> rpcpb.StakerValidator
+	2,  // 17: rpcpb.AddDelegatorTxRequest.stake_outs:type_name -> rpcpb.TransferableOutput
+	1,  // 18: rpcpb.AddDelegatorTxRequest.rewards_owner:type_name -> rpcpb.OutputOwners
+	56, // 19: rpcpb.AddDelegatorTxResponse.diff:type_name -> rpcpb.Diff
+	4,  // 20: rpcpb.AddSubnetValidatorTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	5,  // 21: rpcpb.AddSubnetValidatorTxRequest.validator:type_name -> rpcpb.StakerValidator
+	56, // 22: rpcpb.AddSubnetValidatorTxResponse.diff:type_name -> rpcpb.Diff
+	4,  // 23: rpcpb.CreateSubnetTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	1,  // 24: rpcpb.CreateSubnetTxRequest.owner:type_name -> rpcpb.OutputOwners
+	56, // 25: rpcpb.CreateSubnetTxResponse.diff:type_name -> rpcpb.Diff
+	4,  // 26: rpcpb.CreateChainTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	56, // 27: rpcpb.CreateChainTxResponse.diff:type_name -> rpcpb.Diff
+	4,  // 28: rpcpb.ImportTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	3,  // 29: rpcpb.ImportTxRequest.imported_inputs:type_name -> rpcpb.TransferableInput
+	56, // 30: rpcpb.ImportTxResponse.diff:type_name -> rpcpb.Diff
+	4,  // 31: rpcpb.ExportTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	2,  // 32: rpcpb.ExportTxRequest.exported_outputs:type_name -> rpcpb.TransferableOutput
+	56, // 33: rpcpb.ExportTxResponse.diff:type_name -> rpcpb.Diff
+	6,  // 34: rpcpb.ProofOfPossessionVerifyRequest.proof_of_possession:type_name -> rpcpb.ProofOfPossession
+	56, // 35: rpcpb.ProofOfPossessionVerifyResponse.diff:type_name -> rpcpb.Diff
+	1,  // 36: rpcpb.SecpTransferOutput.owners:type_name -> rpcpb.OutputOwners
+	27, // 37: rpcpb.SecpOutput.transfer:type_name -> rpcpb.SecpTransferOutput
+	1,  // 38: rpcpb.SecpOutput.mint:type_name -> rpcpb.OutputOwners
+	28, // 39: rpcpb.InitialState.outputs:type_name -> rpcpb.SecpOutput
+	1,  // 40: rpcpb.SecpMintOperation.mint_output:type_name -> rpcpb.OutputOwners
+	27, // 41: rpcpb.SecpMintOperation.transfer_output:type_name -> rpcpb.SecpTransferOutput
+	30, // 42: rpcpb.AvmOperation.utxo_ids:type_name -> rpcpb.UtxoId
+	31, // 43: rpcpb.AvmOperation.mint:type_name -> rpcpb.SecpMintOperation
+	4,  // 44: rpcpb.AvmBaseTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	56, // 45: rpcpb.AvmBaseTxResponse.diff:type_name -> rpcpb.Diff
+	4,  // 46: rpcpb.AvmCreateAssetTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	29, // 47: rpcpb.AvmCreateAssetTxRequest.initial_states:type_name -> rpcpb.InitialState
+	56, // 48: rpcpb.AvmCreateAssetTxResponse.diff:type_name -> rpcpb.Diff
+	4,  // 49: rpcpb.AvmOperationTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	32, // 50: rpcpb.AvmOperationTxRequest.operations:type_name -> rpcpb.AvmOperation
+	56, // 51: rpcpb.AvmOperationTxResponse.diff:type_name -> rpcpb.Diff
+	4,  // 52: rpcpb.AvmImportTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	3,  // 53: rpcpb.AvmImportTxRequest.imported_inputs:type_name -> rpcpb.TransferableInput
+	56, // 54: rpcpb.AvmImportTxResponse.diff:type_name -> rpcpb.Diff
+	4,  // 55: rpcpb.AvmExportTxRequest.base_tx:type_name -> rpcpb.BaseTx
+	2,  // 56: rpcpb.AvmExportTxRequest.exported_outputs:type_name -> rpcpb.TransferableOutput
+	56, // 57: rpcpb.AvmExportTxResponse.diff:type_name -> rpcpb.Diff
+	0,  // 58: rpcpb.SignedTxRequest.chain:type_name -> rpcpb.TxChain
+	43, // 59: rpcpb.SignedTxRequest.credentials:type_name -> rpcpb.Credential
+	44, // 60: rpcpb.SignedTxResponse.signers:type_name -> rpcpb.CredentialSigners
+	56, // 61: rpcpb.SignedTxResponse.diff:type_name -> rpcpb.Diff
+	0,  // 62: rpcpb.UtxoRequest.chain:type_name -> rpcpb.TxChain
+	30, // 63: rpcpb.UtxoRequest.utxo_id:type_name -> rpcpb.UtxoId
+	2,  // 64: rpcpb.UtxoRequest.output:type_name -> rpcpb.TransferableOutput
+	56, // 65: rpcpb.UtxoResponse.diff:type_name -> rpcpb.Diff
+	0,  // 66: rpcpb.TransferableOutputsRequest.chain:type_name -> rpcpb.TxChain
+	2,  // 67: rpcpb.TransferableOutputsRequest.outputs:type_name -> rpcpb.TransferableOutput
+	56, // 68: rpcpb.TransferableOutputsResponse.diff:type_name -> rpcpb.Diff
+	0,  // 69: rpcpb.TransferableInputsRequest.chain:type_name -> rpcpb.TxChain
+	3,  // 70: rpcpb.TransferableInputsRequest.inputs:type_name -> rpcpb.TransferableInput
+	56, // 71: rpcpb.TransferableInputsResponse.diff:type_name -> rpcpb.Diff
+	0,  // 72: rpcpb.StaticFeeRequest.chain:type_name -> rpcpb.TxChain
+	53, // 73: rpcpb.StaticFeeRequest.fee_config:type_name -> rpcpb.StaticFeeConfig
+	53, // 74: rpcpb.StaticFeeResponse.expected_fee_config:type_name -> rpcpb.StaticFeeConfig
+	7,  // 75: rpcpb.TxService.AddPermissionlessValidatorTx:input_type -> rpcpb.AddPermissionlessValidatorTxRequest
+	9,  // 76: rpcpb.TxService.AddValidatorTx:input_type -> rpcpb.AddValidatorTxRequest
+	11, // 77: rpcpb.TxService.AddDelegatorTx:input_type -> rpcpb.AddDelegatorTxRequest
+	13, // 78: rpcpb.TxService.AddSubnetValidatorTx:input_type -> rpcpb.AddSubnetValidatorTxRequest
+	15, // 79: rpcpb.TxService.CreateSubnetTx:input_type -> rpcpb.CreateSubnetTxRequest
+	17, // 80: rpcpb.TxService.CreateChainTx:input_type -> rpcpb.CreateChainTxRequest
+	19, // 81: rpcpb.TxService.ChainIds:input_type -> rpcpb.ChainIdsRequest
+	21, // 82: rpcpb.TxService.ImportTx:input_type -> rpcpb.ImportTxRequest
+	23, // 83: rpcpb.TxService.ExportTx:input_type -> rpcpb.ExportTxRequest
+	25, // 84: rpcpb.TxService.ProofOfPossessionVerify:input_type -> rpcpb.ProofOfPossessionVerifyRequest
+	33, // 85: rpcpb.TxService.AvmBaseTx:input_type -> rpcpb.AvmBaseTxRequest
+	35, // 86: rpcpb.TxService.AvmCreateAssetTx:input_type -> rpcpb.AvmCreateAssetTxRequest
+	37, // 87: rpcpb.TxService.AvmOperationTx:input_type -> rpcpb.AvmOperationTxRequest
+	39, // 88: rpcpb.TxService.AvmImportTx:input_type -> rpcpb.AvmImportTxRequest
+	41, // 89: rpcpb.TxService.AvmExportTx:input_type -> rpcpb.AvmExportTxRequest
+	45, // 90: rpcpb.TxService.SignedTx:input_type -> rpcpb.SignedTxRequest
+	47, // 91: rpcpb.TxService.Utxo:input_type -> rpcpb.UtxoRequest
+	49, // 92: rpcpb.TxService.TransferableOutputs:input_type -> rpcpb.TransferableOutputsRequest
+	51, // 93: rpcpb.TxService.TransferableInputs:input_type -> rpcpb.TransferableInputsRequest
+	54, // 94: rpcpb.TxService.StaticFee:input_type -> rpcpb.StaticFeeRequest
+	8,  // 95: rpcpb.TxService.AddPermissionlessValidatorTx:output_type -> rpcpb.AddPermissionlessValidatorTxResponse
+	10, // 96: rpcpb.TxService.AddValidatorTx:output_type -> rpcpb.AddValidatorTxResponse
+	12, // 97: rpcpb.TxService.AddDelegatorTx:output_type -> rpcpb.AddDelegatorTxResponse
+	14, // 98: rpcpb.TxService.AddSubnetValidatorTx:output_type -> rpcpb.AddSubnetValidatorTxResponse
+	16, // 99: rpcpb.TxService.CreateSubnetTx:output_type -> rpcpb.CreateSubnetTxResponse
+	18, // 100: rpcpb.TxService.CreateChainTx:output_type -> rpcpb.CreateChainTxResponse
+	20, // 101: rpcpb.TxService.ChainIds:output_type -> rpcpb.ChainIdsResponse
+	22, // 102: rpcpb.TxService.ImportTx:output_type -> rpcpb.ImportTxResponse
+	24, // 103: rpcpb.TxService.ExportTx:output_type -> rpcpb.ExportTxResponse
+	26, // 104: rpcpb.TxService.ProofOfPossessionVerify:output_type -> rpcpb.ProofOfPossessionVerifyResponse
+	34, // 105: rpcpb.TxService.AvmBaseTx:output_type -> rpcpb.AvmBaseTxResponse
+	36, // 106: rpcpb.TxService.AvmCreateAssetTx:output_type -> rpcpb.AvmCreateAssetTxResponse
+	38, // 107: rpcpb.TxService.AvmOperationTx:output_type -> rpcpb.AvmOperationTxResponse
+	40, // 108: rpcpb.TxService.AvmImportTx:output_type -> rpcpb.AvmImportTxResponse
+	42, // 109: rpcpb.TxService.AvmExportTx:output_type -> rpcpb.AvmExportTxResponse
+	46, // 110: rpcpb.TxService.SignedTx:output_type -> rpcpb.SignedTxResponse
+	48, // 111: rpcpb.TxService.Utxo:output_type -> rpcpb.UtxoResponse
+	50, // 112: rpcpb.TxService.TransferableOutputs:output_type -> rpcpb.TransferableOutputsResponse
+	52, // 113: rpcpb.TxService.TransferableInputs:output_type -> rpcpb.TransferableInputsResponse
+	55, // 114: rpcpb.TxService.StaticFee:output_type -> rpcpb.StaticFeeResponse
+	95, // [95:115] is the sub-list for method output_type
+	75, // [75:95] is the sub-list for method input_type
+	75, // [75:75] is the sub-list for extension type_name
+	75, // [75:75] is the sub-list for extension extendee
+	0,  // [0:75] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_tx_proto_init() }
+func file_rpcpb_tx_proto_init() {
+	if File_rpcpb_tx_proto != nil {
+		return
+	}
+	file_rpcpb_diff_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpcpb_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutputOwners); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferableOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferableInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BaseTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakerValidator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofOfPossession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPermissionlessValidatorTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPermissionlessValidatorTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddValidatorTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddValidatorTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDelegatorTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDelegatorTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSubnetValidatorTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSubnetValidatorTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubnetTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubnetTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChainTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChainTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofOfPossessionVerifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofOfPossessionVerifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecpTransferOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecpOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitialState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecpMintOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvmOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvmBaseTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvmBaseTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvmCreateAssetTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvmCreateAssetTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvmOperationTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvmOperationTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvmImportTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvmImportTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvmExportTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvmExportTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Credential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CredentialSigners); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedTxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferableOutputsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferableOutputsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferableInputsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferableInputsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaticFeeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaticFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpcpb_tx_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaticFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rpcpb_tx_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*SecpOutput_Transfer)(nil),
+		(*SecpOutput_Mint)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_tx_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   55,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rpcpb_tx_proto_goTypes,
+		DependencyIndexes: file_rpcpb_tx_proto_depIdxs,
+		EnumInfos:         file_rpcpb_tx_proto_enumTypes,
+		MessageInfos:      file_rpcpb_tx_proto_msgTypes,
+	}.Build()
+	File_rpcpb_tx_proto = out.File
+	file_rpcpb_tx_proto_rawDesc = nil
+	file_rpcpb_tx_proto_goTypes = nil
+	file_rpcpb_tx_proto_depIdxs = nil
+}
@@ -0,0 +1,836 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/tx.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TxService_AddPermissionlessValidatorTx_FullMethodName = "/rpcpb.TxService/AddPermissionlessValidatorTx"
+	TxService_AddValidatorTx_FullMethodName               = "/rpcpb.TxService/AddValidatorTx"
+	TxService_AddDelegatorTx_FullMethodName               = "/rpcpb.TxService/AddDelegatorTx"
+	TxService_AddSubnetValidatorTx_FullMethodName         = "/rpcpb.TxService/AddSubnetValidatorTx"
+	TxService_CreateSubnetTx_FullMethodName               = "/rpcpb.TxService/CreateSubnetTx"
+	TxService_CreateChainTx_FullMethodName                = "/rpcpb.TxService/CreateChainTx"
+	TxService_ChainIds_FullMethodName                     = "/rpcpb.TxService/ChainIds"
+	TxService_ImportTx_FullMethodName                     = "/rpcpb.TxService/ImportTx"
+	TxService_ExportTx_FullMethodName                     = "/rpcpb.TxService/ExportTx"
+	TxService_ProofOfPossessionVerify_FullMethodName      = "/rpcpb.TxService/ProofOfPossessionVerify"
+	TxService_AvmBaseTx_FullMethodName                    = "/rpcpb.TxService/AvmBaseTx"
+	TxService_AvmCreateAssetTx_FullMethodName             = "/rpcpb.TxService/AvmCreateAssetTx"
+	TxService_AvmOperationTx_FullMethodName               = "/rpcpb.TxService/AvmOperationTx"
+	TxService_AvmImportTx_FullMethodName                  = "/rpcpb.TxService/AvmImportTx"
+	TxService_AvmExportTx_FullMethodName                  = "/rpcpb.TxService/AvmExportTx"
+	TxService_SignedTx_FullMethodName                     = "/rpcpb.TxService/SignedTx"
+	TxService_Utxo_FullMethodName                         = "/rpcpb.TxService/Utxo"
+	TxService_TransferableOutputs_FullMethodName          = "/rpcpb.TxService/TransferableOutputs"
+	TxService_TransferableInputs_FullMethodName           = "/rpcpb.TxService/TransferableInputs"
+	TxService_StaticFee_FullMethodName                    = "/rpcpb.TxService/StaticFee"
+)
+
+// TxServiceClient is the client API for TxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TxServiceClient interface {
+	AddPermissionlessValidatorTx(ctx context.Context, in *AddPermissionlessValidatorTxRequest, opts ...grpc.CallOption) (*AddPermissionlessValidatorTxResponse, error)
+	AddValidatorTx(ctx context.Context, in *AddValidatorTxRequest, opts ...grpc.CallOption) (*AddValidatorTxResponse, error)
+	AddDelegatorTx(ctx context.Context, in *AddDelegatorTxRequest, opts ...grpc.CallOption) (*AddDelegatorTxResponse, error)
+	AddSubnetValidatorTx(ctx context.Context, in *AddSubnetValidatorTxRequest, opts ...grpc.CallOption) (*AddSubnetValidatorTxResponse, error)
+	CreateSubnetTx(ctx context.Context, in *CreateSubnetTxRequest, opts ...grpc.CallOption) (*CreateSubnetTxResponse, error)
+	CreateChainTx(ctx context.Context, in *CreateChainTxRequest, opts ...grpc.CallOption) (*CreateChainTxResponse, error)
+	// Derives the subnet ID or blockchain ID the P-chain assigns on accepting a
+	// signed CreateSubnetTx or CreateChainTx (i.e., the ID of the signed tx).
+	// ref. "executor.StandardTxExecutor.CreateSubnetTx", "chains.manager.createChain"
+	ChainIds(ctx context.Context, in *ChainIdsRequest, opts ...grpc.CallOption) (*ChainIdsResponse, error)
+	ImportTx(ctx context.Context, in *ImportTxRequest, opts ...grpc.CallOption) (*ImportTxResponse, error)
+	ExportTx(ctx context.Context, in *ExportTxRequest, opts ...grpc.CallOption) (*ExportTxResponse, error)
+	// Verifies the proof of possession of a validator BLS key, as
+	// "signer.ProofOfPossession.Verify".
+	ProofOfPossessionVerify(ctx context.Context, in *ProofOfPossessionVerifyRequest, opts ...grpc.CallOption) (*ProofOfPossessionVerifyResponse, error)
+	// X-chain txs, serialized with the secp256k1fx, nftfx and propertyfx
+	// registered as on the X-chain.
+	AvmBaseTx(ctx context.Context, in *AvmBaseTxRequest, opts ...grpc.CallOption) (*AvmBaseTxResponse, error)
+	AvmCreateAssetTx(ctx context.Context, in *AvmCreateAssetTxRequest, opts ...grpc.CallOption) (*AvmCreateAssetTxResponse, error)
+	AvmOperationTx(ctx context.Context, in *AvmOperationTxRequest, opts ...grpc.CallOption) (*AvmOperationTxResponse, error)
+	AvmImportTx(ctx context.Context, in *AvmImportTxRequest, opts ...grpc.CallOption) (*AvmImportTxResponse, error)
+	AvmExportTx(ctx context.Context, in *AvmExportTxRequest, opts ...grpc.CallOption) (*AvmExportTxResponse, error)
+	// Signs an unsigned tx of any chain with the given credentials.
+	SignedTx(ctx context.Context, in *SignedTxRequest, opts ...grpc.CallOption) (*SignedTxResponse, error)
+	// UTXOs and transferables, packed with the codec of the given chain.
+	Utxo(ctx context.Context, in *UtxoRequest, opts ...grpc.CallOption) (*UtxoResponse, error)
+	TransferableOutputs(ctx context.Context, in *TransferableOutputsRequest, opts ...grpc.CallOption) (*TransferableOutputsResponse, error)
+	TransferableInputs(ctx context.Context, in *TransferableInputsRequest, opts ...grpc.CallOption) (*TransferableInputsResponse, error)
+	// Static fee of an unsigned P-chain or X-chain tx, by its type, past the
+	// activation of apricot phase 3.
+	// ref. "genesis.TxFeeConfig", "config.Config.GetCreateSubnetTxFee"
+	StaticFee(ctx context.Context, in *StaticFeeRequest, opts ...grpc.CallOption) (*StaticFeeResponse, error)
+}
+
+type txServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTxServiceClient(cc grpc.ClientConnInterface) TxServiceClient {
+	return &txServiceClient{cc}
+}
+
+func (c *txServiceClient) AddPermissionlessValidatorTx(ctx context.Context, in *AddPermissionlessValidatorTxRequest, opts ...grpc.CallOption) (*AddPermissionlessValidatorTxResponse, error) {
+	out := new(AddPermissionlessValidatorTxResponse)
+	err := c.cc.Invoke(ctx, TxService_AddPermissionlessValidatorTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) AddValidatorTx(ctx context.Context, in *AddValidatorTxRequest, opts ...grpc.CallOption) (*AddValidatorTxResponse, error) {
+	out := new(AddValidatorTxResponse)
+	err := c.cc.Invoke(ctx, TxService_AddValidatorTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) AddDelegatorTx(ctx context.Context, in *AddDelegatorTxRequest, opts ...grpc.CallOption) (*AddDelegatorTxResponse, error) {
+	out := new(AddDelegatorTxResponse)
+	err := c.cc.Invoke(ctx, TxService_AddDelegatorTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) AddSubnetValidatorTx(ctx context.Context, in *AddSubnetValidatorTxRequest, opts ...grpc.CallOption) (*AddSubnetValidatorTxResponse, error) {
+	out := new(AddSubnetValidatorTxResponse)
+	err := c.cc.Invoke(ctx, TxService_AddSubnetValidatorTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) CreateSubnetTx(ctx context.Context, in *CreateSubnetTxRequest, opts ...grpc.CallOption) (*CreateSubnetTxResponse, error) {
+	out := new(CreateSubnetTxResponse)
+	err := c.cc.Invoke(ctx, TxService_CreateSubnetTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) CreateChainTx(ctx context.Context, in *CreateChainTxRequest, opts ...grpc.CallOption) (*CreateChainTxResponse, error) {
+	out := new(CreateChainTxResponse)
+	err := c.cc.Invoke(ctx, TxService_CreateChainTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) ChainIds(ctx context.Context, in *ChainIdsRequest, opts ...grpc.CallOption) (*ChainIdsResponse, error) {
+	out := new(ChainIdsResponse)
+	err := c.cc.Invoke(ctx, TxService_ChainIds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) ImportTx(ctx context.Context, in *ImportTxRequest, opts ...grpc.CallOption) (*ImportTxResponse, error) {
+	out := new(ImportTxResponse)
+	err := c.cc.Invoke(ctx, TxService_ImportTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) ExportTx(ctx context.Context, in *ExportTxRequest, opts ...grpc.CallOption) (*ExportTxResponse, error) {
+	out := new(ExportTxResponse)
+	err := c.cc.Invoke(ctx, TxService_ExportTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) ProofOfPossessionVerify(ctx context.Context, in *ProofOfPossessionVerifyRequest, opts ...grpc.CallOption) (*ProofOfPossessionVerifyResponse, error) {
+	out := new(ProofOfPossessionVerifyResponse)
+	err := c.cc.Invoke(ctx, TxService_ProofOfPossessionVerify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) AvmBaseTx(ctx context.Context, in *AvmBaseTxRequest, opts ...grpc.CallOption) (*AvmBaseTxResponse, error) {
+	out := new(AvmBaseTxResponse)
+	err := c.cc.Invoke(ctx, TxService_AvmBaseTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) AvmCreateAssetTx(ctx context.Context, in *AvmCreateAssetTxRequest, opts ...grpc.CallOption) (*AvmCreateAssetTxResponse, error) {
+	out := new(AvmCreateAssetTxResponse)
+	err := c.cc.Invoke(ctx, TxService_AvmCreateAssetTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) AvmOperationTx(ctx context.Context, in *AvmOperationTxRequest, opts ...grpc.CallOption) (*AvmOperationTxResponse, error) {
+	out := new(AvmOperationTxResponse)
+	err := c.cc.Invoke(ctx, TxService_AvmOperationTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) AvmImportTx(ctx context.Context, in *AvmImportTxRequest, opts ...grpc.CallOption) (*AvmImportTxResponse, error) {
+	out := new(AvmImportTxResponse)
+	err := c.cc.Invoke(ctx, TxService_AvmImportTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) AvmExportTx(ctx context.Context, in *AvmExportTxRequest, opts ...grpc.CallOption) (*AvmExportTxResponse, error) {
+	out := new(AvmExportTxResponse)
+	err := c.cc.Invoke(ctx, TxService_AvmExportTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) SignedTx(ctx context.Context, in *SignedTxRequest, opts ...grpc.CallOption) (*SignedTxResponse, error) {
+	out := new(SignedTxResponse)
+	err := c.cc.Invoke(ctx, TxService_SignedTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) Utxo(ctx context.Context, in *UtxoRequest, opts ...grpc.CallOption) (*UtxoResponse, error) {
+	out := new(UtxoResponse)
+	err := c.cc.Invoke(ctx, TxService_Utxo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) TransferableOutputs(ctx context.Context, in *TransferableOutputsRequest, opts ...grpc.CallOption) (*TransferableOutputsResponse, error) {
+	out := new(TransferableOutputsResponse)
+	err := c.cc.Invoke(ctx, TxService_TransferableOutputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) TransferableInputs(ctx context.Context, in *TransferableInputsRequest, opts ...grpc.CallOption) (*TransferableInputsResponse, error) {
+	out := new(TransferableInputsResponse)
+	err := c.cc.Invoke(ctx, TxService_TransferableInputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) StaticFee(ctx context.Context, in *StaticFeeRequest, opts ...grpc.CallOption) (*StaticFeeResponse, error) {
+	out := new(StaticFeeResponse)
+	err := c.cc.Invoke(ctx, TxService_StaticFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TxServiceServer is the server API for TxService service.
+// All implementations must embed UnimplementedTxServiceServer
+// for forward compatibility
+type TxServiceServer interface {
+	AddPermissionlessValidatorTx(context.Context, *AddPermissionlessValidatorTxRequest) (*AddPermissionlessValidatorTxResponse, error)
+	AddValidatorTx(context.Context, *AddValidatorTxRequest) (*AddValidatorTxResponse, error)
+	AddDelegatorTx(context.Context, *AddDelegatorTxRequest) (*AddDelegatorTxResponse, error)
+	AddSubnetValidatorTx(context.Context, *AddSubnetValidatorTxRequest) (*AddSubnetValidatorTxResponse, error)
+	CreateSubnetTx(context.Context, *CreateSubnetTxRequest) (*CreateSubnetTxResponse, error)
+	CreateChainTx(context.Context, *CreateChainTxRequest) (*CreateChainTxResponse, error)
+	// Derives the subnet ID or blockchain ID the P-chain assigns on accepting a
+	// signed CreateSubnetTx or CreateChainTx (i.e., the ID of the signed tx).
+	// ref. "executor.StandardTxExecutor.CreateSubnetTx", "chains.manager.createChain"
+	ChainIds(context.Context, *ChainIdsRequest) (*ChainIdsResponse, error)
+	ImportTx(context.Context, *ImportTxRequest) (*ImportTxResponse, error)
+	ExportTx(context.Context, *ExportTxRequest) (*ExportTxResponse, error)
+	// Verifies the proof of possession of a validator BLS key, as
+	// "signer.ProofOfPossession.Verify".
+	ProofOfPossessionVerify(context.Context, *ProofOfPossessionVerifyRequest) (*ProofOfPossessionVerifyResponse, error)
+	// X-chain txs, serialized with the secp256k1fx, nftfx and propertyfx
+	// registered as on the X-chain.
+	AvmBaseTx(context.Context, *AvmBaseTxRequest) (*AvmBaseTxResponse, error)
+	AvmCreateAssetTx(context.Context, *AvmCreateAssetTxRequest) (*AvmCreateAssetTxResponse, error)
+	AvmOperationTx(context.Context, *AvmOperationTxRequest) (*AvmOperationTxResponse, error)
+	AvmImportTx(context.Context, *AvmImportTxRequest) (*AvmImportTxResponse, error)
+	AvmExportTx(context.Context, *AvmExportTxRequest) (*AvmExportTxResponse, error)
+	// Signs an unsigned tx of any chain with the given credentials.
+	SignedTx(context.Context, *SignedTxRequest) (*SignedTxResponse, error)
+	// UTXOs and transferables, packed with the codec of the given chain.
+	Utxo(context.Context, *UtxoRequest) (*UtxoResponse, error)
+	TransferableOutputs(context.Context, *TransferableOutputsRequest) (*TransferableOutputsResponse, error)
+	TransferableInputs(context.Context, *TransferableInputsRequest) (*TransferableInputsResponse, error)
+	// Static fee of an unsigned P-chain or X-chain tx, by its type, past the
+	// activation of apricot phase 3.
+	// ref. "genesis.TxFeeConfig", "config.Config.GetCreateSubnetTxFee"
+	StaticFee(context.Context, *StaticFeeRequest) (*StaticFeeResponse, error)
+	mustEmbedUnimplementedTxServiceServer()
+}
+
+// UnimplementedTxServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTxServiceServer struct {
+}
+
+func (UnimplementedTxServiceServer) AddPermissionlessValidatorTx(context.Context, *AddPermissionlessValidatorTxRequest) (*AddPermissionlessValidatorTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPermissionlessValidatorTx not implemented")
+}
+func (UnimplementedTxServiceServer) AddValidatorTx(context.Context, *AddValidatorTxRequest) (*AddValidatorTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddValidatorTx not implemented")
+}
+func (UnimplementedTxServiceServer) AddDelegatorTx(context.Context, *AddDelegatorTxRequest) (*AddDelegatorTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDelegatorTx not implemented")
+}
+func (UnimplementedTxServiceServer) AddSubnetValidatorTx(context.Context, *AddSubnetValidatorTxRequest) (*AddSubnetValidatorTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubnetValidatorTx not implemented")
+}
+func (UnimplementedTxServiceServer) CreateSubnetTx(context.Context, *CreateSubnetTxRequest) (*CreateSubnetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubnetTx not implemented")
+}
+func (UnimplementedTxServiceServer) CreateChainTx(context.Context, *CreateChainTxRequest) (*CreateChainTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChainTx not implemented")
+}
+func (UnimplementedTxServiceServer) ChainIds(context.Context, *ChainIdsRequest) (*ChainIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainIds not implemented")
+}
+func (UnimplementedTxServiceServer) ImportTx(context.Context, *ImportTxRequest) (*ImportTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTx not implemented")
+}
+func (UnimplementedTxServiceServer) ExportTx(context.Context, *ExportTxRequest) (*ExportTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTx not implemented")
+}
+func (UnimplementedTxServiceServer) ProofOfPossessionVerify(context.Context, *ProofOfPossessionVerifyRequest) (*ProofOfPossessionVerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProofOfPossessionVerify not implemented")
+}
+func (UnimplementedTxServiceServer) AvmBaseTx(context.Context, *AvmBaseTxRequest) (*AvmBaseTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvmBaseTx not implemented")
+}
+func (UnimplementedTxServiceServer) AvmCreateAssetTx(context.Context, *AvmCreateAssetTxRequest) (*AvmCreateAssetTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvmCreateAssetTx not implemented")
+}
+func (UnimplementedTxServiceServer) AvmOperationTx(context.Context, *AvmOperationTxRequest) (*AvmOperationTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvmOperationTx not implemented")
+}
+func (UnimplementedTxServiceServer) AvmImportTx(context.Context, *AvmImportTxRequest) (*AvmImportTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvmImportTx not implemented")
+}
+func (UnimplementedTxServiceServer) AvmExportTx(context.Context, *AvmExportTxRequest) (*AvmExportTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvmExportTx not implemented")
+}
+func (UnimplementedTxServiceServer) SignedTx(context.Context, *SignedTxRequest) (*SignedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignedTx not implemented")
+}
+func (UnimplementedTxServiceServer) Utxo(context.Context, *UtxoRequest) (*UtxoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Utxo not implemented")
+}
+func (UnimplementedTxServiceServer) TransferableOutputs(context.Context, *TransferableOutputsRequest) (*TransferableOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferableOutputs not implemented")
+}
+func (UnimplementedTxServiceServer) TransferableInputs(context.Context, *TransferableInputsRequest) (*TransferableInputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferableInputs not implemented")
+}
+func (UnimplementedTxServiceServer) StaticFee(context.Context, *StaticFeeRequest) (*StaticFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StaticFee not implemented")
+}
+func (UnimplementedTxServiceServer) mustEmbedUnimplementedTxServiceServer() {}
+
+// UnsafeTxServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TxServiceServer will
+// result in compilation errors.
+type UnsafeTxServiceServer interface {
+	mustEmbedUnimplementedTxServiceServer()
+}
+
+func RegisterTxServiceServer(s grpc.ServiceRegistrar, srv TxServiceServer) {
+	s.RegisterService(&TxService_ServiceDesc, srv)
+}
+
+func _TxService_AddPermissionlessValidatorTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPermissionlessValidatorTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).AddPermissionlessValidatorTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_AddPermissionlessValidatorTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).AddPermissionlessValidatorTx(ctx, req.(*AddPermissionlessValidatorTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_AddValidatorTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddValidatorTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).AddValidatorTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_AddValidatorTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).AddValidatorTx(ctx, req.(*AddValidatorTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_AddDelegatorTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDelegatorTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).AddDelegatorTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_AddDelegatorTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).AddDelegatorTx(ctx, req.(*AddDelegatorTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_AddSubnetValidatorTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSubnetValidatorTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).AddSubnetValidatorTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_AddSubnetValidatorTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).AddSubnetValidatorTx(ctx, req.(*AddSubnetValidatorTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_CreateSubnetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubnetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).CreateSubnetTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_CreateSubnetTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).CreateSubnetTx(ctx, req.(*CreateSubnetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_CreateChainTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChainTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).CreateChainTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_CreateChainTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).CreateChainTx(ctx, req.(*CreateChainTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_ChainIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).ChainIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_ChainIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).ChainIds(ctx, req.(*ChainIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_ImportTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).ImportTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_ImportTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).ImportTx(ctx, req.(*ImportTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_ExportTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).ExportTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_ExportTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).ExportTx(ctx, req.(*ExportTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_ProofOfPossessionVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProofOfPossessionVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).ProofOfPossessionVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_ProofOfPossessionVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).ProofOfPossessionVerify(ctx, req.(*ProofOfPossessionVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_AvmBaseTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvmBaseTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).AvmBaseTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_AvmBaseTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).AvmBaseTx(ctx, req.(*AvmBaseTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_AvmCreateAssetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvmCreateAssetTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).AvmCreateAssetTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_AvmCreateAssetTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).AvmCreateAssetTx(ctx, req.(*AvmCreateAssetTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_AvmOperationTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvmOperationTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).AvmOperationTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_AvmOperationTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).AvmOperationTx(ctx, req.(*AvmOperationTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_AvmImportTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvmImportTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).AvmImportTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_AvmImportTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).AvmImportTx(ctx, req.(*AvmImportTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_AvmExportTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvmExportTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).AvmExportTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_AvmExportTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).AvmExportTx(ctx, req.(*AvmExportTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_SignedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignedTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).SignedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_SignedTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).SignedTx(ctx, req.(*SignedTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_Utxo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UtxoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).Utxo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_Utxo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).Utxo(ctx, req.(*UtxoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_TransferableOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferableOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).TransferableOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_TransferableOutputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).TransferableOutputs(ctx, req.(*TransferableOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_TransferableInputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferableInputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).TransferableInputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_TransferableInputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).TransferableInputs(ctx, req.(*TransferableInputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_StaticFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaticFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).StaticFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_StaticFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).StaticFee(ctx, req.(*StaticFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TxService_ServiceDesc is the grpc.ServiceDesc for TxService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TxService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.TxService",
+	HandlerType: (*TxServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddPermissionlessValidatorTx",
+			Handler:    _TxService_AddPermissionlessValidatorTx_Handler,
+		},
+		{
+			MethodName: "AddValidatorTx",
+			Handler:    _TxService_AddValidatorTx_Handler,
+		},
+		{
+			MethodName: "AddDelegatorTx",
+			Handler:    _TxService_AddDelegatorTx_Handler,
+		},
+		{
+			MethodName: "AddSubnetValidatorTx",
+			Handler:    _TxService_AddSubnetValidatorTx_Handler,
+		},
+		{
+			MethodName: "CreateSubnetTx",
+			Handler:    _TxService_CreateSubnetTx_Handler,
+		},
+		{
+			MethodName: "CreateChainTx",
+			Handler:    _TxService_CreateChainTx_Handler,
+		},
+		{
+			MethodName: "ChainIds",
+			Handler:    _TxService_ChainIds_Handler,
+		},
+		{
+			MethodName: "ImportTx",
+			Handler:    _TxService_ImportTx_Handler,
+		},
+		{
+			MethodName: "ExportTx",
+			Handler:    _TxService_ExportTx_Handler,
+		},
+		{
+			MethodName: "ProofOfPossessionVerify",
+			Handler:    _TxService_ProofOfPossessionVerify_Handler,
+		},
+		{
+			MethodName: "AvmBaseTx",
+			Handler:    _TxService_AvmBaseTx_Handler,
+		},
+		{
+			MethodName: "AvmCreateAssetTx",
+			Handler:    _TxService_AvmCreateAssetTx_Handler,
+		},
+		{
+			MethodName: "AvmOperationTx",
+			Handler:    _TxService_AvmOperationTx_Handler,
+		},
+		{
+			MethodName: "AvmImportTx",
+			Handler:    _TxService_AvmImportTx_Handler,
+		},
+		{
+			MethodName: "AvmExportTx",
+			Handler:    _TxService_AvmExportTx_Handler,
+		},
+		{
+			MethodName: "SignedTx",
+			Handler:    _TxService_SignedTx_Handler,
+		},
+		{
+			MethodName: "Utxo",
+			Handler:    _TxService_Utxo_Handler,
+		},
+		{
+			MethodName: "TransferableOutputs",
+			Handler:    _TxService_TransferableOutputs_Handler,
+		},
+		{
+			MethodName: "TransferableInputs",
+			Handler:    _TxService_TransferableInputs_Handler,
+		},
+		{
+			MethodName: "StaticFee",
+			Handler:    _TxService_StaticFee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/tx.proto",
+}
@@ -0,0 +1,158 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: rpcpb/upgrade.proto
+
+package rpcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UpgradeEra selects the network upgrade whose serialization and
+// validation rules a check follows. Only checks whose rules differ across
+// the eras the server implements take it: BuildVertex, BuildPlatformBlock and
+// PlatformTxJson. TxService, AVM and Coreth tx checks do not, since their
+// serialization is the same from Banff to Cortina, the latest upgrade of the
+// avalanchego version of the server. Durango and Etna fail every check.
+type UpgradeEra int32
+
+const (
+	// Rules of the check before upgrade eras were selectable.
+	UpgradeEra_UPGRADE_ERA_UNSPECIFIED UpgradeEra = 0
+	UpgradeEra_UPGRADE_ERA_BANFF       UpgradeEra = 1
+	UpgradeEra_UPGRADE_ERA_CORTINA     UpgradeEra = 2
+	UpgradeEra_UPGRADE_ERA_DURANGO     UpgradeEra = 3
+	UpgradeEra_UPGRADE_ERA_ETNA        UpgradeEra = 4
+	// Latest upgrade supported by the avalanchego version of the server.
+	UpgradeEra_UPGRADE_ERA_LATEST UpgradeEra = 5
+)
+
+// Enum value maps for UpgradeEra.
+var (
+	UpgradeEra_name = map[int32]string{
+		0: "UPGRADE_ERA_UNSPECIFIED",
+		1: "UPGRADE_ERA_BANFF",
+		2: "UPGRADE_ERA_CORTINA",
+		3: "UPGRADE_ERA_DURANGO",
+		4: "UPGRADE_ERA_ETNA",
+		5: "UPGRADE_ERA_LATEST",
+	}
+	UpgradeEra_value = map[string]int32{
+		"UPGRADE_ERA_UNSPECIFIED": 0,
+		"UPGRADE_ERA_BANFF":       1,
+		"UPGRADE_ERA_CORTINA":     2,
+		"UPGRADE_ERA_DURANGO":     3,
+		"UPGRADE_ERA_ETNA":        4,
+		"UPGRADE_ERA_LATEST":      5,
+	}
+)
+
+func (x UpgradeEra) Enum() *UpgradeEra {
+	p := new(UpgradeEra)
+	*p = x
+	return p
+}
+
+func (x UpgradeEra) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpgradeEra) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpcpb_upgrade_proto_enumTypes[0].Descriptor()
+}
+
+func (UpgradeEra) Type() protoreflect.EnumType {
+	return &file_rpcpb_upgrade_proto_enumTypes[0]
+}
+
+func (x UpgradeEra) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpgradeEra.Descriptor instead.
+func (UpgradeEra) EnumDescriptor() ([]byte, []int) {
+	return file_rpcpb_upgrade_proto_rawDescGZIP(), []int{0}
+}
+
+var File_rpcpb_upgrade_proto protoreflect.FileDescriptor
+
+var file_rpcpb_upgrade_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2a, 0xa0, 0x01, 0x0a,
+	0x0a, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x45, 0x72, 0x61, 0x12, 0x1b, 0x0a, 0x17, 0x55,
+	0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x45, 0x52, 0x41, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x50, 0x47, 0x52,
+	0x41, 0x44, 0x45, 0x5f, 0x45, 0x52, 0x41, 0x5f, 0x42, 0x41, 0x4e, 0x46, 0x46, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x45, 0x52, 0x41, 0x5f, 0x43,
+	0x4f, 0x52, 0x54, 0x49, 0x4e, 0x41, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x50, 0x47, 0x52,
+	0x41, 0x44, 0x45, 0x5f, 0x45, 0x52, 0x41, 0x5f, 0x44, 0x55, 0x52, 0x41, 0x4e, 0x47, 0x4f, 0x10,
+	0x03, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x45, 0x52, 0x41,
+	0x5f, 0x45, 0x54, 0x4e, 0x41, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x50, 0x47, 0x52, 0x41,
+	0x44, 0x45, 0x5f, 0x45, 0x52, 0x41, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x10, 0x05, 0x42,
+	0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x76,
+	0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x2d, 0x72, 0x73, 0x2f, 0x61, 0x76, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x67, 0x6f, 0x2d,
+	0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x3b, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpcpb_upgrade_proto_rawDescOnce sync.Once
+	file_rpcpb_upgrade_proto_rawDescData = file_rpcpb_upgrade_proto_rawDesc
+)
+
+func file_rpcpb_upgrade_proto_rawDescGZIP() []byte {
+	file_rpcpb_upgrade_proto_rawDescOnce.Do(func() {
+		file_rpcpb_upgrade_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpcpb_upgrade_proto_rawDescData)
+	})
+	return file_rpcpb_upgrade_proto_rawDescData
+}
+
+var file_rpcpb_upgrade_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpcpb_upgrade_proto_goTypes = []interface{}{
+	(UpgradeEra)(0), // 0: rpcpb.UpgradeEra
+}
+var file_rpcpb_upgrade_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpcpb_upgrade_proto_init() }
+func file_rpcpb_upgrade_proto_init() {
+	if File_rpcpb_upgrade_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpcpb_upgrade_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpcpb_upgrade_proto_goTypes,
+		DependencyIndexes: file_rpcpb_upgrade_proto_depIdxs,
+		EnumInfos:         file_rpcpb_upgrade_proto_enumTypes,
+	}.Build()
+	File_rpcpb_upgrade_proto = out.File
+	file_rpcpb_upgrade_proto_rawDesc = nil
+	file_rpcpb_upgrade_proto_goTypes = nil
+	file_rpcpb_upgrade_proto_depIdxs = nil
+}
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/validators.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ValidatorsService_ValidatorSetAtHeight_FullMethodName = "/rpcpb.ValidatorsService/ValidatorSetAtHeight"
+)
+
+// ValidatorsServiceClient is the client API for ValidatorsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ValidatorsServiceClient interface {
+	ValidatorSetAtHeight(ctx context.Context, in *ValidatorSetAtHeightRequest, opts ...grpc.CallOption) (*ValidatorSetAtHeightResponse, error)
+}
+
+type validatorsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewValidatorsServiceClient(cc grpc.ClientConnInterface) ValidatorsServiceClient {
+	return &validatorsServiceClient{cc}
+}
+
+func (c *validatorsServiceClient) ValidatorSetAtHeight(ctx context.Context, in *ValidatorSetAtHeightRequest, opts ...grpc.CallOption) (*ValidatorSetAtHeightResponse, error) {
+	out := new(ValidatorSetAtHeightResponse)
+	err := c.cc.Invoke(ctx, ValidatorsService_ValidatorSetAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ValidatorsServiceServer is the server API for ValidatorsService service.
+// All implementations must embed UnimplementedValidatorsServiceServer
+// for forward compatibility
+type ValidatorsServiceServer interface {
+	ValidatorSetAtHeight(context.Context, *ValidatorSetAtHeightRequest) (*ValidatorSetAtHeightResponse, error)
+	mustEmbedUnimplementedValidatorsServiceServer()
+}
+
+// UnimplementedValidatorsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedValidatorsServiceServer struct {
+}
+
+func (UnimplementedValidatorsServiceServer) ValidatorSetAtHeight(context.Context, *ValidatorSetAtHeightRequest) (*ValidatorSetAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetAtHeight not implemented")
+}
+func (UnimplementedValidatorsServiceServer) mustEmbedUnimplementedValidatorsServiceServer() {}
+
+// UnsafeValidatorsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ValidatorsServiceServer will
+// result in compilation errors.
+type UnsafeValidatorsServiceServer interface {
+	mustEmbedUnimplementedValidatorsServiceServer()
+}
+
+func RegisterValidatorsServiceServer(s grpc.ServiceRegistrar, srv ValidatorsServiceServer) {
+	s.RegisterService(&ValidatorsService_ServiceDesc, srv)
+}
+
+func _ValidatorsService_ValidatorSetAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorSetAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorsServiceServer).ValidatorSetAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValidatorsService_ValidatorSetAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorsServiceServer).ValidatorSetAtHeight(ctx, req.(*ValidatorSetAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ValidatorsService_ServiceDesc is the grpc.ServiceDesc for ValidatorsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ValidatorsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.ValidatorsService",
+	HandlerType: (*ValidatorsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidatorSetAtHeight",
+			Handler:    _ValidatorsService_ValidatorSetAtHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/validators.proto",
+}
@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: rpcpb/warp.proto
+
+package rpcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	WarpService_WarpUnsignedMessage_FullMethodName      = "/rpcpb.WarpService/WarpUnsignedMessage"
+	WarpService_WarpSignedMessage_FullMethodName        = "/rpcpb.WarpService/WarpSignedMessage"
+	WarpService_WarpAddressedCallPayload_FullMethodName = "/rpcpb.WarpService/WarpAddressedCallPayload"
+	WarpService_WarpHashPayload_FullMethodName          = "/rpcpb.WarpService/WarpHashPayload"
+	WarpService_WarpVerifySignature_FullMethodName      = "/rpcpb.WarpService/WarpVerifySignature"
+)
+
+// WarpServiceClient is the client API for WarpService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WarpServiceClient interface {
+	WarpUnsignedMessage(ctx context.Context, in *WarpUnsignedMessageRequest, opts ...grpc.CallOption) (*WarpUnsignedMessageResponse, error)
+	// Packs an unsigned message with its "warp.BitSetSignature".
+	WarpSignedMessage(ctx context.Context, in *WarpSignedMessageRequest, opts ...grpc.CallOption) (*WarpSignedMessageResponse, error)
+	WarpAddressedCallPayload(ctx context.Context, in *WarpAddressedCallPayloadRequest, opts ...grpc.CallOption) (*WarpAddressedCallPayloadResponse, error)
+	WarpHashPayload(ctx context.Context, in *WarpHashPayloadRequest, opts ...grpc.CallOption) (*WarpHashPayloadResponse, error)
+	// Verifies an aggregate signature against a validator set, as
+	// "warp.BitSetSignature.Verify".
+	WarpVerifySignature(ctx context.Context, in *WarpVerifySignatureRequest, opts ...grpc.CallOption) (*WarpVerifySignatureResponse, error)
+}
+
+type warpServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWarpServiceClient(cc grpc.ClientConnInterface) WarpServiceClient {
+	return &warpServiceClient{cc}
+}
+
+func (c *warpServiceClient) WarpUnsignedMessage(ctx context.Context, in *WarpUnsignedMessageRequest, opts ...grpc.CallOption) (*WarpUnsignedMessageResponse, error) {
+	out := new(WarpUnsignedMessageResponse)
+	err := c.cc.Invoke(ctx, WarpService_WarpUnsignedMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warpServiceClient) WarpSignedMessage(ctx context.Context, in *WarpSignedMessageRequest, opts ...grpc.CallOption) (*WarpSignedMessageResponse, error) {
+	out := new(WarpSignedMessageResponse)
+	err := c.cc.Invoke(ctx, WarpService_WarpSignedMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warpServiceClient) WarpAddressedCallPayload(ctx context.Context, in *WarpAddressedCallPayloadRequest, opts ...grpc.CallOption) (*WarpAddressedCallPayloadResponse, error) {
+	out := new(WarpAddressedCallPayloadResponse)
+	err := c.cc.Invoke(ctx, WarpService_WarpAddressedCallPayload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warpServiceClient) WarpHashPayload(ctx context.Context, in *WarpHashPayloadRequest, opts ...grpc.CallOption) (*WarpHashPayloadResponse, error) {
+	out := new(WarpHashPayloadResponse)
+	err := c.cc.Invoke(ctx, WarpService_WarpHashPayload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warpServiceClient) WarpVerifySignature(ctx context.Context, in *WarpVerifySignatureRequest, opts ...grpc.CallOption) (*WarpVerifySignatureResponse, error) {
+	out := new(WarpVerifySignatureResponse)
+	err := c.cc.Invoke(ctx, WarpService_WarpVerifySignature_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WarpServiceServer is the server API for WarpService service.
+// All implementations must embed UnimplementedWarpServiceServer
+// for forward compatibility
+type WarpServiceServer interface {
+	WarpUnsignedMessage(context.Context, *WarpUnsignedMessageRequest) (*WarpUnsignedMessageResponse, error)
+	// Packs an unsigned message with its "warp.BitSetSignature".
+	WarpSignedMessage(context.Context, *WarpSignedMessageRequest) (*WarpSignedMessageResponse, error)
+	WarpAddressedCallPayload(context.Context, *WarpAddressedCallPayloadRequest) (*WarpAddressedCallPayloadResponse, error)
+	WarpHashPayload(context.Context, *WarpHashPayloadRequest) (*WarpHashPayloadResponse, error)
+	// Verifies an aggregate signature against a validator set, as
+	// "warp.BitSetSignature.Verify".
+	WarpVerifySignature(context.Context, *WarpVerifySignatureRequest) (*WarpVerifySignatureResponse, error)
+	mustEmbedUnimplementedWarpServiceServer()
+}
+
+// UnimplementedWarpServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWarpServiceServer struct {
+}
+
+func (UnimplementedWarpServiceServer) WarpUnsignedMessage(context.Context, *WarpUnsignedMessageRequest) (*WarpUnsignedMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarpUnsignedMessage not implemented")
+}
+func (UnimplementedWarpServiceServer) WarpSignedMessage(context.Context, *WarpSignedMessageRequest) (*WarpSignedMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarpSignedMessage not implemented")
+}
+func (UnimplementedWarpServiceServer) WarpAddressedCallPayload(context.Context, *WarpAddressedCallPayloadRequest) (*WarpAddressedCallPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarpAddressedCallPayload not implemented")
+}
+func (UnimplementedWarpServiceServer) WarpHashPayload(context.Context, *WarpHashPayloadRequest) (*WarpHashPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarpHashPayload not implemented")
+}
+func (UnimplementedWarpServiceServer) WarpVerifySignature(context.Context, *WarpVerifySignatureRequest) (*WarpVerifySignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarpVerifySignature not implemented")
+}
+func (UnimplementedWarpServiceServer) mustEmbedUnimplementedWarpServiceServer() {}
+
+// UnsafeWarpServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WarpServiceServer will
+// result in compilation errors.
+type UnsafeWarpServiceServer interface {
+	mustEmbedUnimplementedWarpServiceServer()
+}
+
+func RegisterWarpServiceServer(s grpc.ServiceRegistrar, srv WarpServiceServer) {
+	s.RegisterService(&WarpService_ServiceDesc, srv)
+}
+
+func _WarpService_WarpUnsignedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpUnsignedMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarpServiceServer).WarpUnsignedMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarpService_WarpUnsignedMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarpServiceServer).WarpUnsignedMessage(ctx, req.(*WarpUnsignedMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarpService_WarpSignedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpSignedMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarpServiceServer).WarpSignedMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarpService_WarpSignedMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarpServiceServer).WarpSignedMessage(ctx, req.(*WarpSignedMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarpService_WarpAddressedCallPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpAddressedCallPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarpServiceServer).WarpAddressedCallPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarpService_WarpAddressedCallPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarpServiceServer).WarpAddressedCallPayload(ctx, req.(*WarpAddressedCallPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarpService_WarpHashPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpHashPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarpServiceServer).WarpHashPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarpService_WarpHashPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarpServiceServer).WarpHashPayload(ctx, req.(*WarpHashPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarpService_WarpVerifySignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpVerifySignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarpServiceServer).WarpVerifySignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarpService_WarpVerifySignature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarpServiceServer).WarpVerifySignature(ctx, req.(*WarpVerifySignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WarpService_ServiceDesc is the grpc.ServiceDesc for WarpService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WarpService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.WarpService",
+	HandlerType: (*WarpServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WarpUnsignedMessage",
+			Handler:    _WarpService_WarpUnsignedMessage_Handler,
+		},
+		{
+			MethodName: "WarpSignedMessage",
+			Handler:    _WarpService_WarpSignedMessage_Handler,
+		},
+		{
+			MethodName: "WarpAddressedCallPayload",
+			Handler:    _WarpService_WarpAddressedCallPayload_Handler,
+		},
+		{
+			MethodName: "WarpHashPayload",
+			Handler:    _WarpService_WarpHashPayload_Handler,
+		},
+		{
+			MethodName: "WarpVerifySignature",
+			Handler:    _WarpService_WarpVerifySignature_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpcpb/warp.proto",
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"go.uber.org/zap"
+)
+
+// ref. "address.errBits5To8"
+var errAddressBits5To8 = errors.New("unable to convert address from 5-bit to 8-bit formatting")
+
+func (s *server) FormatAddress(ctx context.Context, req *rpcpb.FormatAddressRequest) (*rpcpb.FormatAddressResponse, error) {
+	logger(ctx).Debug("received FormatAddress request", zap.String("chain-alias", req.ChainAlias), zap.String("hrp", req.Hrp))
+
+	hrp := req.Hrp
+	if hrp == "" {
+		hrp = constants.GetHRP(req.NetworkId)
+	}
+	formatted, err := address.Format(req.ChainAlias, hrp, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.FormatAddressResponse{
+		ExpectedFormattedAddress: formatted,
+		Success:                  true,
+	}
+	if formatted != req.FormattedAddress {
+		resp.Message = fmt.Sprintf("expected formatted address %q, got %q", formatted, req.FormattedAddress)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) ParseAddress(ctx context.Context, req *rpcpb.ParseAddressRequest) (*rpcpb.ParseAddressResponse, error) {
+	logger(ctx).Debug("received ParseAddress request", zap.String("address", req.FormattedAddress), zap.Bool("local", req.Local))
+
+	resp := &rpcpb.ParseAddressResponse{
+		Success: true,
+	}
+	chainAlias, hrp, addr, class, err := parseAddress(req)
+	if err != nil {
+		resp.ExpectedErrorClass = class
+		resp.ExpectedError = err.Error()
+	} else {
+		resp.ExpectedChainAlias = chainAlias
+		resp.ExpectedHrp = hrp
+		resp.ExpectedAddress = addr
+	}
+
+	var mismatches []error
+	if resp.ExpectedErrorClass != req.ErrorClass {
+		mismatches = append(mismatches, fmt.Errorf("expected error class %s, got %s", resp.ExpectedErrorClass, req.ErrorClass))
+	} else if err == nil {
+		if chainAlias != req.ParsedChainAlias {
+			mismatches = append(mismatches, fmt.Errorf("expected chain alias %q, got %q", chainAlias, req.ParsedChainAlias))
+		}
+		if hrp != req.ParsedHrp {
+			mismatches = append(mismatches, fmt.Errorf("expected hrp %q, got %q", hrp, req.ParsedHrp))
+		}
+		if !bytes.Equal(addr, req.ParsedAddress) {
+			mismatches = append(mismatches, fmt.Errorf("expected address 0x%x, got 0x%x", addr, req.ParsedAddress))
+		}
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages("", mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// parseAddress returns the parts of the address, or the error avalanchego
+// rejects it with. Local addresses are checked in the order of the address
+// manager, with the chain alias standing in for the chain ID lookup.
+// ref. "avax.addressManager.ParseAddress"
+func parseAddress(req *rpcpb.ParseAddressRequest) (string, string, []byte, rpcpb.AddressErrorClass, error) {
+	chainAlias, hrp, addr, err := address.Parse(req.FormattedAddress)
+	if err != nil {
+		return "", "", nil, addressErrorClass(req.FormattedAddress, err), err
+	}
+	if !req.Local {
+		return chainAlias, hrp, addr, rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_UNSPECIFIED, nil
+	}
+
+	if chainAlias != req.ChainAlias {
+		return "", "", nil, rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_CHAIN_ALIAS_MISMATCH,
+			fmt.Errorf("expected chain alias to be %q but was %q", req.ChainAlias, chainAlias)
+	}
+	if expectedHRP := constants.GetHRP(req.NetworkId); hrp != expectedHRP {
+		return "", "", nil, rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_HRP_MISMATCH,
+			fmt.Errorf("expected hrp %q but got %q", expectedHRP, hrp)
+	}
+	if _, err := ids.ToShortID(addr); err != nil {
+		return "", "", nil, rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_ADDRESS_LENGTH, err
+	}
+	return chainAlias, hrp, addr, rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_UNSPECIFIED, nil
+}
+
+// addressErrorClass classifies the error of "address.Parse".
+func addressErrorClass(addrStr string, err error) rpcpb.AddressErrorClass {
+	var (
+		invalidLength         bech32.ErrInvalidLength
+		invalidCharacter      bech32.ErrInvalidCharacter
+		invalidSeparatorIndex bech32.ErrInvalidSeparatorIndex
+		nonCharsetChar        bech32.ErrNonCharsetChar
+		invalidChecksum       bech32.ErrInvalidChecksum
+	)
+	switch {
+	case !strings.Contains(addrStr, "-"):
+		return rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_NO_SEPARATOR
+	case errors.Is(err, bech32.ErrMixedCase{}):
+		return rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_MIXED_CASE
+	case errors.As(err, &invalidLength):
+		return rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_LENGTH
+	case errors.As(err, &invalidCharacter):
+		return rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_CHARACTER
+	case errors.As(err, &invalidSeparatorIndex):
+		return rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_SEPARATOR_INDEX
+	case errors.As(err, &nonCharsetChar):
+		return rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_NON_CHARSET_CHAR
+	case errors.As(err, &invalidChecksum):
+		return rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_CHECKSUM
+	case err.Error() == errAddressBits5To8.Error():
+		return rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_INVALID_BITS
+	default:
+		return rpcpb.AddressErrorClass_ADDRESS_ERROR_CLASS_OTHER
+	}
+}
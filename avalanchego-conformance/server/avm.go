@@ -0,0 +1,309 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	avmfxs "github.com/ava-labs/avalanchego/vms/avm/fxs"
+	avmtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/nftfx"
+	"github.com/ava-labs/avalanchego/vms/propertyfx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// newAVMParser returns the parser of X-chain txs, whose codec type IDs
+// depend on the registered fxs.
+// ref. "avm.StaticService.BuildGenesis"
+func newAVMParser() (avmtxs.Parser, error) {
+	return avmtxs.NewParser([]avmfxs.Fx{
+		&secp256k1fx.Fx{},
+		&nftfx.Fx{},
+		&propertyfx.Fx{},
+	})
+}
+
+func (s *server) AvmBaseTx(ctx context.Context, req *rpcpb.AvmBaseTxRequest) (*rpcpb.AvmBaseTxResponse, error) {
+	baseTx, err := s.avmBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+
+	utx := &baseTx
+	expected, txID, err := s.initializeAVMTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.AvmBaseTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) AvmCreateAssetTx(ctx context.Context, req *rpcpb.AvmCreateAssetTxRequest) (*rpcpb.AvmCreateAssetTxResponse, error) {
+	baseTx, err := s.avmBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	if req.Denomination > 255 {
+		return nil, outOfRange("denomination", fmt.Errorf("%d overflows a byte", req.Denomination))
+	}
+	states := make([]*avmtxs.InitialState, 0, len(req.InitialStates))
+	for i, state := range req.InitialStates {
+		outs := make([]verify.State, 0, len(state.Outputs))
+		for j, out := range state.Outputs {
+			o, err := s.secpOutput(out)
+			if err != nil {
+				return nil, invalidField(fmt.Sprintf("initial_states[%d].outputs[%d]", i, j), err)
+			}
+			outs = append(outs, o)
+		}
+		states = append(states, &avmtxs.InitialState{
+			FxIndex: state.FxIndex,
+			Outs:    outs,
+		})
+	}
+
+	utx := &avmtxs.CreateAssetTx{
+		BaseTx:       baseTx,
+		Name:         req.Name,
+		Symbol:       req.Symbol,
+		Denomination: byte(req.Denomination),
+		States:       states,
+	}
+	expected, txID, err := s.initializeAVMTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.AvmCreateAssetTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) AvmOperationTx(ctx context.Context, req *rpcpb.AvmOperationTxRequest) (*rpcpb.AvmOperationTxResponse, error) {
+	baseTx, err := s.avmBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	ops := make([]*avmtxs.Operation, 0, len(req.Operations))
+	for i, op := range req.Operations {
+		converted, err := s.avmOperation(op)
+		if err != nil {
+			return nil, invalidField(fmt.Sprintf("operations[%d]", i), err)
+		}
+		ops = append(ops, converted)
+	}
+
+	utx := &avmtxs.OperationTx{
+		BaseTx: baseTx,
+		Ops:    ops,
+	}
+	expected, txID, err := s.initializeAVMTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.AvmOperationTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) AvmImportTx(ctx context.Context, req *rpcpb.AvmImportTxRequest) (*rpcpb.AvmImportTxResponse, error) {
+	baseTx, err := s.avmBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	sourceChain, err := toID("source_chain", req.SourceChain)
+	if err != nil {
+		return nil, err
+	}
+	importedIns, err := transferableInputs(req.ImportedInputs)
+	if err != nil {
+		return nil, invalidField("imported_inputs", err)
+	}
+
+	utx := &avmtxs.ImportTx{
+		BaseTx:      baseTx,
+		SourceChain: sourceChain,
+		ImportedIns: importedIns,
+	}
+	expected, txID, err := s.initializeAVMTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.AvmImportTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) AvmExportTx(ctx context.Context, req *rpcpb.AvmExportTxRequest) (*rpcpb.AvmExportTxResponse, error) {
+	baseTx, err := s.avmBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	destinationChain, err := toID("destination_chain", req.DestinationChain)
+	if err != nil {
+		return nil, err
+	}
+	exportedOuts, err := s.transferableOutputs(req.ExportedOutputs)
+	if err != nil {
+		return nil, invalidField("exported_outputs", err)
+	}
+
+	utx := &avmtxs.ExportTx{
+		BaseTx:           baseTx,
+		DestinationChain: destinationChain,
+		ExportedOuts:     exportedOuts,
+	}
+	expected, txID, err := s.initializeAVMTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.AvmExportTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+// initializeAVMTx returns the unsigned bytes of the tx, and the ID of the
+// tx without credentials.
+// ref. "txs.Tx.Initialize"
+func (s *server) initializeAVMTx(utx avmtxs.UnsignedTx) ([]byte, ids.ID, error) {
+	tx := &avmtxs.Tx{Unsigned: utx}
+	if err := s.avmParser.InitializeTx(tx); err != nil {
+		return nil, ids.Empty, err
+	}
+	return utx.Bytes(), tx.ID(), nil
+}
+
+func (s *server) avmBaseTx(tx *rpcpb.BaseTx) (avmtxs.BaseTx, error) {
+	baseTx, err := s.platformBaseTx(tx)
+	if err != nil {
+		return avmtxs.BaseTx{}, err
+	}
+	return avmtxs.BaseTx{BaseTx: baseTx.BaseTx}, nil
+}
+
+func (s *server) secpOutput(out *rpcpb.SecpOutput) (verify.State, error) {
+	switch o := out.GetOutput().(type) {
+	case *rpcpb.SecpOutput_Transfer:
+		out, err := s.secpTransferOutput(o.Transfer)
+		if err != nil {
+			return nil, invalidField("transfer", err)
+		}
+		return out, nil
+	case *rpcpb.SecpOutput_Mint:
+		owners, err := s.outputOwners(o.Mint)
+		if err != nil {
+			return nil, invalidField("mint", err)
+		}
+		return &secp256k1fx.MintOutput{OutputOwners: *owners}, nil
+	default:
+		return nil, invalidField("output", errMissingField)
+	}
+}
+
+func (s *server) secpTransferOutput(out *rpcpb.SecpTransferOutput) (*secp256k1fx.TransferOutput, error) {
+	if out == nil {
+		return nil, errMissingField
+	}
+	owners, err := s.outputOwners(out.Owners)
+	if err != nil {
+		return nil, invalidField("owners", err)
+	}
+	return &secp256k1fx.TransferOutput{
+		Amt:          out.Amount,
+		OutputOwners: *owners,
+	}, nil
+}
+
+func (s *server) avmOperation(op *rpcpb.AvmOperation) (*avmtxs.Operation, error) {
+	assetID, err := toID("asset_id", op.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	utxoIDs := make([]*avax.UTXOID, 0, len(op.UtxoIds))
+	for i, utxoID := range op.UtxoIds {
+		txID, err := toID(fmt.Sprintf("utxo_ids[%d].tx_id", i), utxoID.TxId)
+		if err != nil {
+			return nil, err
+		}
+		utxoIDs = append(utxoIDs, &avax.UTXOID{
+			TxID:        txID,
+			OutputIndex: utxoID.OutputIndex,
+		})
+	}
+	if op.Mint == nil {
+		return nil, invalidField("mint", errMissingField)
+	}
+	mintOwners, err := s.outputOwners(op.Mint.MintOutput)
+	if err != nil {
+		return nil, invalidField("mint.mint_output", err)
+	}
+	transferOut, err := s.secpTransferOutput(op.Mint.TransferOutput)
+	if err != nil {
+		return nil, invalidField("mint.transfer_output", err)
+	}
+	return &avmtxs.Operation{
+		Asset:   avax.Asset{ID: assetID},
+		UTXOIDs: utxoIDs,
+		Op: &secp256k1fx.MintOperation{
+			MintInput:      secp256k1fx.Input{SigIndices: op.Mint.MintInputSigIndices},
+			MintOutput:     secp256k1fx.MintOutput{OutputOwners: *mintOwners},
+			TransferOutput: *transferOut,
+		},
+	}, nil
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultBenchCount = 1000
+	maxBenchCount     = 100000
+	// maxBenchBytes bounds the payloads of a run, count times payload size.
+	maxBenchBytes = 1 << 30
+)
+
+var errUnsupportedBenchOp = errors.New("unsupported bench op")
+
+// benchBuilders build a message of each benchmarked op, with the payload as
+// its app bytes or container.
+var benchBuilders = map[string]func(mc message.OutboundMsgBuilder, chainID ids.ID, requestID uint32, payload []byte) (message.OutboundMessage, error){
+	message.AppGossipOp.String(): func(mc message.OutboundMsgBuilder, chainID ids.ID, _ uint32, payload []byte) (message.OutboundMessage, error) {
+		return mc.AppGossip(chainID, payload)
+	},
+	message.AppRequestOp.String(): func(mc message.OutboundMsgBuilder, chainID ids.ID, requestID uint32, payload []byte) (message.OutboundMessage, error) {
+		return mc.AppRequest(chainID, requestID, DefaultMaxMessageTimeout, payload)
+	},
+	message.AppResponseOp.String(): func(mc message.OutboundMsgBuilder, chainID ids.ID, requestID uint32, payload []byte) (message.OutboundMessage, error) {
+		return mc.AppResponse(chainID, requestID, payload)
+	},
+	message.PutOp.String(): func(mc message.OutboundMsgBuilder, chainID ids.ID, requestID uint32, payload []byte) (message.OutboundMessage, error) {
+		return mc.Put(chainID, requestID, payload, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	},
+	message.PushQueryOp.String(): func(mc message.OutboundMsgBuilder, chainID ids.ID, requestID uint32, payload []byte) (message.OutboundMessage, error) {
+		return mc.PushQuery(chainID, requestID, DefaultMaxMessageTimeout, payload, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	},
+	message.AncestorsOp.String(): func(mc message.OutboundMsgBuilder, chainID ids.ID, requestID uint32, payload []byte) (message.OutboundMessage, error) {
+		return mc.Ancestors(chainID, requestID, [][]byte{payload})
+	},
+}
+
+func (s *server) Bench(ctx context.Context, req *rpcpb.BenchRequest) (*rpcpb.BenchResponse, error) {
+	logger(ctx).Info("received Bench request",
+		zap.String("op", req.Op),
+		zap.Uint32("payload-size", req.PayloadSize),
+		zap.Uint32("count", req.Count),
+	)
+
+	build, ok := benchBuilders[req.Op]
+	if !ok {
+		return nil, invalidField("op", fmt.Errorf("%w %q", errUnsupportedBenchOp, req.Op))
+	}
+	count := int(req.Count)
+	if count == 0 {
+		count = defaultBenchCount
+	}
+	if count > maxBenchCount {
+		return nil, invalidField("count", fmt.Errorf("%d > %d", count, maxBenchCount))
+	}
+	if req.PayloadSize > constants.DefaultMaxMessageSize {
+		return nil, invalidField("payload_size", fmt.Errorf("%d > %d", req.PayloadSize, constants.DefaultMaxMessageSize))
+	}
+	if total := uint64(count) * uint64(req.PayloadSize); total > maxBenchBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "count times payload_size %d > %d", total, maxBenchBytes)
+	}
+	compressType, _, err := requestCompressionType(false, req.CompressionType)
+	if err != nil {
+		return nil, invalidField("compression_type", err)
+	}
+	mc, err := s.msgCreator(compressType, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// The same random payload for every message, built once so that
+	// generating it is not timed.
+	payload := utils.RandomBytes(int(req.PayloadSize))
+	chainID := ids.GenerateTestID()
+
+	var (
+		serializeDurations = make([]time.Duration, count)
+		parseDurations     = make([]time.Duration, count)
+		serializedSize     int
+	)
+	for i := 0; i < count; i++ {
+		// checked periodically rather than per message, to keep it out of
+		// the timings
+		if i%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, status.FromContextError(err).Err()
+			}
+		}
+
+		start := time.Now()
+		msg, err := build(mc, chainID, uint32(i), payload)
+		serializeDurations[i] = time.Since(start)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build %s message %d: %w", req.Op, i, err)
+		}
+		msgBytes := msg.Bytes()
+		serializedSize = len(msgBytes)
+
+		start = time.Now()
+		_, err = mc.Parse(msgBytes, ids.EmptyNodeID, func() {})
+		parseDurations[i] = time.Since(start)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s message %d: %w", req.Op, i, err)
+		}
+	}
+
+	return &rpcpb.BenchResponse{
+		Op:             req.Op,
+		Count:          uint32(count),
+		SerializedSize: uint32(serializedSize),
+		Serialize:      benchStats(serializeDurations, serializedSize),
+		Parse:          benchStats(parseDurations, serializedSize),
+	}, nil
+}
+
+// benchStats summarizes the durations of an operation on each message, of
+// the serialized size.
+func benchStats(durations []time.Duration, serializedSize int) *rpcpb.BenchStats {
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	stats := &rpcpb.BenchStats{
+		TotalNs: uint64(total),
+		MinNs:   uint64(sorted[0]),
+		MaxNs:   uint64(sorted[len(sorted)-1]),
+		MeanNs:  float64(total) / float64(len(sorted)),
+		P50Ns:   uint64(percentile(sorted, 50)),
+		P90Ns:   uint64(percentile(sorted, 90)),
+		P99Ns:   uint64(percentile(sorted, 99)),
+	}
+	if secs := total.Seconds(); secs > 0 {
+		stats.MsgsPerSec = float64(len(sorted)) / secs
+		stats.BytesPerSec = float64(len(sorted)*serializedSize) / secs
+	}
+	return stats
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/version"
+	avmtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	ptxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+func (s *server) Capabilities(ctx context.Context, req *rpcpb.CapabilitiesRequest) (*rpcpb.CapabilitiesResponse, error) {
+	resp := &rpcpb.CapabilitiesResponse{
+		AvalanchegoVersion: version.Current.String(),
+		P2PVersion:         version.CurrentApp.String(),
+		RpcchainvmProtocol: uint32(version.RPCChainVMProtocol),
+		CompressionTypes:   compressionTypes(),
+		MaxRecvMsgSize:     uint64(s.cfg.maxRecvMsgSize()),
+		MaxSendMsgSize:     uint64(s.cfg.maxSendMsgSize()),
+		StrictInput:        !s.cfg.LenientInput,
+		CodecVersions: []*rpcpb.CodecVersion{
+			{Codec: "platformvm", Version: ptxs.Version},
+			{Codec: "avm", Version: avmtxs.CodecVersion},
+			{Codec: "coreth", Version: corethCodecVersion},
+			{Codec: "warp", Version: warpCodecVersion},
+		},
+	}
+	for _, desc := range s.services {
+		resp.Services = append(resp.Services, serviceName(desc.ServiceName))
+		if desc == &rpcpb.MessageService_ServiceDesc {
+			resp.MessageKinds = messageKinds()
+		}
+	}
+	return resp, nil
+}
+
+// compressionTypes returns the compression types accepted by message
+// checks.
+func compressionTypes() []rpcpb.CompressionType {
+	nums := make([]int, 0, len(rpcpb.CompressionType_name))
+	for num := range rpcpb.CompressionType_name {
+		nums = append(nums, int(num))
+	}
+	sort.Ints(nums)
+
+	var types []rpcpb.CompressionType
+	for _, num := range nums {
+		t := rpcpb.CompressionType(num)
+		if t == rpcpb.CompressionType_COMPRESSION_TYPE_UNSPECIFIED {
+			continue
+		}
+		if _, _, err := requestCompressionType(false, t); err == nil {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+// messageKinds returns the names of the p2p messages MessageService has a
+// method for (e.g., "get_accepted_frontier" for "GetAcceptedFrontier").
+func messageKinds() []string {
+	methods := make(map[string]bool, len(rpcpb.MessageService_ServiceDesc.Methods))
+	for _, m := range rpcpb.MessageService_ServiceDesc.Methods {
+		methods[m.MethodName] = true
+	}
+
+	var kinds []string
+	for _, op := range message.ExternalOps {
+		name := op.String()
+		words := strings.Split(name, "_")
+		for i, w := range words {
+			if w != "" {
+				words[i] = strings.ToUpper(w[:1]) + w[1:]
+			}
+		}
+		if methods[strings.Join(words, "")] {
+			kinds = append(kinds, name)
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
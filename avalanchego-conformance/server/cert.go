@@ -0,0 +1,266 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"go.uber.org/zap"
+)
+
+const stakingHandshakeTimeout = 10 * time.Second
+
+var (
+	errUnsupportedCertKey        = errors.New("unsupported certificate key")
+	errUnsupportedCertSignature  = errors.New("unsupported certificate signature algorithm")
+	errInvalidStakingKey         = errors.New("invalid staking key")
+	errStakingHandshakeTimeout   = errors.New("handshake timed out")
+	errNoPeerCert                = errors.New("tls handshake finished with no peer certificate")
+	errUnverifiableSignedIP      = errors.New("signed IP does not verify against the certificate")
+	errStakingCertNodeIDMismatch = errors.New("node ID mismatch")
+
+	// certificate of the avalanchego end of the handshakes, generated once
+	handshakeCertOnce sync.Once
+	handshakeCert     *tls.Certificate
+	handshakeCertErr  error
+)
+
+func (s *server) StakingCertificate(ctx context.Context, req *rpcpb.StakingCertificateRequest) (*rpcpb.StakingCertificateResponse, error) {
+	logger(ctx).Debug("received StakingCertificate request", zap.Int("cert-size", len(req.Certificate)), zap.Int("key-size", len(req.PrivateKey)))
+
+	resp := &rpcpb.StakingCertificateResponse{
+		Success: true,
+	}
+	certDER := pemOrDER(req.Certificate)
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		// also rejects duplicate and malformed extensions
+		resp.RejectionReasons = []string{fmt.Sprintf("failed parsing cert: %v", err)}
+		resp.Message = resp.RejectionReasons[0]
+		resp.Success = false
+		return resp, nil
+	}
+	nodeID := ids.NodeIDFromCert(cert)
+	resp.ExpectedNodeId = nodeID[:]
+	resp.ExpectedNodeIdString = nodeID.String()
+
+	reasons := stakingCertRejections(cert)
+	if len(req.PrivateKey) > 0 {
+		now, err := s.nowUnix(ctx)
+		if err != nil {
+			return nil, err
+		}
+		reasons = append(reasons, stakingKeyRejections(cert, certDER, req.PrivateKey, now, len(reasons) > 0)...)
+	}
+	for _, err := range reasons {
+		resp.RejectionReasons = append(resp.RejectionReasons, err.Error())
+	}
+
+	if !bytes.Equal(nodeID[:], req.NodeId) {
+		reasons = append(reasons, fmt.Errorf("%w: expected %s", errStakingCertNodeIDMismatch, nodeID))
+	}
+	if len(reasons) > 0 {
+		resp.Message = joinMessages("", reasons)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// stakingCertRejections returns the reasons a peer would refuse the
+// certificate regardless of its key. Peers skip the CA verification, so
+// unhandled critical extensions and validity periods are not checked.
+// ref. "peer.TLSConfig"
+func stakingCertRejections(cert *x509.Certificate) []error {
+	var errs []error
+
+	// ref. "tls.Conn.verifyClientCertificate"
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey, ed25519.PublicKey:
+	case *ecdsa.PublicKey:
+		// TLS 1.3 only defines signature schemes of these curves
+		if pub.Curve != elliptic.P256() && pub.Curve != elliptic.P384() && pub.Curve != elliptic.P521() {
+			errs = append(errs, fmt.Errorf("%w: curve %s", errUnsupportedCertKey, pub.Curve.Params().Name))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%w: %s", errUnsupportedCertKey, cert.PublicKeyAlgorithm))
+	}
+
+	// Peers sign the SHA-256 digest of their IPs with PKCS #1 v1.5 or ECDSA,
+	// and verify them with the signature algorithm of the certificate.
+	// ref. "peer.UnsignedIP.Sign", "peer.SignedIP.Verify"
+	switch cert.SignatureAlgorithm {
+	case x509.SHA256WithRSA:
+		if _, ok := cert.PublicKey.(*rsa.PublicKey); !ok {
+			errs = append(errs, fmt.Errorf("%w: %s with a %s key", errUnsupportedCertSignature, cert.SignatureAlgorithm, cert.PublicKeyAlgorithm))
+		}
+	case x509.ECDSAWithSHA256:
+		if _, ok := cert.PublicKey.(*ecdsa.PublicKey); !ok {
+			errs = append(errs, fmt.Errorf("%w: %s with a %s key", errUnsupportedCertSignature, cert.SignatureAlgorithm, cert.PublicKeyAlgorithm))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%w: %s", errUnsupportedCertSignature, cert.SignatureAlgorithm))
+	}
+	return errs
+}
+
+// stakingKeyRejections returns the reasons a peer would refuse the
+// certificate when used with the key: at handshake, or when verifying the
+// IPs signed with the key at now. The signed IP is not checked when the
+// certificate is known to be refused.
+func stakingKeyRejections(cert *x509.Certificate, certDER []byte, keyBytes []byte, now uint64, refused bool) []error {
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := keyBytes
+	if block, _ := pem.Decode(keyBytes); block == nil {
+		keyPEM = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes})
+	}
+	tlsCert, err := staking.LoadTLSCertFromBytes(keyPEM, certPEM)
+	if err != nil {
+		return []error{fmt.Errorf("%w: %v", errInvalidStakingKey, err)}
+	}
+
+	var errs []error
+	if err := stakingHandshake(*tlsCert); err != nil {
+		errs = append(errs, err)
+	}
+	if refused {
+		return errs
+	}
+
+	signer, ok := tlsCert.PrivateKey.(crypto.Signer)
+	if !ok {
+		return append(errs, fmt.Errorf("%w: %T is not a signer", errInvalidStakingKey, tlsCert.PrivateKey))
+	}
+	ip := peer.UnsignedIP{
+		IPPort: ips.IPPort{
+			IP:   net.IPv4(127, 0, 0, 1),
+			Port: 9651,
+		},
+		Timestamp: now,
+	}
+	signedIP, err := ip.Sign(signer)
+	if err != nil {
+		return append(errs, fmt.Errorf("%w: %v", errUnverifiableSignedIP, err))
+	}
+	if err := signedIP.Verify(cert); err != nil {
+		errs = append(errs, fmt.Errorf("%w: %v", errUnverifiableSignedIP, err))
+	}
+	return errs
+}
+
+// stakingHandshake performs the TLS handshakes of avalanchego peers, with
+// the certificate at either end of the connection.
+func stakingHandshake(cert tls.Certificate) error {
+	handshakeCertOnce.Do(func() {
+		handshakeCert, handshakeCertErr = staking.NewTLSCert()
+	})
+	if handshakeCertErr != nil {
+		return handshakeCertErr
+	}
+
+	for _, inbound := range []bool{true, false} {
+		role := "outbound"
+		if inbound {
+			role = "inbound"
+		}
+		if err := handshake(peer.TLSConfig(*handshakeCert, nil), peer.TLSConfig(cert, nil), inbound); err != nil {
+			return fmt.Errorf("%s handshake failed: %w", role, err)
+		}
+	}
+	return nil
+}
+
+// handshake connects the two ends over an in-memory connection, and returns
+// the error of the local end, or else of the remote end.
+// ref. "peer.connToIDAndCert"
+func handshake(local *tls.Config, remote *tls.Config, inbound bool) error {
+	localConn, remoteConn := net.Pipe()
+	defer localConn.Close()
+	defer remoteConn.Close()
+
+	deadline := time.Now().Add(stakingHandshakeTimeout)
+	_ = localConn.SetDeadline(deadline)
+	_ = remoteConn.SetDeadline(deadline)
+
+	localTLS, remoteTLS := tls.Client(localConn, local), tls.Server(remoteConn, remote)
+	if inbound {
+		localTLS, remoteTLS = tls.Server(localConn, local), tls.Client(remoteConn, remote)
+	}
+
+	remoteErrs := make(chan error, 1)
+	go func() {
+		err := remoteTLS.Handshake()
+		if err != nil {
+			_ = remoteConn.Close()
+		}
+		remoteErrs <- err
+	}()
+	localErr := localTLS.Handshake()
+	if localErr != nil {
+		_ = localConn.Close()
+	}
+	remoteErr := <-remoteErrs
+	if localErr == nil && len(localTLS.ConnectionState().PeerCertificates) == 0 {
+		localErr = errNoPeerCert
+	}
+
+	var netErr net.Error
+	switch {
+	case errors.As(localErr, &netErr) && netErr.Timeout():
+		return errStakingHandshakeTimeout
+	case localErr != nil:
+		return localErr
+	default:
+		return remoteErr
+	}
+}
+
+// unsignedIPBytes returns the bytes signed by a validator claiming the IP.
+// ref. "peer.UnsignedIP.bytes"
+func unsignedIPBytes(ip *peer.UnsignedIP) []byte {
+	p := wrappers.Packer{
+		Bytes: make([]byte, wrappers.IPLen+wrappers.LongLen),
+	}
+	ips.PackIP(&p, ip.IPPort)
+	p.PackLong(ip.Timestamp)
+	return p.Bytes
+}
+
+// unsignedIPFields annotates the bytes of an unsigned IP.
+func unsignedIPFields([]byte) []diff.Field {
+	return []diff.Field{
+		{Name: "ip_addr", Start: 0, End: net.IPv6len},
+		{Name: "ip_port", Start: net.IPv6len, End: wrappers.IPLen},
+		{Name: "timestamp", Start: wrappers.IPLen, End: wrappers.IPLen + wrappers.LongLen},
+	}
+}
+
+// pemOrDER returns the DER bytes of the first PEM block, or the bytes
+// themselves if not PEM encoded.
+func pemOrDER(b []byte) []byte {
+	if block, _ := pem.Decode(b); block != nil {
+		return block.Bytes
+	}
+	return b
+}
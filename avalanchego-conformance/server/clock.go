@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// NowMetadataKey is the gRPC metadata key a client sets to override
+// "now" (in unix seconds) for a single request, so that timestamp-sensitive
+// checks are deterministic regardless of the server clock.
+const NowMetadataKey = "conformance-now-unix"
+
+// now returns the time to be used as "now" by timestamp-sensitive checks
+// (e.g., IP signatures, staking certificate validity, proposer windows).
+// The per-request override takes precedence over the server clock, which
+// may itself be fixed via Config.FakeTime.
+func (s *server) now(ctx context.Context) (time.Time, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if vs := md.Get(NowMetadataKey); len(vs) > 0 {
+			unix, err := strconv.ParseInt(vs[0], 10, 64)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("invalid %q metadata %q (%w)", NowMetadataKey, vs[0], err)
+			}
+			return time.Unix(unix, 0), nil
+		}
+	}
+	return s.clock.Time(), nil
+}
+
+// nowUnix returns now in unix seconds, failing with an INVALID_ARGUMENT
+// error if the override is malformed.
+func (s *server) nowUnix(ctx context.Context) (uint64, error) {
+	t, err := s.now(ctx)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return uint64(t.Unix()), nil
+}
+
+// unixClock returns the clock of a session outliving the request (e.g., a
+// peer session): fixed at the override if set, the server clock otherwise.
+func (s *server) unixClock(ctx context.Context) (func() uint64, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); !ok || len(md.Get(NowMetadataKey)) == 0 {
+		return s.clock.Unix, nil
+	}
+	now, err := s.nowUnix(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func() uint64 { return now }, nil
+}
@@ -0,0 +1,273 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+)
+
+var (
+	errInvalidCodecType  = errors.New("invalid codec type")
+	errInvalidCodecValue = errors.New("invalid codec value")
+
+	interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+func (s *server) Pack(ctx context.Context, req *rpcpb.PackRequest) (*rpcpb.PackResponse, error) {
+	if req.CodecVersion > math.MaxUint16 {
+		return nil, fmt.Errorf("codec version %d overflows uint16", req.CodecVersion)
+	}
+
+	c := linearcodec.NewDefault()
+	registered := make(map[uint32]codecRegisteredType, len(req.RegisteredTypes))
+	typeID := uint32(0)
+	for i, rt := range req.RegisteredTypes {
+		c.SkipRegistrations(int(rt.Skip))
+		typeID += rt.Skip
+
+		// registered types must be distinct even when their fields are not
+		typ, err := codecStructOf(rt.StructType, fmt.Sprintf("Registered%d", i))
+		if err != nil {
+			return nil, invalidField(fmt.Sprintf("registered_types[%d]", i), err)
+		}
+		if err := c.RegisterType(reflect.New(typ).Interface()); err != nil {
+			return nil, err
+		}
+		registered[typeID] = codecRegisteredType{
+			desc: rt.StructType,
+			typ:  typ,
+		}
+		typeID++
+	}
+	m := codec.NewDefaultManager()
+	if err := m.RegisterCodec(uint16(req.CodecVersion), c); err != nil {
+		return nil, err
+	}
+
+	typ, err := codecGoType(req.Type)
+	if err != nil {
+		return nil, fmt.Errorf("type: %w", err)
+	}
+	v, err := codecGoValue(req.Type, req.Value, registered, "value")
+	if err != nil {
+		return nil, err
+	}
+	// interfaces are packed with their type ID only behind a pointer
+	ptr := reflect.New(typ)
+	ptr.Elem().Set(v)
+	expected, err := m.Marshal(uint16(req.CodecVersion), ptr.Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.PackResponse{
+		ExpectedPackedBytes: expected,
+		Success:             true,
+	}
+	if d := newDiff(expected, req.PackedBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+type codecRegisteredType struct {
+	desc *rpcpb.CodecStructType
+	typ  reflect.Type
+}
+
+var codecPrimitiveTypes = map[rpcpb.CodecPrimitive]reflect.Type{
+	rpcpb.CodecPrimitive_CODEC_PRIMITIVE_BOOL:   reflect.TypeOf(false),
+	rpcpb.CodecPrimitive_CODEC_PRIMITIVE_UINT8:  reflect.TypeOf(uint8(0)),
+	rpcpb.CodecPrimitive_CODEC_PRIMITIVE_UINT16: reflect.TypeOf(uint16(0)),
+	rpcpb.CodecPrimitive_CODEC_PRIMITIVE_UINT32: reflect.TypeOf(uint32(0)),
+	rpcpb.CodecPrimitive_CODEC_PRIMITIVE_UINT64: reflect.TypeOf(uint64(0)),
+	rpcpb.CodecPrimitive_CODEC_PRIMITIVE_INT8:   reflect.TypeOf(int8(0)),
+	rpcpb.CodecPrimitive_CODEC_PRIMITIVE_INT16:  reflect.TypeOf(int16(0)),
+	rpcpb.CodecPrimitive_CODEC_PRIMITIVE_INT32:  reflect.TypeOf(int32(0)),
+	rpcpb.CodecPrimitive_CODEC_PRIMITIVE_INT64:  reflect.TypeOf(int64(0)),
+	rpcpb.CodecPrimitive_CODEC_PRIMITIVE_STRING: reflect.TypeOf(""),
+	rpcpb.CodecPrimitive_CODEC_PRIMITIVE_BYTES:  reflect.TypeOf([]byte(nil)),
+}
+
+// codecGoType returns the Go type of the described type.
+func codecGoType(t *rpcpb.CodecType) (reflect.Type, error) {
+	switch k := t.GetKind().(type) {
+	case *rpcpb.CodecType_Primitive:
+		typ, ok := codecPrimitiveTypes[k.Primitive]
+		if !ok {
+			return nil, fmt.Errorf("%w: primitive %s", errInvalidCodecType, k.Primitive)
+		}
+		return typ, nil
+	case *rpcpb.CodecType_FixedBytesLen:
+		return reflect.ArrayOf(int(k.FixedBytesLen), reflect.TypeOf(byte(0))), nil
+	case *rpcpb.CodecType_SliceOf:
+		elem, err := codecGoType(k.SliceOf)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.SliceOf(elem), nil
+	case *rpcpb.CodecType_StructType:
+		return codecStructOf(k.StructType, "")
+	case *rpcpb.CodecType_Interface:
+		return interfaceType, nil
+	default:
+		return nil, fmt.Errorf("%w: missing kind", errInvalidCodecType)
+	}
+}
+
+// codecStructOf returns a struct type of the described fields, named
+// "F0", "F1", ... A non-empty marker names an extra unserialized field.
+func codecStructOf(st *rpcpb.CodecStructType, marker string) (reflect.Type, error) {
+	if st == nil {
+		return nil, fmt.Errorf("%w: missing struct type", errInvalidCodecType)
+	}
+	fields := make([]reflect.StructField, 0, len(st.Fields)+1)
+	for i, f := range st.Fields {
+		typ, err := codecGoType(f)
+		if err != nil {
+			return nil, invalidField(fmt.Sprintf("fields[%d]", i), err)
+		}
+		fields = append(fields, reflect.StructField{
+			Name: fmt.Sprintf("F%d", i),
+			Type: typ,
+			Tag:  `serialize:"true"`,
+		})
+	}
+	if marker != "" {
+		fields = append(fields, reflect.StructField{
+			Name: marker,
+			Type: reflect.TypeOf([0]byte{}),
+		})
+	}
+	return reflect.StructOf(fields), nil
+}
+
+// codecGoValue returns the Go value of the described type. The path names
+// the value in errors.
+func codecGoValue(t *rpcpb.CodecType, v *rpcpb.CodecValue, registered map[uint32]codecRegisteredType, path string) (reflect.Value, error) {
+	typ, err := codecGoType(t)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	rv := reflect.New(typ).Elem()
+
+	switch k := t.GetKind().(type) {
+	case *rpcpb.CodecType_Primitive:
+		switch typ.Kind() {
+		case reflect.Bool:
+			b, ok := v.GetValue().(*rpcpb.CodecValue_BoolValue)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("%w: %s: expected bool_value", errInvalidCodecValue, path)
+			}
+			rv.SetBool(b.BoolValue)
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			u, ok := v.GetValue().(*rpcpb.CodecValue_UintValue)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("%w: %s: expected uint_value", errInvalidCodecValue, path)
+			}
+			if rv.OverflowUint(u.UintValue) {
+				return reflect.Value{}, fmt.Errorf("%w: %s: %d overflows %s", errInvalidCodecValue, path, u.UintValue, typ)
+			}
+			rv.SetUint(u.UintValue)
+		case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			i, ok := v.GetValue().(*rpcpb.CodecValue_IntValue)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("%w: %s: expected int_value", errInvalidCodecValue, path)
+			}
+			if rv.OverflowInt(i.IntValue) {
+				return reflect.Value{}, fmt.Errorf("%w: %s: %d overflows %s", errInvalidCodecValue, path, i.IntValue, typ)
+			}
+			rv.SetInt(i.IntValue)
+		case reflect.String:
+			str, ok := v.GetValue().(*rpcpb.CodecValue_StringValue)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("%w: %s: expected string_value", errInvalidCodecValue, path)
+			}
+			rv.SetString(str.StringValue)
+		default: // []byte
+			b, ok := v.GetValue().(*rpcpb.CodecValue_BytesValue)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("%w: %s: expected bytes_value", errInvalidCodecValue, path)
+			}
+			rv.SetBytes(b.BytesValue)
+		}
+
+	case *rpcpb.CodecType_FixedBytesLen:
+		b, ok := v.GetValue().(*rpcpb.CodecValue_BytesValue)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%w: %s: expected bytes_value", errInvalidCodecValue, path)
+		}
+		if len(b.BytesValue) != int(k.FixedBytesLen) {
+			return reflect.Value{}, fmt.Errorf("%w: %s: expected %d bytes, got %d", errInvalidCodecValue, path, k.FixedBytesLen, len(b.BytesValue))
+		}
+		reflect.Copy(rv, reflect.ValueOf(b.BytesValue))
+
+	case *rpcpb.CodecType_SliceOf:
+		vs, ok := v.GetValue().(*rpcpb.CodecValue_Values)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%w: %s: expected values", errInvalidCodecValue, path)
+		}
+		elems := vs.Values.GetValues()
+		rv.Set(reflect.MakeSlice(typ, len(elems), len(elems)))
+		for i, elem := range elems {
+			ev, err := codecGoValue(k.SliceOf, elem, registered, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			rv.Index(i).Set(ev)
+		}
+
+	case *rpcpb.CodecType_StructType:
+		vs, ok := v.GetValue().(*rpcpb.CodecValue_Values)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%w: %s: expected values", errInvalidCodecValue, path)
+		}
+		if err := setCodecFields(rv, k.StructType, vs.Values, registered, path); err != nil {
+			return reflect.Value{}, err
+		}
+
+	case *rpcpb.CodecType_Interface:
+		iv, ok := v.GetValue().(*rpcpb.CodecValue_InterfaceValue)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%w: %s: expected interface_value", errInvalidCodecValue, path)
+		}
+		rt, ok := registered[iv.InterfaceValue.TypeId]
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%w: %s: unregistered type ID %d", errInvalidCodecValue, path, iv.InterfaceValue.TypeId)
+		}
+		ptr := reflect.New(rt.typ)
+		if err := setCodecFields(ptr.Elem(), rt.desc, iv.InterfaceValue.Fields, registered, path); err != nil {
+			return reflect.Value{}, err
+		}
+		rv.Set(ptr)
+	}
+	return rv, nil
+}
+
+// setCodecFields sets the fields of the struct value to the given values.
+func setCodecFields(rv reflect.Value, st *rpcpb.CodecStructType, vs *rpcpb.CodecValues, registered map[uint32]codecRegisteredType, path string) error {
+	values := vs.GetValues()
+	if len(values) != len(st.Fields) {
+		return fmt.Errorf("%w: %s: expected %d fields, got %d", errInvalidCodecValue, path, len(st.Fields), len(values))
+	}
+	for i, f := range st.Fields {
+		fv, err := codecGoValue(f, values[i], registered, fmt.Sprintf("%s.F%d", path, i))
+		if err != nil {
+			return err
+		}
+		rv.Field(i).Set(fv)
+	}
+	return nil
+}
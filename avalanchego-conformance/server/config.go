@@ -0,0 +1,272 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/vms/proposervm"
+)
+
+func (s *server) SubnetConfig(ctx context.Context, req *rpcpb.SubnetConfigRequest) (*rpcpb.SubnetConfigResponse, error) {
+	resp := &rpcpb.SubnetConfigResponse{Success: true}
+
+	unknownKeys, err := unknownJSONKeys(req.ConfigFile, reflect.TypeOf(subnets.Config{}), "")
+	if err != nil {
+		resp.Message = fmt.Sprintf("failed to decode config file (%v)", err)
+		resp.Success = false
+		return resp, nil
+	}
+	resp.UnknownKeys = unknownKeys
+
+	// ref. "config.getSubnetConfigsFromDir"
+	cfg := defaultSubnetConfig()
+	if err := json.Unmarshal(req.ConfigFile, &cfg); err != nil {
+		resp.Message = fmt.Sprintf("failed to unmarshal config file (%v)", err)
+		resp.Success = false
+		return resp, nil
+	}
+	if err := cfg.Valid(); err != nil {
+		resp.Message = fmt.Sprintf("invalid subnet config (%v)", err)
+		resp.Success = false
+		return resp, nil
+	}
+
+	resolved, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	resp.ExpectedResolvedJson = string(resolved)
+
+	if req.ResolvedJson != "" {
+		if resp.Message, resp.Success, err = compareJSON(resolved, "resolved_json", req.ResolvedJson); err != nil {
+			return nil, err
+		}
+	}
+	if resp.Success && len(unknownKeys) > 0 {
+		resp.Message = fmt.Sprintf("unknown keys are ignored by avalanchego %q", unknownKeys)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// defaultSubnetConfig returns the subnet config avalanchego starts from
+// before applying a subnet config file, assuming default node flags.
+// ref. "config.getDefaultSubnetConfig"
+func defaultSubnetConfig() subnets.Config {
+	return subnets.Config{
+		// ref. "config.getConsensusConfig" and "config.addNodeFlags"
+		ConsensusParameters: snowball.Parameters{
+			K:                       20,
+			Alpha:                   15,
+			BetaVirtuous:            20,
+			BetaRogue:               20,
+			ConcurrentRepolls:       4,
+			OptimalProcessing:       10,
+			MaxOutstandingItems:     256,
+			MaxItemProcessingTime:   30 * time.Second,
+			MixedQueryNumPushVdr:    10,
+			MixedQueryNumPushNonVdr: 0,
+		},
+		ValidatorOnly: false,
+		// ref. "config.getGossipConfig"
+		GossipConfig: subnets.GossipConfig{
+			AcceptedFrontierValidatorSize:    constants.DefaultConsensusGossipAcceptedFrontierValidatorSize,
+			AcceptedFrontierNonValidatorSize: constants.DefaultConsensusGossipAcceptedFrontierNonValidatorSize,
+			AcceptedFrontierPeerSize:         constants.DefaultConsensusGossipAcceptedFrontierPeerSize,
+			OnAcceptValidatorSize:            constants.DefaultConsensusGossipOnAcceptValidatorSize,
+			OnAcceptNonValidatorSize:         constants.DefaultConsensusGossipOnAcceptNonValidatorSize,
+			OnAcceptPeerSize:                 constants.DefaultConsensusGossipOnAcceptPeerSize,
+			AppGossipValidatorSize:           constants.DefaultAppGossipValidatorSize,
+			AppGossipNonValidatorSize:        constants.DefaultAppGossipNonValidatorSize,
+			AppGossipPeerSize:                constants.DefaultAppGossipPeerSize,
+		},
+		ProposerMinBlockDelay: proposervm.DefaultMinBlockDelay,
+	}
+}
+
+// chainConfig mirrors "chains.ChainConfig", which has no JSON tags.
+type chainConfig struct {
+	Config  []byte
+	Upgrade []byte
+}
+
+func (s *server) ChainConfigContent(ctx context.Context, req *rpcpb.ChainConfigContentRequest) (*rpcpb.ChainConfigContentResponse, error) {
+	resp := &rpcpb.ChainConfigContentResponse{Success: true}
+
+	// ref. "config.getChainConfigsFromFlag"
+	chainConfigs := make(map[string]chainConfig)
+	if err := json.Unmarshal(req.Content, &chainConfigs); err != nil {
+		resp.Message = fmt.Sprintf("could not unmarshal JSON (%v)", err)
+		resp.Success = false
+		return resp, nil
+	}
+
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(req.Content, &raw); err != nil {
+		return nil, err
+	}
+	for chain, b := range raw {
+		unknownKeys, err := unknownJSONKeys(b, reflect.TypeOf(chainConfig{}), chain+".")
+		if err != nil {
+			return nil, err
+		}
+		resp.UnknownKeys = append(resp.UnknownKeys, unknownKeys...)
+		resp.Chains = append(resp.Chains, chain)
+	}
+	sort.Strings(resp.Chains)
+	sort.Strings(resp.UnknownKeys)
+
+	if len(resp.UnknownKeys) > 0 {
+		resp.Message = fmt.Sprintf("unknown keys are ignored by avalanchego %q", resp.UnknownKeys)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// unknownJSONKeys returns the (dot-separated) keys in the JSON object that
+// do not map to any field of the struct type, and thus are silently ignored
+// by "json.Unmarshal". As with "json.Unmarshal", keys match field names
+// case-insensitively.
+func unknownJSONKeys(b []byte, t reflect.Type, prefix string) ([]string, error) {
+	obj := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]reflect.Type)
+	collectJSONFields(t, fields)
+
+	unknownKeys := []string{}
+	for k, v := range obj {
+		var (
+			ft    reflect.Type
+			found bool
+		)
+		for name, typ := range fields {
+			if strings.EqualFold(name, k) {
+				ft, found = typ, true
+				break
+			}
+		}
+		if !found {
+			unknownKeys = append(unknownKeys, prefix+k)
+			continue
+		}
+		if ft.Kind() == reflect.Struct && ft != reflect.TypeOf(time.Time{}) {
+			nested, err := unknownJSONKeys(v, ft, prefix+k+".")
+			if err != nil {
+				return nil, err
+			}
+			unknownKeys = append(unknownKeys, nested...)
+		}
+	}
+	sort.Strings(unknownKeys)
+	return unknownKeys, nil
+}
+
+func collectJSONFields(t reflect.Type, fields map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			collectJSONFields(f.Type, fields)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+}
+
+func (s *server) BootstrapBeacons(ctx context.Context, req *rpcpb.BootstrapBeaconsRequest) (*rpcpb.BootstrapBeaconsResponse, error) {
+	resp := &rpcpb.BootstrapBeaconsResponse{Success: true}
+
+	// ref. "config.getBootstrapConfig"
+	var bootstrapIPs []ips.IPPort
+	for _, ip := range strings.Split(req.BootstrapIps, ",") {
+		if ip == "" {
+			continue
+		}
+		addr, err := ips.ToIPPort(ip)
+		if err != nil {
+			resp.Message = fmt.Sprintf("couldn't parse bootstrap ip %s (%v)", ip, err)
+			resp.Success = false
+			return resp, nil
+		}
+		bootstrapIPs = append(bootstrapIPs, addr)
+	}
+	var bootstrapIDs []ids.NodeID
+	for _, id := range strings.Split(req.BootstrapIds, ",") {
+		if id == "" {
+			continue
+		}
+		nodeID, err := ids.NodeIDFromString(id)
+		if err != nil {
+			resp.Message = fmt.Sprintf("couldn't parse bootstrap peer id %s (%v)", id, err)
+			resp.Success = false
+			return resp, nil
+		}
+		bootstrapIDs = append(bootstrapIDs, nodeID)
+	}
+	if len(bootstrapIPs) != len(bootstrapIDs) {
+		resp.Message = fmt.Sprintf("expected the number of bootstrapIPs (%d) to match the number of bootstrapIDs (%d)", len(bootstrapIPs), len(bootstrapIDs))
+		resp.Success = false
+		return resp, nil
+	}
+
+	for i, ip := range bootstrapIPs {
+		resp.ExpectedBeacons = append(resp.ExpectedBeacons, &rpcpb.BootstrapBeacon{
+			IpAddr: ip.IP,
+			IpPort: uint32(ip.Port),
+			NodeId: bootstrapIDs[i].Bytes(),
+		})
+	}
+
+	if len(req.Beacons) != len(bootstrapIPs) {
+		resp.Message = fmt.Sprintf("parsed %d beacons, expected %d", len(bootstrapIPs), len(req.Beacons))
+		resp.Success = false
+		return resp, nil
+	}
+	for i, b := range req.Beacons {
+		ip, nodeID := bootstrapIPs[i], bootstrapIDs[i]
+		if !net.IP(b.IpAddr).Equal(ip.IP) || b.IpPort != uint32(ip.Port) {
+			if resp.Message != "" {
+				resp.Message += "; "
+			}
+			resp.Message += fmt.Sprintf("beacon %d: expected ip %s, parsed %s", i, ips.IPPort{IP: b.IpAddr, Port: uint16(b.IpPort)}, ip)
+			resp.Success = false
+		}
+		if !bytes.Equal(b.NodeId, nodeID.Bytes()) {
+			if resp.Message != "" {
+				resp.Message += "; "
+			}
+			resp.Message += fmt.Sprintf("beacon %d: expected node ID 0x%x, parsed %s", i, b.NodeId, nodeID)
+			resp.Success = false
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// The C-chain atomic txs below mirror coreth "plugin/evm", which is not a
+// dependency of this module (coreth depends on a newer avalanchego). Type
+// IDs and field order must be kept in sync with coreth.
+// ref. "evm.Codec"
+const corethCodecVersion = 0
+
+var (
+	errCorethInputsNotSortedUnique  = errors.New("inputs not sorted and unique")
+	errCorethOutputsNotSortedUnique = errors.New("outputs not sorted and unique")
+	errCorethOutputsNotSorted       = errors.New("tx outputs not sorted")
+
+	corethCodec = newCorethCodec()
+)
+
+func newCorethCodec() codec.Manager {
+	c := linearcodec.NewDefault()
+	errs := wrappers.Errs{}
+	errs.Add(
+		c.RegisterType(&corethImportTx{}),
+		c.RegisterType(&corethExportTx{}),
+	)
+	c.SkipRegistrations(3)
+	errs.Add(
+		c.RegisterType(&secp256k1fx.TransferInput{}),
+		c.RegisterType(&secp256k1fx.MintOutput{}),
+		c.RegisterType(&secp256k1fx.TransferOutput{}),
+		c.RegisterType(&secp256k1fx.MintOperation{}),
+		c.RegisterType(&secp256k1fx.Credential{}),
+		c.RegisterType(&secp256k1fx.Input{}),
+		c.RegisterType(&secp256k1fx.OutputOwners{}),
+	)
+	m := codec.NewDefaultManager()
+	errs.Add(m.RegisterCodec(corethCodecVersion, c))
+	if errs.Errored() {
+		panic(errs.Err)
+	}
+	return m
+}
+
+// ref. "evm.EVMOutput"
+type corethEVMOutput struct {
+	Address ids.ShortID `serialize:"true"`
+	Amount  uint64      `serialize:"true"`
+	AssetID ids.ID      `serialize:"true"`
+}
+
+func (o corethEVMOutput) Less(other corethEVMOutput) bool {
+	if c := bytes.Compare(o.Address[:], other.Address[:]); c != 0 {
+		return c < 0
+	}
+	return bytes.Compare(o.AssetID[:], other.AssetID[:]) < 0
+}
+
+// ref. "evm.EVMInput"
+type corethEVMInput struct {
+	Address ids.ShortID `serialize:"true"`
+	Amount  uint64      `serialize:"true"`
+	AssetID ids.ID      `serialize:"true"`
+	Nonce   uint64      `serialize:"true"`
+}
+
+func (i corethEVMInput) Less(other corethEVMInput) bool {
+	if c := bytes.Compare(i.Address[:], other.Address[:]); c != 0 {
+		return c < 0
+	}
+	return bytes.Compare(i.AssetID[:], other.AssetID[:]) < 0
+}
+
+// ref. "evm.UnsignedImportTx"
+type corethImportTx struct {
+	NetworkID      uint32                    `serialize:"true"`
+	BlockchainID   ids.ID                    `serialize:"true"`
+	SourceChain    ids.ID                    `serialize:"true"`
+	ImportedInputs []*avax.TransferableInput `serialize:"true"`
+	Outs           []corethEVMOutput         `serialize:"true"`
+}
+
+// ref. "evm.UnsignedExportTx"
+type corethExportTx struct {
+	NetworkID        uint32                     `serialize:"true"`
+	BlockchainID     ids.ID                     `serialize:"true"`
+	DestinationChain ids.ID                     `serialize:"true"`
+	Ins              []corethEVMInput           `serialize:"true"`
+	ExportedOutputs  []*avax.TransferableOutput `serialize:"true"`
+}
+
+// ref. "evm.Tx"
+type corethTx struct {
+	// *corethImportTx or *corethExportTx
+	Unsigned interface{}         `serialize:"true"`
+	Creds    []verify.Verifiable `serialize:"true"`
+}
+
+func (s *server) UnsignedImportTx(ctx context.Context, req *rpcpb.UnsignedImportTxRequest) (*rpcpb.UnsignedImportTxResponse, error) {
+	blockchainID, err := toID("blockchain_id", req.BlockchainId)
+	if err != nil {
+		return nil, err
+	}
+	sourceChain, err := toID("source_chain", req.SourceChain)
+	if err != nil {
+		return nil, err
+	}
+	importedIns, err := transferableInputs(req.ImportedInputs)
+	if err != nil {
+		return nil, invalidField("imported_inputs", err)
+	}
+	outs := make([]corethEVMOutput, 0, len(req.Outputs))
+	for i, out := range req.Outputs {
+		addr, err := toShortID(fmt.Sprintf("outputs[%d].address", i), out.Address)
+		if err != nil {
+			return nil, err
+		}
+		assetID, err := toID(fmt.Sprintf("outputs[%d].asset_id", i), out.AssetId)
+		if err != nil {
+			return nil, err
+		}
+		outs = append(outs, corethEVMOutput{
+			Address: addr,
+			Amount:  out.Amount,
+			AssetID: assetID,
+		})
+	}
+
+	utx := &corethImportTx{
+		NetworkID:      req.NetworkId,
+		BlockchainID:   blockchainID,
+		SourceChain:    sourceChain,
+		ImportedInputs: importedIns,
+		Outs:           outs,
+	}
+	expected, txID, err := initializeCorethTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	// ref. "evm.UnsignedImportTx.Verify"
+	var orderErrs []error
+	if !utils.IsSortedAndUniqueSortable(utx.ImportedInputs) {
+		orderErrs = append(orderErrs, errCorethInputsNotSortedUnique)
+	}
+	if !utils.IsSortedAndUniqueSortable(utx.Outs) {
+		orderErrs = append(orderErrs, errCorethOutputsNotSortedUnique)
+	}
+
+	resp := &rpcpb.UnsignedImportTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+	if len(orderErrs) > 0 {
+		resp.Message = joinMessages(resp.Message, orderErrs)
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) UnsignedExportTx(ctx context.Context, req *rpcpb.UnsignedExportTxRequest) (*rpcpb.UnsignedExportTxResponse, error) {
+	blockchainID, err := toID("blockchain_id", req.BlockchainId)
+	if err != nil {
+		return nil, err
+	}
+	destinationChain, err := toID("destination_chain", req.DestinationChain)
+	if err != nil {
+		return nil, err
+	}
+	ins := make([]corethEVMInput, 0, len(req.Inputs))
+	for i, in := range req.Inputs {
+		addr, err := toShortID(fmt.Sprintf("inputs[%d].address", i), in.Address)
+		if err != nil {
+			return nil, err
+		}
+		assetID, err := toID(fmt.Sprintf("inputs[%d].asset_id", i), in.AssetId)
+		if err != nil {
+			return nil, err
+		}
+		ins = append(ins, corethEVMInput{
+			Address: addr,
+			Amount:  in.Amount,
+			AssetID: assetID,
+			Nonce:   in.Nonce,
+		})
+	}
+	exportedOuts, err := s.transferableOutputs(req.ExportedOutputs)
+	if err != nil {
+		return nil, invalidField("exported_outputs", err)
+	}
+
+	utx := &corethExportTx{
+		NetworkID:        req.NetworkId,
+		BlockchainID:     blockchainID,
+		DestinationChain: destinationChain,
+		Ins:              ins,
+		ExportedOutputs:  exportedOuts,
+	}
+	expected, txID, err := initializeCorethTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	// ref. "evm.UnsignedExportTx.Verify"
+	var orderErrs []error
+	if !utils.IsSortedAndUniqueSortable(utx.Ins) {
+		orderErrs = append(orderErrs, errCorethInputsNotSortedUnique)
+	}
+	if !avax.IsSortedTransferableOutputs(utx.ExportedOutputs, corethCodec) {
+		orderErrs = append(orderErrs, errCorethOutputsNotSorted)
+	}
+
+	resp := &rpcpb.UnsignedExportTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+	if len(orderErrs) > 0 {
+		resp.Message = joinMessages(resp.Message, orderErrs)
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+// initializeCorethTx returns the unsigned bytes of the tx, and the ID of the
+// tx without credentials.
+// ref. "evm.Tx.Sign"
+func initializeCorethTx(utx interface{}) ([]byte, ids.ID, error) {
+	tx := &corethTx{Unsigned: utx}
+	unsignedBytes, err := corethCodec.Marshal(corethCodecVersion, &tx.Unsigned)
+	if err != nil {
+		return nil, ids.Empty, err
+	}
+	signedBytes, err := corethCodec.Marshal(corethCodecVersion, tx)
+	if err != nil {
+		return nil, ids.Empty, err
+	}
+	return unsignedBytes, hashing.ComputeHash256Array(signedBytes), nil
+}
+
+// joinMessages appends the errors to the message, separated by "; ".
+func joinMessages(msg string, errs []error) string {
+	parts := make([]string, 0, len(errs)+1)
+	if msg != "" {
+		parts = append(parts, msg)
+	}
+	for _, err := range errs {
+		parts = append(parts, err.Error())
+	}
+	return strings.Join(parts, "; ")
+}
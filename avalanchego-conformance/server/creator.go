@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/utils/compression"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultMaxMessageTimeout is the default Config.MaxMessageTimeout.
+const DefaultMaxMessageTimeout = 10 * time.Second
+
+// maxMsgCreators bounds the cached creators, since requests set arbitrary
+// timeouts (e.g., when fuzzed). Creators of other keys are not cached.
+const maxMsgCreators = 64
+
+type msgCreatorKey struct {
+	compressType compression.Type
+	maxTimeout   time.Duration
+}
+
+// msgCreators holds the message creators of each compression type and
+// timeout, created on first use. Creators are safe for concurrent use, and
+// are shared by requests since creating one (with its compressor and
+// metrics registry) dominates the cost of cheap message checks.
+type msgCreators struct {
+	mu       sync.Mutex
+	creators map[msgCreatorKey]message.Creator
+}
+
+func (c *msgCreators) get(compressType compression.Type, maxTimeout time.Duration) (message.Creator, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := msgCreatorKey{compressType: compressType, maxTimeout: maxTimeout}
+	if mc, ok := c.creators[key]; ok {
+		return mc, nil
+	}
+	// a registry per creator, since creators register the same metrics
+	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compressType, maxTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if c.creators == nil {
+		c.creators = make(map[msgCreatorKey]message.Creator)
+	}
+	if len(c.creators) < maxMsgCreators {
+		c.creators[key] = mc
+	}
+	return mc, nil
+}
+
+// msgCreator returns the message creator of the compression type and
+// maximum message timeout (see maxMessageTimeout).
+func (s *server) msgCreator(compressType compression.Type, maxTimeout uint64) (message.Creator, error) {
+	return s.msgCreators.get(compressType, s.maxMessageTimeout(maxTimeout))
+}
+
+// maxMessageTimeout returns the maximum message timeout of a request, the
+// configured one if zero.
+func (s *server) maxMessageTimeout(maxTimeout uint64) time.Duration {
+	switch {
+	case maxTimeout != 0:
+		return time.Duration(maxTimeout)
+	case s.cfg.MaxMessageTimeout != 0:
+		return s.cfg.MaxMessageTimeout
+	default:
+		return DefaultMaxMessageTimeout
+	}
+}
+
+// expectedTimeout returns the timeout a receiving peer applies to a message
+// with the deadline. Deadlines above the max int64 are negative, and so
+// already expired.
+// ref. "message.msgBuilder.parseInbound"
+func expectedTimeout(deadline uint64, maxTimeout time.Duration) int64 {
+	return int64(math.Min(time.Duration(deadline), maxTimeout))
+}
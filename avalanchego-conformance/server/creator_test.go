@@ -0,0 +1,52 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+)
+
+// BenchmarkAppGossip compares checking AppGossip messages with the cached
+// creators against creating a creator per request.
+func BenchmarkAppGossip(b *testing.B) {
+	ctx := context.Background()
+	req := &rpcpb.AppGossipRequest{
+		ChainId:  make([]byte, 32),
+		AppBytes: make([]byte, 1024),
+	}
+	s := &server{}
+	resp, err := s.AppGossip(ctx, req)
+	if err != nil {
+		b.Fatal(err)
+	}
+	req.SerializedMsg = resp.ExpectedSerializedMsg
+
+	for _, tt := range []struct {
+		name   string
+		cached bool
+	}{
+		{name: "cached", cached: true},
+		{name: "uncached", cached: false},
+	} {
+		b.Run(tt.name, func(b *testing.B) {
+			s := &server{}
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if !tt.cached {
+					s.msgCreators = msgCreators{}
+				}
+				resp, err := s.AppGossip(ctx, req)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if !resp.Success {
+					b.Fatal(resp.Message)
+				}
+			}
+		})
+	}
+}
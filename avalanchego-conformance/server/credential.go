@@ -0,0 +1,147 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	avmfxs "github.com/ava-labs/avalanchego/vms/avm/fxs"
+	avmtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"go.uber.org/zap"
+)
+
+var errUnknownTxChain = errors.New("unknown tx chain")
+
+func (s *server) SignedTx(ctx context.Context, req *rpcpb.SignedTxRequest) (*rpcpb.SignedTxResponse, error) {
+	logger(ctx).Debug("received SignedTx request", zap.String("chain", req.Chain.String()))
+
+	creds := make([]*secp256k1fx.Credential, 0, len(req.Credentials))
+	for i, cred := range req.Credentials {
+		sigs := make([][secp256k1.SignatureLen]byte, len(cred.Signatures))
+		for j, sig := range cred.Signatures {
+			if len(sig) != secp256k1.SignatureLen {
+				return nil, wrongLength(fmt.Sprintf("credentials[%d].signatures[%d]", i, j), fmt.Errorf("expected %d bytes, got %d", secp256k1.SignatureLen, len(sig)))
+			}
+			copy(sigs[j][:], sig)
+		}
+		creds = append(creds, &secp256k1fx.Credential{Sigs: sigs})
+	}
+
+	var (
+		expected []byte
+		txID     ids.ID
+		err      error
+	)
+	switch req.Chain {
+	case rpcpb.TxChain_TX_CHAIN_PLATFORM:
+		expected, txID, err = signPlatformTx(req.UnsignedTxBytes, creds)
+	case rpcpb.TxChain_TX_CHAIN_AVM:
+		expected, txID, err = s.signAVMTx(req.UnsignedTxBytes, creds)
+	case rpcpb.TxChain_TX_CHAIN_CORETH:
+		expected, txID, err = signCorethTx(req.UnsignedTxBytes, creds)
+	default:
+		err = fmt.Errorf("%w %s", errUnknownTxChain, req.Chain)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// every credential signs the hash of the unsigned bytes
+	// ref. "secp256k1fx.Fx.VerifyCredentials"
+	hash := hashing.ComputeHash256(req.UnsignedTxBytes)
+	signers := make([]*rpcpb.CredentialSigners, 0, len(creds))
+	for i, cred := range creds {
+		addrs := make([][]byte, 0, len(cred.Sigs))
+		for j, sig := range cred.Sigs {
+			pk, err := s.secpFactory.RecoverHashPublicKey(hash, sig[:])
+			if err != nil {
+				return nil, invalidField(fmt.Sprintf("credentials[%d].signatures[%d]", i, j), err)
+			}
+			addr := pk.Address()
+			addrs = append(addrs, addr[:])
+		}
+		signers = append(signers, &rpcpb.CredentialSigners{Addresses: addrs})
+	}
+
+	resp := &rpcpb.SignedTxResponse{
+		ExpectedSignedTxBytes: expected,
+		ExpectedTxId:          txID[:],
+		Signers:               signers,
+		Success:               true,
+	}
+	if d := newDiff(expected, req.SignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+// ref. "txs.Tx.Sign"
+func signPlatformTx(unsignedBytes []byte, creds []*secp256k1fx.Credential) ([]byte, ids.ID, error) {
+	var utx txs.UnsignedTx
+	if _, err := txs.Codec.Unmarshal(unsignedBytes, &utx); err != nil {
+		return nil, ids.Empty, err
+	}
+	tx := &txs.Tx{
+		Unsigned: utx,
+		Creds:    make([]verify.Verifiable, 0, len(creds)),
+	}
+	for _, cred := range creds {
+		tx.Creds = append(tx.Creds, cred)
+	}
+	if err := tx.Initialize(txs.Codec); err != nil {
+		return nil, ids.Empty, err
+	}
+	return tx.Bytes(), tx.ID(), nil
+}
+
+// ref. "txs.Tx.SignSECP256K1Fx"
+func (s *server) signAVMTx(unsignedBytes []byte, creds []*secp256k1fx.Credential) ([]byte, ids.ID, error) {
+	var utx avmtxs.UnsignedTx
+	if _, err := s.avmParser.Codec().Unmarshal(unsignedBytes, &utx); err != nil {
+		return nil, ids.Empty, err
+	}
+	tx := &avmtxs.Tx{
+		Unsigned: utx,
+		Creds:    make([]*avmfxs.FxCredential, 0, len(creds)),
+	}
+	for _, cred := range creds {
+		tx.Creds = append(tx.Creds, &avmfxs.FxCredential{Verifiable: cred})
+	}
+	if err := s.avmParser.InitializeTx(tx); err != nil {
+		return nil, ids.Empty, err
+	}
+	return tx.Bytes(), tx.ID(), nil
+}
+
+// ref. "evm.Tx.Sign"
+func signCorethTx(unsignedBytes []byte, creds []*secp256k1fx.Credential) ([]byte, ids.ID, error) {
+	var utx interface{}
+	if _, err := corethCodec.Unmarshal(unsignedBytes, &utx); err != nil {
+		return nil, ids.Empty, err
+	}
+	tx := &corethTx{
+		Unsigned: utx,
+		Creds:    make([]verify.Verifiable, 0, len(creds)),
+	}
+	for _, cred := range creds {
+		tx.Creds = append(tx.Creds, cred)
+	}
+	signedBytes, err := corethCodec.Marshal(corethCodecVersion, tx)
+	if err != nil {
+		return nil, ids.Empty, err
+	}
+	return signedBytes, hashing.ComputeHash256Array(signedBytes), nil
+}
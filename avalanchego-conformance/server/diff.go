@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+)
+
+var (
+	// paths of the messages written to peers, and of the decompressed
+	// messages they carry
+	framedMessagePath = diff.FramedProtoPath((&p2p.Message{}).ProtoReflect().Descriptor())
+	messagePath       = diff.ProtoPath((&p2p.Message{}).ProtoReflect().Descriptor())
+)
+
+// newDiff returns the diff of the received bytes from the expected ones,
+// or nil if they are equal.
+func newDiff(expected []byte, received []byte, path diff.PathFunc) *rpcpb.Diff {
+	d, ok := diff.Locate(expected, received, diff.DefaultWindow, path)
+	if !ok {
+		return nil
+	}
+	return &rpcpb.Diff{
+		Summary:        d.String(),
+		ExpectedLength: uint64(d.ExpectedLen),
+		ReceivedLength: uint64(d.ReceivedLen),
+		FirstOffset:    uint64(d.Offset),
+		FieldPath:      d.Path,
+		WindowOffset:   uint64(d.WindowStart),
+		ExpectedWindow: d.ExpectedWindow,
+		ReceivedWindow: d.ReceivedWindow,
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+var errUnknownRequest = errors.New("no registered method for request")
+
+// unaryMethod is a unary method of a registered service.
+type unaryMethod struct {
+	serviceName string
+	desc        grpc.MethodDesc
+	output      protoreflect.MessageDescriptor
+}
+
+// unaryMethodsByInput maps the request message of each unary method of the
+// services to the method, so that requests can be dispatched by type (e.g.,
+// from streams bundling requests of several methods).
+func unaryMethodsByInput(descs []*grpc.ServiceDesc) (map[protoreflect.FullName]unaryMethod, error) {
+	methods := make(map[protoreflect.FullName]unaryMethod)
+	for _, desc := range descs {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(desc.ServiceName))
+		if err != nil {
+			return nil, err
+		}
+		sd, ok := d.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", desc.ServiceName)
+		}
+		for _, m := range desc.Methods {
+			md := sd.Methods().ByName(protoreflect.Name(m.MethodName))
+			if md == nil {
+				return nil, fmt.Errorf("method %s not found in %s", m.MethodName, desc.ServiceName)
+			}
+			methods[md.Input().FullName()] = unaryMethod{
+				serviceName: desc.ServiceName,
+				desc:        m,
+				output:      md.Output(),
+			}
+		}
+	}
+	return methods, nil
+}
+
+// dispatch calls the unary method of the request type through the unary
+// interceptors of the server, so that dispatched requests count towards
+// usage quotas, metrics and reports as if they were served directly.
+func (s *server) dispatch(ctx context.Context, req proto.Message) (proto.Message, error) {
+	name := req.ProtoReflect().Descriptor().FullName()
+	m, ok := s.unaryMethods[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", errUnknownRequest, name)
+	}
+	dec := func(v interface{}) error {
+		proto.Merge(v.(proto.Message), req)
+		return nil
+	}
+	resp, err := m.desc.Handler(s, ctx, dec, s.dispatchInterceptor)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(proto.Message), nil
+}
+
+// recoverDispatch dispatches the request, recovering from handler panics
+// (e.g., on unset nested messages of random or batched requests) as
+// rejections of the request.
+func (s *server) recoverDispatch(ctx context.Context, req proto.Message) (resp proto.Message, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panicked: %v", r)
+		}
+	}()
+	return s.dispatch(ctx, req)
+}
@@ -0,0 +1,32 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestDispatchInterceptors checks that dispatched requests go through the
+// unary interceptors, as served requests do.
+func TestDispatchInterceptors(t *testing.T) {
+	srv, err := New(Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := srv.(*server)
+	defer s.ln.Close()
+
+	_, err = s.dispatch(context.Background(), &rpcpb.AppGossipRequest{ChainId: []byte{1}})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Fatalf("expected %s, got %s (%v)", codes.InvalidArgument, code, err)
+	}
+	if usage := s.usage["MessageService"]; usage == nil || usage.requests != 1 {
+		t.Fatalf("expected the request to be accounted, got %+v", usage)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const serverDurationField = protoreflect.Name("server_duration_ms")
+
+// durationUnaryInterceptor sets "server_duration_ms" on every response, so
+// clients can attribute slow runs to specific checks.
+func durationUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	if err == nil {
+		setServerDuration(resp, time.Since(start))
+	}
+	return resp, err
+}
+
+// durationStreamInterceptor is the streaming variant of
+// durationUnaryInterceptor, measured from the start of the stream.
+func durationStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &durationServerStream{ServerStream: ss, start: time.Now()})
+}
+
+type durationServerStream struct {
+	grpc.ServerStream
+	start time.Time
+}
+
+func (s *durationServerStream) SendMsg(m interface{}) error {
+	setServerDuration(m, time.Since(s.start))
+	return s.ServerStream.SendMsg(m)
+}
+
+func setServerDuration(m interface{}, d time.Duration) {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return
+	}
+	r := msg.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName(serverDurationField)
+	if fd == nil || fd.Kind() != protoreflect.Uint64Kind {
+		return
+	}
+	r.Set(fd, protoreflect.ValueOfUint64(uint64(d.Milliseconds())))
+}
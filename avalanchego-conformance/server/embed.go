@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultDialTimeout is the default Config.DialTimeout.
+const DefaultDialTimeout = 10 * time.Second
+
+// Serve runs the server in-process, for Go integration suites and tools
+// that embed it instead of spawning the binary, and returns once it is
+// ready to accept requests. Zero ports are ephemeral for the gRPC server
+// and disable the gateway, and Ports reports the bound ones:
+//
+//	ports, errc, err := server.Serve(ctx, server.Config{})
+//	...
+//	conn, err := grpc.Dial(ports.Endpoint(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+//
+// The server runs until ctx is done. The returned channel then receives
+// the error the server stopped with (nil on a clean shutdown), and is
+// closed.
+func Serve(ctx context.Context, cfg Config) (Ports, <-chan error, error) {
+	srv, err := New(cfg)
+	if err != nil {
+		return Ports{}, nil, err
+	}
+	s := srv.(*server)
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.Run(ctx)
+		close(errc)
+	}()
+	select {
+	case <-s.ready:
+		return s.ports(), errc, nil
+	case err := <-errc:
+		if err == nil {
+			err = ctx.Err()
+		}
+		return Ports{}, nil, err
+	}
+}
+
+// Endpoint returns the gRPC endpoint to dial (e.g., "localhost:9090" or
+// "unix:/tmp/conformance.sock").
+func (p Ports) Endpoint() string {
+	if p.GRPCSocket != "" {
+		return "unix:" + p.GRPCSocket
+	}
+	return fmt.Sprintf("localhost:%d", p.Port)
+}
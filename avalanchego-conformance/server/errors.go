@@ -0,0 +1,205 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the "ErrorInfo" status details of the
+// server.
+const ErrorDomain = "avalanchego-conformance"
+
+// PanicReason is the "ErrorInfo" reason of requests whose handler panicked.
+const PanicReason = "PANIC"
+
+// fieldError is a malformed request field. Handlers return it as is, or
+// wrapped, and fieldErrorUnaryInterceptor converts it to an
+// INVALID_ARGUMENT status with a "BadRequest" detail naming the field and
+// an "InputError" detail classifying it, so that clients can tell which of
+// their inputs is malformed, and how.
+type fieldError struct {
+	field string
+	class rpcpb.InputErrorClass
+	err   error
+}
+
+// invalidField returns the error of the malformed request field, a path
+// such as "outputs[1].asset_id". A field error of a nested message is
+// prefixed with the field of the message, or of the list for an index
+// such as "[1].asset_id".
+func invalidField(field string, err error) error {
+	var fe *fieldError
+	if errors.As(err, &fe) {
+		if strings.HasPrefix(fe.field, "[") {
+			return &fieldError{field: field + fe.field, class: fe.class, err: fe.err}
+		}
+		return &fieldError{field: field + "." + fe.field, class: fe.class, err: fe.err}
+	}
+	class := rpcpb.InputErrorClass_INPUT_ERROR_CLASS_INVALID
+	if errors.Is(err, errMissingField) {
+		class = rpcpb.InputErrorClass_INPUT_ERROR_CLASS_MISSING
+	}
+	return &fieldError{field: field, class: class, err: err}
+}
+
+func (e *fieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.field, e.err)
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+// status returns the INVALID_ARGUMENT status of the field error, with the
+// message of the error wrapping it, if any.
+func (e *fieldError) status(msg string) *status.Status {
+	st := status.New(codes.InvalidArgument, msg)
+	withDetails, err := st.WithDetails(
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       e.field,
+				Description: e.err.Error(),
+			}},
+		},
+		&rpcpb.InputError{
+			Class:       e.class,
+			Field:       e.field,
+			Description: e.err.Error(),
+		},
+	)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// toID, toShortID and toNodeID parse the ID of the request field.
+func toID(field string, b []byte) (ids.ID, error) {
+	id, err := ids.ToID(b)
+	if err != nil {
+		return id, wrongLength(field, err)
+	}
+	return id, nil
+}
+
+func toShortID(field string, b []byte) (ids.ShortID, error) {
+	id, err := ids.ToShortID(b)
+	if err != nil {
+		return id, wrongLength(field, err)
+	}
+	return id, nil
+}
+
+func toNodeID(field string, b []byte) (ids.NodeID, error) {
+	id, err := ids.ToNodeID(b)
+	if err != nil {
+		return id, wrongLength(field, err)
+	}
+	return id, nil
+}
+
+// wrongLength returns the error of the request field of the wrong length
+// (e.g., "expected 32 bytes but got 31").
+func wrongLength(field string, err error) error {
+	return &fieldError{
+		field: field,
+		class: rpcpb.InputErrorClass_INPUT_ERROR_CLASS_WRONG_LENGTH,
+		err:   err,
+	}
+}
+
+// outOfRange returns the error of the request field overflowing its
+// serialized type (e.g., "port 65536 overflows a short").
+func outOfRange(field string, err error) error {
+	return &fieldError{
+		field: field,
+		class: rpcpb.InputErrorClass_INPUT_ERROR_CLASS_OUT_OF_RANGE,
+		err:   err,
+	}
+}
+
+// statusError converts field errors to status errors. Other errors are
+// returned as is.
+func statusError(err error) error {
+	if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return err
+	}
+	var fe *fieldError
+	if errors.As(err, &fe) {
+		return fe.status(err.Error()).Err()
+	}
+	return err
+}
+
+// fieldErrorUnaryInterceptor converts the field errors of handlers to
+// status errors. It is the innermost interceptor, so that the others (e.g.,
+// metrics) see the status code.
+func fieldErrorUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, statusError(err)
+}
+
+// fieldErrorStreamInterceptor is the streaming variant of
+// fieldErrorUnaryInterceptor.
+func fieldErrorStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return statusError(handler(srv, ss))
+}
+
+// recoveryUnaryInterceptor fails requests whose handler panics (e.g., deep
+// in avalanchego code on a malformed input) with an INTERNAL error instead
+// of crashing the server, so that the input only fails its own check. The
+// status carries an "ErrorInfo" detail with PanicReason, the method and the
+// request ID; the stack is only logged, since it exposes server internals.
+func recoveryUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredError(ctx, info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// recoveryStreamInterceptor is the streaming variant of
+// recoveryUnaryInterceptor, which fails the stream but not the others of
+// the connection.
+func recoveryStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredError(ss.Context(), info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+func recoveredError(ctx context.Context, fullMethod string, r interface{}) error {
+	stack := string(debug.Stack())
+	logger(ctx).Error("recovered from panic", zap.Any("panic", r), zap.String("stack", stack))
+
+	st := status.Newf(codes.Internal, "panic handling %s (request ID %s): %v", fullMethod, requestID(ctx), r)
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: PanicReason,
+		Domain: ErrorDomain,
+		Metadata: map[string]string{
+			"method":     fullMethod,
+			"request_id": requestID(ctx),
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
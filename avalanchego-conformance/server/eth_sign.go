@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ethereum/go-ethereum/accounts"
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"go.uber.org/zap"
+)
+
+var (
+	// ref. "ethapi.PersonalAccountAPI.EcRecover"
+	errEthInvalidSignatureV = errors.New("invalid Ethereum signature (V is not 27 or 28)")
+	errEthSignerMismatch    = errors.New("signer mismatch")
+)
+
+func (s *server) EthPersonalSign(ctx context.Context, req *rpcpb.EthPersonalSignRequest) (*rpcpb.EthPersonalSignResponse, error) {
+	logger(ctx).Debug("received EthPersonalSign request", zap.Int("message-size", len(req.Message)))
+
+	hash := accounts.TextHash(req.Message)
+	sig, signer, mismatches, err := ethSignHash(hash, req.PrivateKey, req.Signature, req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.EthPersonalSignResponse{
+		ExpectedHash:      hash,
+		ExpectedSignature: sig,
+		ExpectedSigner:    signer,
+		Success:           true,
+	}
+	if !bytes.Equal(hash, req.Hash) {
+		mismatches = append([]error{fmt.Errorf("expected hash 0x%x, got 0x%x", hash, req.Hash)}, mismatches...)
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages(resp.Message, mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) EthTypedData(ctx context.Context, req *rpcpb.EthTypedDataRequest) (*rpcpb.EthTypedDataResponse, error) {
+	logger(ctx).Debug("received EthTypedData request", zap.Int("typed-data-size", len(req.TypedDataJson)))
+
+	resp := &rpcpb.EthTypedDataResponse{
+		Success: true,
+	}
+	var typedData apitypes.TypedData
+	if err := json.Unmarshal([]byte(req.TypedDataJson), &typedData); err != nil {
+		return nil, err
+	}
+	resp.ExpectedEncodedType = string(typedData.EncodeType(typedData.PrimaryType))
+	domainSeparator, messageHash, digest, err := ethTypedDataHashes(&typedData)
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	}
+	if (err != nil) != req.Rejected {
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+		return resp, nil
+	}
+	if req.Rejected {
+		return resp, nil
+	}
+
+	sig, signer, mismatches, err := ethSignHash(digest, req.PrivateKey, req.Signature, req.Signer)
+	if err != nil {
+		return nil, err
+	}
+	resp.ExpectedDomainSeparator = domainSeparator
+	resp.ExpectedMessageHash = messageHash
+	resp.ExpectedDigest = digest
+	resp.ExpectedSignature = sig
+	resp.ExpectedSigner = signer
+
+	var hashMismatches []error
+	if !bytes.Equal(domainSeparator, req.DomainSeparator) {
+		hashMismatches = append(hashMismatches, fmt.Errorf("expected domain separator 0x%x, got 0x%x", domainSeparator, req.DomainSeparator))
+	}
+	if !bytes.Equal(messageHash, req.MessageHash) {
+		hashMismatches = append(hashMismatches, fmt.Errorf("expected message hash 0x%x, got 0x%x", messageHash, req.MessageHash))
+	}
+	if !bytes.Equal(digest, req.Digest) {
+		hashMismatches = append(hashMismatches, fmt.Errorf("expected digest 0x%x, got 0x%x", digest, req.Digest))
+	}
+	if mismatches = append(hashMismatches, mismatches...); len(mismatches) > 0 {
+		resp.Message = joinMessages(resp.Message, mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// ethSignHash signs the hash with the private key, if any, or else takes the
+// signature given, and recovers its signer. Signatures are [r || s || v],
+// with v 27 or 28 as wallets return them. Mismatches with the signature and
+// signer given are returned as errors.
+// ref. "ethapi.PersonalAccountAPI.Sign", "ethapi.PersonalAccountAPI.EcRecover"
+func ethSignHash(hash []byte, privateKey []byte, signature []byte, signer []byte) ([]byte, []byte, []error, error) {
+	sig := signature
+	if len(privateKey) > 0 {
+		key, err := eth_crypto.ToECDSA(privateKey)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if sig, err = eth_crypto.Sign(hash, key); err != nil {
+			return nil, nil, nil, err
+		}
+		sig[eth_crypto.RecoveryIDOffset] += 27
+	}
+	if len(sig) != eth_crypto.SignatureLength {
+		return nil, nil, nil, errEthSignatureLen
+	}
+
+	var mismatches []error
+	if len(privateKey) > 0 && len(signature) > 0 {
+		if d := newDiff(sig, signature, diff.AnnotatorPath(secpSignatureFields)); d != nil {
+			mismatches = append(mismatches, fmt.Errorf("signature: %s", d.Summary))
+		}
+	}
+	v := sig[eth_crypto.RecoveryIDOffset]
+	if v != 27 && v != 28 {
+		return sig, nil, append(mismatches, errEthInvalidSignatureV), nil
+	}
+	plain := make([]byte, len(sig))
+	copy(plain, sig)
+	plain[eth_crypto.RecoveryIDOffset] -= 27
+	pub, err := eth_crypto.SigToPub(hash, plain)
+	if err != nil {
+		return sig, nil, append(mismatches, err), nil
+	}
+	addr := eth_crypto.PubkeyToAddress(*pub)
+	if !bytes.Equal(addr[:], signer) {
+		mismatches = append(mismatches, fmt.Errorf("%w: expected %s, got 0x%x", errEthSignerMismatch, addr, signer))
+	}
+	return sig, addr[:], mismatches, nil
+}
+
+// ethTypedDataHashes returns the domain separator, the hash of the message
+// and the digest signed.
+// ref. "apitypes.TypedDataAndHash"
+func ethTypedDataHashes(typedData *apitypes.TypedData) ([]byte, []byte, []byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	digest, _, err := apitypes.TypedDataAndHash(*typedData)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return domainSeparator, messageHash, digest, nil
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"go.uber.org/zap"
+)
+
+var (
+	errEthIntTooLarge    = errors.New("integer exceeds 256 bits")
+	errEthSignatureLen   = fmt.Errorf("signature must be %d bytes", eth_crypto.SignatureLength)
+	errEthSenderMismatch = errors.New("sender mismatch")
+
+	ethLegacyTxFieldNames     = []string{"nonce", "gas_price", "gas", "to", "value", "data", "v", "r", "s"}
+	ethAccessListTxFieldNames = []string{"chain_id", "nonce", "gas_price", "gas", "to", "value", "data", "access_list", "v", "r", "s"}
+	ethDynamicFeeTxFieldNames = []string{"chain_id", "nonce", "gas_tip_cap", "gas_fee_cap", "gas", "to", "value", "data", "access_list", "v", "r", "s"}
+)
+
+func (s *server) EthTx(ctx context.Context, req *rpcpb.EthTxRequest) (*rpcpb.EthTxResponse, error) {
+	logger(ctx).Debug("received EthTx request", zap.String("tx-type", req.TxType.String()), zap.Uint64("chain-id", req.ChainId))
+
+	tx, err := newEthTx(req)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := ethSigner(req.TxType, req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	sigHash := signer.Hash(tx).Bytes()
+
+	sig := req.Signature
+	if len(req.PrivateKey) > 0 {
+		key, err := eth_crypto.ToECDSA(req.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		if sig, err = eth_crypto.Sign(sigHash, key); err != nil {
+			return nil, err
+		}
+	}
+	if len(sig) != eth_crypto.SignatureLength {
+		return nil, errEthSignatureLen
+	}
+	signedTx, err := tx.WithSignature(signer, sig)
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	txHash := signedTx.Hash().Bytes()
+
+	resp := &rpcpb.EthTxResponse{
+		ExpectedSigningHash: sigHash,
+		ExpectedSignature:   sig,
+		ExpectedTxBytes:     txBytes,
+		ExpectedTxHash:      txHash,
+		Success:             true,
+	}
+	if d := newDiff(txBytes, req.TxBytes, diff.AnnotatorPath(ethTxFields)); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	var mismatches []error
+	if !bytes.Equal(sigHash, req.SigningHash) {
+		mismatches = append(mismatches, fmt.Errorf("expected signing hash 0x%x, got 0x%x", sigHash, req.SigningHash))
+	}
+	if len(req.PrivateKey) > 0 && len(req.Signature) > 0 {
+		if d := newDiff(sig, req.Signature, diff.AnnotatorPath(secpSignatureFields)); d != nil {
+			mismatches = append(mismatches, fmt.Errorf("signature: %s", d.Summary))
+		}
+	}
+	if !bytes.Equal(txHash, req.TxHash) {
+		mismatches = append(mismatches, fmt.Errorf("expected tx hash 0x%x, got 0x%x", txHash, req.TxHash))
+	}
+	sender, err := types.Sender(signer, signedTx)
+	switch {
+	case err != nil:
+		mismatches = append(mismatches, err)
+	case !bytes.Equal(sender[:], req.Sender):
+		resp.ExpectedSender = sender[:]
+		mismatches = append(mismatches, fmt.Errorf("%w: expected %s, got 0x%x", errEthSenderMismatch, sender, req.Sender))
+	default:
+		resp.ExpectedSender = sender[:]
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages(resp.Message, mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// newEthTx returns the unsigned Ethereum tx of the request.
+func newEthTx(req *rpcpb.EthTxRequest) (*types.Transaction, error) {
+	chainID := new(big.Int).SetUint64(req.ChainId)
+	gasPrice, err := ethBigInt("gas_price", req.GasPrice)
+	if err != nil {
+		return nil, err
+	}
+	gasTipCap, err := ethBigInt("gas_tip_cap", req.GasTipCap)
+	if err != nil {
+		return nil, err
+	}
+	gasFeeCap, err := ethBigInt("gas_fee_cap", req.GasFeeCap)
+	if err != nil {
+		return nil, err
+	}
+	value, err := ethBigInt("value", req.Value)
+	if err != nil {
+		return nil, err
+	}
+	var to *common.Address
+	if len(req.To) > 0 {
+		if len(req.To) != common.AddressLength {
+			return nil, fmt.Errorf("to must be %d bytes, got %d", common.AddressLength, len(req.To))
+		}
+		addr := common.BytesToAddress(req.To)
+		to = &addr
+	}
+
+	accessList := make(types.AccessList, 0, len(req.AccessList))
+	for i, tuple := range req.AccessList {
+		if len(tuple.Address) != common.AddressLength {
+			return nil, wrongLength(fmt.Sprintf("access_list[%d].address", i), fmt.Errorf("must be %d bytes, got %d", common.AddressLength, len(tuple.Address)))
+		}
+		keys := make([]common.Hash, 0, len(tuple.StorageKeys))
+		for j, key := range tuple.StorageKeys {
+			if len(key) != common.HashLength {
+				return nil, wrongLength(fmt.Sprintf("access_list[%d].storage_keys[%d]", i, j), fmt.Errorf("must be %d bytes, got %d", common.HashLength, len(key)))
+			}
+			keys = append(keys, common.BytesToHash(key))
+		}
+		accessList = append(accessList, types.AccessTuple{
+			Address:     common.BytesToAddress(tuple.Address),
+			StorageKeys: keys,
+		})
+	}
+
+	switch req.TxType {
+	case rpcpb.EthTxType_ETH_TX_TYPE_LEGACY:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    req.Nonce,
+			GasPrice: gasPrice,
+			Gas:      req.Gas,
+			To:       to,
+			Value:    value,
+			Data:     req.Data,
+		}), nil
+	case rpcpb.EthTxType_ETH_TX_TYPE_ACCESS_LIST:
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      req.Nonce,
+			GasPrice:   gasPrice,
+			Gas:        req.Gas,
+			To:         to,
+			Value:      value,
+			Data:       req.Data,
+			AccessList: accessList,
+		}), nil
+	case rpcpb.EthTxType_ETH_TX_TYPE_DYNAMIC_FEE:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      req.Nonce,
+			GasTipCap:  gasTipCap,
+			GasFeeCap:  gasFeeCap,
+			Gas:        req.Gas,
+			To:         to,
+			Value:      value,
+			Data:       req.Data,
+			AccessList: accessList,
+		}), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", types.ErrTxTypeNotSupported, req.TxType)
+	}
+}
+
+// ethSigner returns the signer of the tx. A zero chain ID stands for the
+// Homestead signer, which only signs legacy txs.
+// ref. "types.LatestSignerForChainID"
+func ethSigner(txType rpcpb.EthTxType, chainID uint64) (types.Signer, error) {
+	if chainID == 0 {
+		if txType != rpcpb.EthTxType_ETH_TX_TYPE_LEGACY {
+			return nil, fmt.Errorf("%w: %s with chain ID 0", types.ErrTxTypeNotSupported, txType)
+		}
+		return types.HomesteadSigner{}, nil
+	}
+	return types.LatestSignerForChainID(new(big.Int).SetUint64(chainID)), nil
+}
+
+func ethBigInt(name string, b []byte) (*big.Int, error) {
+	if len(b) > 32 {
+		return nil, fmt.Errorf("%s: %w", name, errEthIntTooLarge)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// ethTxFields annotates the RLP fields of a signed Ethereum tx.
+func ethTxFields(b []byte) []diff.Field {
+	var (
+		fields []diff.Field
+		names  = ethLegacyTxFieldNames
+		offset int
+	)
+	// typed txs are prefixed with a type byte, below the RLP list prefixes
+	if len(b) > 0 && b[0] < 0x7f {
+		fields = append(fields, diff.Field{Name: "type", Start: 0, End: 1})
+		switch b[0] {
+		case types.AccessListTxType:
+			names = ethAccessListTxFieldNames
+		case types.DynamicFeeTxType:
+			names = ethDynamicFeeTxFieldNames
+		default:
+			return fields
+		}
+		offset = 1
+	}
+
+	_, content, rest, err := rlp.Split(b[offset:])
+	if err != nil {
+		return fields
+	}
+	offset += len(b[offset:]) - len(rest) - len(content)
+	for _, name := range names {
+		if len(content) == 0 {
+			break
+		}
+		_, _, next, err := rlp.Split(content)
+		if err != nil {
+			break
+		}
+		size := len(content) - len(next)
+		fields = append(fields, diff.Field{Name: name, Start: offset, End: offset + size})
+		offset += size
+		content = next
+	}
+	return fields
+}
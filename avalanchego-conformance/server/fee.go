@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	avmtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"go.uber.org/zap"
+)
+
+var errNoStaticFee = errors.New("tx type has no static fee")
+
+// txFeeConfig returns the fee config of the network, the local network's for
+// networks other than mainnet and fuji.
+func txFeeConfig(networkID uint32) *rpcpb.StaticFeeConfig {
+	cfg := genesis.GetTxFeeConfig(networkID)
+	return &rpcpb.StaticFeeConfig{
+		TxFee:                         cfg.TxFee,
+		CreateAssetTxFee:              cfg.CreateAssetTxFee,
+		CreateSubnetTxFee:             cfg.CreateSubnetTxFee,
+		TransformSubnetTxFee:          cfg.TransformSubnetTxFee,
+		CreateBlockchainTxFee:         cfg.CreateBlockchainTxFee,
+		AddPrimaryNetworkValidatorFee: cfg.AddPrimaryNetworkValidatorFee,
+		AddPrimaryNetworkDelegatorFee: cfg.AddPrimaryNetworkDelegatorFee,
+		AddSubnetValidatorFee:         cfg.AddSubnetValidatorFee,
+		AddSubnetDelegatorFee:         cfg.AddSubnetDelegatorFee,
+	}
+}
+
+// TODO: add a DynamicFee check once avalanchego is upgraded past v1.10.x,
+// which has no dynamic (complexity-based) P-chain fees.
+func (s *server) StaticFee(ctx context.Context, req *rpcpb.StaticFeeRequest) (*rpcpb.StaticFeeResponse, error) {
+	logger(ctx).Debug("received StaticFee request",
+		zap.String("chain", req.Chain.String()),
+		zap.Int("tx-size", len(req.UnsignedTxBytes)),
+	)
+
+	cfg := req.FeeConfig
+	if cfg == nil {
+		cfg = txFeeConfig(req.NetworkId)
+	}
+	var (
+		fee uint64
+		utx interface{}
+		err error
+	)
+	switch req.Chain {
+	case rpcpb.TxChain_TX_CHAIN_PLATFORM:
+		var platformTx txs.UnsignedTx
+		if _, err = txs.Codec.Unmarshal(req.UnsignedTxBytes, &platformTx); err == nil {
+			utx = platformTx
+			fee, err = platformStaticFee(cfg, platformTx)
+		}
+	case rpcpb.TxChain_TX_CHAIN_AVM:
+		var avmTx avmtxs.UnsignedTx
+		if _, err = s.avmParser.Codec().Unmarshal(req.UnsignedTxBytes, &avmTx); err == nil {
+			utx = avmTx
+			fee, err = avmStaticFee(cfg, avmTx)
+		}
+	default:
+		err = fmt.Errorf("%w %s", errUnknownTxChain, req.Chain)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.StaticFeeResponse{
+		ExpectedFee:       fee,
+		ExpectedTxType:    reflect.TypeOf(utx).Elem().Name(),
+		ExpectedFeeConfig: cfg,
+		Success:           true,
+	}
+	if fee != req.Fee {
+		resp.Message = fmt.Sprintf("expected fee %d for %s, got %d", fee, resp.ExpectedTxType, req.Fee)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// platformStaticFee returns the fee the P-chain burns for the tx.
+// ref. "executor.StandardTxExecutor", "executor.verifyAddValidatorTx",
+// "executor.verifyAddPermissionlessValidatorTx"
+func platformStaticFee(cfg *rpcpb.StaticFeeConfig, utx txs.UnsignedTx) (uint64, error) {
+	switch utx := utx.(type) {
+	case *txs.AddValidatorTx:
+		return cfg.AddPrimaryNetworkValidatorFee, nil
+	case *txs.AddDelegatorTx:
+		return cfg.AddPrimaryNetworkDelegatorFee, nil
+	case *txs.AddSubnetValidatorTx:
+		return cfg.AddSubnetValidatorFee, nil
+	case *txs.AddPermissionlessValidatorTx:
+		if utx.Subnet == constants.PrimaryNetworkID {
+			return cfg.AddPrimaryNetworkValidatorFee, nil
+		}
+		return cfg.AddSubnetValidatorFee, nil
+	case *txs.AddPermissionlessDelegatorTx:
+		if utx.Subnet == constants.PrimaryNetworkID {
+			return cfg.AddPrimaryNetworkDelegatorFee, nil
+		}
+		return cfg.AddSubnetDelegatorFee, nil
+	case *txs.CreateSubnetTx:
+		return cfg.CreateSubnetTxFee, nil
+	case *txs.CreateChainTx:
+		return cfg.CreateBlockchainTxFee, nil
+	case *txs.TransformSubnetTx:
+		return cfg.TransformSubnetTxFee, nil
+	case *txs.ImportTx, *txs.ExportTx, *txs.RemoveSubnetValidatorTx:
+		return cfg.TxFee, nil
+	default:
+		return 0, fmt.Errorf("%w: %T", errNoStaticFee, utx)
+	}
+}
+
+// avmStaticFee returns the fee the X-chain burns for the tx.
+// ref. "executor.SyntacticVerifier"
+func avmStaticFee(cfg *rpcpb.StaticFeeConfig, utx avmtxs.UnsignedTx) (uint64, error) {
+	switch utx := utx.(type) {
+	case *avmtxs.CreateAssetTx:
+		return cfg.CreateAssetTxFee, nil
+	case *avmtxs.BaseTx, *avmtxs.OperationTx, *avmtxs.ImportTx, *avmtxs.ExportTx:
+		return cfg.TxFee, nil
+	default:
+		return 0, fmt.Errorf("%w: %T", errNoStaticFee, utx)
+	}
+}
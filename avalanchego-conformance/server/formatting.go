@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/utils/cb58"
+	"go.uber.org/zap"
+)
+
+// ref. "base58.BTCAlphabet"
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+func (s *server) Cb58Encode(ctx context.Context, req *rpcpb.Cb58EncodeRequest) (*rpcpb.Cb58EncodeResponse, error) {
+	logger(ctx).Debug("received Cb58Encode request", zap.Int("data-size", len(req.Data)))
+
+	encoded, err := cb58.Encode(req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.Cb58EncodeResponse{
+		ExpectedEncoded: encoded,
+		Success:         true,
+	}
+	if encoded != req.Encoded {
+		resp.Message = fmt.Sprintf("expected encoded %q, got %q", encoded, req.Encoded)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) Cb58Decode(ctx context.Context, req *rpcpb.Cb58DecodeRequest) (*rpcpb.Cb58DecodeResponse, error) {
+	logger(ctx).Debug("received Cb58Decode request", zap.Int("encoded-size", len(req.Encoded)))
+
+	resp := &rpcpb.Cb58DecodeResponse{
+		Success: true,
+	}
+	data, err := cb58.Decode(req.Encoded)
+	if err != nil {
+		resp.ExpectedErrorClass = cb58ErrorClass(req.Encoded, err)
+		resp.ExpectedError = err.Error()
+	} else {
+		resp.ExpectedData = data
+	}
+
+	switch {
+	case resp.ExpectedErrorClass != req.ErrorClass:
+		resp.Message = fmt.Sprintf("expected error class %s, got %s", resp.ExpectedErrorClass, req.ErrorClass)
+		resp.Success = false
+	case err == nil && !bytes.Equal(data, req.Data):
+		resp.Message = fmt.Sprintf("expected data 0x%x, got 0x%x", data, req.Data)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// cb58ErrorClass classifies the error of "cb58.Decode".
+func cb58ErrorClass(str string, err error) rpcpb.Cb58ErrorClass {
+	switch {
+	case errors.Is(err, cb58.ErrMissingChecksum):
+		return rpcpb.Cb58ErrorClass_CB58_ERROR_CLASS_TOO_SHORT
+	case !errors.Is(err, cb58.ErrBase58Decoding):
+		return rpcpb.Cb58ErrorClass_CB58_ERROR_CLASS_BAD_CHECKSUM
+	case str == "":
+		return rpcpb.Cb58ErrorClass_CB58_ERROR_CLASS_EMPTY
+	case strings.IndexFunc(str, func(r rune) bool { return !strings.ContainsRune(base58Alphabet, r) }) >= 0:
+		return rpcpb.Cb58ErrorClass_CB58_ERROR_CLASS_BAD_CHARACTER
+	default:
+		return rpcpb.Cb58ErrorClass_CB58_ERROR_CLASS_BASE58_DECODING
+	}
+}
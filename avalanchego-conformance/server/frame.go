@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"go.uber.org/zap"
+)
+
+func (s *server) WriteFrame(ctx context.Context, req *rpcpb.WriteFrameRequest) (*rpcpb.WriteFrameResponse, error) {
+	logger(ctx).Debug("received WriteFrame request", zap.Int("payload-size", len(req.Payload)))
+
+	resp := &rpcpb.WriteFrameResponse{
+		MaxMsgLength: constants.DefaultMaxMessageSize,
+		Success:      true,
+	}
+	expected, err := writeFrame(req.Payload)
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	} else {
+		resp.ExpectedSerializedFrame = expected
+	}
+
+	switch {
+	case (err != nil) != req.Rejected:
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	case req.Rejected:
+	default:
+		if d := newDiff(expected, req.SerializedFrame, framePath); d != nil {
+			resp.Diff = d
+			resp.Message = d.Summary
+			resp.Success = false
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) ReadFrame(ctx context.Context, req *rpcpb.ReadFrameRequest) (*rpcpb.ReadFrameResponse, error) {
+	logger(ctx).Debug("received ReadFrame request", zap.Int("stream-size", len(req.Stream)))
+
+	resp := &rpcpb.ReadFrameResponse{
+		MaxMsgLength: constants.DefaultMaxMessageSize,
+		Success:      true,
+	}
+	expected, frameLen, err := readFrame(bytes.NewReader(req.Stream))
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	} else {
+		resp.ExpectedPayload = expected
+		resp.ExpectedFrameLength = uint32(frameLen)
+	}
+
+	switch {
+	case (err != nil) != req.Rejected:
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	case req.Rejected:
+	default:
+		if d := newDiff(expected, req.Payload, nil); d != nil {
+			resp.Diff = d
+			resp.Message = d.Summary
+			resp.Success = false
+		}
+	}
+	return resp, nil
+}
+
+// writeFrame frames the payload as a peer writes it, prefixed with its
+// length. Payloads above the maximum message size are rejected.
+// ref. "peer.writeMessages", "peer.writeMsgLen"
+func writeFrame(payload []byte) ([]byte, error) {
+	if len(payload) > constants.DefaultMaxMessageSize {
+		return nil, fmt.Errorf("%w: %d > %d", errMaxMsgLengthExceeded, len(payload), constants.DefaultMaxMessageSize)
+	}
+	return frameMsg(payload), nil
+}
+
+// readFrame reads the next frame of the stream as a peer reads it, and
+// returns its payload and the length of the frame. Bytes past the frame are
+// the next frames, and are not read.
+// ref. "peer.readMessages", "peer.readMsgLen"
+func readFrame(r io.Reader) ([]byte, int, error) {
+	prefix := make([]byte, wrappers.IntLen)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, 0, fmt.Errorf("%w: failed to read the length prefix (%w)", errInvalidMsgLength, err)
+	}
+	// the most significant bit is the legacy codec flag, which is ignored
+	msgLen := binary.BigEndian.Uint32(prefix) &^ msgLenCodecBit
+	if msgLen > constants.DefaultMaxMessageSize {
+		return nil, 0, fmt.Errorf("%w: %d > %d", errMaxMsgLengthExceeded, msgLen, constants.DefaultMaxMessageSize)
+	}
+	payload := make([]byte, msgLen)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, 0, fmt.Errorf("%w: length prefix %d, failed to read the payload (%w)", errInvalidMsgLength, msgLen, err)
+	}
+	return payload, wrappers.IntLen + int(msgLen), nil
+}
+
+// framePath resolves the paths of frames, whose payloads are opaque.
+func framePath(_ []byte, offset int) string {
+	if offset < wrappers.IntLen {
+		return "length prefix"
+	}
+	return "payload"
+}
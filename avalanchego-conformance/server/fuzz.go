@@ -0,0 +1,241 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	maxFuzzCount     = 1000
+	defaultFuzzBytes = 64
+	defaultFuzzItems = 4
+
+	// fuzzAttempts bounds the random requests tried per input, since the
+	// random values of some fields (e.g., enums or lengths) are rejected.
+	fuzzAttempts = 100
+	// maxFuzzDepth bounds the nesting of generated messages.
+	maxFuzzDepth = 4
+)
+
+func (s *server) Fuzz(ctx context.Context, req *rpcpb.FuzzRequest) (*rpcpb.FuzzResponse, error) {
+	logger(ctx).Debug("fuzzing",
+		zap.String("request-type", req.RequestType),
+		zap.Uint64("seed", req.Seed),
+		zap.Uint32("count", req.Count),
+	)
+
+	mt, err := s.fuzzRequestType(req.RequestType)
+	if err != nil {
+		return nil, err
+	}
+	count := req.Count
+	if count == 0 {
+		count = 1
+	}
+	if count > maxFuzzCount {
+		return nil, invalidField("count", fmt.Errorf("%d exceeds %d", count, maxFuzzCount))
+	}
+	f := &fuzzer{
+		maxBytes: int(req.MaxBytes),
+		maxItems: int(req.MaxItems),
+		checked:  checkedFields(s.unaryMethods[mt.Descriptor().FullName()].output),
+	}
+	if f.maxBytes == 0 {
+		f.maxBytes = defaultFuzzBytes
+	}
+	if f.maxItems == 0 {
+		f.maxItems = defaultFuzzItems
+	}
+
+	resp := &rpcpb.FuzzResponse{}
+	for i := uint64(0); i < uint64(count); i++ {
+		seed := req.Seed + i
+		input, err := s.fuzzInput(ctx, f, mt, seed)
+		if err != nil {
+			return nil, err
+		}
+		resp.Inputs = append(resp.Inputs, input)
+	}
+	return resp, nil
+}
+
+// fuzzRequestType returns the message type of a check request type.
+func (s *server) fuzzRequestType(name string) (protoreflect.MessageType, error) {
+	m, ok := s.unaryMethods[protoreflect.FullName(name)]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "no registered method for request type %q", name)
+	}
+	if m.output.Fields().ByName(successField) == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not a conformance check request", name)
+	}
+	return protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(name))
+}
+
+// fuzzInput generates the request of the seed, drawing random requests
+// until one is accepted.
+func (s *server) fuzzInput(ctx context.Context, f *fuzzer, mt protoreflect.MessageType, seed uint64) (*rpcpb.FuzzInput, error) {
+	// math/rand, since inputs must be reproducible from the seed
+	f.rng = rand.New(rand.NewSource(int64(seed)))
+	for attempt := 0; attempt < fuzzAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		check := mt.New()
+		f.fill(check, 0)
+		resp, err := s.recoverDispatch(ctx, check.Interface())
+		if err != nil {
+			continue
+		}
+		clearResult(resp)
+
+		input := &rpcpb.FuzzInput{Seed: seed}
+		if input.Request, err = anypb.New(check.Interface()); err != nil {
+			return nil, err
+		}
+		if input.Response, err = anypb.New(resp); err != nil {
+			return nil, err
+		}
+		return input, nil
+	}
+	return nil, status.Errorf(codes.FailedPrecondition, "no random %s accepted after %d attempts (seed %d)", mt.Descriptor().FullName(), fuzzAttempts, seed)
+}
+
+// checkedFields returns the names of the request fields checked against
+// the canonical bytes of the response (e.g., "serialized_msg" for
+// "expected_serialized_msg"), which are left unset.
+func checkedFields(output protoreflect.MessageDescriptor) map[protoreflect.Name]bool {
+	checked := make(map[protoreflect.Name]bool)
+	fields := output.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		name := string(fields.Get(i).Name())
+		if strings.HasPrefix(name, "expected_") {
+			checked[protoreflect.Name(strings.TrimPrefix(name, "expected_"))] = true
+		}
+	}
+	return checked
+}
+
+// fuzzer fills messages with random values. Bytes fields named after
+// identifiers and keys (e.g., "chain_id" or "node_id") are of their fixed
+// length, so that they are accepted.
+type fuzzer struct {
+	rng      *rand.Rand
+	maxBytes int
+	maxItems int
+	checked  map[protoreflect.Name]bool
+}
+
+func (f *fuzzer) fill(m protoreflect.Message, depth int) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if depth == 0 && f.skip(fd.Name()) {
+			continue
+		}
+		if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			// set a single field of the oneof, picked by its first field
+			if oneof.Fields().Get(0) != fd {
+				continue
+			}
+			fd = oneof.Fields().Get(f.rng.Intn(oneof.Fields().Len()))
+		}
+
+		switch {
+		case fd.IsMap():
+			continue
+		case fd.IsList():
+			l := m.Mutable(fd).List()
+			for n := f.rng.Intn(f.maxItems + 1); n > 0; n-- {
+				if fd.Kind() == protoreflect.MessageKind {
+					if depth+1 < maxFuzzDepth {
+						f.fill(l.AppendMutable().Message(), depth+1)
+					}
+					continue
+				}
+				l.Append(f.value(fd))
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			if depth+1 < maxFuzzDepth {
+				f.fill(m.Mutable(fd).Message(), depth+1)
+			}
+		default:
+			m.Set(fd, f.value(fd))
+		}
+	}
+}
+
+// skip returns true for request fields left unset: the fields checked
+// against the canonical bytes, and compression options, so that the
+// canonical bytes are uncompressed.
+func (f *fuzzer) skip(name protoreflect.Name) bool {
+	return f.checked[name] || name == "gzip_compressed" || name == "compression_type"
+}
+
+func (f *fuzzer) value(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(f.rng.Intn(2) == 1)
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		return protoreflect.ValueOfEnum(values.Get(f.rng.Intn(values.Len())).Number())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(f.rng.Uint32()))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(f.rng.Uint32())
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(int64(f.rng.Uint64()))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(f.rng.Uint64())
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(f.rng.Float32())
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(f.rng.Float64())
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(f.string())
+	default:
+		return protoreflect.ValueOfBytes(f.bytes(f.bytesLen(fd.Name())))
+	}
+}
+
+// bytesLen returns the length of a random bytes field of the name.
+func (f *fuzzer) bytesLen(name protoreflect.Name) int {
+	n := strings.TrimSuffix(string(name), "s")
+	switch {
+	case n == "node_id" || n == "short_id" || n == "address":
+		return len(ids.ShortID{})
+	case n == "id" || strings.HasSuffix(n, "_id") || strings.HasSuffix(n, "_chain") || n == "private_key":
+		return len(ids.ID{})
+	default:
+		return f.rng.Intn(f.maxBytes + 1)
+	}
+}
+
+func (f *fuzzer) bytes(n int) []byte {
+	b := make([]byte, n)
+	_, _ = f.rng.Read(b)
+	return b
+}
+
+const fuzzAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+func (f *fuzzer) string() string {
+	b := make([]byte, f.rng.Intn(17))
+	for i := range b {
+		b[i] = fuzzAlphabet[f.rng.Intn(len(fuzzAlphabet))]
+	}
+	return string(b)
+}
@@ -0,0 +1,436 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// maxFuzzSessions bounds the open sessions, since they are only
+	// released when closed by the client.
+	maxFuzzSessions = 64
+	// maxFuzzCases bounds the divergent cases tracked per session.
+	maxFuzzCases = 1000
+	// maxFuzzCaseBytes bounds the input and the received bytes of a case,
+	// as avalanchego bounds messages.
+	maxFuzzCaseBytes = constants.DefaultMaxMessageSize
+	// maxFuzzSessionBytes bounds the inputs and received bytes of the cases
+	// reported to a session.
+	maxFuzzSessionBytes = 64 << 20
+	// maxVariantsChecked bounds the variants checked per case.
+	maxVariantsChecked = 10000
+)
+
+type fuzzSessions struct {
+	mu       sync.Mutex
+	lastID   uint64
+	sessions map[string]*fuzzSession
+}
+
+type fuzzSession struct {
+	mu          sync.Mutex
+	requestType protoreflect.MessageType
+	cases       []*fuzzCase
+	// reported bytes of the cases
+	bytes int
+}
+
+// fuzzCase is a divergent input being minimized: the smallest input the
+// client diverged on so far is reduced by a single field at a time, and
+// the variants the client still diverges on replace it.
+type fuzzCase struct {
+	id       uint32
+	message  string
+	original proto.Message
+	received []byte
+
+	best         proto.Message
+	bestResponse proto.Message
+	bestReceived []byte
+
+	// reduced variants of best left to check
+	variants []protoreflect.Message
+	// variant (and response) waiting for the client to check it
+	pending         proto.Message
+	pendingResponse proto.Message
+
+	checked    uint32
+	reductions uint32
+	done       bool
+}
+
+func (s *server) StartFuzzSession(ctx context.Context, req *rpcpb.StartFuzzSessionRequest) (*rpcpb.StartFuzzSessionResponse, error) {
+	mt, err := s.fuzzRequestType(req.RequestType)
+	if err != nil {
+		return nil, err
+	}
+
+	s.fuzz.mu.Lock()
+	defer s.fuzz.mu.Unlock()
+	if len(s.fuzz.sessions) >= maxFuzzSessions {
+		return nil, status.Errorf(codes.ResourceExhausted, "%d fuzz sessions open, close one with FuzzSessionReport", len(s.fuzz.sessions))
+	}
+	if s.fuzz.sessions == nil {
+		s.fuzz.sessions = make(map[string]*fuzzSession)
+	}
+	s.fuzz.lastID++
+	id := strconv.FormatUint(s.fuzz.lastID, 10)
+	s.fuzz.sessions[id] = &fuzzSession{requestType: mt}
+
+	logger(ctx).Info("started fuzz session", zap.String("session-id", id), zap.String("request-type", req.RequestType))
+	return &rpcpb.StartFuzzSessionResponse{SessionId: id}, nil
+}
+
+func (s *server) ReportDivergence(ctx context.Context, req *rpcpb.ReportDivergenceRequest) (*rpcpb.MinimizeStep, error) {
+	sess, err := s.fuzzSession(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Request == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	input, err := req.Request.UnmarshalNew()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal request (%v)", err)
+	}
+	if got, want := input.ProtoReflect().Descriptor().FullName(), sess.requestType.Descriptor().FullName(); got != want {
+		return nil, status.Errorf(codes.InvalidArgument, "request is a %s, session is of %s", got, want)
+	}
+	inputSize := proto.Size(input)
+	if inputSize > maxFuzzCaseBytes {
+		return nil, invalidField("request", fmt.Errorf("%d bytes > %d", inputSize, maxFuzzCaseBytes))
+	}
+	if len(req.Received) > maxFuzzCaseBytes {
+		return nil, invalidField("received", fmt.Errorf("%d bytes > %d", len(req.Received), maxFuzzCaseBytes))
+	}
+	resp, err := s.recoverDispatch(ctx, input)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request rejected (%v)", err)
+	}
+	clearResult(resp)
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if len(sess.cases) >= maxFuzzCases {
+		return nil, status.Errorf(codes.ResourceExhausted, "session has %d cases", len(sess.cases))
+	}
+	caseBytes := inputSize + len(req.Received)
+	if sess.bytes+caseBytes > maxFuzzSessionBytes {
+		return nil, status.Errorf(codes.ResourceExhausted, "session cases have %d bytes, %d more exceed %d", sess.bytes, caseBytes, maxFuzzSessionBytes)
+	}
+	sess.bytes += caseBytes
+	c := &fuzzCase{
+		id:           uint32(len(sess.cases)),
+		message:      req.Message,
+		original:     input,
+		received:     req.Received,
+		best:         input,
+		bestResponse: resp,
+		bestReceived: req.Received,
+		variants:     variants(input.ProtoReflect()),
+	}
+	sess.cases = append(sess.cases, c)
+
+	logger(ctx).Debug("reported divergence", zap.String("session-id", req.SessionId), zap.Uint32("case-id", c.id))
+	return s.nextVariant(ctx, c)
+}
+
+func (s *server) ReportVariant(ctx context.Context, req *rpcpb.ReportVariantRequest) (*rpcpb.MinimizeStep, error) {
+	sess, err := s.fuzzSession(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Received) > maxFuzzCaseBytes {
+		return nil, invalidField("received", fmt.Errorf("%d bytes > %d", len(req.Received), maxFuzzCaseBytes))
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if int(req.CaseId) >= len(sess.cases) {
+		return nil, status.Errorf(codes.NotFound, "case %d not found", req.CaseId)
+	}
+	c := sess.cases[req.CaseId]
+	if c.pending == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no variant of case %d to check", c.id)
+	}
+	c.checked++
+	if req.Diverges {
+		c.reductions++
+		c.best, c.bestResponse, c.bestReceived = c.pending, c.pendingResponse, req.Received
+		c.variants = variants(c.best.ProtoReflect())
+	}
+	c.pending, c.pendingResponse = nil, nil
+	return s.nextVariant(ctx, c)
+}
+
+func (s *server) FuzzSessionReport(ctx context.Context, req *rpcpb.FuzzSessionReportRequest) (*rpcpb.FuzzSessionReportResponse, error) {
+	sess, err := s.fuzzSession(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	if req.CloseSession {
+		s.fuzz.mu.Lock()
+		delete(s.fuzz.sessions, req.SessionId)
+		s.fuzz.mu.Unlock()
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	resp := &rpcpb.FuzzSessionReportResponse{
+		RequestType: string(sess.requestType.Descriptor().FullName()),
+	}
+	for _, c := range sess.cases {
+		dc := &rpcpb.DivergentCase{
+			CaseId:            c.id,
+			Message:           c.message,
+			OriginalReceived:  c.received,
+			MinimizedReceived: c.bestReceived,
+			VariantsChecked:   c.checked,
+			Reductions:        c.reductions,
+			Minimized:         c.done,
+		}
+		if dc.OriginalRequest, err = anypb.New(c.original); err != nil {
+			return nil, err
+		}
+		if dc.MinimizedRequest, err = anypb.New(c.best); err != nil {
+			return nil, err
+		}
+		if dc.MinimizedResponse, err = anypb.New(c.bestResponse); err != nil {
+			return nil, err
+		}
+		resp.Cases = append(resp.Cases, dc)
+	}
+	return resp, nil
+}
+
+func (s *server) fuzzSession(id string) (*fuzzSession, error) {
+	s.fuzz.mu.Lock()
+	defer s.fuzz.mu.Unlock()
+	sess, ok := s.fuzz.sessions[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "fuzz session %q not found", id)
+	}
+	return sess, nil
+}
+
+// nextVariant returns the next variant of the case avalanchego accepts, or
+// marks the case as done if none is left.
+func (s *server) nextVariant(ctx context.Context, c *fuzzCase) (*rpcpb.MinimizeStep, error) {
+	step := &rpcpb.MinimizeStep{CaseId: c.id}
+	for !c.done && c.pending == nil {
+		if len(c.variants) == 0 || c.checked >= maxVariantsChecked {
+			c.done = true
+			c.variants = nil
+			break
+		}
+		v := c.variants[0].Interface()
+		c.variants = c.variants[1:]
+		resp, err := s.recoverDispatch(ctx, v)
+		if err != nil {
+			// variants avalanchego rejects are not worth checking
+			continue
+		}
+		clearResult(resp)
+		c.pending, c.pendingResponse = v, resp
+	}
+
+	step.Done = c.done
+	step.VariantsChecked = c.checked
+	step.Reductions = c.reductions
+	if c.pending != nil {
+		var err error
+		if step.Request, err = anypb.New(c.pending); err != nil {
+			return nil, err
+		}
+		if step.Response, err = anypb.New(c.pendingResponse); err != nil {
+			return nil, err
+		}
+	}
+	return step, nil
+}
+
+// variants returns the reductions of the message by a single field, the
+// largest first: cleared messages and lists, list halves, removed list
+// items, and reduced scalars (see reducedValues), recursively.
+func variants(m protoreflect.Message) []protoreflect.Message {
+	var vs []protoreflect.Message
+	with := func(mutate func(c protoreflect.Message)) {
+		c := proto.Clone(m.Interface()).ProtoReflect()
+		mutate(c)
+		vs = append(vs, c)
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			with(func(c protoreflect.Message) { c.Clear(fd) })
+
+		case fd.IsList():
+			with(func(c protoreflect.Message) { c.Clear(fd) })
+			n := v.List().Len()
+			if n > 1 {
+				with(func(c protoreflect.Message) { c.Mutable(fd).List().Truncate(n / 2) })
+				with(func(c protoreflect.Message) {
+					l := c.Mutable(fd).List()
+					for i := 0; i < n-n/2; i++ {
+						l.Set(i, l.Get(n/2+i))
+					}
+					l.Truncate(n - n/2)
+				})
+				for i := 0; i < n; i++ {
+					i := i
+					with(func(c protoreflect.Message) { removeItem(c.Mutable(fd).List(), i) })
+				}
+			}
+			for i := 0; i < n; i++ {
+				i := i
+				for _, rv := range reducedItems(fd, v.List().Get(i)) {
+					rv := rv
+					with(func(c protoreflect.Message) { c.Mutable(fd).List().Set(i, rv) })
+				}
+			}
+
+		case fd.Kind() == protoreflect.MessageKind:
+			with(func(c protoreflect.Message) { c.Clear(fd) })
+			for _, r := range variants(v.Message()) {
+				r := r
+				with(func(c protoreflect.Message) { c.Set(fd, protoreflect.ValueOfMessage(r)) })
+			}
+
+		default:
+			for _, rv := range reducedValues(fd, v) {
+				rv := rv
+				with(func(c protoreflect.Message) { c.Set(fd, rv) })
+			}
+		}
+		return true
+	})
+	return vs
+}
+
+func removeItem(l protoreflect.List, i int) {
+	for j := i; j < l.Len()-1; j++ {
+		l.Set(j, l.Get(j+1))
+	}
+	l.Truncate(l.Len() - 1)
+}
+
+// reducedItems returns the reductions of a list item.
+func reducedItems(fd protoreflect.FieldDescriptor, v protoreflect.Value) []protoreflect.Value {
+	if fd.Kind() != protoreflect.MessageKind {
+		return reducedValues(fd, v)
+	}
+	var rvs []protoreflect.Value
+	for _, r := range variants(v.Message()) {
+		rvs = append(rvs, protoreflect.ValueOfMessage(r))
+	}
+	return rvs
+}
+
+// reducedValues returns the reductions of a scalar value: the zero value,
+// then the halves of bytes and strings, halved numbers, and zeroed bytes
+// (e.g., for fixed-length identifiers).
+func reducedValues(fd protoreflect.FieldDescriptor, v protoreflect.Value) []protoreflect.Value {
+	var rvs []protoreflect.Value
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		if v.Bool() {
+			rvs = append(rvs, protoreflect.ValueOfBool(false))
+		}
+	case protoreflect.EnumKind:
+		if v.Enum() != 0 {
+			rvs = append(rvs, protoreflect.ValueOfEnum(0))
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		for _, r := range reducedInts(v.Int()) {
+			rvs = append(rvs, protoreflect.ValueOfInt32(int32(r)))
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		for _, r := range reducedInts(v.Int()) {
+			rvs = append(rvs, protoreflect.ValueOfInt64(r))
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		for _, r := range reducedUints(v.Uint()) {
+			rvs = append(rvs, protoreflect.ValueOfUint32(uint32(r)))
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		for _, r := range reducedUints(v.Uint()) {
+			rvs = append(rvs, protoreflect.ValueOfUint64(r))
+		}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if f := v.Float(); f != 0 {
+			rvs = append(rvs, floatValue(fd, 0), floatValue(fd, f/2))
+		}
+	case protoreflect.StringKind:
+		if r := []rune(v.String()); len(r) > 0 {
+			rvs = append(rvs, protoreflect.ValueOfString(""))
+			if len(r) > 1 {
+				rvs = append(rvs,
+					protoreflect.ValueOfString(string(r[:len(r)/2])),
+					protoreflect.ValueOfString(string(r[len(r)/2:])),
+				)
+			}
+		}
+	case protoreflect.BytesKind:
+		b := v.Bytes()
+		if len(b) == 0 {
+			break
+		}
+		rvs = append(rvs, protoreflect.ValueOfBytes(nil))
+		if len(b) > 1 {
+			rvs = append(rvs,
+				protoreflect.ValueOfBytes(append([]byte(nil), b[:len(b)/2]...)),
+				protoreflect.ValueOfBytes(append([]byte(nil), b[len(b)/2:]...)),
+			)
+		}
+		for _, x := range b {
+			if x != 0 {
+				rvs = append(rvs, protoreflect.ValueOfBytes(make([]byte, len(b))))
+				break
+			}
+		}
+	}
+	return rvs
+}
+
+func reducedInts(v int64) []int64 {
+	switch {
+	case v == 0:
+		return nil
+	case v/2 == 0:
+		return []int64{0}
+	default:
+		return []int64{0, v / 2}
+	}
+}
+
+func reducedUints(v uint64) []uint64 {
+	switch {
+	case v == 0:
+		return nil
+	case v/2 == 0:
+		return []uint64{0}
+	default:
+		return []uint64{0, v / 2}
+	}
+}
+
+func floatValue(fd protoreflect.FieldDescriptor, f float64) protoreflect.Value {
+	if fd.Kind() == protoreflect.FloatKind {
+		return protoreflect.ValueOfFloat32(float32(f))
+	}
+	return protoreflect.ValueOfFloat64(f)
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// newGateway returns the grpc-gateway mux serving every unary method of the
+// registered services as REST/JSON at "POST /<service>/<method>" (e.g.,
+// "POST /rpcpb.KeyService/BlsSignature"), proxied to the gRPC server over
+// conn. Bytes fields are base64-encoded, as in the proto3 JSON mapping.
+// Liveness and readiness probes are served at "GET /healthz" and
+// "GET /readyz".
+func (s *server) newGateway(conn *grpc.ClientConn) (*runtime.ServeMux, error) {
+	mux := runtime.NewServeMux()
+	for inputName, m := range s.unaryMethods {
+		input, err := protoregistry.GlobalTypes.FindMessageByName(inputName)
+		if err != nil {
+			return nil, err
+		}
+		output, err := unaryMethodOutput(m)
+		if err != nil {
+			return nil, err
+		}
+		fullMethod := fmt.Sprintf("/%s/%s", m.serviceName, m.desc.MethodName)
+		if err := mux.HandlePath(http.MethodPost, fullMethod, gatewayHandler(mux, conn, fullMethod, input, output)); err != nil {
+			return nil, err
+		}
+		zap.L().Debug("registered gateway path", zap.String("path", fullMethod))
+	}
+	if err := s.handleHealthPaths(mux); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+
+func unaryMethodOutput(m unaryMethod) (protoreflect.MessageType, error) {
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(m.serviceName))
+	if err != nil {
+		return nil, err
+	}
+	md := d.(protoreflect.ServiceDescriptor).Methods().ByName(protoreflect.Name(m.desc.MethodName))
+	return protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+}
+
+// gatewayHandler mirrors the handlers generated by protoc-gen-grpc-gateway
+// for methods with a "*" body.
+func gatewayHandler(
+	mux *runtime.ServeMux,
+	conn *grpc.ClientConn,
+	fullMethod string,
+	input protoreflect.MessageType,
+	output protoreflect.MessageType,
+) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		ctx, err := runtime.AnnotateContext(ctx, mux, req, fullMethod, runtime.WithHTTPPathPattern(fullMethod))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		in := input.New().Interface()
+		if err := inboundMarshaler.NewDecoder(req.Body).Decode(in); err != nil && err != io.EOF {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+
+		out := output.New().Interface()
+		var md runtime.ServerMetadata
+		if err := conn.Invoke(ctx, fullMethod, in, out, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD)); err != nil {
+			runtime.HTTPError(runtime.NewServerMetadataContext(ctx, md), mux, outboundMarshaler, w, req, err)
+			return
+		}
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, req, out, mux.GetForwardResponseOptions()...)
+	}
+}
+
+// serveGateway serves the gateway on GwPort, returning the channel its
+// serving error is sent on.
+func (s *server) serveGateway(ctx context.Context) (chan error, error) {
+	conn, err := s.dialSelf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mux, err := s.newGateway(conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", s.cfg.GwPort))
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	s.gwConn = conn
+	s.gwAddr = ln.Addr()
+	s.gwServer = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: s.cfg.DialTimeout,
+		TLSConfig:         s.tlsConfig,
+	}
+	errc := make(chan error)
+	go func() {
+		zap.L().Info("serving gRPC gateway", zap.Uint16("port", s.cfg.GwPort), zap.Bool("tls", s.tlsConfig != nil))
+		if s.tlsConfig != nil {
+			errc <- s.gwServer.ServeTLS(ln, "", "")
+			return
+		}
+		errc <- s.gwServer.Serve(ln)
+	}()
+	return errc, nil
+}
+
+// dialSelf dials the gRPC server, for the gateway to proxy requests to.
+func (s *server) dialSelf(ctx context.Context) (*grpc.ClientConn, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.DialTimeout)
+	defer cancel()
+	creds := insecure.NewCredentials()
+	if s.tlsConfig != nil {
+		creds = selfDialCredentials(s.tlsConfig)
+	}
+	target := fmt.Sprintf("localhost:%d", addrPort(s.ln.Addr()))
+	if s.cfg.GRPCSocket != "" {
+		target = "unix:" + s.cfg.GRPCSocket
+	}
+	return grpc.DialContext(
+		ctx,
+		target,
+		grpc.WithBlock(),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(s.cfg.callOptions()...),
+	)
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	pgenesis "github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"go.uber.org/zap"
+)
+
+// ref. "genesis.errNoStakers"
+var errNoGenesisStakers = errors.New("initial stakers must be > 0")
+
+func (s *server) ParseGenesis(ctx context.Context, req *rpcpb.ParseGenesisRequest) (*rpcpb.ParseGenesisResponse, error) {
+	logger(ctx).Debug("received ParseGenesis request", zap.Int("genesis-size", len(req.GenesisBytes)))
+
+	resp := &rpcpb.ParseGenesisResponse{Success: true}
+
+	gen, err := pgenesis.Parse(req.GenesisBytes)
+	if err != nil {
+		resp.Message = fmt.Sprintf("failed to parse genesis (%v)", err)
+		resp.Success = false
+		return resp, nil
+	}
+	expected, err := json.Marshal(gen)
+	if err != nil {
+		return nil, err
+	}
+	genesisID := hashing.ComputeHash256Array(req.GenesisBytes)
+
+	resp.ExpectedJson = string(expected)
+	resp.ExpectedGenesisId = genesisID[:]
+	if req.Json != "" {
+		if resp.Message, resp.Success, err = compareJSON(expected, "json", req.Json); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) BuildGenesis(ctx context.Context, req *rpcpb.BuildGenesisRequest) (*rpcpb.BuildGenesisResponse, error) {
+	logger(ctx).Debug("received BuildGenesis request",
+		zap.Uint32("network-id", req.NetworkId),
+		zap.Int("allocations", len(req.Allocations)),
+		zap.Int("initial-stakers", len(req.InitialStakers)),
+	)
+
+	config := &genesis.Config{
+		NetworkID:                  req.NetworkId,
+		StartTime:                  req.StartTime,
+		InitialStakeDuration:       req.InitialStakeDuration,
+		InitialStakeDurationOffset: req.InitialStakeDurationOffset,
+		CChainGenesis:              req.CChainGenesis,
+		Message:                    req.Message,
+	}
+	for i, a := range req.Allocations {
+		ethAddr, err := toShortID(fmt.Sprintf("allocations[%d].eth_addr", i), a.EthAddr)
+		if err != nil {
+			return nil, err
+		}
+		avaxAddr, err := toShortID(fmt.Sprintf("allocations[%d].avax_addr", i), a.AvaxAddr)
+		if err != nil {
+			return nil, err
+		}
+		allocation := genesis.Allocation{
+			ETHAddr:       ethAddr,
+			AVAXAddr:      avaxAddr,
+			InitialAmount: a.InitialAmount,
+		}
+		for _, unlock := range a.UnlockSchedule {
+			allocation.UnlockSchedule = append(allocation.UnlockSchedule, genesis.LockedAmount{
+				Amount:   unlock.Amount,
+				Locktime: unlock.Locktime,
+			})
+		}
+		config.Allocations = append(config.Allocations, allocation)
+	}
+	for i, addr := range req.InitialStakedFunds {
+		id, err := toShortID(fmt.Sprintf("initial_staked_funds[%d]", i), addr)
+		if err != nil {
+			return nil, err
+		}
+		config.InitialStakedFunds = append(config.InitialStakedFunds, id)
+	}
+	for i, staker := range req.InitialStakers {
+		nodeID, err := toNodeID(fmt.Sprintf("initial_stakers[%d].node_id", i), staker.NodeId)
+		if err != nil {
+			return nil, err
+		}
+		rewardAddr, err := toShortID(fmt.Sprintf("initial_stakers[%d].reward_address", i), staker.RewardAddress)
+		if err != nil {
+			return nil, err
+		}
+		config.InitialStakers = append(config.InitialStakers, genesis.Staker{
+			NodeID:        nodeID,
+			RewardAddress: rewardAddr,
+			DelegationFee: staker.DelegationFee,
+		})
+	}
+	// "genesis.FromConfig" splits the staked funds among the initial stakers
+	// without checking there are any
+	if len(config.InitialStakers) == 0 {
+		return nil, errNoGenesisStakers
+	}
+
+	genesisBytes, avaxAssetID, err := genesis.FromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	xChain, err := genesis.VMGenesis(genesisBytes, constants.AVMID)
+	if err != nil {
+		return nil, err
+	}
+	cChain, err := genesis.VMGenesis(genesisBytes, constants.EVMID)
+	if err != nil {
+		return nil, err
+	}
+	xChainID, cChainID := xChain.ID(), cChain.ID()
+	genesisID := hashing.ComputeHash256Array(genesisBytes)
+
+	resp := &rpcpb.BuildGenesisResponse{
+		ExpectedGenesisBytes: genesisBytes,
+		ExpectedGenesisId:    genesisID[:],
+		ExpectedAvaxAssetId:  avaxAssetID[:],
+		ExpectedXChainId:     xChainID[:],
+		ExpectedCChainId:     cChainID[:],
+		Success:              true,
+	}
+	if d := newDiff(genesisBytes, req.GenesisBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	var mismatches []error
+	if !bytes.Equal(avaxAssetID[:], req.AvaxAssetId) {
+		mismatches = append(mismatches, fmt.Errorf("expected AVAX asset ID %s, got 0x%x", avaxAssetID, req.AvaxAssetId))
+	}
+	if !bytes.Equal(xChainID[:], req.XChainId) {
+		mismatches = append(mismatches, fmt.Errorf("expected X-chain ID %s, got 0x%x", xChainID, req.XChainId))
+	}
+	if !bytes.Equal(cChainID[:], req.CChainId) {
+		mismatches = append(mismatches, fmt.Errorf("expected C-chain ID %s, got 0x%x", cChainID, req.CChainId))
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages(resp.Message, mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
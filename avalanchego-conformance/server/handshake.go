@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"go.uber.org/zap"
+)
+
+func (s *server) HandshakeTimestamps(ctx context.Context, req *rpcpb.HandshakeTimestampsRequest) (*rpcpb.HandshakeTimestampsResponse, error) {
+	logger(ctx).Debug("received HandshakeTimestamps request",
+		zap.Uint64("my-time", req.MyTime),
+		zap.Uint64("my-version-time", req.MyVersionTime),
+	)
+
+	now := req.Now
+	if now == 0 {
+		var err error
+		if now, err = s.nowUnix(ctx); err != nil {
+			return nil, err
+		}
+	}
+	maxClockDifference := time.Duration(req.MaxClockDifferenceMs) * time.Millisecond
+	if maxClockDifference == 0 {
+		maxClockDifference = constants.DefaultNetworkMaxClockDifference
+	}
+
+	err := checkPeerClock(req.MyTime, now, maxClockDifference)
+	if err == nil {
+		err = checkVersionTime(req.MyVersionTime, now, maxClockDifference)
+	}
+	resp := &rpcpb.HandshakeTimestampsResponse{
+		ExpectedNow:                   now,
+		ExpectedMaxClockDifferenceMs:  uint64(maxClockDifference.Milliseconds()),
+		ExpectedClockDifference:       int64(req.MyTime - now),
+		ExpectedVersionTimeDifference: int64(req.MyVersionTime - now),
+		// the IP of a peer is only tracked once its handshake succeeds
+		// ref. "network.Connected"
+		ExpectedReplacesTrackedIp: err == nil && (req.TrackedIpTimestamp == 0 || req.TrackedIpTimestamp < req.MyVersionTime),
+		Success:                   true,
+	}
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	}
+
+	var mismatches []error
+	if (err != nil) != req.Rejected {
+		mismatches = append(mismatches, errors.New(rejectionMismatch(err, req.Rejected)))
+	}
+	if resp.ExpectedReplacesTrackedIp != req.ReplacesTrackedIp {
+		mismatches = append(mismatches, fmt.Errorf("expected replaces_tracked_ip %t, got %t", resp.ExpectedReplacesTrackedIp, req.ReplacesTrackedIp))
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages(resp.Message, mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// checkPeerClock returns why avalanchego would close the connection on the
+// Version "my_time" of a peer, if it would. Times are compared as floats,
+// as avalanchego does.
+// ref. "peer.handleVersion"
+func checkPeerClock(peerTime uint64, myTime uint64, maxClockDifference time.Duration) error {
+	if math.Abs(float64(peerTime)-float64(myTime)) > maxClockDifference.Seconds() {
+		return fmt.Errorf("%w: peer time %d, server time %d", errPeerClockDifference, peerTime, myTime)
+	}
+	return nil
+}
+
+// checkVersionTime returns why avalanchego would close the connection on the
+// Version "my_version_time" of a peer, if it would. Signed IPs may be
+// arbitrarily old, but not too far in the future.
+// ref. "peer.handleVersion"
+func checkVersionTime(versionTime uint64, myTime uint64, maxClockDifference time.Duration) error {
+	if float64(versionTime)-float64(myTime) > maxClockDifference.Seconds() {
+		return fmt.Errorf("%w: version time %d, server time %d", errPeerVersionTime, versionTime, myTime)
+	}
+	return nil
+}
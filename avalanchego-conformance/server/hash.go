@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"go.uber.org/zap"
+)
+
+// ref. "cb58.checksumLen"
+const defaultChecksumLen = 4
+
+var (
+	errUnknownHashFunction = errors.New("unknown hash function")
+	errInvalidHashRange    = errors.New("invalid hash range")
+	errInvalidChecksumLen  = errors.New("invalid checksum length")
+)
+
+func (s *server) Hash(ctx context.Context, req *rpcpb.HashRequest) (*rpcpb.HashResponse, error) {
+	logger(ctx).Debug("received Hash request", zap.String("function", req.Function.String()), zap.Int("data-size", len(req.Data)))
+
+	var digest []byte
+	switch req.Function {
+	case rpcpb.HashFunction_HASH_FUNCTION_HASH256:
+		digest = hashing.ComputeHash256(req.Data)
+	case rpcpb.HashFunction_HASH_FUNCTION_HASH256_RANGES:
+		ranges := make([][2]int, 0, len(req.Ranges))
+		for i, r := range req.Ranges {
+			// avalanchego panics on out of bounds ranges
+			if r.Start > r.End || int(r.End) > len(req.Data) {
+				return nil, fmt.Errorf("%w: ranges[%d] [%d, %d) of %d bytes", errInvalidHashRange, i, r.Start, r.End, len(req.Data))
+			}
+			ranges = append(ranges, [2]int{int(r.Start), int(r.End)})
+		}
+		digest = hashing.ComputeHash256Ranges(req.Data, ranges)
+	case rpcpb.HashFunction_HASH_FUNCTION_HASH160:
+		digest = hashing.ComputeHash160(req.Data)
+	case rpcpb.HashFunction_HASH_FUNCTION_CHECKSUM:
+		length := int(req.ChecksumLength)
+		if length == 0 {
+			length = defaultChecksumLen
+		}
+		if length > hashing.HashLen {
+			return nil, fmt.Errorf("%w: %d", errInvalidChecksumLen, length)
+		}
+		digest = hashing.Checksum(req.Data, length)
+	case rpcpb.HashFunction_HASH_FUNCTION_PUBKEY_BYTES_TO_ADDRESS:
+		digest = hashing.PubkeyBytesToAddress(req.Data)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownHashFunction, req.Function)
+	}
+
+	resp := &rpcpb.HashResponse{
+		ExpectedDigest: digest,
+		Success:        true,
+	}
+	if d := newDiff(digest, req.Digest, nil); d != nil {
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+	return resp, nil
+}
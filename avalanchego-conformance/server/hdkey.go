@@ -0,0 +1,206 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	dsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// BIP-44 path of the external chain of the first account, with the
+	// AVAX coin type.
+	defaultDerivationPath = "m/44'/9000'/0'/0"
+
+	// ref. https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki
+	hardenedKeyStart = uint32(0x80000000)
+)
+
+var (
+	errInvalidDerivationPath = errors.New("invalid derivation path")
+	errInvalidExtendedKey    = errors.New("invalid extended key")
+)
+
+func (s *server) Secp256K1DeriveKeys(ctx context.Context, req *rpcpb.Secp256K1DeriveKeysRequest) (*rpcpb.Secp256K1DeriveKeysResponse, error) {
+	logger(ctx).Debug("received Secp256K1DeriveKeys request", zap.String("path", req.Path), zap.Int("keys", len(req.DerivedKeys)))
+
+	path := req.Path
+	if path == "" {
+		path = defaultDerivationPath
+	}
+	indices, err := parseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	parent, err := newMasterKey(mnemonicSeed(req.Mnemonic, req.Passphrase))
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range indices {
+		parent, err = parent.child(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hrp := constants.GetHRP(req.NetworkId)
+	resp := &rpcpb.Secp256K1DeriveKeysResponse{
+		ExpectedDerivedKeys: make([]*rpcpb.Secp256K1DerivedKey, 0, len(req.DerivedKeys)),
+		Success:             true,
+	}
+	var mismatches []error
+	for i, received := range req.DerivedKeys {
+		child, err := parent.child(received.Index)
+		if err != nil {
+			return nil, invalidField(fmt.Sprintf("derived_keys[%d]", i), err)
+		}
+		expected, err := s.derivedKey(received.Index, child.key, hrp)
+		if err != nil {
+			return nil, invalidField(fmt.Sprintf("derived_keys[%d]", i), err)
+		}
+		resp.ExpectedDerivedKeys = append(resp.ExpectedDerivedKeys, expected)
+
+		for _, f := range []struct {
+			name               string
+			expected, received string
+		}{
+			{"private_key_hex", expected.PrivateKeyHex, strings.TrimPrefix(received.PrivateKeyHex, "0x")},
+			{"x_address", expected.XAddress, received.XAddress},
+			{"p_address", expected.PAddress, received.PAddress},
+			{"c_address", expected.CAddress, received.CAddress},
+			{"eth_address", expected.EthAddress, received.EthAddress},
+		} {
+			if f.expected != f.received {
+				mismatches = append(mismatches, fmt.Errorf("derived_keys[%d].%s: expected %q, got %q", i, f.name, f.expected, f.received))
+			}
+		}
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages("", mismatches)
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) derivedKey(index uint32, key [32]byte, hrp string) (*rpcpb.Secp256K1DerivedKey, error) {
+	privKey, err := s.secpFactory.ToPrivateKey(key[:])
+	if err != nil {
+		return nil, err
+	}
+	xAddr, err := encodeAddr(privKey, "X", hrp)
+	if err != nil {
+		return nil, err
+	}
+	pAddr, err := encodeAddr(privKey, "P", hrp)
+	if err != nil {
+		return nil, err
+	}
+	cAddr, err := encodeAddr(privKey, "C", hrp)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.Secp256K1DerivedKey{
+		Index:         index,
+		PrivateKeyHex: hex.EncodeToString(privKey.Bytes()),
+		XAddress:      xAddr,
+		PAddress:      pAddr,
+		CAddress:      cAddr,
+		EthAddress:    encodeEthAddr(privKey),
+	}, nil
+}
+
+// mnemonicSeed returns the 64-byte seed of the mnemonic.
+// ref. https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki
+func mnemonicSeed(mnemonic string, passphrase string) []byte {
+	mnemonic = norm.NFKD.String(strings.Join(strings.Fields(mnemonic), " "))
+	salt := norm.NFKD.String("mnemonic" + passphrase)
+	return pbkdf2.Key([]byte(mnemonic), []byte(salt), 2048, 64, sha512.New)
+}
+
+// parseDerivationPath returns the child indices of a path (e.g.,
+// "m/44'/9000'/0'/0").
+func parseDerivationPath(path string) ([]uint32, error) {
+	parts := strings.Split(path, "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("%w %q: expected \"m\" prefix", errInvalidDerivationPath, path)
+	}
+	indices := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		offset := uint32(0)
+		if trimmed := strings.TrimRight(part, "'h"); len(trimmed) == len(part)-1 {
+			part, offset = trimmed, hardenedKeyStart
+		}
+		i, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(i) >= hardenedKeyStart {
+			return nil, fmt.Errorf("%w %q: invalid index %q", errInvalidDerivationPath, path, part)
+		}
+		indices = append(indices, uint32(i)+offset)
+	}
+	return indices, nil
+}
+
+// extendedKey is a private key with its chain code.
+type extendedKey struct {
+	key       [32]byte
+	chainCode []byte
+}
+
+func newMasterKey(seed []byte) (*extendedKey, error) {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	_, _ = mac.Write(seed)
+	return newExtendedKey(mac.Sum(nil), nil)
+}
+
+// child derives the private child key at the index.
+func (k *extendedKey) child(i uint32) (*extendedKey, error) {
+	var data []byte
+	if i >= hardenedKeyStart {
+		data = append([]byte{0}, k.key[:]...)
+	} else {
+		var scalar dsecp256k1.ModNScalar
+		scalar.SetBytes(&k.key)
+		data = dsecp256k1.NewPrivateKey(&scalar).PubKey().SerializeCompressed()
+	}
+	data = binary.BigEndian.AppendUint32(data, i)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	_, _ = mac.Write(data)
+	return newExtendedKey(mac.Sum(nil), &k.key)
+}
+
+// newExtendedKey returns the key of the 64-byte HMAC output, tweaking the
+// parent key if any.
+func newExtendedKey(i []byte, parent *[32]byte) (*extendedKey, error) {
+	var key dsecp256k1.ModNScalar
+	if overflow := key.SetByteSlice(i[:32]); overflow {
+		return nil, errInvalidExtendedKey
+	}
+	if parent != nil {
+		var p dsecp256k1.ModNScalar
+		p.SetBytes(parent)
+		key.Add(&p)
+	}
+	if key.IsZero() {
+		return nil, errInvalidExtendedKey
+	}
+	return &extendedKey{
+		key:       key.Bytes(),
+		chainCode: i[32:],
+	}, nil
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// setServing sets the "grpc.health.v1.Health" status of the server (the
+// empty service name) and of every registered service. The server only
+// reports SERVING once both the gRPC server and the gateway (if served)
+// accept connections.
+func (s *server) setServing(serving bool) {
+	st := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if serving {
+		st = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	s.health.SetServingStatus("", st)
+	for _, desc := range s.services {
+		s.health.SetServingStatus(desc.ServiceName, st)
+	}
+}
+
+// handleHealthz reports whether the server process is up, for liveness
+// probes.
+func (s *server) handleHealthz(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// handleReadyz reports whether the server accepts requests, for readiness
+// probes (e.g., docker-compose healthchecks or k8s readiness probes).
+func (s *server) handleReadyz(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+	resp, err := s.health.Check(req.Context(), &grpc_health_v1.HealthCheckRequest{})
+	if err != nil || resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("not ready\n"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// handleHealthPaths serves "GET /healthz" and "GET /readyz" on the gateway.
+func (s *server) handleHealthPaths(mux *runtime.ServeMux) error {
+	if err := mux.HandlePath(http.MethodGet, "/healthz", s.handleHealthz); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodGet, "/readyz", s.handleReadyz)
+}
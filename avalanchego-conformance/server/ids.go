@@ -0,0 +1,180 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"go.uber.org/zap"
+)
+
+var errUnknownIDKind = errors.New("unknown ID kind")
+
+func (s *server) IdFromBytes(ctx context.Context, req *rpcpb.IdFromBytesRequest) (*rpcpb.IdFromBytesResponse, error) {
+	logger(ctx).Debug("received IdFromBytes request", zap.String("kind", req.Kind.String()))
+
+	resp := &rpcpb.IdFromBytesResponse{
+		Success: true,
+	}
+	var err error
+	switch req.Kind {
+	case rpcpb.IdKind_ID_KIND_ID:
+		var id ids.ID
+		if id, err = ids.ToID(req.IdBytes); err == nil {
+			resp.ExpectedFormatted, resp.ExpectedHex = id.String(), id.Hex()
+		}
+	case rpcpb.IdKind_ID_KIND_SHORT_ID:
+		var id ids.ShortID
+		if id, err = ids.ToShortID(req.IdBytes); err == nil {
+			resp.ExpectedFormatted, resp.ExpectedHex = id.String(), id.Hex()
+		}
+	case rpcpb.IdKind_ID_KIND_NODE_ID:
+		var id ids.NodeID
+		if id, err = ids.ToNodeID(req.IdBytes); err == nil {
+			resp.ExpectedFormatted, resp.ExpectedHex = id.String(), ids.ShortID(id).Hex()
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownIDKind, req.Kind)
+	}
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	}
+
+	switch {
+	case (err != nil) != req.Rejected:
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	case err != nil:
+	case resp.ExpectedFormatted != req.Formatted:
+		resp.Message = fmt.Sprintf("expected formatted %q, got %q", resp.ExpectedFormatted, req.Formatted)
+		resp.Success = false
+	case resp.ExpectedHex != req.Hex:
+		resp.Message = fmt.Sprintf("expected hex %q, got %q", resp.ExpectedHex, req.Hex)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) IdParse(ctx context.Context, req *rpcpb.IdParseRequest) (*rpcpb.IdParseResponse, error) {
+	logger(ctx).Debug("received IdParse request", zap.String("kind", req.Kind.String()), zap.String("formatted", req.Formatted))
+
+	resp := &rpcpb.IdParseResponse{
+		Success: true,
+	}
+	var err error
+	switch req.Kind {
+	case rpcpb.IdKind_ID_KIND_ID:
+		var id ids.ID
+		if id, err = ids.FromString(req.Formatted); err == nil {
+			resp.ExpectedIdBytes = id[:]
+		}
+	case rpcpb.IdKind_ID_KIND_SHORT_ID:
+		var id ids.ShortID
+		if id, err = ids.ShortFromString(req.Formatted); err == nil {
+			resp.ExpectedIdBytes = id[:]
+		}
+	case rpcpb.IdKind_ID_KIND_NODE_ID:
+		var id ids.NodeID
+		if id, err = ids.NodeIDFromString(req.Formatted); err == nil {
+			resp.ExpectedIdBytes = id[:]
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownIDKind, req.Kind)
+	}
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	}
+
+	switch {
+	case (err != nil) != req.Rejected:
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	case err == nil && !bytes.Equal(resp.ExpectedIdBytes, req.IdBytes):
+		resp.Message = fmt.Sprintf("expected ID bytes 0x%x, got 0x%x", resp.ExpectedIdBytes, req.IdBytes)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) ShortIdFromPublicKey(ctx context.Context, req *rpcpb.ShortIdFromPublicKeyRequest) (*rpcpb.ShortIdFromPublicKeyResponse, error) {
+	pubkey, err := s.secpFactory.ToPublicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	addr := pubkey.Address()
+
+	resp := &rpcpb.ShortIdFromPublicKeyResponse{
+		ExpectedShortId:     addr[:],
+		ExpectedShortIdCb58: addr.String(),
+		Success:             true,
+	}
+	if !bytes.Equal(addr[:], req.ShortId) {
+		resp.Message = fmt.Sprintf("expected short ID %s (0x%x), got 0x%x", addr, addr[:], req.ShortId)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) IdBits(ctx context.Context, req *rpcpb.IdBitsRequest) (*rpcpb.IdBitsResponse, error) {
+	logger(ctx).Debug("received IdBits request", zap.Uint32("start", req.Start), zap.Uint32("stop", req.Stop))
+
+	id, err := toID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	other, err := toID("other_id", req.OtherId)
+	if err != nil {
+		return nil, err
+	}
+
+	bits := make([]byte, ids.NumBits)
+	for i := range bits {
+		bits[i] = byte(id.Bit(uint(i)))
+	}
+	firstDifference := int32(-1)
+	if i, ok := ids.FirstDifferenceSubset(int(req.Start), int(req.Stop), id, other); ok {
+		firstDifference = int32(i)
+	}
+	prefixed := id.Prefix(req.Prefixes...)
+
+	resp := &rpcpb.IdBitsResponse{
+		ExpectedBits:            bits,
+		ExpectedEqualSubset:     ids.EqualSubset(int(req.Start), int(req.Stop), id, other),
+		ExpectedFirstDifference: firstDifference,
+		ExpectedPrefixedId:      prefixed[:],
+		Success:                 true,
+	}
+	var mismatches []error
+	if d := newDiff(resp.ExpectedBits, req.Bits, nil); d != nil {
+		mismatches = append(mismatches, fmt.Errorf("bits: %s", d.Summary))
+	}
+	if resp.ExpectedEqualSubset != req.EqualSubset {
+		mismatches = append(mismatches, fmt.Errorf("expected equal subset %t, got %t", resp.ExpectedEqualSubset, req.EqualSubset))
+	}
+	if resp.ExpectedFirstDifference != req.FirstDifference {
+		mismatches = append(mismatches, fmt.Errorf("expected first difference %d, got %d", resp.ExpectedFirstDifference, req.FirstDifference))
+	}
+	if !bytes.Equal(resp.ExpectedPrefixedId, req.PrefixedId) {
+		mismatches = append(mismatches, fmt.Errorf("expected prefixed ID %s, got 0x%x", prefixed, req.PrefixedId))
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages("", mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// rejectionMismatch describes a client accepting what avalanchego rejects,
+// or the other way around.
+func rejectionMismatch(err error, rejected bool) string {
+	if rejected {
+		return "expected accepted, got rejected"
+	}
+	return fmt.Sprintf("expected rejected (%v), got accepted", err)
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// interceptors returns the interceptor chains of the server, outermost
+// first. Panic recovery and the optional request log follow request
+// logging, so that they log with the request ID. The interceptors of the
+// config follow authentication, so that they only see authenticated
+// requests, and precede usage accounting, so that requests they reject
+// (e.g., rate limited ones) do not count towards the service quotas.
+func (s *server) interceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	unary := []grpc.UnaryServerInterceptor{durationUnaryInterceptor, s.loggingUnaryInterceptor, recoveryUnaryInterceptor}
+	stream := []grpc.StreamServerInterceptor{durationStreamInterceptor, s.loggingStreamInterceptor, recoveryStreamInterceptor}
+	if s.cfg.LogRequests {
+		unary = append(unary, requestLogUnaryInterceptor)
+		stream = append(stream, requestLogStreamInterceptor)
+	}
+	unary = append(unary, s.metricsUnaryInterceptor, s.authUnaryInterceptor)
+	stream = append(stream, s.metricsStreamInterceptor, s.authStreamInterceptor)
+
+	unary = append(unary, s.cfg.UnaryInterceptors...)
+	stream = append(stream, s.cfg.StreamInterceptors...)
+
+	unary = append(unary, s.usageUnaryInterceptor, s.reportUnaryInterceptor, fieldErrorUnaryInterceptor)
+	stream = append(stream, s.usageStreamInterceptor, s.reportStreamInterceptor, fieldErrorStreamInterceptor)
+	return unary, stream
+}
+
+// chainUnaryInterceptors composes the interceptors into one, the first
+// being the outermost, as "grpc.ChainUnaryInterceptor" does for served
+// requests.
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return chainedUnaryHandler(interceptors, info, handler)(ctx, req)
+	}
+}
+
+func chainedUnaryHandler(interceptors []grpc.UnaryServerInterceptor, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	if len(interceptors) == 0 {
+		return handler
+	}
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		return interceptors[0](ctx, req, info, chainedUnaryHandler(interceptors[1:], info, handler))
+	}
+}
+
+// requestLogUnaryInterceptor logs every request at the info level, with its
+// outcome and duration, as an access log of a shared server.
+func requestLogUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logger(ctx).Info("request", append(outcomeFields(resp, err), zap.Duration("duration", time.Since(start)))...)
+	return resp, err
+}
+
+// requestLogStreamInterceptor is the streaming variant of
+// requestLogUnaryInterceptor, logging once the stream is closed.
+func requestLogStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	logger(ss.Context()).Info("stream",
+		zap.Stringer("code", status.Code(err)),
+		zap.Error(err),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return err
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"go.uber.org/zap"
+)
+
+func (s *server) IdJson(ctx context.Context, req *rpcpb.IdJsonRequest) (*rpcpb.IdJsonResponse, error) {
+	id, err := toID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := json.Marshal(id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.IdJsonResponse{ExpectedJson: string(expected)}
+	if resp.Message, resp.Success, err = compareJSON(expected, "json", req.Json); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *server) ShortIdJson(ctx context.Context, req *rpcpb.ShortIdJsonRequest) (*rpcpb.ShortIdJsonResponse, error) {
+	shortID, err := toShortID("short_id", req.ShortId)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := json.Marshal(shortID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.ShortIdJsonResponse{ExpectedJson: string(expected)}
+	if resp.Message, resp.Success, err = compareJSON(expected, "json", req.Json); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *server) NodeIdJson(ctx context.Context, req *rpcpb.NodeIdJsonRequest) (*rpcpb.NodeIdJsonResponse, error) {
+	nodeID, err := toNodeID("node_id", req.NodeId)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := json.Marshal(nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.NodeIdJsonResponse{ExpectedJson: string(expected)}
+	if resp.Message, resp.Success, err = compareJSON(expected, "json", req.Json); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *server) UtxoJson(ctx context.Context, req *rpcpb.UtxoJsonRequest) (*rpcpb.UtxoJsonResponse, error) {
+	logger(ctx).Debug("received UtxoJson request", zap.Int("utxo-size", len(req.UtxoBytes)))
+
+	utxo := new(avax.UTXO)
+	if _, err := txs.Codec.Unmarshal(req.UtxoBytes, utxo); err != nil {
+		return nil, invalidField("utxo_bytes", err)
+	}
+	expected, err := json.Marshal(utxo)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.UtxoJsonResponse{ExpectedJson: string(expected)}
+	if resp.Message, resp.Success, err = compareJSON(expected, "json", req.Json); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *server) PlatformTxJson(ctx context.Context, req *rpcpb.PlatformTxJsonRequest) (*rpcpb.PlatformTxJsonResponse, error) {
+	logger(ctx).Debug("received PlatformTxJson request", zap.Int("tx-size", len(req.TxBytes)))
+
+	// P-chain txs serialize the same way from Banff to Cortina
+	if _, err := resolveUpgradeEra(req.UpgradeEra); err != nil {
+		return &rpcpb.PlatformTxJsonResponse{Message: err.Error()}, nil
+	}
+
+	tx, err := txs.Parse(txs.Codec, req.TxBytes)
+	if err != nil {
+		return nil, invalidField("tx_bytes", err)
+	}
+	expected, err := json.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.PlatformTxJsonResponse{ExpectedJson: string(expected)}
+	if resp.Message, resp.Success, err = compareJSON(expected, "json", req.Json); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// compareJSON semantically compares the JSON produced by avalanchego with
+// the received JSON of the request field, ignoring key order and
+// whitespace. Received JSON that fails to decode is an invalid field.
+func compareJSON(expected []byte, field string, received string) (string, bool, error) {
+	expectedVal, err := decodeJSON(expected)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to decode expected JSON (%w)", err)
+	}
+	receivedVal, err := decodeJSON([]byte(received))
+	if err != nil {
+		return "", false, invalidField(field, err)
+	}
+	if !reflect.DeepEqual(expectedVal, receivedVal) {
+		return fmt.Sprintf("expected JSON %s, got %s", expected, received), false, nil
+	}
+	return "", true, nil
+}
+
+// decodeJSON decodes numbers as json.Number to not lose uint64 precision.
+func decodeJSON(b []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
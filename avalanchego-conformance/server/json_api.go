@@ -0,0 +1,536 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ref. "platformvm.GetCurrentValidatorsReply"
+type platformGetCurrentValidatorsReply struct {
+	Validators []interface{} `json:"validators"`
+}
+
+func (s *server) PlatformGetTx(ctx context.Context, req *rpcpb.PlatformGetTxRequest) (*rpcpb.PlatformGetTxResponse, error) {
+	logger(ctx).Debug("received PlatformGetTx request", zap.Int("tx-size", len(req.TxBytes)), zap.String("encoding", req.Encoding))
+
+	encoding, err := parseAPIEncoding(req.Encoding)
+	if err != nil {
+		return nil, invalidField("encoding", err)
+	}
+	tx, err := txs.Parse(txs.Codec, req.TxBytes)
+	if err != nil {
+		return nil, invalidField("tx_bytes", err)
+	}
+
+	// ref. "platformvm.Service.GetTx"
+	reply := &api.GetTxReply{Encoding: encoding}
+	var callErr error
+	if encoding == formatting.JSON {
+		tx.Unsigned.InitCtx(platformContext(req.NetworkId))
+		reply.Tx = tx
+	} else if reply.Tx, err = formatting.Encode(encoding, tx.Bytes()); err != nil {
+		callErr = fmt.Errorf("couldn't encode tx as a string: %w", err)
+	}
+	expected := renderJSONRPC("platform.getTx", req.Id, reply, callErr)
+
+	resp := &rpcpb.PlatformGetTxResponse{ExpectedJson: string(expected)}
+	if resp.Message, resp.Success, err = compareJSON(expected, "json", req.Json); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *server) PlatformGetUtxos(ctx context.Context, req *rpcpb.PlatformGetUtxosRequest) (*rpcpb.PlatformGetUtxosResponse, error) {
+	logger(ctx).Debug("received PlatformGetUtxos request", zap.Int("utxos", len(req.Utxos)), zap.String("encoding", req.Encoding))
+
+	encoding, err := parseAPIEncoding(req.Encoding)
+	if err != nil {
+		return nil, invalidField("encoding", err)
+	}
+	utxos := make([]*avax.UTXO, len(req.Utxos))
+	for i, utxoBytes := range req.Utxos {
+		utxos[i] = new(avax.UTXO)
+		if _, err := txs.Codec.Unmarshal(utxoBytes, utxos[i]); err != nil {
+			return nil, invalidField(fmt.Sprintf("utxos[%d]", i), err)
+		}
+	}
+	endAddr := ids.ShortEmpty
+	if len(req.EndAddress) > 0 {
+		if endAddr, err = toShortID("end_address", req.EndAddress); err != nil {
+			return nil, err
+		}
+	}
+	endUTXOID := ids.Empty
+	if len(req.EndUtxoId) > 0 {
+		if endUTXOID, err = toID("end_utxo_id", req.EndUtxoId); err != nil {
+			return nil, err
+		}
+	}
+
+	reply, callErr := newPlatformGetUTXOsReply(utxos, endAddr, endUTXOID, encoding, req.NetworkId)
+	expected := renderJSONRPC("platform.getUTXOs", req.Id, reply, callErr)
+
+	resp := &rpcpb.PlatformGetUtxosResponse{ExpectedJson: string(expected)}
+	if resp.Message, resp.Success, err = compareJSON(expected, "json", req.Json); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// newPlatformGetUTXOsReply renders the fetched UTXOs, as the P-chain API does.
+// ref. "platformvm.Service.GetUTXOs"
+func newPlatformGetUTXOsReply(utxos []*avax.UTXO, endAddr ids.ShortID, endUTXOID ids.ID, encoding formatting.Encoding, networkID uint32) (*api.GetUTXOsReply, error) {
+	reply := &api.GetUTXOsReply{UTXOs: make([]string, len(utxos))}
+	for i, utxo := range utxos {
+		b, err := txs.Codec.Marshal(txs.Version, utxo)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't serialize UTXO %q: %w", utxo.InputID(), err)
+		}
+		reply.UTXOs[i], err = formatting.Encode(encoding, b)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't encode UTXO %s as string: %w", utxo.InputID(), err)
+		}
+	}
+
+	endAddress, err := formatPlatformAddress(endAddr, networkID)
+	if err != nil {
+		return nil, fmt.Errorf("problem formatting address: %w", err)
+	}
+	reply.EndIndex.Address = endAddress
+	reply.EndIndex.UTXO = endUTXOID.String()
+	reply.NumFetched = avajson.Uint64(len(utxos))
+	reply.Encoding = encoding
+	return reply, nil
+}
+
+// currentStaker is a current staker of the P-chain state, with the
+// attributes the API reads from its transaction.
+// ref. "state.Staker", "platformvm.stakerAttributes"
+type currentStaker struct {
+	txID            ids.ID
+	tx              txs.Staker
+	priority        txs.Priority
+	potentialReward uint64
+	delegateeReward uint64
+	uptime          float64
+	connected       bool
+}
+
+// less orders current stakers by end time, priority and then ID.
+// ref. "state.Staker.Less"
+func (s *currentStaker) less(than *currentStaker) bool {
+	if s.tx.EndTime().Before(than.tx.EndTime()) {
+		return true
+	}
+	if than.tx.EndTime().Before(s.tx.EndTime()) {
+		return false
+	}
+	if s.priority != than.priority {
+		return s.priority < than.priority
+	}
+	return bytes.Compare(s.txID[:], than.txID[:]) == -1
+}
+
+func (s *server) PlatformGetCurrentValidators(ctx context.Context, req *rpcpb.PlatformGetCurrentValidatorsRequest) (*rpcpb.PlatformGetCurrentValidatorsResponse, error) {
+	logger(ctx).Debug("received PlatformGetCurrentValidators request", zap.Int("stakers", len(req.Stakers)))
+
+	subnetID := constants.PrimaryNetworkID
+	if len(req.SubnetId) > 0 {
+		var err error
+		if subnetID, err = toID("subnet_id", req.SubnetId); err != nil {
+			return nil, err
+		}
+	}
+	var nodeIDs []ids.NodeID
+	if len(req.NodeId) > 0 {
+		nodeID, err := toNodeID("node_id", req.NodeId)
+		if err != nil {
+			return nil, err
+		}
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+
+	var (
+		stakers    = make([]*currentStaker, 0, len(req.Stakers))
+		validators = make(map[ids.NodeID]*currentStaker)
+		delegators = make(map[ids.NodeID][]*currentStaker)
+	)
+	for i, st := range req.Stakers {
+		tx, err := txs.Parse(txs.Codec, st.TxBytes)
+		if err != nil {
+			return nil, invalidField(fmt.Sprintf("stakers[%d].tx_bytes", i), err)
+		}
+		stakerTx, ok := tx.Unsigned.(txs.Staker)
+		if !ok {
+			return nil, invalidField(fmt.Sprintf("stakers[%d].tx_bytes", i), fmt.Errorf("%T is not a staker tx", tx.Unsigned))
+		}
+		if stakerTx.SubnetID() != subnetID {
+			continue
+		}
+		staker := &currentStaker{
+			txID:            tx.ID(),
+			tx:              stakerTx,
+			priority:        stakerTx.CurrentPriority(),
+			potentialReward: st.PotentialReward,
+			delegateeReward: st.DelegateeReward,
+			uptime:          st.Uptime,
+			connected:       st.Connected,
+		}
+		nodeID := stakerTx.NodeID()
+		if _, ok := tx.Unsigned.(txs.DelegatorTx); ok {
+			delegators[nodeID] = append(delegators[nodeID], staker)
+		} else {
+			if _, ok := validators[nodeID]; ok {
+				return nil, invalidField(fmt.Sprintf("stakers[%d]", i), fmt.Errorf("duplicate validator %s", nodeID))
+			}
+			validators[nodeID] = staker
+		}
+		stakers = append(stakers, staker)
+	}
+	sort.Slice(stakers, func(i, j int) bool { return stakers[i].less(stakers[j]) })
+
+	// the stakers iterated by avalanchego: every staker of the subnet, or
+	// the validator of the node requested followed by its delegators
+	targetStakers := stakers
+	if len(nodeIDs) == 1 {
+		targetStakers = nil
+		if vdr, ok := validators[nodeIDs[0]]; ok {
+			targetStakers = append(targetStakers, vdr)
+			for _, staker := range stakers {
+				if staker != vdr && staker.tx.NodeID() == nodeIDs[0] {
+					targetStakers = append(targetStakers, staker)
+				}
+			}
+		}
+	}
+
+	reply, err := newPlatformGetCurrentValidatorsReply(targetStakers, validators, len(nodeIDs), subnetID, req.SubnetTracked, req.NetworkId)
+	if err != nil {
+		return nil, err
+	}
+	expected := renderJSONRPC("platform.getCurrentValidators", req.Id, reply, nil)
+
+	resp := &rpcpb.PlatformGetCurrentValidatorsResponse{ExpectedJson: string(expected)}
+	if resp.Message, resp.Success, err = compareJSON(expected, "json", req.Json); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// newPlatformGetCurrentValidatorsReply renders the stakers, as the P-chain API
+// does, with the delegators of each validator if a single node is requested.
+// ref. "platformvm.Service.GetCurrentValidators"
+func newPlatformGetCurrentValidatorsReply(
+	targetStakers []*currentStaker,
+	validators map[ids.NodeID]*currentStaker,
+	numNodeIDs int,
+	subnetID ids.ID,
+	subnetTracked bool,
+	networkID uint32,
+) (*platformGetCurrentValidatorsReply, error) {
+	reply := &platformGetCurrentValidatorsReply{Validators: []interface{}{}}
+	vdrToDelegators := map[ids.NodeID][]platformapi.PrimaryDelegator{}
+
+	// ref. "platformvm.Service.getAPIUptime"
+	getAPIUptime := func(staker *currentStaker) *avajson.Float32 {
+		if constants.PrimaryNetworkID != subnetID && !subnetTracked {
+			return nil
+		}
+		uptime := avajson.Float32(staker.uptime * 100)
+		return &uptime
+	}
+	// ref. "platformvm.Service.getAPIOwner"
+	getAPIOwner := func(owner *secp256k1fx.OutputOwners) (*platformapi.Owner, error) {
+		apiOwner := &platformapi.Owner{
+			Locktime:  avajson.Uint64(owner.Locktime),
+			Threshold: avajson.Uint32(owner.Threshold),
+		}
+		for _, addr := range owner.Addrs {
+			addrStr, err := formatPlatformAddress(addr, networkID)
+			if err != nil {
+				return nil, err
+			}
+			apiOwner.Addresses = append(apiOwner.Addresses, addrStr)
+		}
+		return apiOwner, nil
+	}
+
+	for _, currentStaker := range targetStakers {
+		nodeID := currentStaker.tx.NodeID()
+		weight := avajson.Uint64(currentStaker.tx.Weight())
+		apiStaker := platformapi.Staker{
+			TxID:        currentStaker.txID,
+			StartTime:   avajson.Uint64(currentStaker.tx.StartTime().Unix()),
+			EndTime:     avajson.Uint64(currentStaker.tx.EndTime().Unix()),
+			Weight:      weight,
+			StakeAmount: &weight,
+			NodeID:      nodeID,
+		}
+		potentialReward := avajson.Uint64(currentStaker.potentialReward)
+
+		// the delegatee reward is of the validator of the node
+		var delegateeReward uint64
+		if vdr, ok := validators[nodeID]; ok {
+			delegateeReward = vdr.delegateeReward
+		}
+		jsonDelegateeReward := avajson.Uint64(delegateeReward)
+
+		switch currentStaker.priority {
+		case txs.PrimaryNetworkValidatorCurrentPriority, txs.SubnetPermissionlessValidatorCurrentPriority:
+			stakerTx, ok := currentStaker.tx.(txs.ValidatorTx)
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "unexpected staker tx type %T", currentStaker.tx)
+			}
+			var pop *signer.ProofOfPossession
+			if staker, ok := stakerTx.(*txs.AddPermissionlessValidatorTx); ok {
+				if s, ok := staker.Signer.(*signer.ProofOfPossession); ok {
+					pop = s
+				}
+			}
+			delegationFee := avajson.Float32(100 * float32(stakerTx.Shares()) / float32(reward.PercentDenominator))
+
+			var (
+				validationRewardOwner *platformapi.Owner
+				delegationRewardOwner *platformapi.Owner
+				err                   error
+			)
+			if owner, ok := stakerTx.ValidationRewardsOwner().(*secp256k1fx.OutputOwners); ok {
+				if validationRewardOwner, err = getAPIOwner(owner); err != nil {
+					return nil, err
+				}
+			}
+			if owner, ok := stakerTx.DelegationRewardsOwner().(*secp256k1fx.OutputOwners); ok {
+				if delegationRewardOwner, err = getAPIOwner(owner); err != nil {
+					return nil, err
+				}
+			}
+
+			reply.Validators = append(reply.Validators, platformapi.PermissionlessValidator{
+				Staker:                 apiStaker,
+				Uptime:                 getAPIUptime(currentStaker),
+				Connected:              currentStaker.connected,
+				PotentialReward:        &potentialReward,
+				AccruedDelegateeReward: &jsonDelegateeReward,
+				RewardOwner:            validationRewardOwner,
+				ValidationRewardOwner:  validationRewardOwner,
+				DelegationRewardOwner:  delegationRewardOwner,
+				DelegationFee:          delegationFee,
+				Signer:                 pop,
+			})
+
+		case txs.PrimaryNetworkDelegatorCurrentPriority, txs.SubnetPermissionlessDelegatorCurrentPriority:
+			var rewardOwner *platformapi.Owner
+			// delegators are only detailed for a single node
+			if numNodeIDs == 1 {
+				stakerTx, ok := currentStaker.tx.(txs.DelegatorTx)
+				if !ok {
+					return nil, status.Errorf(codes.InvalidArgument, "unexpected staker tx type %T", currentStaker.tx)
+				}
+				if owner, ok := stakerTx.RewardsOwner().(*secp256k1fx.OutputOwners); ok {
+					var err error
+					if rewardOwner, err = getAPIOwner(owner); err != nil {
+						return nil, err
+					}
+				}
+			}
+			delegator := platformapi.PrimaryDelegator{
+				Staker:          apiStaker,
+				RewardOwner:     rewardOwner,
+				PotentialReward: &potentialReward,
+			}
+			vdrToDelegators[delegator.NodeID] = append(vdrToDelegators[delegator.NodeID], delegator)
+
+		case txs.SubnetPermissionedValidatorCurrentPriority:
+			reply.Validators = append(reply.Validators, platformapi.PermissionedValidator{
+				Staker:    apiStaker,
+				Connected: currentStaker.connected,
+				Uptime:    getAPIUptime(currentStaker),
+			})
+
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unexpected staker priority %d", currentStaker.priority)
+		}
+	}
+
+	for i, vdrIntf := range reply.Validators {
+		vdr, ok := vdrIntf.(platformapi.PermissionlessValidator)
+		if !ok {
+			continue
+		}
+		delegators, ok := vdrToDelegators[vdr.NodeID]
+		if !ok {
+			delegators = []platformapi.PrimaryDelegator{}
+		}
+		delegatorCount := avajson.Uint64(len(delegators))
+		delegatorWeight := avajson.Uint64(0)
+		for _, d := range delegators {
+			delegatorWeight += d.Weight
+		}
+
+		vdr.DelegatorCount = &delegatorCount
+		vdr.DelegatorWeight = &delegatorWeight
+		if numNodeIDs == 1 {
+			vdr.Delegators = &delegators
+		}
+		reply.Validators[i] = vdr
+	}
+	return reply, nil
+}
+
+func (s *server) ParseApiRequest(ctx context.Context, req *rpcpb.ParseApiRequestRequest) (*rpcpb.ParseApiRequestResponse, error) {
+	logger(ctx).Debug("received ParseApiRequest request", zap.Int("body-size", len(req.Body)))
+
+	resp := &rpcpb.ParseApiRequestResponse{
+		Success: true,
+	}
+	method, params, bindings, err := parseAPIRequest(req.Body)
+	resp.ExpectedMethod = method
+	resp.FieldBindings = bindings
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	} else {
+		resp.ExpectedParamsJson = string(params)
+	}
+
+	switch {
+	case (err != nil) != req.Rejected:
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	case req.Rejected:
+	default:
+		if resp.Message, resp.Success, err = compareJSON(params, "params_json", req.ParamsJson); err != nil {
+			return nil, err
+		}
+		if !resp.Success {
+			break
+		}
+		// parameters avalanchego binds leniently (or ignores) are bugs of
+		// the client all the same
+		if unbound := unboundAPIParams(bindings); len(unbound) > 0 {
+			resp.Message = "parameters not bound to their field: " + strings.Join(unbound, ", ")
+			resp.Success = false
+		}
+	}
+	return resp, nil
+}
+
+// parseAPIRequest parses the JSON-RPC request body as the API server does,
+// returning the method resolved, its arguments marshaled back to JSON, and
+// the bindings of its parameters.
+// ref. "rpc.Server.ServeHTTP", "rpc.serviceMap.get"
+func parseAPIRequest(body string) (string, []byte, []*rpcpb.ApiFieldBinding, error) {
+	httpReq := httptest.NewRequest(http.MethodPost, "/ext", strings.NewReader(body))
+	httpReq.Header.Set("Content-Type", "application/json")
+	codecReq := avajson.NewCodec().NewRequest(httpReq)
+
+	method, err := codecReq.Method()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	parts := strings.Split(method, ".")
+	if len(parts) != 2 {
+		return method, nil, nil, fmt.Errorf("rpc: service/method request ill-formed: %q", method)
+	}
+	methods, ok := apiArgs[parts[0]]
+	if !ok {
+		return method, nil, nil, fmt.Errorf("rpc: can't find service %q", method)
+	}
+	newArgs, ok := methods[parts[1]]
+	if !ok {
+		return method, nil, nil, fmt.Errorf("rpc: can't find method %q", method)
+	}
+
+	// the codec does not expose the parameters, decoded again as it does
+	var rpcReq struct {
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(strings.NewReader(body)).Decode(&rpcReq); err != nil {
+		return method, nil, nil, err
+	}
+	args := newArgs()
+	bindings := bindAPIParams(args, rpcReq.Params)
+	if err := codecReq.ReadRequest(args); err != nil {
+		return method, nil, bindings, err
+	}
+	params, err := json.Marshal(args)
+	if err != nil {
+		return method, nil, bindings, err
+	}
+	return method, params, bindings, nil
+}
+
+// renderJSONRPC renders the JSON-RPC response of the method, of the reply or
+// else of the error the method fails with, as the API server writes it.
+// ref. "rpc.Server.ServeHTTP"
+func renderJSONRPC(method string, id uint64, reply interface{}, callErr error) []byte {
+	body := `{"jsonrpc":"2.0","method":` + strconv.Quote(method) + `,"params":{},"id":` + strconv.FormatUint(id, 10) + `}`
+	httpReq := httptest.NewRequest(http.MethodPost, "/ext/bc/P", strings.NewReader(body))
+	httpReq.Header.Set("Content-Type", "application/json")
+	codecReq := avajson.NewCodec().NewRequest(httpReq)
+
+	w := httptest.NewRecorder()
+	if callErr != nil {
+		codecReq.WriteError(w, http.StatusBadRequest, callErr)
+	} else {
+		codecReq.WriteResponse(w, reply)
+	}
+	return w.Body.Bytes()
+}
+
+// parseAPIEncoding parses the encoding requested of the API, hex if empty.
+func parseAPIEncoding(s string) (formatting.Encoding, error) {
+	if s == "" {
+		return formatting.Hex, nil
+	}
+	var encoding formatting.Encoding
+	if err := encoding.UnmarshalJSON([]byte(strconv.Quote(s))); err != nil {
+		return 0, fmt.Errorf("encoding %q: %w", s, err)
+	}
+	return encoding, nil
+}
+
+// platformContext is the context of the P-chain of the network, for the
+// addresses of its JSON to be formatted.
+func platformContext(networkID uint32) *snow.Context {
+	aliaser := ids.NewAliaser()
+	_ = aliaser.Alias(constants.PlatformChainID, "P")
+	return &snow.Context{
+		NetworkID: networkID,
+		ChainID:   constants.PlatformChainID,
+		BCLookup:  aliaser,
+	}
+}
+
+// formatPlatformAddress formats the address on the P-chain of the network.
+// ref. "avax.AddressManager.FormatLocalAddress"
+func formatPlatformAddress(addr ids.ShortID, networkID uint32) (string, error) {
+	return address.Format("P", constants.GetHRP(networkID), addr[:])
+}
@@ -0,0 +1,218 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/api/keystore"
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+)
+
+// apiArgs are the arguments of the methods of the APIs, by service and then
+// by method (as resolved by the API server).
+var apiArgs = map[string]map[string]func() interface{}{
+	"platform": {
+		"GetHeight":            func() interface{} { return &struct{}{} },
+		"GetTx":                func() interface{} { return &api.GetTxArgs{} },
+		"GetTxStatus":          func() interface{} { return &platformvm.GetTxStatusArgs{} },
+		"GetUTXOs":             func() interface{} { return &api.GetUTXOsArgs{} },
+		"GetBalance":           func() interface{} { return &platformvm.GetBalanceRequest{} },
+		"GetStake":             func() interface{} { return &platformvm.GetStakeArgs{} },
+		"GetCurrentValidators": func() interface{} { return &platformvm.GetCurrentValidatorsArgs{} },
+		"GetBlock":             func() interface{} { return &api.GetBlockArgs{} },
+		"IssueTx":              func() interface{} { return &api.FormattedTx{} },
+	},
+	"avm": {
+		"GetBlock":            func() interface{} { return &api.GetBlockArgs{} },
+		"GetBlockByHeight":    func() interface{} { return &api.GetBlockByHeightArgs{} },
+		"GetHeight":           func() interface{} { return &struct{}{} },
+		"IssueTx":             func() interface{} { return &api.FormattedTx{} },
+		"GetAddressTxs":       func() interface{} { return &avm.GetAddressTxsArgs{} },
+		"GetTxStatus":         func() interface{} { return &api.JSONTxID{} },
+		"GetTx":               func() interface{} { return &api.GetTxArgs{} },
+		"GetUTXOs":            func() interface{} { return &api.GetUTXOsArgs{} },
+		"GetAssetDescription": func() interface{} { return &avm.GetAssetDescriptionArgs{} },
+		"GetBalance":          func() interface{} { return &avm.GetBalanceArgs{} },
+		"GetAllBalances":      func() interface{} { return &avm.GetAllBalancesArgs{} },
+		"CreateAddress":       func() interface{} { return &api.UserPass{} },
+		"ListAddresses":       func() interface{} { return &api.UserPass{} },
+		"ExportKey":           func() interface{} { return &avm.ExportKeyArgs{} },
+		"ImportKey":           func() interface{} { return &avm.ImportKeyArgs{} },
+		"Send":                func() interface{} { return &avm.SendArgs{} },
+	},
+	"info": {
+		"GetNodeVersion":  func() interface{} { return &struct{}{} },
+		"GetNodeID":       func() interface{} { return &struct{}{} },
+		"GetNodeIP":       func() interface{} { return &struct{}{} },
+		"GetNetworkID":    func() interface{} { return &struct{}{} },
+		"GetNetworkName":  func() interface{} { return &struct{}{} },
+		"GetBlockchainID": func() interface{} { return &info.GetBlockchainIDArgs{} },
+		"Peers":           func() interface{} { return &info.PeersArgs{} },
+		"IsBootstrapped":  func() interface{} { return &info.IsBootstrappedArgs{} },
+		"Uptime":          func() interface{} { return &info.UptimeRequest{} },
+		"GetTxFee":        func() interface{} { return &struct{}{} },
+		"GetVMs":          func() interface{} { return &struct{}{} },
+	},
+	"keystore": {
+		"CreateUser": func() interface{} { return &api.UserPass{} },
+		"DeleteUser": func() interface{} { return &api.UserPass{} },
+		"ListUsers":  func() interface{} { return &struct{}{} },
+		"ImportUser": func() interface{} { return &keystore.ImportUserArgs{} },
+		"ExportUser": func() interface{} { return &keystore.ExportUserArgs{} },
+	},
+}
+
+// apiField is a field of arguments, by its JSON name.
+type apiField struct {
+	name string
+	typ  reflect.Type
+}
+
+// apiFields returns the fields of the arguments as encoding/json binds them,
+// with the fields of embedded structs promoted.
+// ref. "json.typeFields"
+func apiFields(t reflect.Type) []apiField {
+	var fields []apiField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				fields = append(fields, apiFields(ft)...)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, apiField{name: name, typ: f.Type})
+	}
+	return fields
+}
+
+// bindAPIParams reports how each parameter binds to the fields of the
+// arguments, then the fields no parameter binds to. The parameters are an
+// object, or an array whose first element is (as read by the codec). None
+// are reported if the parameters are neither.
+// ref. "json2.CodecRequest.ReadRequest"
+func bindAPIParams(args interface{}, params json.RawMessage) []*rpcpb.ApiFieldBinding {
+	fields := apiFields(reflect.TypeOf(args).Elem())
+
+	params = bytes.TrimSpace(params)
+	if len(params) > 0 && params[0] == '[' {
+		var elems []json.RawMessage
+		if err := json.Unmarshal(params, &elems); err != nil {
+			return nil
+		}
+		params = nil
+		if len(elems) > 0 {
+			params = bytes.TrimSpace(elems[0])
+		}
+	}
+
+	var bindings []*rpcpb.ApiFieldBinding
+	bound := make(map[string]bool, len(fields))
+	if len(params) > 0 && !bytes.Equal(params, []byte(avajson.Null)) {
+		dec := json.NewDecoder(bytes.NewReader(params))
+		if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+			return nil
+		}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil
+			}
+			param := tok.(string)
+			var value json.RawMessage
+			if err := dec.Decode(&value); err != nil {
+				return nil
+			}
+			bindings = append(bindings, bindAPIParam(fields, param, value, bound))
+		}
+	}
+	for _, f := range fields {
+		if !bound[f.name] {
+			bindings = append(bindings, &rpcpb.ApiFieldBinding{
+				Field:  f.name,
+				Status: rpcpb.ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_MISSING,
+			})
+		}
+	}
+	return bindings
+}
+
+// bindAPIParam binds the parameter to the field of the same name, or else
+// to the first whose name matches case-insensitively, as encoding/json does.
+func bindAPIParam(fields []apiField, param string, value json.RawMessage, bound map[string]bool) *rpcpb.ApiFieldBinding {
+	binding := &rpcpb.ApiFieldBinding{
+		Param:  param,
+		Status: rpcpb.ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_BOUND,
+	}
+	var field *apiField
+	for i := range fields {
+		if fields[i].name == param {
+			field = &fields[i]
+			break
+		}
+	}
+	if field == nil {
+		for i := range fields {
+			if strings.EqualFold(fields[i].name, param) {
+				field = &fields[i]
+				binding.Status = rpcpb.ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_CASE_MISMATCH
+				break
+			}
+		}
+	}
+	if field == nil {
+		binding.Status = rpcpb.ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_UNKNOWN
+		return binding
+	}
+
+	binding.Field = field.name
+	bound[field.name] = true
+	if err := json.Unmarshal(value, reflect.New(field.typ).Interface()); err != nil {
+		binding.Status = rpcpb.ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_INVALID
+		binding.Error = err.Error()
+	}
+	return binding
+}
+
+// unboundAPIParams describes the parameters not bound to the field of the
+// same name.
+func unboundAPIParams(bindings []*rpcpb.ApiFieldBinding) []string {
+	var unbound []string
+	for _, b := range bindings {
+		switch b.Status {
+		case rpcpb.ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_CASE_MISMATCH:
+			unbound = append(unbound, fmt.Sprintf("%q (case mismatch of %q)", b.Param, b.Field))
+		case rpcpb.ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_INVALID:
+			unbound = append(unbound, fmt.Sprintf("%q (invalid: %s)", b.Param, b.Error))
+		case rpcpb.ApiFieldBindingStatus_API_FIELD_BINDING_STATUS_UNKNOWN:
+			unbound = append(unbound, fmt.Sprintf("%q (unknown)", b.Param))
+		}
+	}
+	return unbound
+}
@@ -6,25 +6,40 @@ package server
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
+	"net"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
 	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/utils/cb58"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	dsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	eth_crypto "github.com/ethereum/go-ethereum/crypto"
 	"go.uber.org/zap"
 )
 
+var (
+	// ref. "secp256k1.errMutatedSig"
+	errHighS          = errors.New("s over the half order, signature was mutated from its original format")
+	errSignerMismatch = errors.New("signature is of a different key")
+)
+
 func (s *server) CertificateToNodeId(ctx context.Context, req *rpcpb.CertificateToNodeIdRequest) (*rpcpb.CertificateToNodeIdResponse, error) {
-	zap.L().Debug("received CertificateToNodeId request", zap.Int("cert-size", len(req.Certificate)))
+	logger(ctx).Debug("received CertificateToNodeId request", zap.Int("cert-size", len(req.Certificate)))
 
 	nodeID, err := ids.ToShortID(hashing.PubkeyBytesToAddress(req.Certificate))
 	if err != nil {
@@ -40,11 +55,52 @@ func (s *server) CertificateToNodeId(ctx context.Context, req *rpcpb.Certificate
 	return resp, nil
 }
 
-func (s *server) Secp256K1RecoverHashPublicKey(ctx context.Context, req *rpcpb.Secp256K1RecoverHashPublicKeyRequest) (*rpcpb.Secp256K1RecoverHashPublicKeyResponse, error) {
-	zap.L().Debug("received Secp256K1RecoverHashPublicKey request")
+func (s *server) SignedIp(ctx context.Context, req *rpcpb.SignedIpRequest) (*rpcpb.SignedIpResponse, error) {
+	logger(ctx).Debug("received SignedIp request", zap.Int("cert-size", len(req.Certificate)))
 
+	cert, err := x509.ParseCertificate(pemOrDER(req.Certificate))
+	if err != nil {
+		return nil, err
+	}
+	addr := net.IP(req.IpAddr)
+	if len(addr) != net.IPv4len && len(addr) != net.IPv6len {
+		return nil, wrongLength("ip_addr", fmt.Errorf("invalid IP length %d", len(addr)))
+	}
+	if req.IpPort > math.MaxUint16 {
+		return nil, outOfRange("ip_port", fmt.Errorf("port %d overflows a short", req.IpPort))
+	}
+	ip := peer.UnsignedIP{
+		IPPort: ips.IPPort{
+			IP:   addr,
+			Port: uint16(req.IpPort),
+		},
+		Timestamp: req.Timestamp,
+	}
+
+	resp := &rpcpb.SignedIpResponse{
+		ExpectedUnsignedIpBytes: unsignedIPBytes(&ip),
+		Success:                 true,
+	}
+	if d := newDiff(resp.ExpectedUnsignedIpBytes, req.UnsignedIpBytes, diff.AnnotatorPath(unsignedIPFields)); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+	signedIP := peer.SignedIP{
+		UnsignedIP: ip,
+		Signature:  req.Sig,
+	}
+	if err := signedIP.Verify(cert); err != nil {
+		resp.Message = joinMessages(resp.Message, []error{fmt.Errorf("%w: %v", errUnverifiableSignedIP, err)})
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) Secp256K1RecoverHashPublicKey(ctx context.Context, req *rpcpb.Secp256K1RecoverHashPublicKeyRequest) (*rpcpb.Secp256K1RecoverHashPublicKeyResponse, error) {
 	resp := &rpcpb.Secp256K1RecoverHashPublicKeyResponse{Success: true}
-	pubkey, err := s.secpFactory.RecoverHashPublicKey(req.Message, req.Signature)
+	pubkey, err := s.recoverHashPublicKey(req.Message, req.Signature)
 	if err != nil {
 		resp.Message = fmt.Sprintf("failed RecoverHashPublicKey %v", err)
 		resp.Success = false
@@ -61,11 +117,9 @@ func (s *server) Secp256K1RecoverHashPublicKey(ctx context.Context, req *rpcpb.S
 }
 
 func (s *server) Secp256K1Info(ctx context.Context, req *rpcpb.Secp256K1InfoRequest) (*rpcpb.Secp256K1InfoResponse, error) {
-	zap.L().Debug("received Secp256K1Info request")
-
 	// based on the received cb58-encoded key, create its own key info using avalanchego
 	privKeyInfo := &rpcpb.Secp256K1Info{KeyType: "hot", ChainAddresses: make(map[uint32]*rpcpb.ChainAddresses)}
-	privKey, err := decodePrivateKey(req.Secp256K1Info.PrivateKeyCb58)
+	privKey, err := decodePrivateKey(s.secpFactory, req.Secp256K1Info.PrivateKeyCb58)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +161,8 @@ func (s *server) Secp256K1Info(ctx context.Context, req *rpcpb.Secp256K1InfoRequ
 		resp.Message += "req.Secp256K1Info.PrivateKeyHex != ExpectedSecp256K1Info.PrivateKeyHex"
 		resp.Success = false
 	}
-	if !reflect.DeepEqual(req.Secp256K1Info.ChainAddresses, privKeyInfo.ChainAddresses) {
+	// an empty map is decoded as nil, e.g., when only the address matrix is checked
+	if len(req.Secp256K1Info.ChainAddresses) > 0 && !reflect.DeepEqual(req.Secp256K1Info.ChainAddresses, privKeyInfo.ChainAddresses) {
 		if resp.Message != "" {
 			resp.Message += "; "
 		}
@@ -130,6 +185,33 @@ func (s *server) Secp256K1Info(ctx context.Context, req *rpcpb.Secp256K1InfoRequ
 		resp.Success = false
 	}
 
+	var mismatches []error
+	resp.ExpectedAddressMatrix = make([]*rpcpb.AddressMatrixEntry, 0, len(req.AddressMatrix))
+	for i, entry := range req.AddressMatrix {
+		hrp := entry.Hrp
+		if hrp == "" {
+			hrp = constants.GetHRP(entry.NetworkId)
+		}
+		addr, err := encodeAddr(privKey, entry.ChainAlias, hrp)
+		if err != nil {
+			return nil, err
+		}
+		resp.ExpectedAddressMatrix = append(resp.ExpectedAddressMatrix, &rpcpb.AddressMatrixEntry{
+			NetworkId:  entry.NetworkId,
+			Hrp:        hrp,
+			ChainAlias: entry.ChainAlias,
+			Address:    addr,
+		})
+		if entry.Address != addr {
+			resp.MismatchedEntries = append(resp.MismatchedEntries, uint32(i))
+			mismatches = append(mismatches, fmt.Errorf("address_matrix[%d]: expected %q, got %q", i, addr, entry.Address))
+		}
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages(resp.Message, mismatches)
+		resp.Success = false
+	}
+
 	if resp.Success {
 		resp.Message = "SUCCESS"
 	}
@@ -138,6 +220,112 @@ func (s *server) Secp256K1Info(ctx context.Context, req *rpcpb.Secp256K1InfoRequ
 
 const privKeyEncPfx = "PrivateKey-"
 
+func (s *server) Secp256K1Sign(ctx context.Context, req *rpcpb.Secp256K1SignRequest) (*rpcpb.Secp256K1SignResponse, error) {
+	logger(ctx).Debug("received Secp256K1Sign request", zap.Bool("prehashed", req.Prehashed))
+
+	privKey, err := s.secpFactory.ToPrivateKey(req.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	hash := secpHash(req.Message, req.Prehashed)
+	sig, err := privKey.SignHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.Secp256K1SignResponse{
+		ExpectedHash:      hash,
+		ExpectedSignature: sig,
+		Success:           true,
+	}
+	if d := newDiff(sig, req.Signature, diff.AnnotatorPath(secpSignatureFields)); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+		if len(req.Signature) == secp256k1.SignatureLen && isHighS(req.Signature) {
+			resp.Message = joinMessages(resp.Message, []error{errHighS})
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) Secp256K1Verify(ctx context.Context, req *rpcpb.Secp256K1VerifyRequest) (*rpcpb.Secp256K1VerifyResponse, error) {
+	logger(ctx).Debug("received Secp256K1Verify request", zap.Bool("prehashed", req.Prehashed))
+
+	pubkey, err := s.secpFactory.ToPublicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	hash := secpHash(req.Message, req.Prehashed)
+
+	resp := &rpcpb.Secp256K1VerifyResponse{
+		ExpectedValid: pubkey.VerifyHash(hash, req.Signature),
+		Success:       true,
+	}
+	if !resp.ExpectedValid {
+		// "VerifyHash" recovers the key with a factory of its own
+		f := secp256k1.Factory{}
+		if _, err := f.RecoverHashPublicKey(hash, req.Signature); err != nil {
+			resp.ExpectedError = err.Error()
+		} else {
+			resp.ExpectedError = errSignerMismatch.Error()
+		}
+	}
+	if resp.ExpectedValid != req.Valid {
+		resp.Message = fmt.Sprintf("expected valid %t, got %t", resp.ExpectedValid, req.Valid)
+		if resp.ExpectedError != "" {
+			resp.Message += fmt.Sprintf(" (%s)", resp.ExpectedError)
+		}
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) Secp256K1PublicKey(ctx context.Context, req *rpcpb.Secp256K1PublicKeyRequest) (*rpcpb.Secp256K1PublicKeyResponse, error) {
+	privKey, err := s.secpFactory.ToPrivateKey(req.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	pubkey := privKey.PublicKey().Bytes()
+
+	resp := &rpcpb.Secp256K1PublicKeyResponse{
+		ExpectedPublicKey: pubkey,
+		Success:           true,
+	}
+	if !bytes.Equal(pubkey, req.PublicKey) {
+		resp.Message = fmt.Sprintf("expected public key 0x%x, got 0x%x", pubkey, req.PublicKey)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// secpHash returns the hash signed for the message.
+// ref. "secp256k1.PrivateKey.Sign"
+func secpHash(msg []byte, prehashed bool) []byte {
+	if prehashed {
+		return msg
+	}
+	return hashing.ComputeHash256(msg)
+}
+
+// isHighS returns true if the s of the [r || s || v] signature is over the
+// half order, which avalanchego rejects as mutated.
+// ref. "secp256k1.verifySECP256K1RSignatureFormat"
+func isHighS(sig []byte) bool {
+	var sValue dsecp256k1.ModNScalar
+	sValue.SetByteSlice(sig[32:64])
+	return sValue.IsOverHalfOrder()
+}
+
+// secpSignatureFields annotates a [r || s || v] signature.
+func secpSignatureFields([]byte) []diff.Field {
+	return []diff.Field{
+		{Name: "r", Start: 0, End: 32},
+		{Name: "s", Start: 32, End: 64},
+		{Name: "v", Start: 64, End: secp256k1.SignatureLen},
+	}
+}
+
 func encodePrivateKey(pk *secp256k1.PrivateKey) (string, error) {
 	privKeyRaw := pk.Bytes()
 	enc, err := cb58.Encode(privKeyRaw)
@@ -147,7 +335,7 @@ func encodePrivateKey(pk *secp256k1.PrivateKey) (string, error) {
 	return privKeyEncPfx + enc, nil
 }
 
-func decodePrivateKey(enc string) (*secp256k1.PrivateKey, error) {
+func decodePrivateKey(keyFactory *secp256k1.Factory, enc string) (*secp256k1.PrivateKey, error) {
 	rawPk := strings.Replace(enc, privKeyEncPfx, "", 1)
 
 	// ref. "formatting.Decode(formatting.CB58"
@@ -156,7 +344,6 @@ func decodePrivateKey(enc string) (*secp256k1.PrivateKey, error) {
 		return nil, err
 	}
 
-	keyFactory := new(secp256k1.Factory)
 	return keyFactory.ToPrivateKey(skBytes)
 }
 
@@ -175,8 +362,6 @@ func encodeEthAddr(pk *secp256k1.PrivateKey) string {
 }
 
 func (s *server) BlsSignature(ctx context.Context, req *rpcpb.BlsSignatureRequest) (*rpcpb.BlsSignatureResponse, error) {
-	zap.L().Debug("received BlsSignature request")
-
 	sk, err := bls.SecretKeyFromBytes(req.PrivateKey)
 	if err != nil {
 		return nil, err
@@ -191,7 +376,7 @@ func (s *server) BlsSignature(ctx context.Context, req *rpcpb.BlsSignatureReques
 		Success: true,
 	}
 
-	zap.L().Info("verifying Signature")
+	logger(ctx).Debug("verifying Signature")
 	sig := bls.Sign(sk, req.Message)
 	if !bls.Verify(pubkey, sig, req.Message) {
 		if resp.Message != "" {
@@ -201,7 +386,7 @@ func (s *server) BlsSignature(ctx context.Context, req *rpcpb.BlsSignatureReques
 		resp.Success = false
 	}
 
-	zap.L().Info("verifying Signature by loading")
+	logger(ctx).Debug("verifying Signature by loading")
 	loadedSig, err := bls.SignatureFromBytes(req.Signature)
 	if err != nil {
 		return nil, err
@@ -214,7 +399,7 @@ func (s *server) BlsSignature(ctx context.Context, req *rpcpb.BlsSignatureReques
 		resp.Success = false
 	}
 
-	zap.L().Info("verifying SignatureProofOfPossession")
+	logger(ctx).Debug("verifying SignatureProofOfPossession")
 	sigPoP := bls.SignProofOfPossession(sk, req.Message)
 	if !bls.VerifyProofOfPossession(pubkey, sigPoP, req.Message) {
 		if resp.Message != "" {
@@ -224,7 +409,7 @@ func (s *server) BlsSignature(ctx context.Context, req *rpcpb.BlsSignatureReques
 		resp.Success = false
 	}
 
-	zap.L().Info("verifying SignatureProofOfPossession by loading")
+	logger(ctx).Debug("verifying SignatureProofOfPossession by loading")
 	loadedSigPoP, err := bls.SignatureFromBytes(req.SignatureProofOfPossession)
 	if err != nil {
 		return nil, err
@@ -242,3 +427,196 @@ func (s *server) BlsSignature(ctx context.Context, req *rpcpb.BlsSignatureReques
 	}
 	return resp, nil
 }
+
+func (s *server) BlsBatchVerify(ctx context.Context, req *rpcpb.BlsBatchVerifyRequest) (*rpcpb.BlsBatchVerifyResponse, error) {
+	logger(ctx).Debug("received BlsBatchVerify request", zap.Int("items", len(req.Items)))
+
+	pubkeys := make([]*bls.PublicKey, len(req.Items))
+	sigs := make([]*bls.Signature, len(req.Items))
+	parsed := true
+	for i, item := range req.Items {
+		pubkey, err := bls.PublicKeyFromBytes(item.PublicKey)
+		if err != nil {
+			parsed = false
+			continue
+		}
+		sig, err := bls.SignatureFromBytes(item.Signature)
+		if err != nil {
+			parsed = false
+			continue
+		}
+		pubkeys[i], sigs[i] = pubkey, sig
+	}
+
+	resp := &rpcpb.BlsBatchVerifyResponse{
+		ExpectedValid: make([]bool, len(req.Items)),
+		Success:       true,
+	}
+
+	start := time.Now()
+	for i, item := range req.Items {
+		if pubkeys[i] != nil {
+			resp.ExpectedValid[i] = bls.Verify(pubkeys[i], sigs[i], item.Message)
+		}
+	}
+	resp.VerifyDurationNs = uint64(time.Since(start).Nanoseconds())
+
+	// ref. "warp.BitSetSignature.Verify"
+	resp.Aggregated = parsed && len(req.Items) > 0
+	for _, item := range req.Items {
+		if !bytes.Equal(item.Message, req.Items[0].Message) {
+			resp.Aggregated = false
+			break
+		}
+	}
+	if resp.Aggregated {
+		start := time.Now()
+		aggPubKey, err := bls.AggregatePublicKeys(pubkeys)
+		if err != nil {
+			return nil, err
+		}
+		aggSig, err := bls.AggregateSignatures(sigs)
+		if err != nil {
+			return nil, err
+		}
+		resp.ExpectedAggregateValid = bls.Verify(aggPubKey, aggSig, req.Items[0].Message)
+		resp.AggregateVerifyDurationNs = uint64(time.Since(start).Nanoseconds())
+	}
+
+	for i, item := range req.Items {
+		if item.Valid != resp.ExpectedValid[i] {
+			if resp.Message != "" {
+				resp.Message += "; "
+			}
+			resp.Message += fmt.Sprintf("item %d: expected valid %t, got %t", i, resp.ExpectedValid[i], item.Valid)
+			resp.Success = false
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) BlsAggregatePublicKeys(ctx context.Context, req *rpcpb.BlsAggregatePublicKeysRequest) (*rpcpb.BlsAggregatePublicKeysResponse, error) {
+	logger(ctx).Debug("received BlsAggregatePublicKeys request", zap.Int("public_keys", len(req.PublicKeys)))
+
+	pubkeys, err := blsPublicKeys(req.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	aggPubKey, err := bls.AggregatePublicKeys(pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	expected := bls.PublicKeyToBytes(aggPubKey)
+
+	resp := &rpcpb.BlsAggregatePublicKeysResponse{
+		ExpectedAggregatePublicKey: expected,
+		Success:                    true,
+	}
+	if d := newDiff(expected, req.AggregatePublicKey, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) BlsAggregateSignatures(ctx context.Context, req *rpcpb.BlsAggregateSignaturesRequest) (*rpcpb.BlsAggregateSignaturesResponse, error) {
+	logger(ctx).Debug("received BlsAggregateSignatures request", zap.Int("signatures", len(req.Signatures)))
+
+	sigs := make([]*bls.Signature, 0, len(req.Signatures))
+	for i, b := range req.Signatures {
+		sig, err := bls.SignatureFromBytes(b)
+		if err != nil {
+			return nil, invalidField(fmt.Sprintf("signatures[%d]", i), err)
+		}
+		sigs = append(sigs, sig)
+	}
+	aggSig, err := bls.AggregateSignatures(sigs)
+	if err != nil {
+		return nil, err
+	}
+	expected := bls.SignatureToBytes(aggSig)
+
+	resp := &rpcpb.BlsAggregateSignaturesResponse{
+		ExpectedAggregateSignature: expected,
+		Success:                    true,
+	}
+	if d := newDiff(expected, req.AggregateSignature, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) BlsAggregateVerify(ctx context.Context, req *rpcpb.BlsAggregateVerifyRequest) (*rpcpb.BlsAggregateVerifyResponse, error) {
+	logger(ctx).Debug("received BlsAggregateVerify request", zap.Int("public_keys", len(req.PublicKeys)))
+
+	resp := &rpcpb.BlsAggregateVerifyResponse{
+		Success: true,
+	}
+	pubkeys, err := blsPublicKeys(req.PublicKeys)
+	if err == nil {
+		var (
+			aggPubKey *bls.PublicKey
+			sig       *bls.Signature
+		)
+		aggPubKey, err = bls.AggregatePublicKeys(pubkeys)
+		if err == nil {
+			sig, err = bls.SignatureFromBytes(req.AggregateSignature)
+		}
+		if err == nil {
+			resp.ExpectedValid = bls.Verify(aggPubKey, sig, req.Message)
+		}
+	}
+
+	if req.Valid != resp.ExpectedValid {
+		resp.Message = fmt.Sprintf("expected valid %t, got %t", resp.ExpectedValid, req.Valid)
+		if err != nil {
+			resp.Message += fmt.Sprintf(" (%v)", err)
+		}
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) BlsPublicKeyEncoding(ctx context.Context, req *rpcpb.BlsPublicKeyEncodingRequest) (*rpcpb.BlsPublicKeyEncodingResponse, error) {
+	sk, err := bls.SecretKeyFromBytes(req.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+	pubkey := bls.PublicFromSecretKey(sk)
+
+	resp := &rpcpb.BlsPublicKeyEncodingResponse{
+		ExpectedCompressedPublicKey:   bls.PublicKeyToBytes(pubkey),
+		ExpectedUncompressedPublicKey: pubkey.Serialize(),
+		Success:                       true,
+	}
+	if d := newDiff(resp.ExpectedCompressedPublicKey, req.CompressedPublicKey, nil); d != nil {
+		resp.CompressedDiff = d
+		resp.Message = "compressed: " + d.Summary
+		resp.Success = false
+	}
+	if d := newDiff(resp.ExpectedUncompressedPublicKey, req.UncompressedPublicKey, nil); d != nil {
+		resp.UncompressedDiff = d
+		if resp.Message != "" {
+			resp.Message += "; "
+		}
+		resp.Message += "uncompressed: " + d.Summary
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// blsPublicKeys parses compressed public keys.
+func blsPublicKeys(pks [][]byte) ([]*bls.PublicKey, error) {
+	pubkeys := make([]*bls.PublicKey, 0, len(pks))
+	for i, b := range pks {
+		pubkey, err := bls.PublicKeyFromBytes(b)
+		if err != nil {
+			return nil, invalidField(fmt.Sprintf("public_keys[%d]", i), err)
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	return pubkeys, nil
+}
@@ -0,0 +1,369 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/api/keystore"
+	"github.com/ava-labs/avalanchego/database/manager"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/version"
+	ckeystore "github.com/ava-labs/avalanchego/vms/components/keystore"
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// ref. "keystore.LightScryptN" in go-ethereum
+const (
+	ethKeyfileVersion = 3
+	ethLightScryptN   = 1 << 12
+	ethLightScryptP   = 6
+	ethScryptR        = 8
+	ethScryptDKLen    = 32
+)
+
+var (
+	// ref. "keystore.ErrDecrypt" in go-ethereum
+	errEthKeyfileDecrypt = errors.New("could not decrypt key with given password")
+
+	errUnsupportedEthKeyfile = errors.New("unsupported keyfile")
+)
+
+func (s *server) KeystoreImportUser(ctx context.Context, req *rpcpb.KeystoreImportUserRequest) (*rpcpb.KeystoreImportUserResponse, error) {
+	logger(ctx).Debug("received KeystoreImportUser request", zap.String("username", req.Username))
+
+	blockchainID, err := toID("blockchain_id", req.BlockchainId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.KeystoreImportUserResponse{
+		Success: true,
+	}
+	privKeys, err := importKeystoreUser(req.Username, req.Password, blockchainID, req.UserBytes)
+	if err != nil {
+		resp.Message = err.Error()
+		resp.Success = false
+		return resp, nil
+	}
+	for _, privKey := range privKeys {
+		resp.RecoveredPrivateKeys = append(resp.RecoveredPrivateKeys, privKey.Bytes())
+	}
+
+	expected := make([][]byte, len(req.PrivateKeys))
+	copy(expected, req.PrivateKeys)
+	recovered := make([][]byte, len(resp.RecoveredPrivateKeys))
+	copy(recovered, resp.RecoveredPrivateKeys)
+	sortBytes(expected)
+	sortBytes(recovered)
+	if len(expected) != len(recovered) {
+		resp.Message = fmt.Sprintf("expected %d private keys, recovered %d", len(expected), len(recovered))
+		resp.Success = false
+		return resp, nil
+	}
+	for i := range expected {
+		if !bytes.Equal(expected[i], recovered[i]) {
+			resp.Message = "recovered private keys differ"
+			resp.Success = false
+			break
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) KeystoreExportUser(ctx context.Context, req *rpcpb.KeystoreExportUserRequest) (*rpcpb.KeystoreExportUserResponse, error) {
+	logger(ctx).Debug("received KeystoreExportUser request", zap.String("username", req.Username))
+
+	blockchainID, err := toID("blockchain_id", req.BlockchainId)
+	if err != nil {
+		return nil, err
+	}
+	privKeys := make([]*secp256k1.PrivateKey, 0, len(req.PrivateKeys))
+	for i, b := range req.PrivateKeys {
+		privKey, err := s.secpFactory.ToPrivateKey(b)
+		if err != nil {
+			return nil, invalidField(fmt.Sprintf("private_keys[%d]", i), err)
+		}
+		privKeys = append(privKeys, privKey)
+	}
+
+	ks := newMemKeystore()
+	if err := ks.CreateUser(req.Username, req.Password); err != nil {
+		return nil, err
+	}
+	db, err := ks.GetDatabase(blockchainID, req.Username, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if err := ckeystore.NewUserFromDB(db).PutKeys(privKeys...); err != nil {
+		return nil, err
+	}
+	userBytes, err := ks.ExportUser(req.Username, req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcpb.KeystoreExportUserResponse{
+		UserBytes: userBytes,
+	}, nil
+}
+
+func (s *server) EthKeyfileDecrypt(ctx context.Context, req *rpcpb.EthKeyfileDecryptRequest) (*rpcpb.EthKeyfileDecryptResponse, error) {
+	resp := &rpcpb.EthKeyfileDecryptResponse{
+		Success: true,
+	}
+	keyfile := ethKeyfile{}
+	if err := json.Unmarshal(req.KeyfileJson, &keyfile); err != nil {
+		resp.Message = err.Error()
+		resp.Success = false
+		return resp, nil
+	}
+	keyBytes, err := keyfile.decrypt(req.Passphrase)
+	if err != nil {
+		resp.Message = err.Error()
+		resp.Success = false
+		return resp, nil
+	}
+	key, err := eth_crypto.ToECDSA(keyBytes)
+	if err != nil {
+		resp.Message = fmt.Sprintf("invalid key: %v", err)
+		resp.Success = false
+		return resp, nil
+	}
+	addr := eth_crypto.PubkeyToAddress(key.PublicKey)
+	resp.ExpectedPrivateKey = keyBytes
+	resp.ExpectedEthAddress = addr.String()
+
+	var mismatches []error
+	// ref. "keyStorePassphrase.GetKey" in go-ethereum
+	if !strings.EqualFold(strings.TrimPrefix(keyfile.Address, "0x"), hex.EncodeToString(addr[:])) {
+		mismatches = append(mismatches, fmt.Errorf("key content mismatch: have account %x, want %s", addr, keyfile.Address))
+	}
+	if !bytes.Equal(keyBytes, req.PrivateKey) {
+		mismatches = append(mismatches, errors.New("decrypted private key differs"))
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages("", mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) EthKeyfileEncrypt(ctx context.Context, req *rpcpb.EthKeyfileEncryptRequest) (*rpcpb.EthKeyfileEncryptResponse, error) {
+	key, err := eth_crypto.ToECDSA(req.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	scryptN, scryptP := int(req.ScryptN), int(req.ScryptP)
+	if scryptN == 0 {
+		scryptN = ethLightScryptN
+	}
+	if scryptP == 0 {
+		scryptP = ethLightScryptP
+	}
+	keyfile, err := encryptEthKeyfile(eth_crypto.FromECDSA(key), req.Passphrase, scryptN, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	addr := eth_crypto.PubkeyToAddress(key.PublicKey)
+	keyfile.Address = hex.EncodeToString(addr[:])
+	keyfileJSON, err := json.Marshal(keyfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcpb.EthKeyfileEncryptResponse{
+		KeyfileJson: keyfileJSON,
+	}, nil
+}
+
+func newMemKeystore() keystore.Keystore {
+	return keystore.New(logging.NoLog{}, manager.NewMemDB(version.Semantic1_0_0))
+}
+
+// importKeystoreUser returns the private keys the user stores for the
+// chain, ordered by address.
+// ref. "keystore.NewUserFromKeystore"
+func importKeystoreUser(username string, pw string, blockchainID ids.ID, userBytes []byte) ([]*secp256k1.PrivateKey, error) {
+	ks := newMemKeystore()
+	if err := ks.ImportUser(username, pw, userBytes); err != nil {
+		return nil, err
+	}
+	db, err := ks.GetDatabase(blockchainID, username, pw)
+	if err != nil {
+		return nil, err
+	}
+	user := ckeystore.NewUserFromDB(db)
+	addrs, err := user.GetAddresses()
+	if err != nil {
+		return nil, err
+	}
+	utils.Sort(addrs)
+	privKeys := make([]*secp256k1.PrivateKey, 0, len(addrs))
+	for _, addr := range addrs {
+		privKey, err := user.GetKey(addr)
+		if err != nil {
+			return nil, fmt.Errorf("key of %s: %w", addr, err)
+		}
+		privKeys = append(privKeys, privKey)
+	}
+	return privKeys, nil
+}
+
+func sortBytes(s [][]byte) {
+	sort.Slice(s, func(i, j int) bool {
+		return bytes.Compare(s[i], s[j]) < 0
+	})
+}
+
+// ethKeyfile mirrors the version 3 keyfile of go-ethereum.
+// ref. "keystore.encryptedKeyJSONV3" in go-ethereum
+type ethKeyfile struct {
+	Address string `json:"address"`
+	Crypto  struct {
+		Cipher       string `json:"cipher"`
+		CipherText   string `json:"ciphertext"`
+		CipherParams struct {
+			IV string `json:"iv"`
+		} `json:"cipherparams"`
+		KDF       string                 `json:"kdf"`
+		KDFParams map[string]interface{} `json:"kdfparams"`
+		MAC       string                 `json:"mac"`
+	} `json:"crypto"`
+	ID      string `json:"id"`
+	Version int    `json:"version"`
+}
+
+// ref. "keystore.DecryptDataV3" in go-ethereum
+func (k *ethKeyfile) decrypt(passphrase string) ([]byte, error) {
+	if k.Version != ethKeyfileVersion {
+		return nil, fmt.Errorf("%w: version not supported: %v", errUnsupportedEthKeyfile, k.Version)
+	}
+	if k.Crypto.Cipher != "aes-128-ctr" {
+		return nil, fmt.Errorf("%w: cipher not supported: %v", errUnsupportedEthKeyfile, k.Crypto.Cipher)
+	}
+	mac, err := hex.DecodeString(k.Crypto.MAC)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := hex.DecodeString(k.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := hex.DecodeString(k.Crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+	derivedKey, err := k.kdfKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(eth_crypto.Keccak256(derivedKey[16:32], cipherText), mac) {
+		return nil, errEthKeyfileDecrypt
+	}
+	return aesCTRXOR(derivedKey[:16], cipherText, iv)
+}
+
+// ref. "keystore.getKDFKey" in go-ethereum
+func (k *ethKeyfile) kdfKey(passphrase string) ([]byte, error) {
+	params := k.Crypto.KDFParams
+	saltHex, _ := params["salt"].(string)
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return nil, err
+	}
+	intParam := func(name string) int {
+		f, _ := params[name].(float64)
+		return int(f)
+	}
+	dkLen := intParam("dklen")
+	if dkLen < 32 {
+		return nil, fmt.Errorf("%w: dklen %d", errUnsupportedEthKeyfile, dkLen)
+	}
+
+	switch k.Crypto.KDF {
+	case "scrypt":
+		return scrypt.Key([]byte(passphrase), salt, intParam("n"), intParam("r"), intParam("p"), dkLen)
+	case "pbkdf2":
+		if prf, _ := params["prf"].(string); prf != "hmac-sha256" {
+			return nil, fmt.Errorf("%w: unsupported PBKDF2 PRF: %s", errUnsupportedEthKeyfile, prf)
+		}
+		return pbkdf2.Key([]byte(passphrase), salt, intParam("c"), dkLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported KDF: %s", errUnsupportedEthKeyfile, k.Crypto.KDF)
+	}
+}
+
+// ref. "keystore.EncryptKey" in go-ethereum
+func encryptEthKeyfile(keyBytes []byte, passphrase string, scryptN, scryptP int) (*ethKeyfile, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, ethScryptR, scryptP, ethScryptDKLen)
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	cipherText, err := aesCTRXOR(derivedKey[:16], keyBytes, iv)
+	if err != nil {
+		return nil, err
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	// random UUID (version 4, variant 10)
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+
+	k := &ethKeyfile{
+		ID:      fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]),
+		Version: ethKeyfileVersion,
+	}
+	k.Crypto.Cipher = "aes-128-ctr"
+	k.Crypto.CipherText = hex.EncodeToString(cipherText)
+	k.Crypto.CipherParams.IV = hex.EncodeToString(iv)
+	k.Crypto.KDF = "scrypt"
+	k.Crypto.KDFParams = map[string]interface{}{
+		"n":     scryptN,
+		"r":     ethScryptR,
+		"p":     scryptP,
+		"dklen": ethScryptDKLen,
+		"salt":  hex.EncodeToString(salt),
+	}
+	k.Crypto.MAC = hex.EncodeToString(eth_crypto.Keccak256(derivedKey[16:32], cipherText))
+	return k, nil
+}
+
+func aesCTRXOR(key, inText, iv []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	outText := make([]byte, len(inText))
+	cipher.NewCTR(block, iv).XORKeyStream(outText, inText)
+	return outText, nil
+}
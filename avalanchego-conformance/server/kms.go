@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	dsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"go.uber.org/zap"
+)
+
+var (
+	errDERTrailingData     = errors.New("trailing data after DER signature")
+	errDERScalarOutOfRange = errors.New("DER signature scalar out of range")
+	errSPKITrailingData    = errors.New("trailing data after SubjectPublicKeyInfo")
+	errKmsNoRecoveryID     = errors.New("no recovery ID recovers the public key")
+	errKmsHashLen          = fmt.Errorf("hash must be %d bytes", hashing.HashLen)
+
+	// ref. RFC 5480, section 2.1.1
+	oidECPublicKey = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	// ref. SEC 2, section A.2.1
+	oidSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// derSignature is an ECDSA signature, as AWS KMS returns it.
+// ref. RFC 3279, section 2.2.3
+type derSignature struct {
+	R, S *big.Int
+}
+
+// subjectPublicKeyInfo is a public key, as AWS KMS returns it.
+// ref. RFC 5280, section 4.1.2.7
+type subjectPublicKeyInfo struct {
+	Algorithm struct {
+		Algorithm  asn1.ObjectIdentifier
+		Parameters asn1.ObjectIdentifier
+	}
+	PublicKey asn1.BitString
+}
+
+func (s *server) Secp256K1KmsSignature(ctx context.Context, req *rpcpb.Secp256K1KmsSignatureRequest) (*rpcpb.Secp256K1KmsSignatureResponse, error) {
+	logger(ctx).Debug("received Secp256K1KmsSignature request", zap.Int("der-size", len(req.DerSignature)))
+
+	if len(req.Hash) != hashing.HashLen {
+		return nil, errKmsHashLen
+	}
+	pubKey, err := parseKmsPublicKey(req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.Secp256K1KmsSignatureResponse{
+		Success: true,
+	}
+	sig, normalized, err := s.kmsSignature(req.DerSignature, req.Hash, pubKey)
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	}
+	switch {
+	case (err != nil) != req.Rejected:
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	case req.Rejected:
+	default:
+		resp.ExpectedSignature = sig
+		resp.ExpectedSNormalized = normalized
+		if d := newDiff(sig, req.Signature, diff.AnnotatorPath(secpSignatureFields)); d != nil {
+			resp.Diff = d
+			resp.Message = d.Summary
+			resp.Success = false
+			if len(req.Signature) == secp256k1.SignatureLen && isHighS(req.Signature) {
+				resp.Message = joinMessages(resp.Message, []error{errHighS})
+			}
+		}
+	}
+	return resp, nil
+}
+
+// kmsSignature converts the DER signature into the [r || s || v] signature
+// avalanchego verifies, with a low s and the recovery ID of the public key.
+// It returns whether s was negated to the lower half order.
+func (s *server) kmsSignature(der []byte, hash []byte, pubKey *dsecp256k1.PublicKey) ([]byte, bool, error) {
+	var parsed derSignature
+	rest, err := asn1.Unmarshal(der, &parsed)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(rest) > 0 {
+		return nil, false, errDERTrailingData
+	}
+
+	var r, sValue dsecp256k1.ModNScalar
+	for _, f := range []struct {
+		v      *big.Int
+		scalar *dsecp256k1.ModNScalar
+	}{
+		{v: parsed.R, scalar: &r},
+		{v: parsed.S, scalar: &sValue},
+	} {
+		if f.v.Sign() <= 0 || f.v.BitLen() > 256 {
+			return nil, false, errDERScalarOutOfRange
+		}
+		var b [32]byte
+		f.v.FillBytes(b[:])
+		if overflow := f.scalar.SetBytes(&b); overflow != 0 || f.scalar.IsZero() {
+			return nil, false, errDERScalarOutOfRange
+		}
+	}
+	normalized := sValue.IsOverHalfOrder()
+	if normalized {
+		sValue.Negate()
+	}
+
+	sig := make([]byte, secp256k1.SignatureLen)
+	r.PutBytesUnchecked(sig[:32])
+	sValue.PutBytesUnchecked(sig[32:64])
+	expected := pubKey.SerializeCompressed()
+	for v := byte(0); v < 2; v++ {
+		sig[64] = v
+		recovered, err := s.recoverHashPublicKey(hash, sig)
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(recovered.Bytes(), expected) {
+			return sig, normalized, nil
+		}
+	}
+	return nil, normalized, errKmsNoRecoveryID
+}
+
+// parseKmsPublicKey parses a SEC 1 public key, or a DER SubjectPublicKeyInfo
+// of a secp256k1 key.
+func parseKmsPublicKey(b []byte) (*dsecp256k1.PublicKey, error) {
+	if len(b) > 0 && b[0] == asn1.TagSequence|0x20 {
+		var spki subjectPublicKeyInfo
+		rest, err := asn1.Unmarshal(b, &spki)
+		if err != nil {
+			return nil, err
+		}
+		if len(rest) > 0 {
+			return nil, errSPKITrailingData
+		}
+		if !spki.Algorithm.Algorithm.Equal(oidECPublicKey) || !spki.Algorithm.Parameters.Equal(oidSecp256k1) {
+			return nil, fmt.Errorf("unsupported public key algorithm %v (%v)", spki.Algorithm.Algorithm, spki.Algorithm.Parameters)
+		}
+		b = spki.PublicKey.RightAlign()
+	}
+	return dsecp256k1.ParsePubKey(b)
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"go.uber.org/zap"
+)
+
+// ref. "ledger.rootPath", "ledger.ledgerBufferLimit", "ledger.ledgerPathSize"
+const (
+	ledgerRootPath    = "m/44'/9000'/0'"
+	ledgerBufferLimit = 8192
+	ledgerPathSize    = 9
+)
+
+var errLedgerPathTooLong = fmt.Errorf("path must have at most %d indices", math.MaxUint8)
+
+func (s *server) LedgerPath(ctx context.Context, req *rpcpb.LedgerPathRequest) (*rpcpb.LedgerPathResponse, error) {
+	logger(ctx).Debug("received LedgerPath request", zap.String("path", req.Path))
+
+	resp := &rpcpb.LedgerPathResponse{
+		Success: true,
+	}
+	expected, err := serializeLedgerPath(req.Path)
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	}
+	switch {
+	case (err != nil) != req.Rejected:
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	case req.Rejected:
+	default:
+		resp.ExpectedSerializedPath = expected
+		if d := newDiff(expected, req.SerializedPath, diff.AnnotatorPath(ledgerPathFields)); d != nil {
+			resp.Diff = d
+			resp.Message = d.Summary
+			resp.Success = false
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) LedgerSign(ctx context.Context, req *rpcpb.LedgerSignRequest) (*rpcpb.LedgerSignResponse, error) {
+	logger(ctx).Debug("received LedgerSign request", zap.Int("tx-size", len(req.TxBytes)), zap.Int("addresses", len(req.AddressIndices)))
+
+	rootPath, err := serializeLedgerPath(ledgerRootPath)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.LedgerSignResponse{
+		ExpectedRootPath:     rootPath,
+		ExpectedSigningPaths: make([][]byte, 0, len(req.AddressIndices)),
+		ExpectedAddressPaths: make([][]byte, 0, len(req.AddressIndices)),
+		// signing paths are passed as change paths too
+		ExpectedSignHash: len(req.TxBytes)+2*len(req.AddressIndices)*ledgerPathSize > ledgerBufferLimit,
+		ExpectedHash:     hashing.ComputeHash256(req.TxBytes),
+		Success:          true,
+	}
+	for _, index := range req.AddressIndices {
+		signingPath, err := serializeLedgerPath(fmt.Sprintf("0/%d", index))
+		if err != nil {
+			return nil, err
+		}
+		addressPath, err := serializeLedgerPath(fmt.Sprintf("%s/0/%d", ledgerRootPath, index))
+		if err != nil {
+			return nil, err
+		}
+		resp.ExpectedSigningPaths = append(resp.ExpectedSigningPaths, signingPath)
+		resp.ExpectedAddressPaths = append(resp.ExpectedAddressPaths, addressPath)
+	}
+
+	var mismatches []error
+	if d := newDiff(rootPath, req.RootPath, diff.AnnotatorPath(ledgerPathFields)); d != nil {
+		mismatches = append(mismatches, fmt.Errorf("root path: %s", d.Summary))
+	}
+	mismatches = append(mismatches, ledgerPathMismatches("signing_paths", resp.ExpectedSigningPaths, req.SigningPaths)...)
+	mismatches = append(mismatches, ledgerPathMismatches("address_paths", resp.ExpectedAddressPaths, req.AddressPaths)...)
+	if resp.ExpectedSignHash != req.SignHash {
+		mismatches = append(mismatches, fmt.Errorf("expected sign_hash %t, got %t", resp.ExpectedSignHash, req.SignHash))
+	}
+	if !bytes.Equal(resp.ExpectedHash, req.Hash) {
+		mismatches = append(mismatches, fmt.Errorf("expected hash 0x%x, got 0x%x", resp.ExpectedHash, req.Hash))
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages(resp.Message, mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// serializeLedgerPath serializes the absolute path, or the path relative to
+// the root path, as the number of indices then each index in big-endian.
+func serializeLedgerPath(path string) ([]byte, error) {
+	if !strings.HasPrefix(path, "m/") && path != "m" {
+		path = "m/" + path
+	}
+	indices, err := parseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) > math.MaxUint8 {
+		return nil, errLedgerPathTooLong
+	}
+	b := make([]byte, 1, 1+4*len(indices))
+	b[0] = byte(len(indices))
+	for _, i := range indices {
+		b = binary.BigEndian.AppendUint32(b, i)
+	}
+	return b, nil
+}
+
+func ledgerPathMismatches(name string, expected [][]byte, received [][]byte) []error {
+	if len(expected) != len(received) {
+		return []error{fmt.Errorf("expected %d %s, got %d", len(expected), name, len(received))}
+	}
+	var errs []error
+	for i := range expected {
+		if d := newDiff(expected[i], received[i], diff.AnnotatorPath(ledgerPathFields)); d != nil {
+			errs = append(errs, fmt.Errorf("%s[%d]: %s", name, i, d.Summary))
+		}
+	}
+	return errs
+}
+
+// ledgerPathFields annotates a serialized path.
+func ledgerPathFields(b []byte) []diff.Field {
+	if len(b) == 0 {
+		return nil
+	}
+	fields := []diff.Field{{Name: "len", Start: 0, End: 1}}
+	for i, offset := 0, 1; offset < len(b); i, offset = i+1, offset+4 {
+		end := offset + 4
+		if end > len(b) {
+			end = len(b)
+		}
+		fields = append(fields, diff.Field{Name: fmt.Sprintf("indices[%d]", i), Start: offset, End: end})
+	}
+	return fields
+}
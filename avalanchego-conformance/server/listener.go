@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"go.uber.org/zap"
+)
+
+// newListener listens on the unix socket if set, or on the TCP port.
+func newListener(cfg Config) (net.Listener, error) {
+	if cfg.GRPCSocket == "" {
+		return net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
+	}
+	if err := removeStaleSocket(cfg.GRPCSocket); err != nil {
+		return nil, err
+	}
+	return net.Listen("unix", cfg.GRPCSocket)
+}
+
+// removeStaleSocket removes a socket left behind by a server that did not
+// shut down cleanly (e.g., a killed test shard), so that it can be reused.
+// Sockets a live server accepts connections on are left alone.
+func removeStaleSocket(path string) error {
+	fi, err := os.Stat(path)
+	if err != nil || fi.Mode()&os.ModeSocket == 0 {
+		return nil
+	}
+	if conn, err := net.Dial("unix", path); err == nil {
+		_ = conn.Close()
+		return nil
+	}
+	zap.L().Warn("removing stale socket", zap.String("path", path))
+	return os.Remove(path)
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// RequestIDMetadataKey is the gRPC metadata key a client sets to tag a
+// request (e.g., with the name of the fuzz corpus input it checks), so
+// that it can be found in the server logs. Requests without it are
+// assigned a sequential ID. The ID is sent back in the response header.
+const RequestIDMetadataKey = "conformance-request-id"
+
+type (
+	loggerKey    struct{}
+	requestIDKey struct{}
+)
+
+// logger returns the request-scoped logger, annotated with the request ID
+// and method.
+func logger(ctx context.Context) *zap.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*zap.Logger); ok {
+		return l
+	}
+	return zap.L()
+}
+
+// requestID returns the ID of the request.
+func requestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// newRequestLogger returns the context carrying the request ID and the
+// request-scoped logger.
+func (s *server) newRequestLogger(ctx context.Context, fullMethod string) (context.Context, *zap.Logger) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var id string
+	if vs := md.Get(RequestIDMetadataKey); len(vs) > 0 {
+		id = vs[0]
+	}
+	if id == "" {
+		id = strconv.FormatUint(atomic.AddUint64(&s.requestIDs, 1), 10)
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, id))
+
+	fields := []zap.Field{zap.String("request-id", id), zap.String("method", fullMethod)}
+	if p, ok := peer.FromContext(ctx); ok {
+		fields = append(fields, zap.Stringer("peer", p.Addr))
+	}
+	// REST requests are proxied by the gateway, which records the client
+	if vs := md.Get("x-forwarded-for"); len(vs) > 0 {
+		fields = append(fields, zap.String("forwarded-for", vs[0]))
+	}
+	l := zap.L().With(fields...)
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+	return context.WithValue(ctx, loggerKey{}, l), l
+}
+
+// outcomeFields returns the gRPC status and, for responses with a
+// "success" field, the check result.
+func outcomeFields(resp interface{}, err error) []zap.Field {
+	fields := []zap.Field{zap.Stringer("code", status.Code(err))}
+	if err != nil {
+		return append(fields, zap.Error(err))
+	}
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return fields
+	}
+	fields = append(fields, zap.Int("response-size", proto.Size(msg)))
+	r := msg.ProtoReflect()
+	if fd := r.Descriptor().Fields().ByName(successField); fd != nil {
+		fields = append(fields, zap.Bool("success", r.Get(fd).Bool()))
+	}
+	return fields
+}
+
+// isSlow returns true if the request took at least
+// Config.SlowRequestThreshold.
+func (s *server) isSlow(d time.Duration) bool {
+	return s.cfg.SlowRequestThreshold > 0 && d >= s.cfg.SlowRequestThreshold
+}
+
+func (s *server) loggingUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, l := s.newRequestLogger(ctx, info.FullMethod)
+	var reqSize int
+	if msg, ok := req.(proto.Message); ok {
+		reqSize = proto.Size(msg)
+	}
+	l.Debug("received request", zap.Int("request-size", reqSize))
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	d := time.Since(start)
+
+	fields := append(outcomeFields(resp, err), zap.Int("request-size", reqSize), zap.Duration("duration", d))
+	if s.isSlow(d) {
+		l.Warn("slow request", fields...)
+	} else {
+		l.Debug("handled request", fields...)
+	}
+	return resp, err
+}
+
+// loggingStreamInterceptor is the streaming variant of
+// loggingUnaryInterceptor, logging the number of messages received and
+// sent on the stream. Streams are not subject to the slow request
+// threshold, since they are expected to be long-lived.
+func (s *server) loggingStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, l := s.newRequestLogger(ss.Context(), info.FullMethod)
+	l.Debug("opened stream")
+
+	start := time.Now()
+	ls := &loggingServerStream{ServerStream: ss, ctx: ctx}
+	err := handler(srv, ls)
+	l.Debug("closed stream",
+		zap.Stringer("code", status.Code(err)),
+		zap.Error(err),
+		zap.Uint64("received", ls.received),
+		zap.Uint64("sent", ls.sent),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return err
+}
+
+type loggingServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received uint64
+	sent     uint64
+}
+
+func (ss *loggingServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+func (ss *loggingServerStream) RecvMsg(m interface{}) error {
+	if err := ss.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	ss.received++
+	return nil
+}
+
+func (ss *loggingServerStream) SendMsg(m interface{}) error {
+	if err := ss.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	ss.sent++
+	return nil
+}
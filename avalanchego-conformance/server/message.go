@@ -9,39 +9,59 @@ import (
 	"context"
 	"crypto/x509"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/summary"
 	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
 	"github.com/ava-labs/avalanchego/utils/compression"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/ips"
-	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
-	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
-func (s *server) AcceptedFrontier(ctx context.Context, req *rpcpb.AcceptedFrontierRequest) (*rpcpb.AcceptedFrontierResponse, error) {
-	zap.L().Debug("received AcceptedFrontier request")
+// ref. "peer.bitmaskCodec"
+const msgLenCodecBit = uint32(1 << 31)
+
+var (
+	errUnknownCompressionType = errors.New("unknown compression type")
+	errUnknownEngineType      = errors.New("unknown engine type")
+	errNotGzipCompressed      = errors.New("message is not gzip-compressed")
+	errNotZstdCompressed      = errors.New("message is not zstd-compressed")
+	// ref. "peer.errInvalidMessageLength", "peer.errMaxMessageLengthExceeded"
+	errInvalidMsgLength     = errors.New("invalid message length")
+	errMaxMsgLengthExceeded = errors.New("maximum message length exceeded")
+
+	errNoVerificationRequest = errors.New("no verification request for message")
+)
 
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+func (s *server) AcceptedFrontier(ctx context.Context, req *rpcpb.AcceptedFrontierRequest) (*rpcpb.AcceptedFrontierResponse, error) {
+	mc, err := s.msgCreator(compression.TypeNone, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	containersIDs := make([]ids.ID, 0, len(req.ContainerIds))
-	for _, b := range req.ContainerIds {
-		bb := [32]byte{}
-		copy(bb[:], b)
-		containersIDs = append(containersIDs, ids.ID(bb))
+	containersIDs, err := s.messageIDs("container_ids", req.ContainerIds)
+	if err != nil {
+		return nil, err
 	}
 
 	msg, err := mc.AcceptedFrontier(chainID, req.RequestId, containersIDs)
@@ -49,19 +69,15 @@ func (s *server) AcceptedFrontier(ctx context.Context, req *rpcpb.AcceptedFronti
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.AcceptedFrontierResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
@@ -69,25 +85,23 @@ func (s *server) AcceptedFrontier(ctx context.Context, req *rpcpb.AcceptedFronti
 }
 
 func (s *server) AcceptedStateSummary(ctx context.Context, req *rpcpb.AcceptedStateSummaryRequest) (*rpcpb.AcceptedStateSummaryResponse, error) {
-	zap.L().Debug("received AcceptedStateSummary request")
-
-	compressType := compression.TypeNone
-	if req.GzipCompressed {
-		compressType = compression.TypeGzip
+	compressType, compressionFlag, err := requestCompressionType(req.GzipCompressed, req.CompressionType)
+	if err != nil {
+		return nil, err
 	}
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compressType, 10*time.Second)
+	mc, err := s.msgCreator(compressType, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	summaryIDs := make([]ids.ID, 0, len(req.SummaryIds))
-	for _, b := range req.SummaryIds {
-		bb := [32]byte{}
-		copy(bb[:], b)
-		summaryIDs = append(summaryIDs, ids.ID(bb))
+	summaryIDs, err := s.messageIDs("summary_ids", req.SummaryIds)
+	if err != nil {
+		return nil, err
 	}
 
 	msg, err := mc.AcceptedStateSummary(chainID, req.RequestId, summaryIDs)
@@ -95,67 +109,41 @@ func (s *server) AcceptedStateSummary(ctx context.Context, req *rpcpb.AcceptedSt
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.AcceptedStateSummaryResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		CompressionFlag:       compressionFlag,
 	}
-	if !req.GzipCompressed && !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
-		resp.Success = false
+	stats, d, err := compareSerializedMsg(compressType, expected, req.SerializedMsg)
+	if err != nil {
+		return nil, err
 	}
-	if req.GzipCompressed {
-		// gzip/flate2 in Rust/Go are compatible but outputs are different
-		rd := new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(expected[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		expectedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-
-		rd = new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(req.SerializedMsg[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		receivedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-		if !bytes.Equal(expectedDecompressed, receivedDecompressed) {
-			resp.Message = fmt.Sprintf("decompressed output expected [%x], got [%x]", expectedDecompressed, receivedDecompressed)
-			resp.Success = false
-		}
+	resp.CompressionStats = stats
+	if d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
 	}
 
 	return resp, nil
 }
 
 func (s *server) Accepted(ctx context.Context, req *rpcpb.AcceptedRequest) (*rpcpb.AcceptedResponse, error) {
-	zap.L().Debug("received Accepted request")
-
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+	mc, err := s.msgCreator(compression.TypeNone, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	containersIDs := make([]ids.ID, 0, len(req.ContainerIds))
-	for _, b := range req.ContainerIds {
-		bb := [32]byte{}
-		copy(bb[:], b)
-		containersIDs = append(containersIDs, ids.ID(bb))
+	containersIDs, err := s.messageIDs("container_ids", req.ContainerIds)
+	if err != nil {
+		return nil, err
 	}
 
 	msg, err := mc.Accepted(chainID, req.RequestId, containersIDs)
@@ -163,19 +151,15 @@ func (s *server) Accepted(ctx context.Context, req *rpcpb.AcceptedRequest) (*rpc
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.AcceptedResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
@@ -183,301 +167,257 @@ func (s *server) Accepted(ctx context.Context, req *rpcpb.AcceptedRequest) (*rpc
 }
 
 func (s *server) Ancestors(ctx context.Context, req *rpcpb.AncestorsRequest) (*rpcpb.AncestorsResponse, error) {
-	zap.L().Debug("received Ancestors request")
-
-	compressType := compression.TypeNone
-	if req.GzipCompressed {
-		compressType = compression.TypeGzip
+	compressType, compressionFlag, err := requestCompressionType(req.GzipCompressed, req.CompressionType)
+	if err != nil {
+		return nil, err
 	}
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compressType, 10*time.Second)
+	mc, err := s.msgCreator(compressType, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
 	msg, err := mc.Ancestors(chainID, req.RequestId, req.Containers)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.AncestorsResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		CompressionFlag:       compressionFlag,
+	}
+	stats, d, err := compareSerializedMsg(compressType, expected, req.SerializedMsg)
+	if err != nil {
+		return nil, err
 	}
-	if !req.GzipCompressed && !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	resp.CompressionStats = stats
+	if d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
-	if req.GzipCompressed {
-		// gzip/flate2 in Rust/Go are compatible but outputs are different
-		rd := new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(expected[wrappers.IntLen+2:])); err != nil {
-			return nil, err
+
+	return resp, nil
+}
+
+// ref. "config.BootstrapAncestorsMaxContainersSentKey"
+const maxAncestorsContainers = 2000
+
+func (s *server) AncestorsChunked(stream rpcpb.MessageService_AncestorsChunkedServer) error {
+	req := &rpcpb.AncestorsRequest{}
+	chunks := 0
+	// containers are bounded as avalanchego bounds the Ancestors it sends,
+	// including the length prefix of each container
+	// ref. "getter.getter.GetAncestors"
+	containersLen := 0
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
 		}
-		expectedDecompressed, err := io.ReadAll(rd)
 		if err != nil {
-			return nil, err
+			return err
 		}
-
-		rd = new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(req.SerializedMsg[wrappers.IntLen+2:])); err != nil {
-			return nil, err
+		if chunks == 0 {
+			req.ChainId = chunk.ChainId
+			req.RequestId = chunk.RequestId
+			req.GzipCompressed = chunk.GzipCompressed
+			req.CompressionType = chunk.CompressionType
 		}
-		receivedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
+		if len(req.Containers)+len(chunk.Containers) > maxAncestorsContainers {
+			return invalidField("containers", fmt.Errorf("more than %d containers", maxAncestorsContainers))
 		}
-		if !bytes.Equal(expectedDecompressed, receivedDecompressed) {
-			resp.Message = fmt.Sprintf("decompressed output expected [%x], got [%x]", expectedDecompressed, receivedDecompressed)
-			resp.Success = false
+		for _, container := range chunk.Containers {
+			containersLen += wrappers.IntLen + len(container)
 		}
-	}
+		if containersLen > constants.MaxContainersLen {
+			return invalidField("containers", fmt.Errorf("%d bytes > %d", containersLen, constants.MaxContainersLen))
+		}
+		if len(req.SerializedMsg)+len(chunk.SerializedMsg) > wrappers.IntLen+constants.DefaultMaxMessageSize {
+			return invalidField("serialized_msg", fmt.Errorf("more than %d bytes", wrappers.IntLen+constants.DefaultMaxMessageSize))
+		}
+		req.Containers = append(req.Containers, chunk.Containers...)
+		req.SerializedMsg = append(req.SerializedMsg, chunk.SerializedMsg...)
+		chunks++
+	}
+	logger(stream.Context()).Debug("assembled AncestorsChunked request",
+		zap.Int("chunks", chunks),
+		zap.Int("containers", len(req.Containers)),
+		zap.Int("serialized-msg-size", len(req.SerializedMsg)),
+	)
 
-	return resp, nil
+	resp, err := s.Ancestors(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
 }
 
 func (s *server) AppGossip(ctx context.Context, req *rpcpb.AppGossipRequest) (*rpcpb.AppGossipResponse, error) {
-	zap.L().Debug("received AppGossip request")
-
-	compressType := compression.TypeNone
-	if req.GzipCompressed {
-		compressType = compression.TypeGzip
+	compressType, compressionFlag, err := requestCompressionType(req.GzipCompressed, req.CompressionType)
+	if err != nil {
+		return nil, err
 	}
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compressType, 10*time.Second)
+	mc, err := s.msgCreator(compressType, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
 	msg, err := mc.AppGossip(chainID, req.AppBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.AppGossipResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		CompressionFlag:       compressionFlag,
 	}
-	if !req.GzipCompressed && !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
-		resp.Success = false
+	stats, d, err := compareSerializedMsg(compressType, expected, req.SerializedMsg)
+	if err != nil {
+		return nil, err
 	}
-	if req.GzipCompressed {
-		// gzip/flate2 in Rust/Go are compatible but outputs are different
-		rd := new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(expected[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		expectedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-
-		rd = new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(req.SerializedMsg[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		receivedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-		if !bytes.Equal(expectedDecompressed, receivedDecompressed) {
-			resp.Message = fmt.Sprintf("decompressed output expected [%x], got [%x]", expectedDecompressed, receivedDecompressed)
-			resp.Success = false
-		}
+	resp.CompressionStats = stats
+	if d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
 	}
 
 	return resp, nil
 }
 
 func (s *server) AppRequest(ctx context.Context, req *rpcpb.AppRequestRequest) (*rpcpb.AppRequestResponse, error) {
-	zap.L().Debug("received AppRequest request")
-
-	compressType := compression.TypeNone
-	if req.GzipCompressed {
-		compressType = compression.TypeGzip
+	compressType, compressionFlag, err := requestCompressionType(req.GzipCompressed, req.CompressionType)
+	if err != nil {
+		return nil, err
 	}
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compressType, 10*time.Second)
+	mc, err := s.msgCreator(compressType, req.MaxTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
 	msg, err := mc.AppRequest(chainID, req.RequestId, time.Duration(req.Deadline), req.AppBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.AppRequestResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		CompressionFlag:       compressionFlag,
+		ExpectedTimeout:       expectedTimeout(req.Deadline, s.maxMessageTimeout(req.MaxTimeout)),
 	}
-	if !req.GzipCompressed && !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
-		resp.Success = false
+	stats, d, err := compareSerializedMsg(compressType, expected, req.SerializedMsg)
+	if err != nil {
+		return nil, err
 	}
-	if req.GzipCompressed {
-		// gzip/flate2 in Rust/Go are compatible but outputs are different
-		rd := new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(expected[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		expectedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-
-		rd = new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(req.SerializedMsg[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		receivedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-		if !bytes.Equal(expectedDecompressed, receivedDecompressed) {
-			resp.Message = fmt.Sprintf("decompressed output expected [%x], got [%x]", expectedDecompressed, receivedDecompressed)
-			resp.Success = false
-		}
+	resp.CompressionStats = stats
+	if d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
 	}
 
 	return resp, nil
 }
 
 func (s *server) AppResponse(ctx context.Context, req *rpcpb.AppResponseRequest) (*rpcpb.AppResponseResponse, error) {
-	zap.L().Debug("received AppResponse request")
-
-	compressType := compression.TypeNone
-	if req.GzipCompressed {
-		compressType = compression.TypeGzip
+	compressType, compressionFlag, err := requestCompressionType(req.GzipCompressed, req.CompressionType)
+	if err != nil {
+		return nil, err
 	}
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compressType, 10*time.Second)
+	mc, err := s.msgCreator(compressType, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
 	msg, err := mc.AppResponse(chainID, req.RequestId, req.AppBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.AppResponseResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		CompressionFlag:       compressionFlag,
 	}
-	if !req.GzipCompressed && !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
-		resp.Success = false
+	stats, d, err := compareSerializedMsg(compressType, expected, req.SerializedMsg)
+	if err != nil {
+		return nil, err
 	}
-	if req.GzipCompressed {
-		// gzip/flate2 in Rust/Go are compatible but outputs are different
-		rd := new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(expected[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		expectedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-
-		rd = new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(req.SerializedMsg[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		receivedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-		if !bytes.Equal(expectedDecompressed, receivedDecompressed) {
-			resp.Message = fmt.Sprintf("decompressed output expected [%x], got [%x]", expectedDecompressed, receivedDecompressed)
-			resp.Success = false
-		}
+	resp.CompressionStats = stats
+	if d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
 	}
 
 	return resp, nil
 }
 
 func (s *server) Chits(ctx context.Context, req *rpcpb.ChitsRequest) (*rpcpb.ChitsResponse, error) {
-	zap.L().Debug("received Chits request")
-
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+	mc, err := s.msgCreator(compression.TypeNone, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	containersIDs := make([]ids.ID, 0, len(req.ContainerIds))
-	for _, b := range req.ContainerIds {
-		bb := [32]byte{}
-		copy(bb[:], b)
-		containersIDs = append(containersIDs, ids.ID(bb))
+	preferredIDs, err := s.messageIDs("container_ids", req.ContainerIds)
+	if err != nil {
+		return nil, err
+	}
+	acceptedIDs, err := s.messageIDs("accepted_container_ids", req.AcceptedContainerIds)
+	if err != nil {
+		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	msg, err := mc.Chits(ids.ID(chainID), req.RequestId, containersIDs, nil)
+	msg, err := mc.Chits(ids.ID(chainID), req.RequestId, preferredIDs, acceptedIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.ChitsResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
@@ -485,34 +425,35 @@ func (s *server) Chits(ctx context.Context, req *rpcpb.ChitsRequest) (*rpcpb.Chi
 }
 
 func (s *server) GetAcceptedFrontier(ctx context.Context, req *rpcpb.GetAcceptedFrontierRequest) (*rpcpb.GetAcceptedFrontierResponse, error) {
-	zap.L().Debug("received GetAcceptedFrontier request")
-
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+	engineType, err := requestEngineType(req.EngineType)
+	if err != nil {
+		return nil, err
+	}
+	mc, err := s.msgCreator(compression.TypeNone, req.MaxTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	msg, err := mc.GetAcceptedFrontier(chainID, req.RequestId, time.Duration(req.Deadline), p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	msg, err := mc.GetAcceptedFrontier(chainID, req.RequestId, time.Duration(req.Deadline), engineType)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.GetAcceptedFrontierResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		ExpectedTimeout:       expectedTimeout(req.Deadline, s.maxMessageTimeout(req.MaxTimeout)),
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
@@ -520,106 +461,82 @@ func (s *server) GetAcceptedFrontier(ctx context.Context, req *rpcpb.GetAccepted
 }
 
 func (s *server) GetAcceptedStateSummary(ctx context.Context, req *rpcpb.GetAcceptedStateSummaryRequest) (*rpcpb.GetAcceptedStateSummaryResponse, error) {
-	zap.L().Debug("received GetAcceptedStateSummary request")
-
-	compressType := compression.TypeNone
-	if req.GzipCompressed {
-		compressType = compression.TypeGzip
+	compressType, compressionFlag, err := requestCompressionType(req.GzipCompressed, req.CompressionType)
+	if err != nil {
+		return nil, err
 	}
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compressType, 10*time.Second)
+	mc, err := s.msgCreator(compressType, req.MaxTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
 	msg, err := mc.GetAcceptedStateSummary(chainID, req.RequestId, time.Duration(req.Deadline), req.Heights)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.GetAcceptedStateSummaryResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		CompressionFlag:       compressionFlag,
+		ExpectedTimeout:       expectedTimeout(req.Deadline, s.maxMessageTimeout(req.MaxTimeout)),
 	}
-	if !req.GzipCompressed && !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
-		resp.Success = false
+	stats, d, err := compareSerializedMsg(compressType, expected, req.SerializedMsg)
+	if err != nil {
+		return nil, err
 	}
-	if req.GzipCompressed {
-		// gzip/flate2 in Rust/Go are compatible but outputs are different
-		rd := new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(expected[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		expectedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-
-		rd = new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(req.SerializedMsg[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		receivedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-		if !bytes.Equal(expectedDecompressed, receivedDecompressed) {
-			resp.Message = fmt.Sprintf("decompressed output expected [%x], got [%x]", expectedDecompressed, receivedDecompressed)
-			resp.Success = false
-		}
+	resp.CompressionStats = stats
+	if d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
 	}
 
 	return resp, nil
 }
 
 func (s *server) GetAccepted(ctx context.Context, req *rpcpb.GetAcceptedRequest) (*rpcpb.GetAcceptedResponse, error) {
-	zap.L().Debug("received GetAccepted request")
-
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+	engineType, err := requestEngineType(req.EngineType)
+	if err != nil {
+		return nil, err
+	}
+	mc, err := s.msgCreator(compression.TypeNone, req.MaxTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	containersIDs := make([]ids.ID, 0, len(req.ContainerIds))
-	for _, b := range req.ContainerIds {
-		bb := [32]byte{}
-		copy(bb[:], b)
-		containersIDs = append(containersIDs, ids.ID(bb))
+	containersIDs, err := s.messageIDs("container_ids", req.ContainerIds)
+	if err != nil {
+		return nil, err
 	}
 
-	msg, err := mc.GetAccepted(chainID, req.RequestId, time.Duration(req.Deadline), containersIDs, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	msg, err := mc.GetAccepted(chainID, req.RequestId, time.Duration(req.Deadline), containersIDs, engineType)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.GetAcceptedResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		ExpectedTimeout:       expectedTimeout(req.Deadline, s.maxMessageTimeout(req.MaxTimeout)),
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
@@ -627,37 +544,40 @@ func (s *server) GetAccepted(ctx context.Context, req *rpcpb.GetAcceptedRequest)
 }
 
 func (s *server) GetAncestors(ctx context.Context, req *rpcpb.GetAncestorsRequest) (*rpcpb.GetAncestorsResponse, error) {
-	zap.L().Debug("received GetAncestors request")
-
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+	engineType, err := requestEngineType(req.EngineType)
+	if err != nil {
+		return nil, err
+	}
+	mc, err := s.msgCreator(compression.TypeNone, req.MaxTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	containerID := [32]byte{}
-	copy(containerID[:], req.ContainerId)
+	containerID, err := s.messageID("container_id", req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
 
-	msg, err := mc.GetAncestors(chainID, req.RequestId, time.Duration(req.Deadline), containerID, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	msg, err := mc.GetAncestors(chainID, req.RequestId, time.Duration(req.Deadline), containerID, engineType)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.GetAncestorsResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		ExpectedTimeout:       expectedTimeout(req.Deadline, s.maxMessageTimeout(req.MaxTimeout)),
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
@@ -665,34 +585,31 @@ func (s *server) GetAncestors(ctx context.Context, req *rpcpb.GetAncestorsReques
 }
 
 func (s *server) GetStateSummaryFrontier(ctx context.Context, req *rpcpb.GetStateSummaryFrontierRequest) (*rpcpb.GetStateSummaryFrontierResponse, error) {
-	zap.L().Debug("received GetStateSummaryFrontier request")
-
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+	mc, err := s.msgCreator(compression.TypeNone, req.MaxTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
 	msg, err := mc.GetStateSummaryFrontier(chainID, req.RequestId, time.Duration(req.Deadline))
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.GetStateSummaryFrontierResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		ExpectedTimeout:       expectedTimeout(req.Deadline, s.maxMessageTimeout(req.MaxTimeout)),
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
@@ -700,65 +617,80 @@ func (s *server) GetStateSummaryFrontier(ctx context.Context, req *rpcpb.GetStat
 }
 
 func (s *server) Get(ctx context.Context, req *rpcpb.GetRequest) (*rpcpb.GetResponse, error) {
-	zap.L().Debug("received Get request")
-
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+	engineType, err := requestEngineType(req.EngineType)
+	if err != nil {
+		return nil, err
+	}
+	mc, err := s.msgCreator(compression.TypeNone, req.MaxTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	containerID := [32]byte{}
-	copy(containerID[:], req.ContainerId)
+	containerID, err := s.messageID("container_id", req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
 
-	msg, err := mc.Get(chainID, req.RequestId, time.Duration(req.Deadline), containerID, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	msg, err := mc.Get(chainID, req.RequestId, time.Duration(req.Deadline), containerID, engineType)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.GetResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		ExpectedTimeout:       expectedTimeout(req.Deadline, s.maxMessageTimeout(req.MaxTimeout)),
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
 	return resp, nil
 }
 
+// TODO: add a GetPeerList check once avalanchego is upgraded past v1.10.x,
+// which has no bloom-filtered peer list requests.
 func (s *server) Peerlist(ctx context.Context, req *rpcpb.PeerlistRequest) (*rpcpb.PeerlistResponse, error) {
-	zap.L().Debug("received Peerlist request")
-
-	compressType := compression.TypeNone
-	if req.GzipCompressed {
-		compressType = compression.TypeGzip
+	compressType, compressionFlag, err := requestCompressionType(req.GzipCompressed, req.CompressionType)
+	if err != nil {
+		return nil, err
 	}
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compressType, 10*time.Second)
+	mc, err := s.msgCreator(compressType, 0)
 	if err != nil {
 		return nil, err
 	}
 
 	ipCerts := make([]ips.ClaimedIPPort, len(req.Peers))
 	for i, p := range req.Peers {
+		txID := ids.Empty
+		if len(p.TxId) > 0 {
+			txID, err = toID(fmt.Sprintf("peers[%d].tx_id", i), p.TxId)
+			if err != nil {
+				return nil, err
+			}
+		}
+		port, err := s.messagePort(fmt.Sprintf("peers[%d].ip_port", i), p.IpPort)
+		if err != nil {
+			return nil, err
+		}
 		ipCerts[i] = ips.ClaimedIPPort{
 			Cert: &x509.Certificate{Raw: p.Certificate},
 			IPPort: ips.IPPort{
 				IP:   p.IpAddr,
-				Port: uint16(p.IpPort),
+				Port: port,
 			},
 			Timestamp: p.GetTimestamp(),
 			Signature: p.Sig,
+			TxID:      txID,
 		}
 	}
 
@@ -767,55 +699,29 @@ func (s *server) Peerlist(ctx context.Context, req *rpcpb.PeerlistRequest) (*rpc
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.PeerlistResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		CompressionFlag:       compressionFlag,
 	}
-	if !req.GzipCompressed && !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
-		resp.Success = false
+	stats, d, err := compareSerializedMsg(compressType, expected, req.SerializedMsg)
+	if err != nil {
+		return nil, err
 	}
-	if req.GzipCompressed {
-		// gzip/flate2 in Rust/Go are compatible but outputs are different
-		rd := new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(expected[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		expectedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-
-		rd = new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(req.SerializedMsg[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		receivedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-		if !bytes.Equal(expectedDecompressed, receivedDecompressed) {
-			resp.Message = fmt.Sprintf("decompressed output expected [%x], got [%x]", expectedDecompressed, receivedDecompressed)
-			resp.Success = false
-		}
+	resp.CompressionStats = stats
+	if d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
 	}
 
 	return resp, nil
 }
 
 func (s *server) Ping(ctx context.Context, req *rpcpb.PingRequest) (*rpcpb.PingResponse, error) {
-	zap.L().Debug("received Ping request")
-
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+	mc, err := s.msgCreator(compression.TypeNone, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -824,19 +730,15 @@ func (s *server) Ping(ctx context.Context, req *rpcpb.PingRequest) (*rpcpb.PingR
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.PingResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
@@ -844,9 +746,7 @@ func (s *server) Ping(ctx context.Context, req *rpcpb.PingRequest) (*rpcpb.PingR
 }
 
 func (s *server) Pong(ctx context.Context, req *rpcpb.PongRequest) (*rpcpb.PongResponse, error) {
-	zap.L().Debug("received Pong request")
-
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+	mc, err := s.msgCreator(compression.TypeNone, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -855,19 +755,15 @@ func (s *server) Pong(ctx context.Context, req *rpcpb.PongRequest) (*rpcpb.PongR
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.PongResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
@@ -875,37 +771,40 @@ func (s *server) Pong(ctx context.Context, req *rpcpb.PongRequest) (*rpcpb.PongR
 }
 
 func (s *server) PullQuery(ctx context.Context, req *rpcpb.PullQueryRequest) (*rpcpb.PullQueryResponse, error) {
-	zap.L().Debug("received PullQuery request")
-
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+	engineType, err := requestEngineType(req.EngineType)
+	if err != nil {
+		return nil, err
+	}
+	mc, err := s.msgCreator(compression.TypeNone, req.MaxTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	containerID := [32]byte{}
-	copy(containerID[:], req.ContainerId)
+	containerID, err := s.messageID("container_id", req.ContainerId)
+	if err != nil {
+		return nil, err
+	}
 
-	msg, err := mc.PullQuery(ids.ID(chainID), req.RequestId, time.Duration(req.Deadline), ids.ID(containerID), p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	msg, err := mc.PullQuery(ids.ID(chainID), req.RequestId, time.Duration(req.Deadline), ids.ID(containerID), engineType)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.PullQueryResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		ExpectedTimeout:       expectedTimeout(req.Deadline, s.maxMessageTimeout(req.MaxTimeout)),
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
@@ -913,216 +812,487 @@ func (s *server) PullQuery(ctx context.Context, req *rpcpb.PullQueryRequest) (*r
 }
 
 func (s *server) PushQuery(ctx context.Context, req *rpcpb.PushQueryRequest) (*rpcpb.PushQueryResponse, error) {
-	zap.L().Debug("received PushQuery request")
-
-	compressType := compression.TypeNone
-	if req.GzipCompressed {
-		compressType = compression.TypeGzip
+	compressType, compressionFlag, err := requestCompressionType(req.GzipCompressed, req.CompressionType)
+	if err != nil {
+		return nil, err
 	}
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compressType, 10*time.Second)
+	engineType, err := requestEngineType(req.EngineType)
+	if err != nil {
+		return nil, err
+	}
+	mc, err := s.msgCreator(compressType, req.MaxTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	msg, err := mc.PushQuery(ids.ID(chainID), req.RequestId, time.Duration(req.Deadline), req.ContainerBytes, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	msg, err := mc.PushQuery(ids.ID(chainID), req.RequestId, time.Duration(req.Deadline), req.ContainerBytes, engineType)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.PushQueryResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		CompressionFlag:       compressionFlag,
+		ExpectedTimeout:       expectedTimeout(req.Deadline, s.maxMessageTimeout(req.MaxTimeout)),
 	}
-	if !req.GzipCompressed && !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
-		resp.Success = false
+	stats, d, err := compareSerializedMsg(compressType, expected, req.SerializedMsg)
+	if err != nil {
+		return nil, err
 	}
-	if req.GzipCompressed {
-		// gzip/flate2 in Rust/Go are compatible but outputs are different
-		rd := new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(expected[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		expectedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-
-		rd = new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(req.SerializedMsg[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		receivedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-		if !bytes.Equal(expectedDecompressed, receivedDecompressed) {
-			resp.Message = fmt.Sprintf("decompressed output expected [%x], got [%x]", expectedDecompressed, receivedDecompressed)
-			resp.Success = false
-		}
+	resp.CompressionStats = stats
+	if d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
 	}
 
 	return resp, nil
 }
 
 func (s *server) Put(ctx context.Context, req *rpcpb.PutRequest) (*rpcpb.PutResponse, error) {
-	zap.L().Debug("received Put request")
-
-	compressType := compression.TypeNone
-	if req.GzipCompressed {
-		compressType = compression.TypeGzip
+	compressType, compressionFlag, err := requestCompressionType(req.GzipCompressed, req.CompressionType)
+	if err != nil {
+		return nil, err
 	}
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compressType, 10*time.Second)
+	engineType, err := requestEngineType(req.EngineType)
+	if err != nil {
+		return nil, err
+	}
+	mc, err := s.msgCreator(compressType, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
-	msg, err := mc.Put(ids.ID(chainID), req.RequestId, req.ContainerBytes, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	msg, err := mc.Put(ids.ID(chainID), req.RequestId, req.ContainerBytes, engineType)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.PutResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		CompressionFlag:       compressionFlag,
 	}
-	if !req.GzipCompressed && !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
-		resp.Success = false
+	stats, d, err := compareSerializedMsg(compressType, expected, req.SerializedMsg)
+	if err != nil {
+		return nil, err
 	}
-	if req.GzipCompressed {
-		// gzip/flate2 in Rust/Go are compatible but outputs are different
-		rd := new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(expected[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		expectedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-
-		rd = new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(req.SerializedMsg[wrappers.IntLen+2:])); err != nil {
-			return nil, err
-		}
-		receivedDecompressed, err := io.ReadAll(rd)
-		if err != nil {
-			return nil, err
-		}
-		if !bytes.Equal(expectedDecompressed, receivedDecompressed) {
-			resp.Message = fmt.Sprintf("decompressed output expected [%x], got [%x]", expectedDecompressed, receivedDecompressed)
-			resp.Success = false
-		}
+	resp.CompressionStats = stats
+	if d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
 	}
 
 	return resp, nil
 }
 
 func (s *server) StateSummaryFrontier(ctx context.Context, req *rpcpb.StateSummaryFrontierRequest) (*rpcpb.StateSummaryFrontierResponse, error) {
-	zap.L().Debug("received StateSummaryFrontier request")
-
-	compressType := compression.TypeNone
-	if req.GzipCompressed {
-		compressType = compression.TypeGzip
+	compressType, compressionFlag, err := requestCompressionType(req.GzipCompressed, req.CompressionType)
+	if err != nil {
+		return nil, err
 	}
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compressType, 10*time.Second)
+	mc, err := s.msgCreator(compressType, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	chainID := [32]byte{}
-	copy(chainID[:], req.ChainId)
+	chainID, err := s.messageID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
 
 	msg, err := mc.StateSummaryFrontier(ids.ID(chainID), req.RequestId, req.Summary)
 	if err != nil {
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.StateSummaryFrontierResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
+		CompressionFlag:       compressionFlag,
 	}
-	if !req.GzipCompressed && !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	stats, d, err := compareSerializedMsg(compressType, expected, req.SerializedMsg)
+	if err != nil {
+		return nil, err
+	}
+	resp.CompressionStats = stats
+	if d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
-	if req.GzipCompressed {
-		// gzip/flate2 in Rust/Go are compatible but outputs are different
-		rd := new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(expected[wrappers.IntLen+2:])); err != nil {
-			return nil, err
+
+	return resp, nil
+}
+
+func (s *server) VerifyStream(stream rpcpb.MessageService_VerifyStreamServer) error {
+	verified := 0
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			logger(stream.Context()).Debug("completed VerifyStream request", zap.Int("verified", verified))
+			return nil
 		}
-		expectedDecompressed, err := io.ReadAll(rd)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		rd = new(gzip.Reader)
-		// +2; 1 for type ID, 1 for compressible boolean
-		if err = rd.Reset(bytes.NewReader(req.SerializedMsg[wrappers.IntLen+2:])); err != nil {
+		result := &rpcpb.VerifyStreamResult{Tag: req.Tag}
+		resp, err := s.verifyTagged(stream.Context(), req)
+		if err != nil {
+			result.Message = err.Error()
+		} else {
+			result.Response, err = anypb.New(resp)
+			if err != nil {
+				return err
+			}
+			result.Message, result.Success = summary.Result(resp)
+		}
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+		verified++
+	}
+}
+
+// verifyTagged dispatches the request of a VerifyStream item, which must be
+// of a MessageService method. Like BatchVerify, a panicking item only fails
+// itself, not the stream.
+func (s *server) verifyTagged(ctx context.Context, req *rpcpb.VerifyStreamRequest) (proto.Message, error) {
+	if req.Request == nil {
+		return nil, fmt.Errorf("no request for tag %q", req.Tag)
+	}
+	msg, err := req.Request.UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request for tag %q (%v)", req.Tag, err)
+	}
+	name := msg.ProtoReflect().Descriptor().FullName()
+	if m, ok := s.unaryMethods[name]; !ok || m.serviceName != rpcpb.MessageService_ServiceDesc.ServiceName {
+		return nil, fmt.Errorf("%w %s in %s", errUnknownRequest, name, rpcpb.MessageService_ServiceDesc.ServiceName)
+	}
+	return s.recoverDispatch(ctx, msg)
+}
+
+// maxBatchItems bounds the items of a BatchVerify request.
+const maxBatchItems = 10000
+
+func (s *server) BatchVerify(ctx context.Context, req *rpcpb.BatchVerifyRequest) (*rpcpb.BatchVerifyResponse, error) {
+	logger(ctx).Debug("received BatchVerify request", zap.Int("items", len(req.Items)))
+	if len(req.Items) > maxBatchItems {
+		return nil, invalidField("items", fmt.Errorf("%d items exceed %d", len(req.Items), maxBatchItems))
+	}
+
+	resp := &rpcpb.BatchVerifyResponse{
+		Results: make([]*rpcpb.BatchVerifyResult, 0, len(req.Items)),
+	}
+	for _, item := range req.Items {
+		if err := ctx.Err(); err != nil {
 			return nil, err
 		}
-		receivedDecompressed, err := io.ReadAll(rd)
+		result := &rpcpb.BatchVerifyResult{Tag: item.Tag}
+		itemResp, err := s.verifyBatchItem(ctx, item)
 		if err != nil {
+			result.Error = err.Error()
+		} else {
+			if result.Response, err = anypb.New(itemResp); err != nil {
+				return nil, err
+			}
+			result.Message, result.Success = summary.Result(itemResp)
+		}
+		if !result.Success {
+			resp.Failed++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+// verifyBatchItem dispatches the request set in the oneof of the item.
+func (s *server) verifyBatchItem(ctx context.Context, item *rpcpb.BatchVerifyItem) (proto.Message, error) {
+	m := item.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("request"))
+	if fd == nil {
+		return nil, fmt.Errorf("%w: request for tag %q", errMissingField, item.Tag)
+	}
+	return s.recoverDispatch(ctx, m.Get(fd).Message().Interface())
+}
+
+func (s *server) ParseMessage(ctx context.Context, req *rpcpb.ParseMessageRequest) (*rpcpb.ParseMessageResponse, error) {
+	logger(ctx).Debug("received ParseMessage request", zap.Int("msg-size", len(req.SerializedMsg)))
+
+	var parsed proto.Message
+	if !req.Rejected {
+		if req.Parsed == nil {
+			return nil, fmt.Errorf("%w: parsed", errMissingField)
+		}
+		var err error
+		if parsed, err = req.Parsed.UnmarshalNew(); err != nil {
 			return nil, err
 		}
-		if !bytes.Equal(expectedDecompressed, receivedDecompressed) {
-			resp.Message = fmt.Sprintf("decompressed output expected [%x], got [%x]", expectedDecompressed, receivedDecompressed)
-			resp.Success = false
+	}
+
+	resp := &rpcpb.ParseMessageResponse{
+		Success: true,
+	}
+	expected, op, compressType, err := s.parseInboundMsg(req.SerializedMsg)
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	} else {
+		resp.ExpectedOp = op.String()
+		resp.ExpectedCompressionType = compressType
+		if resp.ExpectedParsed, err = anypb.New(expected); err != nil {
+			return nil, err
 		}
 	}
 
+	switch {
+	case (resp.ExpectedError != "") != req.Rejected:
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	case req.Rejected:
+	case expected.ProtoReflect().Descriptor() != parsed.ProtoReflect().Descriptor():
+		resp.Message = fmt.Sprintf("expected %s, got %s", expected.ProtoReflect().Descriptor().FullName(), parsed.ProtoReflect().Descriptor().FullName())
+		resp.Success = false
+	default:
+		defaultEngineType(parsed)
+		resp.MismatchedFields = mismatchedMsgFields(expected, parsed)
+		if len(resp.MismatchedFields) > 0 {
+			resp.Message = fmt.Sprintf("%s fields parsed differently: %s", op, strings.Join(resp.MismatchedFields, ", "))
+			resp.Success = false
+		}
+	}
 	return resp, nil
 }
 
-func (s *server) Version(ctx context.Context, req *rpcpb.VersionRequest) (*rpcpb.VersionResponse, error) {
-	zap.L().Debug("received Version request")
+// parseInboundMsg parses the framed message as a peer reads it, and returns
+// its fields as the verification request of its op.
+// ref. "peer.readMessages", "message.InboundMsgBuilder.Parse"
+func (s *server) parseInboundMsg(serializedMsg []byte) (proto.Message, message.Op, rpcpb.CompressionType, error) {
+	if len(serializedMsg) < wrappers.IntLen {
+		return nil, 0, 0, fmt.Errorf("%w: %d bytes frame", errInvalidMsgLength, len(serializedMsg))
+	}
+	// the most significant bit is the legacy codec flag, which is ignored
+	msgLen := binary.BigEndian.Uint32(serializedMsg) &^ msgLenCodecBit
+	if msgLen > constants.DefaultMaxMessageSize {
+		return nil, 0, 0, fmt.Errorf("%w: %d > %d", errMaxMsgLengthExceeded, msgLen, constants.DefaultMaxMessageSize)
+	}
+	msgBytes := serializedMsg[wrappers.IntLen:]
+	if uint32(len(msgBytes)) != msgLen {
+		return nil, 0, 0, fmt.Errorf("%w: length prefix %d, got %d bytes", errInvalidMsgLength, msgLen, len(msgBytes))
+	}
 
-	mc, err := message.NewCreator(logging.NoLog{}, prometheus.NewRegistry(), "", compression.TypeNone, 10*time.Second)
+	mc, err := s.msgCreator(compression.TypeNone, 0)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	msg, err := mc.Parse(msgBytes, ids.EmptyNodeID, func() {})
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	// Parse does not expose the compression type
+	var p2pMsg p2p.Message
+	if err := proto.Unmarshal(msgBytes, &p2pMsg); err != nil {
+		return nil, 0, 0, err
+	}
+	compressType := rpcpb.CompressionType_COMPRESSION_TYPE_NONE
+	switch {
+	case len(p2pMsg.GetCompressedGzip()) > 0:
+		compressType = rpcpb.CompressionType_COMPRESSION_TYPE_GZIP
+	case len(p2pMsg.GetCompressedZstd()) > 0:
+		compressType = rpcpb.CompressionType_COMPRESSION_TYPE_ZSTD
+	}
+
+	fields, err := inboundMsgFields(msg.Message())
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return fields, msg.Op(), compressType, nil
+}
+
+// inboundMsgFields converts the parsed message into the verification
+// request of its op. Fields the verification requests do not carry (e.g.,
+// subnet uptimes) are dropped.
+func inboundMsgFields(msg any) (proto.Message, error) {
+	switch m := msg.(type) {
+	case *p2p.AcceptedFrontier:
+		return &rpcpb.AcceptedFrontierRequest{ChainId: m.ChainId, RequestId: m.RequestId, ContainerIds: m.ContainerIds}, nil
+	case *p2p.AcceptedStateSummary:
+		return &rpcpb.AcceptedStateSummaryRequest{ChainId: m.ChainId, RequestId: m.RequestId, SummaryIds: m.SummaryIds}, nil
+	case *p2p.Accepted:
+		return &rpcpb.AcceptedRequest{ChainId: m.ChainId, RequestId: m.RequestId, ContainerIds: m.ContainerIds}, nil
+	case *p2p.Ancestors:
+		return &rpcpb.AncestorsRequest{ChainId: m.ChainId, RequestId: m.RequestId, Containers: m.Containers}, nil
+	case *p2p.AppGossip:
+		return &rpcpb.AppGossipRequest{ChainId: m.ChainId, AppBytes: m.AppBytes}, nil
+	case *p2p.AppRequest:
+		return &rpcpb.AppRequestRequest{ChainId: m.ChainId, RequestId: m.RequestId, Deadline: m.Deadline, AppBytes: m.AppBytes}, nil
+	case *p2p.AppResponse:
+		return &rpcpb.AppResponseRequest{ChainId: m.ChainId, RequestId: m.RequestId, AppBytes: m.AppBytes}, nil
+	case *p2p.Chits:
+		return &rpcpb.ChitsRequest{ChainId: m.ChainId, RequestId: m.RequestId, ContainerIds: m.PreferredContainerIds, AcceptedContainerIds: m.AcceptedContainerIds}, nil
+	case *p2p.GetAcceptedFrontier:
+		return &rpcpb.GetAcceptedFrontierRequest{ChainId: m.ChainId, RequestId: m.RequestId, Deadline: m.Deadline, EngineType: msgEngineType(m.EngineType)}, nil
+	case *p2p.GetAcceptedStateSummary:
+		return &rpcpb.GetAcceptedStateSummaryRequest{ChainId: m.ChainId, RequestId: m.RequestId, Deadline: m.Deadline, Heights: m.Heights}, nil
+	case *p2p.GetAccepted:
+		return &rpcpb.GetAcceptedRequest{ChainId: m.ChainId, RequestId: m.RequestId, Deadline: m.Deadline, ContainerIds: m.ContainerIds, EngineType: msgEngineType(m.EngineType)}, nil
+	case *p2p.GetAncestors:
+		return &rpcpb.GetAncestorsRequest{ChainId: m.ChainId, RequestId: m.RequestId, Deadline: m.Deadline, ContainerId: m.ContainerId, EngineType: msgEngineType(m.EngineType)}, nil
+	case *p2p.GetStateSummaryFrontier:
+		return &rpcpb.GetStateSummaryFrontierRequest{ChainId: m.ChainId, RequestId: m.RequestId, Deadline: m.Deadline}, nil
+	case *p2p.Get:
+		return &rpcpb.GetRequest{ChainId: m.ChainId, RequestId: m.RequestId, Deadline: m.Deadline, ContainerId: m.ContainerId, EngineType: msgEngineType(m.EngineType)}, nil
+	case *p2p.PeerList:
+		peers := make([]*rpcpb.Peer, 0, len(m.ClaimedIpPorts))
+		for _, p := range m.ClaimedIpPorts {
+			peers = append(peers, &rpcpb.Peer{
+				Certificate: p.X509Certificate,
+				IpAddr:      p.IpAddr,
+				IpPort:      p.IpPort,
+				Timestamp:   p.Timestamp,
+				Sig:         p.Signature,
+				TxId:        p.TxId,
+			})
+		}
+		return &rpcpb.PeerlistRequest{Peers: peers}, nil
+	case *p2p.Ping:
+		return &rpcpb.PingRequest{}, nil
+	case *p2p.Pong:
+		return &rpcpb.PongRequest{UptimePct: m.Uptime}, nil
+	case *p2p.PullQuery:
+		return &rpcpb.PullQueryRequest{ChainId: m.ChainId, RequestId: m.RequestId, Deadline: m.Deadline, ContainerId: m.ContainerId, EngineType: msgEngineType(m.EngineType)}, nil
+	case *p2p.PushQuery:
+		return &rpcpb.PushQueryRequest{ChainId: m.ChainId, RequestId: m.RequestId, Deadline: m.Deadline, ContainerBytes: m.Container, EngineType: msgEngineType(m.EngineType)}, nil
+	case *p2p.Put:
+		return &rpcpb.PutRequest{ChainId: m.ChainId, RequestId: m.RequestId, ContainerBytes: m.Container, EngineType: msgEngineType(m.EngineType)}, nil
+	case *p2p.StateSummaryFrontier:
+		return &rpcpb.StateSummaryFrontierRequest{ChainId: m.ChainId, RequestId: m.RequestId, Summary: m.Summary}, nil
+	case *p2p.Version:
+		return &rpcpb.VersionRequest{
+			NetworkId:      m.NetworkId,
+			MyTime:         m.MyTime,
+			IpAddr:         m.IpAddr,
+			IpPort:         m.IpPort,
+			MyVersion:      m.MyVersion,
+			MyVersionTime:  m.MyVersionTime,
+			Sig:            m.Sig,
+			TrackedSubnets: m.TrackedSubnets,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", errNoVerificationRequest, msg)
+	}
+}
+
+// requestEngineType returns the p2p engine type of the request, snowman if
+// unspecified.
+func requestEngineType(t rpcpb.EngineType) (p2p.EngineType, error) {
+	switch t {
+	case rpcpb.EngineType_ENGINE_TYPE_UNSPECIFIED, rpcpb.EngineType_ENGINE_TYPE_SNOWMAN:
+		return p2p.EngineType_ENGINE_TYPE_SNOWMAN, nil
+	case rpcpb.EngineType_ENGINE_TYPE_OMITTED:
+		return p2p.EngineType_ENGINE_TYPE_UNSPECIFIED, nil
+	case rpcpb.EngineType_ENGINE_TYPE_AVALANCHE:
+		return p2p.EngineType_ENGINE_TYPE_AVALANCHE, nil
+	default:
+		return 0, fmt.Errorf("%w: %d", errUnknownEngineType, t)
+	}
+}
+
+// msgEngineType returns the engine type of a parsed message, the reverse
+// of requestEngineType.
+func msgEngineType(t p2p.EngineType) rpcpb.EngineType {
+	switch t {
+	case p2p.EngineType_ENGINE_TYPE_UNSPECIFIED:
+		return rpcpb.EngineType_ENGINE_TYPE_OMITTED
+	case p2p.EngineType_ENGINE_TYPE_AVALANCHE:
+		return rpcpb.EngineType_ENGINE_TYPE_AVALANCHE
+	case p2p.EngineType_ENGINE_TYPE_SNOWMAN:
+		return rpcpb.EngineType_ENGINE_TYPE_SNOWMAN
+	default:
+		// unknown engine types mismatch any parsed by the client
+		return rpcpb.EngineType(-1)
+	}
+}
+
+// defaultEngineType sets the engine type of a request parsed by the client
+// to snowman if unspecified, as when verifying it, so that it is compared
+// with the one parsed by avalanchego.
+func defaultEngineType(m proto.Message) {
+	r := m.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName("engine_type")
+	if fd != nil && !r.Has(fd) {
+		r.Set(fd, protoreflect.ValueOfEnum(rpcpb.EngineType_ENGINE_TYPE_SNOWMAN.Number()))
+	}
+}
+
+// mismatchedMsgFields returns the names of the fields that differ between
+// the verification requests, except for the serialized message and its
+// compression.
+func mismatchedMsgFields(expected proto.Message, received proto.Message) []string {
+	e, r := expected.ProtoReflect(), received.ProtoReflect()
+	fields := e.Descriptor().Fields()
+
+	var mismatched []string
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		switch fd.Name() {
+		case "serialized_msg", "gzip_compressed", "compression_type":
+			continue
+		}
+		// compare messages with only the field set, since protoreflect
+		// values cannot be compared directly
+		ef, rf := e.Type().New(), r.Type().New()
+		if e.Has(fd) {
+			ef.Set(fd, e.Get(fd))
+		}
+		if r.Has(fd) {
+			rf.Set(fd, r.Get(fd))
+		}
+		if !proto.Equal(ef.Interface(), rf.Interface()) {
+			mismatched = append(mismatched, string(fd.Name()))
+		}
+	}
+	return mismatched
+}
+
+// TODO: add a Handshake check once avalanchego is upgraded past v1.10.x,
+// which only builds the legacy Version message (no client name, ACPs or
+// known-peers bloom filter).
+func (s *server) Version(ctx context.Context, req *rpcpb.VersionRequest) (*rpcpb.VersionResponse, error) {
+	mc, err := s.msgCreator(compression.TypeNone, 0)
+	if err != nil {
+		return nil, err
+	}
+	port, err := s.messagePort("ip_port", req.IpPort)
 	if err != nil {
 		return nil, err
 	}
 	ip := ips.IPPort{
 		IP:   net.IP(req.IpAddr),
-		Port: uint16(req.IpPort),
+		Port: port,
 	}
-	trackedSubnets := make([]ids.ID, 0, len(req.TrackedSubnets))
-	for _, b := range req.TrackedSubnets {
-		bb := [32]byte{}
-		copy(bb[:], b)
-		trackedSubnets = append(trackedSubnets, ids.ID(bb))
+	trackedSubnets, err := s.messageIDs("tracked_subnets", req.TrackedSubnets)
+	if err != nil {
+		return nil, err
 	}
 	msg, err := mc.Version(
 		req.NetworkId,
@@ -1137,21 +1307,298 @@ func (s *server) Version(ctx context.Context, req *rpcpb.VersionRequest) (*rpcpb
 		return nil, err
 	}
 
-	// ref. "network/peer.writeMessages"
-	msgBytes := msg.Bytes()
-	msgLen := uint32(len(msgBytes))
-	msgLenBytes := [wrappers.IntLen]byte{}
-	binary.BigEndian.PutUint32(msgLenBytes[:], msgLen)
-	expected := append(msgLenBytes[:], msgBytes...)
+	expected := frameMsg(msg.Bytes())
 
 	resp := &rpcpb.VersionResponse{
 		ExpectedSerializedMsg: expected,
 		Success:               true,
 	}
-	if !bytes.Equal(req.SerializedMsg, expected) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expected)
+	if d := newDiff(expected, req.SerializedMsg, framedMessagePath); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
 		resp.Success = false
 	}
 
 	return resp, nil
 }
+
+// messageID returns the ID of the request field, rejecting IDs of the wrong
+// length, which avalanchego can never send, unless the config is lenient,
+// in which case they are zero-padded or truncated.
+func (s *server) messageID(field string, b []byte) (ids.ID, error) {
+	if s.cfg.LenientInput {
+		id := ids.ID{}
+		copy(id[:], b)
+		return id, nil
+	}
+	return toID(field, b)
+}
+
+func (s *server) messageIDs(field string, bs [][]byte) ([]ids.ID, error) {
+	converted := make([]ids.ID, 0, len(bs))
+	for i, b := range bs {
+		id, err := s.messageID(fmt.Sprintf("%s[%d]", field, i), b)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, id)
+	}
+	return converted, nil
+}
+
+// messagePort returns the port of the request field, rejecting ports over
+// 16 bits unless the config is lenient, in which case they are truncated.
+func (s *server) messagePort(field string, port uint32) (uint16, error) {
+	if port > math.MaxUint16 && !s.cfg.LenientInput {
+		return 0, outOfRange(field, fmt.Errorf("port %d overflows a short", port))
+	}
+	return uint16(port), nil
+}
+
+// requestCompressionType returns the compression type of a request, which
+// is read from the compression type enum when specified and from the
+// deprecated gzip_compressed flag otherwise.
+func requestCompressionType(gzipCompressed bool, t rpcpb.CompressionType) (compression.Type, rpcpb.CompressionFlag, error) {
+	switch t {
+	case rpcpb.CompressionType_COMPRESSION_TYPE_UNSPECIFIED:
+		if gzipCompressed {
+			return compression.TypeGzip, rpcpb.CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED, nil
+		}
+		return compression.TypeNone, rpcpb.CompressionFlag_COMPRESSION_FLAG_GZIP_COMPRESSED, nil
+	case rpcpb.CompressionType_COMPRESSION_TYPE_NONE:
+		return compression.TypeNone, rpcpb.CompressionFlag_COMPRESSION_FLAG_COMPRESSION_TYPE, nil
+	case rpcpb.CompressionType_COMPRESSION_TYPE_GZIP:
+		return compression.TypeGzip, rpcpb.CompressionFlag_COMPRESSION_FLAG_COMPRESSION_TYPE, nil
+	case rpcpb.CompressionType_COMPRESSION_TYPE_ZSTD:
+		return compression.TypeZstd, rpcpb.CompressionFlag_COMPRESSION_FLAG_COMPRESSION_TYPE, nil
+	default:
+		return 0, 0, fmt.Errorf("%w: %s", errUnknownCompressionType, t)
+	}
+}
+
+// frameMsg prefixes the message with its length, as written to peers.
+// The framed message is allocated once at its exact size, since container
+// and app payloads can be multiple megabytes.
+// ref. "network/peer.writeMessages"
+func frameMsg(msgBytes []byte) []byte {
+	framed := make([]byte, wrappers.IntLen+len(msgBytes))
+	binary.BigEndian.PutUint32(framed, uint32(len(msgBytes)))
+	copy(framed[wrappers.IntLen:], msgBytes)
+	return framed
+}
+
+// compareSerializedMsg compares the serialized message produced by
+// avalanchego with the received one. Compressed messages are compared
+// after decompression, since compressed bytes may differ across gzip and
+// zstd implementations and compression levels. The returned diff is nil
+// if the messages are equal.
+func compareSerializedMsg(compressType compression.Type, expected []byte, received []byte) (*rpcpb.CompressionStats, *rpcpb.Diff, error) {
+	switch compressType {
+	case compression.TypeGzip:
+		return compareGzipped(expected, received)
+	case compression.TypeZstd:
+		return compareZstd(expected, received)
+	default:
+		return nil, newDiff(expected, received, framedMessagePath), nil
+	}
+}
+
+// decompressedDiff returns the diff of decompressed messages, or nil if
+// they are equal.
+func decompressedDiff(expected []byte, received []byte) *rpcpb.Diff {
+	d := newDiff(expected, received, messagePath)
+	if d != nil {
+		d.Summary = "decompressed message: " + d.Summary
+		d.Decompressed = true
+	}
+	return d
+}
+
+// compareZstd compares the zstd-compressed messages after decompression.
+// ref. "message.msgBuilder.marshal"
+func compareZstd(expected []byte, received []byte) (*rpcpb.CompressionStats, *rpcpb.Diff, error) {
+	expectedDecompressed, err := unzstdMsg(expected)
+	if err != nil {
+		return nil, nil, err
+	}
+	receivedDecompressed, err := unzstdMsg(received)
+	if err != nil {
+		d := newDiff(expected, received, framedMessagePath)
+		if d == nil {
+			return nil, nil, err
+		}
+		d.Summary = fmt.Sprintf("failed to decompress received message (%v): %s", err, d.Summary)
+		return nil, d, nil
+	}
+
+	// sizes exclude the length prefix, as avalanchego checks the
+	// max message size against the length-prefixed payload
+	stats := &rpcpb.CompressionStats{
+		UncompressedSize:       uint64(len(expectedDecompressed)),
+		ExpectedCompressedSize: uint64(len(expected) - wrappers.IntLen),
+		ReceivedCompressedSize: uint64(len(received) - wrappers.IntLen),
+	}
+	return stats, decompressedDiff(expectedDecompressed, receivedDecompressed), nil
+}
+
+func unzstdMsg(serializedMsg []byte) ([]byte, error) {
+	if len(serializedMsg) < wrappers.IntLen {
+		return nil, fmt.Errorf("message too short (%d bytes)", len(serializedMsg))
+	}
+	msg := new(p2p.Message)
+	if err := proto.Unmarshal(serializedMsg[wrappers.IntLen:], msg); err != nil {
+		return nil, err
+	}
+	compressed, ok := msg.GetMessage().(*p2p.Message_CompressedZstd)
+	if !ok {
+		return nil, errNotZstdCompressed
+	}
+	zstdCompressor, err := compression.NewZstdCompressor(constants.DefaultMaxMessageSize)
+	if err != nil {
+		return nil, err
+	}
+	return zstdCompressor.Decompress(compressed.CompressedZstd)
+}
+
+// compareGzipped decompresses and compares the gzip payloads of the
+// expected (Go) and received (Rust) serialized messages, since gzip/flate2
+// in Rust/Go are compatible but outputs are different. It returns the
+// size breakdown of both messages and, if the decompressed payloads differ,
+// the diff of the decompressed messages.
+func compareGzipped(expected []byte, received []byte) (*rpcpb.CompressionStats, *rpcpb.Diff, error) {
+	expectedPayload, err := gzipPayload(expected)
+	if err != nil {
+		return nil, nil, err
+	}
+	receivedPayload, err := gzipPayload(received)
+	if err != nil {
+		d := newDiff(expected, received, framedMessagePath)
+		if d == nil {
+			return nil, nil, err
+		}
+		d.Summary = fmt.Sprintf("failed to parse received message (%v): %s", err, d.Summary)
+		return nil, d, nil
+	}
+
+	// decompressed outputs are compared in a streaming fashion, so that
+	// multi-megabyte payloads are never fully materialized unless they differ
+	uncompressedSize, equal, err := gunzipEqual(expectedPayload, receivedPayload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// sizes exclude the length prefix, as avalanchego checks the
+	// max message size against the length-prefixed payload
+	stats := &rpcpb.CompressionStats{
+		UncompressedSize:       uint64(uncompressedSize),
+		ExpectedCompressedSize: uint64(len(expected) - wrappers.IntLen),
+		ReceivedCompressedSize: uint64(len(received) - wrappers.IntLen),
+	}
+	if equal {
+		return stats, nil, nil
+	}
+
+	expectedDecompressed, err := gunzip(expectedPayload)
+	if err != nil {
+		return nil, nil, err
+	}
+	receivedDecompressed, err := gunzip(receivedPayload)
+	if err != nil {
+		d := newDiff(expected, received, framedMessagePath)
+		if d == nil {
+			return nil, nil, err
+		}
+		d.Summary = fmt.Sprintf("failed to decompress received message (%v): %s", err, d.Summary)
+		return stats, d, nil
+	}
+	return stats, decompressedDiff(expectedDecompressed, receivedDecompressed), nil
+}
+
+// gzipPayload returns the gzip-compressed bytes of the serialized message,
+// located by parsing the outer message since they are length-delimited.
+func gzipPayload(serializedMsg []byte) ([]byte, error) {
+	if len(serializedMsg) < wrappers.IntLen {
+		return nil, fmt.Errorf("message too short (%d bytes)", len(serializedMsg))
+	}
+	msg := new(p2p.Message)
+	if err := proto.Unmarshal(serializedMsg[wrappers.IntLen:], msg); err != nil {
+		return nil, err
+	}
+	compressed, ok := msg.GetMessage().(*p2p.Message_CompressedGzip)
+	if !ok {
+		return nil, errNotGzipCompressed
+	}
+	return compressed.CompressedGzip, nil
+}
+
+var (
+	gzipReaderPool = sync.Pool{
+		New: func() interface{} {
+			return new(gzip.Reader)
+		},
+	}
+	gzipBufPool = sync.Pool{
+		New: func() interface{} {
+			b := make([]byte, 32*1024)
+			return &b
+		},
+	}
+)
+
+// gunzipEqual returns the decompressed size of the expected payload and
+// whether the received payload decompresses to the same bytes. Identical
+// compressed bytes decompress identically, so the received message is only
+// decompressed if its compressed bytes differ. A received message that fails
+// to decompress is reported as unequal rather than as an error.
+func gunzipEqual(expected []byte, received []byte) (int64, bool, error) {
+	expectedRd := gzipReaderPool.Get().(*gzip.Reader)
+	defer gzipReaderPool.Put(expectedRd)
+	if err := expectedRd.Reset(bytes.NewReader(expected)); err != nil {
+		return 0, false, err
+	}
+	if bytes.Equal(expected, received) {
+		n, err := io.Copy(io.Discard, expectedRd)
+		return n, true, err
+	}
+
+	receivedRd := gzipReaderPool.Get().(*gzip.Reader)
+	defer gzipReaderPool.Put(receivedRd)
+	equal := receivedRd.Reset(bytes.NewReader(received)) == nil
+
+	expectedBuf := gzipBufPool.Get().(*[]byte)
+	defer gzipBufPool.Put(expectedBuf)
+	receivedBuf := gzipBufPool.Get().(*[]byte)
+	defer gzipBufPool.Put(receivedBuf)
+
+	var size int64
+	for {
+		n, expectedErr := io.ReadFull(expectedRd, *expectedBuf)
+		if expectedErr != nil && expectedErr != io.EOF && expectedErr != io.ErrUnexpectedEOF {
+			return 0, false, expectedErr
+		}
+		size += int64(n)
+		if equal {
+			m, receivedErr := io.ReadFull(receivedRd, *receivedBuf)
+			equal = (receivedErr == nil || receivedErr == io.EOF || receivedErr == io.ErrUnexpectedEOF) &&
+				bytes.Equal((*expectedBuf)[:n], (*receivedBuf)[:m])
+			if equal && expectedErr != nil {
+				// the received output must end with the expected one
+				m, receivedErr = io.ReadFull(receivedRd, (*receivedBuf)[:1])
+				equal = m == 0 && receivedErr == io.EOF
+			}
+		}
+		if expectedErr != nil {
+			return size, equal, nil
+		}
+	}
+}
+
+func gunzip(payload []byte) ([]byte, error) {
+	rd := gzipReaderPool.Get().(*gzip.Reader)
+	defer gzipReaderPool.Put(rd)
+
+	if err := rd.Reset(bytes.NewReader(payload)); err != nil {
+		return nil, err
+	}
+	return io.ReadAll(rd)
+}
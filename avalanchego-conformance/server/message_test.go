@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestAppGossipGzip checks gzip messages whose compressed payloads need
+// multi-byte length prefixes, both as echoed and as compressed by another
+// encoder.
+func TestAppGossipGzip(t *testing.T) {
+	for _, size := range []int{100, 200, 4096} {
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			ctx := context.Background()
+			s := &server{}
+			req := &rpcpb.AppGossipRequest{
+				ChainId:         make([]byte, 32),
+				AppBytes:        utils.RandomBytes(size),
+				CompressionType: rpcpb.CompressionType_COMPRESSION_TYPE_GZIP,
+			}
+			resp, err := s.AppGossip(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := resp.ExpectedSerializedMsg
+
+			req.SerializedMsg = expected
+			resp, err = s.AppGossip(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !resp.Success {
+				t.Fatalf("echoed message: %s", resp.Message)
+			}
+
+			req.SerializedMsg = regzip(t, expected, gzip.BestCompression)
+			resp, err = s.AppGossip(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !resp.Success {
+				t.Fatalf("recompressed message: %s", resp.Message)
+			}
+
+			req.AppBytes[size-1]++
+			resp, err = s.AppGossip(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Success || resp.Diff == nil || !resp.Diff.Decompressed {
+				t.Fatalf("expected a decompressed diff, got %q", resp.Message)
+			}
+		})
+	}
+}
+
+// TestAppGossipLarge checks that multi-megabyte compressed messages compare
+// equal when echoed and report a decompressed diff when the payload differs.
+func TestAppGossipLarge(t *testing.T) {
+	for _, compressionType := range []rpcpb.CompressionType{
+		rpcpb.CompressionType_COMPRESSION_TYPE_GZIP,
+		rpcpb.CompressionType_COMPRESSION_TYPE_ZSTD,
+	} {
+		t.Run(compressionType.String(), func(t *testing.T) {
+			ctx := context.Background()
+			s := &server{}
+			req := &rpcpb.AppGossipRequest{
+				ChainId:         make([]byte, 32),
+				AppBytes:        utils.RandomBytes(1 << 20),
+				CompressionType: compressionType,
+			}
+			resp, err := s.AppGossip(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := resp.ExpectedSerializedMsg
+
+			received := [][]byte{expected}
+			if compressionType == rpcpb.CompressionType_COMPRESSION_TYPE_GZIP {
+				received = append(received, regzip(t, expected, gzip.BestSpeed))
+			}
+			for _, msg := range received {
+				req.SerializedMsg = msg
+				resp, err = s.AppGossip(ctx, req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !resp.Success {
+					t.Fatal(resp.Message)
+				}
+				if resp.CompressionStats.GetUncompressedSize() <= 1<<20 {
+					t.Fatalf("unexpected uncompressed size %d", resp.CompressionStats.GetUncompressedSize())
+				}
+			}
+
+			req.AppBytes[len(req.AppBytes)/2]++
+			resp, err = s.AppGossip(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Success || resp.Diff == nil || !resp.Diff.Decompressed {
+				t.Fatalf("expected a decompressed diff, got %q", resp.Message)
+			}
+		})
+	}
+}
+
+// regzip returns the serialized gzip message with its payload recompressed
+// at the level, as a different gzip encoder would.
+func regzip(t testing.TB, serializedMsg []byte, level int) []byte {
+	t.Helper()
+	msg := new(p2p.Message)
+	if err := proto.Unmarshal(serializedMsg[wrappers.IntLen:], msg); err != nil {
+		t.Fatal(err)
+	}
+	rd, err := gzip.NewReader(bytes.NewReader(msg.GetCompressedGzip()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decompressed, err := io.ReadAll(rd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(decompressed); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	msg.Message = &p2p.Message_CompressedGzip{CompressedGzip: buf.Bytes()}
+	msgBytes, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return frameMsg(msgBytes)
+}
@@ -0,0 +1,172 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidInstances = errors.New("invalid number of instances")
+	ErrMultiListeners   = errors.New("multi-instance servers only serve gRPC on ephemeral ports")
+)
+
+// Inventory is the listeners of a multi-instance server, reported once all
+// its instances are ready.
+type Inventory struct {
+	Pid       int     `json:"pid"`
+	Instances []Ports `json:"instances"`
+}
+
+// multiServer runs isolated servers in one process, so that parallel test
+// shards each get their own endpoint without spawning a process per shard.
+type multiServer struct {
+	cfg       Config
+	instances []*server
+}
+
+// NewMulti returns a server running the given number of instances of the
+// config, each on its own ephemeral port. Instances share nothing but the
+// process: usage, quotas, sessions and reports are per instance, the
+// reports of instance i going to the "instance-<i>" subdirectory of
+// Config.ReportDir. A Shutdown RPC only stops the instance serving it.
+//
+// The gateway, metrics server and unix socket are not supported, so Port,
+// GwPort, MetricsPort and GRPCSocket must be unset. Once all instances are
+// ready, the Inventory is written to Config.PortsOutput and
+// Config.PortFile.
+func NewMulti(cfg Config, instances int) (Server, error) {
+	if instances < 1 {
+		return nil, fmt.Errorf("%w %d", ErrInvalidInstances, instances)
+	}
+	if cfg.Port != 0 || cfg.GwPort != 0 || cfg.MetricsPort != 0 || cfg.GRPCSocket != "" {
+		return nil, ErrMultiListeners
+	}
+
+	m := &multiServer{
+		cfg:       cfg,
+		instances: make([]*server, 0, instances),
+	}
+	for i := 0; i < instances; i++ {
+		icfg := cfg
+		icfg.PortsOutput = nil
+		icfg.PortFile = ""
+		if cfg.ReportDir != "" {
+			icfg.ReportDir = filepath.Join(cfg.ReportDir, fmt.Sprintf("instance-%d", i))
+		}
+		srv, err := New(icfg)
+		if err != nil {
+			m.close()
+			return nil, err
+		}
+		s := srv.(*server)
+		s.instance = uint32(i)
+		m.instances = append(m.instances, s)
+	}
+	for _, s := range m.instances {
+		s.instances = m.instances
+	}
+	return m, nil
+}
+
+// close releases the listeners and reports of instances that never ran.
+func (m *multiServer) close() {
+	for _, s := range m.instances {
+		_ = s.ln.Close()
+		_ = s.reporter.close()
+	}
+}
+
+// Run runs every instance until rootCtx is done or an instance fails,
+// which stops the others. It returns once all instances have stopped,
+// with the first error one stopped with.
+func (m *multiServer) Run(rootCtx context.Context) error {
+	ctx, cancel := context.WithCancel(rootCtx)
+	defer cancel()
+
+	errc := make(chan error, len(m.instances))
+	for _, s := range m.instances {
+		s := s
+		go func() {
+			err := s.Run(ctx)
+			if err != nil {
+				zap.L().Warn("instance failed; closing the other instances", zap.Uint32("instance", s.instance), zap.Error(err))
+				cancel()
+			}
+			errc <- err
+		}()
+	}
+	for _, s := range m.instances {
+		select {
+		case <-s.ready:
+		case <-s.closed:
+		}
+	}
+	if rerr := m.reportInventory(); rerr != nil {
+		zap.L().Warn("failed to report inventory", zap.Error(rerr))
+	}
+	if m.cfg.PortFile != "" {
+		defer os.Remove(m.cfg.PortFile)
+	}
+
+	var err error
+	for range m.instances {
+		if ierr := <-errc; ierr != nil && err == nil {
+			err = ierr
+		}
+	}
+	return err
+}
+
+func (m *multiServer) inventory() Inventory {
+	inv := Inventory{
+		Pid:       os.Getpid(),
+		Instances: make([]Ports, 0, len(m.instances)),
+	}
+	for _, s := range m.instances {
+		inv.Instances = append(inv.Instances, s.ports())
+	}
+	return inv
+}
+
+// reportInventory writes the inventory as a JSON line to
+// Config.PortsOutput, and to Config.PortFile.
+func (m *multiServer) reportInventory() error {
+	inv := m.inventory()
+	zap.L().Info("serving instances", zap.Int("instances", len(inv.Instances)))
+	return writePorts(m.cfg, inv)
+}
+
+func (s *server) Listeners(ctx context.Context, req *rpcpb.ListenersRequest) (*rpcpb.ListenersResponse, error) {
+	instances := s.instances
+	if instances == nil {
+		instances = []*server{s}
+	}
+	resp := &rpcpb.ListenersResponse{
+		Instance:  s.instance,
+		Listeners: make([]*rpcpb.Listener, 0, len(instances)),
+	}
+	for _, inst := range instances {
+		serving := true
+		select {
+		case <-inst.closed:
+			serving = false
+		default:
+		}
+		resp.Listeners = append(resp.Listeners, &rpcpb.Listener{
+			Instance: inst.instance,
+			Address:  inst.ln.Addr().String(),
+			Port:     uint32(addrPort(inst.ln.Addr())),
+			Serving:  serving,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,729 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/compression"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/version"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// maxPeerSessions bounds the open sessions, since they are only
+	// released when closed by the client.
+	maxPeerSessions = 16
+	// maxPeerEvents bounds the messages recorded per session.
+	maxPeerEvents = 1000
+	// maxPeerScript bounds the messages of a script.
+	maxPeerScript = 1000
+
+	defaultPeerTimeout = 10 * time.Second
+	maxPeerTimeout     = 5 * time.Minute
+)
+
+var (
+	errPeerNetworkIDMismatch = errors.New("network ID mismatch")
+	errPeerClockDifference   = errors.New("peer clock out of sync")
+	errPeerVersionTime       = errors.New("version time too far in the future")
+	errInvalidPeerField      = errors.New("message with invalid field")
+	errPeerTimedOut          = errors.New("exchange timed out")
+	errPeerDisconnected      = errors.New("connection closed by the peer")
+	errPeerSessionClosed     = errors.New("session closed")
+)
+
+type peerSessions struct {
+	mu       sync.Mutex
+	lastID   uint64
+	sessions map[string]*peerSession
+}
+
+// peerSession is a simulated avalanchego peer, exchanging with a single
+// client peer: accepted on its listener (StartPeer), or dialed (DialPeer).
+// Its checks mirror the network handlers of avalanchego peers.
+// ref. "peer.peer"
+type peerSession struct {
+	ln     net.Listener
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	networkID          uint32
+	cert               tls.Certificate
+	pings              uint32
+	maxClockDifference time.Duration
+	mc                 message.Creator
+	now                func() uint64
+	// messages sent once the handshake finished, before the pings
+	script []*peerScriptMessage
+
+	// connection of the client peer, used by the exchange alone
+	conn net.Conn
+
+	mu         sync.Mutex
+	phase      rpcpb.PeerPhase
+	peerNodeID ids.NodeID
+	connected  time.Time
+	events     []*rpcpb.PeerEvent
+	deviations []*rpcpb.PeerDeviation
+	err        error
+}
+
+type peerScriptMessage struct {
+	framed     []byte
+	responseOp string
+}
+
+func (s *server) StartPeer(ctx context.Context, req *rpcpb.StartPeerRequest) (*rpcpb.StartPeerResponse, error) {
+	timeout, err := peerTimeout(req.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.newPeerSession(ctx, req.NetworkId, req.StakingCertificate, req.StakingKey, req.Pings, req.MaxClockDifferenceMs)
+	if err != nil {
+		return nil, err
+	}
+	p.phase = rpcpb.PeerPhase_PEER_PHASE_LISTENING
+
+	s.peers.mu.Lock()
+	defer s.peers.mu.Unlock()
+	if len(s.peers.sessions) >= maxPeerSessions {
+		return nil, status.Errorf(codes.ResourceExhausted, "%d peer sessions open, close one with PeerTranscript", len(s.peers.sessions))
+	}
+	if p.ln, err = net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		return nil, err
+	}
+	if s.peers.sessions == nil {
+		s.peers.sessions = make(map[string]*peerSession)
+	}
+	s.peers.lastID++
+	id := strconv.FormatUint(s.peers.lastID, 10)
+	s.peers.sessions[id] = p
+
+	// sessions outlive the request, and end with the server
+	rootCtx := s.rootCtx
+	if rootCtx == nil {
+		rootCtx = context.Background()
+	}
+	var peerCtx context.Context
+	peerCtx, p.cancel = context.WithTimeout(rootCtx, timeout)
+	go func() {
+		defer close(p.done)
+		defer p.cancel()
+		p.serve(peerCtx, zap.L().With(zap.String("session-id", id)), p.ln.Accept)
+	}()
+
+	nodeID := ids.NodeIDFromCert(p.cert.Leaf)
+	logger(ctx).Info("started peer session", zap.String("session-id", id), zap.Stringer("address", p.ln.Addr()), zap.Stringer("node-id", nodeID))
+	return &rpcpb.StartPeerResponse{
+		SessionId:          id,
+		Address:            p.ln.Addr().String(),
+		NodeId:             nodeID.String(),
+		StakingCertificate: p.cert.Leaf.Raw,
+		NetworkId:          p.networkID,
+		Version:            version.CurrentApp.String(),
+	}, nil
+}
+
+func (s *server) PeerTranscript(ctx context.Context, req *rpcpb.PeerTranscriptRequest) (*rpcpb.PeerTranscriptResponse, error) {
+	s.peers.mu.Lock()
+	p, ok := s.peers.sessions[req.SessionId]
+	s.peers.mu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "peer session %q not found", req.SessionId)
+	}
+	if req.Wait {
+		select {
+		case <-p.done:
+		case <-ctx.Done():
+		}
+	}
+	if req.CloseSession {
+		s.peers.mu.Lock()
+		delete(s.peers.sessions, req.SessionId)
+		s.peers.mu.Unlock()
+		p.cancel()
+		<-p.done
+	}
+
+	done := false
+	select {
+	case <-p.done:
+		done = true
+	default:
+	}
+	return p.transcript(done), nil
+}
+
+func (s *server) DialPeer(ctx context.Context, req *rpcpb.DialPeerRequest) (*rpcpb.PeerTranscriptResponse, error) {
+	if req.Address == "" {
+		return nil, invalidField("address", errMissingField)
+	}
+	timeout, err := peerTimeout(req.TimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.newPeerSession(ctx, req.NetworkId, req.StakingCertificate, req.StakingKey, req.Pings, req.MaxClockDifferenceMs)
+	if err != nil {
+		return nil, err
+	}
+	if p.script, err = s.peerScript(ctx, req.Script); err != nil {
+		return nil, err
+	}
+	p.phase = rpcpb.PeerPhase_PEER_PHASE_DIALING
+
+	logger(ctx).Debug("dialing peer", zap.String("address", req.Address), zap.Int("script-size", len(p.script)))
+	var peerCtx context.Context
+	peerCtx, p.cancel = context.WithTimeout(ctx, timeout)
+	defer p.cancel()
+	p.serve(peerCtx, logger(ctx), func() (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(peerCtx, "tcp", req.Address)
+	})
+	return p.transcript(true), nil
+}
+
+// peerTimeout returns the timeout of the exchange with a client peer.
+func peerTimeout(timeoutMs uint64) (time.Duration, error) {
+	if timeoutMs == 0 {
+		return defaultPeerTimeout, nil
+	}
+	timeout := time.Duration(timeoutMs) * time.Millisecond
+	if timeoutMs > uint64(maxPeerTimeout/time.Millisecond) {
+		return 0, invalidField("timeout_ms", fmt.Errorf("%dms exceeds %s", timeoutMs, maxPeerTimeout))
+	}
+	return timeout, nil
+}
+
+func (s *server) newPeerSession(ctx context.Context, networkID uint32, certPEM []byte, keyPEM []byte, pings uint32, maxClockDifferenceMs uint64) (*peerSession, error) {
+	cert, err := peerCert(certPEM, keyPEM)
+	if err != nil {
+		return nil, invalidField("staking_certificate", err)
+	}
+	mc, err := s.msgCreator(compression.TypeNone, 0)
+	if err != nil {
+		return nil, err
+	}
+	now, err := s.unixClock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p := &peerSession{
+		done:               make(chan struct{}),
+		networkID:          networkID,
+		cert:               *cert,
+		pings:              pings,
+		maxClockDifference: time.Duration(maxClockDifferenceMs) * time.Millisecond,
+		mc:                 mc,
+		now:                now,
+	}
+	if p.networkID == 0 {
+		p.networkID = constants.LocalID
+	}
+	if p.pings == 0 {
+		p.pings = 1
+	}
+	if p.maxClockDifference == 0 {
+		p.maxClockDifference = constants.DefaultNetworkMaxClockDifference
+	}
+	return p, nil
+}
+
+// peerCert returns the staking certificate of the server peer, a generated
+// one if unset.
+func peerCert(certPEM []byte, keyPEM []byte) (*tls.Certificate, error) {
+	var (
+		cert *tls.Certificate
+		err  error
+	)
+	if len(certPEM) == 0 && len(keyPEM) == 0 {
+		cert, err = staking.NewTLSCert()
+	} else {
+		cert, err = staking.LoadTLSCertFromBytes(keyPEM, certPEM)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cert.Leaf == nil {
+		if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := cert.PrivateKey.(crypto.Signer); !ok {
+		return nil, fmt.Errorf("%w: %T", errUnsupportedCertKey, cert.PrivateKey)
+	}
+	return cert, nil
+}
+
+// peerScript returns the framed messages of the script. Message check
+// requests are sent as their canonical messages.
+func (s *server) peerScript(ctx context.Context, msgs []*rpcpb.PeerScriptMessage) ([]*peerScriptMessage, error) {
+	if len(msgs) > maxPeerScript {
+		return nil, status.Errorf(codes.InvalidArgument, "script of %d messages exceeds %d", len(msgs), maxPeerScript)
+	}
+	script := make([]*peerScriptMessage, 0, len(msgs))
+	for i, m := range msgs {
+		sm := &peerScriptMessage{responseOp: m.ExpectedResponseOp}
+		switch msg := m.Message.(type) {
+		case *rpcpb.PeerScriptMessage_Request:
+			check, err := msg.Request.UnmarshalNew()
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal script message %d (%v)", i, err)
+			}
+			resp, err := s.dispatch(ctx, check)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "script message %d rejected (%v)", i, err)
+			}
+			r := resp.ProtoReflect()
+			fd := r.Descriptor().Fields().ByName("expected_serialized_msg")
+			if fd == nil || fd.Kind() != protoreflect.BytesKind {
+				return nil, status.Errorf(codes.InvalidArgument, "script message %d: %s is not a message check", i, check.ProtoReflect().Descriptor().FullName())
+			}
+			sm.framed = r.Get(fd).Bytes()
+		case *rpcpb.PeerScriptMessage_SerializedMsg:
+			sm.framed = msg.SerializedMsg
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "script message %d is empty", i)
+		}
+		script = append(script, sm)
+	}
+	return script, nil
+}
+
+// serve exchanges with the client peer over the connection returned by
+// connect, until the exchange ends. The connection is closed once the
+// context is done.
+func (p *peerSession) serve(ctx context.Context, log *zap.Logger, connect func() (net.Conn, error)) {
+	var (
+		connMu sync.Mutex
+		conn   net.Conn
+	)
+	// unblocks the accept and reads once the session times out or is closed
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-stop:
+		}
+		if p.ln != nil {
+			_ = p.ln.Close()
+		}
+		connMu.Lock()
+		if conn != nil {
+			_ = conn.Close()
+		}
+		connMu.Unlock()
+	}()
+
+	c, err := connect()
+	if err == nil {
+		connMu.Lock()
+		conn = c
+		connMu.Unlock()
+		if deadline, ok := ctx.Deadline(); ok {
+			_ = c.SetDeadline(deadline)
+		}
+		err = p.exchange(c)
+	}
+	if err != nil {
+		switch ctx.Err() {
+		case context.DeadlineExceeded:
+			err = errPeerTimedOut
+		case context.Canceled:
+			err = errPeerSessionClosed
+		}
+	}
+
+	p.mu.Lock()
+	p.err = err
+	phase := p.phase
+	p.mu.Unlock()
+	log.Info("peer session ended", zap.Stringer("phase", phase), zap.Error(err))
+}
+
+// exchange speaks the p2p handshake with the client peer, sends the script
+// and the pings, and returns once completed or once avalanchego would close
+// the connection.
+// ref. "peer.Start", "peer.readMessages", "peer.handle"
+func (p *peerSession) exchange(c net.Conn) error {
+	defer c.Close()
+
+	// ref. "peer.connToIDAndCert"
+	conn := tls.Server(c, peer.TLSConfig(p.cert, nil))
+	if p.ln == nil {
+		conn = tls.Client(c, peer.TLSConfig(p.cert, nil))
+	}
+	if err := conn.Handshake(); err != nil {
+		return p.deviate(true, "tls handshake failed: %v", err)
+	}
+	peerCert := conn.ConnectionState().PeerCertificates[0]
+	p.conn = conn
+
+	p.mu.Lock()
+	p.phase = rpcpb.PeerPhase_PEER_PHASE_CONNECTED
+	p.peerNodeID = ids.NodeIDFromCert(peerCert)
+	p.connected = time.Now()
+	p.mu.Unlock()
+
+	if err := p.sendVersion(); err != nil {
+		return err
+	}
+	var (
+		gotVersion        bool
+		finishedHandshake bool
+		scripted          int
+		// op of the response to the last script message, if awaited
+		awaiting  string
+		pingsSent uint32
+		pongs     uint32
+	)
+	// advance sends the script messages up to the next one awaiting a
+	// response, then pings once the script is done
+	advance := func() error {
+		for awaiting == "" && scripted < len(p.script) {
+			m := p.script[scripted]
+			scripted++
+			if err := p.write(m.framed); err != nil {
+				return err
+			}
+			awaiting = m.responseOp
+		}
+		if awaiting != "" {
+			return nil
+		}
+		pingsSent++
+		return p.send(p.mc.Ping())
+	}
+	for {
+		payload, _, err := readFrame(conn)
+		if err != nil {
+			if awaiting != "" {
+				_ = p.deviate(false, "no %s answering script message %d", awaiting, scripted-1)
+			}
+			var netErr net.Error
+			switch {
+			case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, net.ErrClosed):
+				return errPeerDisconnected
+			case errors.As(err, &netErr) && netErr.Timeout():
+				return errPeerTimedOut
+			default:
+				return p.deviate(true, "%v", err)
+			}
+		}
+		msg, err := p.record(rpcpb.PeerDirection_PEER_DIRECTION_RECEIVED, frameMsg(payload))
+		if err != nil {
+			// avalanchego reads the next message
+			_ = p.deviate(false, "failed to parse message: %v", err)
+			continue
+		}
+
+		switch m := msg.Message().(type) {
+		case *p2p.Version:
+			if gotVersion {
+				_ = p.deviate(false, "dropped duplicated version message")
+				continue
+			}
+			if err := p.checkVersion(m, peerCert); err != nil {
+				return p.deviate(true, "%v", err)
+			}
+			gotVersion = true
+			p.setPhase(rpcpb.PeerPhase_PEER_PHASE_VERSION_RECEIVED)
+
+			// the server peer knows no other peers
+			if err := p.send(p.mc.PeerList(nil, true)); err != nil {
+				return err
+			}
+
+		case *p2p.PeerList:
+			if !gotVersion {
+				_ = p.deviate(false, "dropped peer list received before the version message")
+				continue
+			}
+			if err := checkPeerList(m); err != nil {
+				return p.deviate(true, "%v", err)
+			}
+			if finishedHandshake {
+				continue
+			}
+			finishedHandshake = true
+			p.setPhase(rpcpb.PeerPhase_PEER_PHASE_HANDSHAKE_FINISHED)
+			if err := advance(); err != nil {
+				return err
+			}
+
+		case *p2p.Ping:
+			// the server peer does not track uptimes, which avalanchego
+			// reports as 0% when it fails to calculate them
+			if err := p.send(p.mc.Pong(0, nil)); err != nil {
+				return err
+			}
+
+		case *p2p.Pong:
+			if err := checkPong(m); err != nil {
+				return p.deviate(true, "%v", err)
+			}
+			if pongs >= pingsSent {
+				continue
+			}
+			pongs++
+			if pongs >= p.pings {
+				p.setPhase(rpcpb.PeerPhase_PEER_PHASE_COMPLETED)
+				return nil
+			}
+			if err := advance(); err != nil {
+				return err
+			}
+
+		case *p2p.PeerListAck:
+
+		default:
+			if !finishedHandshake {
+				_ = p.deviate(false, "dropped %s message: handshake isn't finished", msg.Op())
+				continue
+			}
+			if awaiting != "" && msg.Op().String() == awaiting {
+				awaiting = ""
+				if err := advance(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// sendVersion sends the version message of the server peer, with the local
+// IP of the connection signed by its staking key.
+// ref. "peer.Start", "peer.IPSigner"
+func (p *peerSession) sendVersion() error {
+	addr, ok := p.conn.LocalAddr().(*net.TCPAddr)
+	if !ok {
+		return fmt.Errorf("unexpected local address %s", p.conn.LocalAddr())
+	}
+	now := p.now()
+	ip := peer.UnsignedIP{
+		IPPort: ips.IPPort{
+			IP:   addr.IP,
+			Port: uint16(addr.Port),
+		},
+		Timestamp: now,
+	}
+	signedIP, err := ip.Sign(p.cert.PrivateKey.(crypto.Signer))
+	if err != nil {
+		return err
+	}
+	return p.send(p.mc.Version(
+		p.networkID,
+		now,
+		ip.IPPort,
+		version.CurrentApp.String(),
+		now,
+		signedIP.Signature,
+		nil,
+	))
+}
+
+// checkVersion returns why avalanchego would close the connection on the
+// version message of the client peer, if it would.
+// ref. "peer.handleVersion"
+func (p *peerSession) checkVersion(msg *p2p.Version, cert *x509.Certificate) error {
+	if msg.NetworkId != p.networkID {
+		return fmt.Errorf("%w: peer network ID %d, expected %d", errPeerNetworkIDMismatch, msg.NetworkId, p.networkID)
+	}
+	myTime := p.now()
+	if err := checkPeerClock(msg.MyTime, myTime, p.maxClockDifference); err != nil {
+		return err
+	}
+	peerVersion, err := version.ParseApplication(msg.MyVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse peer version %q (%w)", msg.MyVersion, err)
+	}
+	if err := version.GetCompatibility(p.networkID).Compatible(peerVersion); err != nil {
+		return fmt.Errorf("peer version %s not compatible (%w)", peerVersion, err)
+	}
+	if err := checkVersionTime(msg.MyVersionTime, myTime, p.maxClockDifference); err != nil {
+		return err
+	}
+	for _, b := range msg.TrackedSubnets {
+		if _, err := ids.ToID(b); err != nil {
+			return fmt.Errorf("%w: tracked subnet (%v)", errInvalidPeerField, err)
+		}
+	}
+	if ipLen := len(msg.IpAddr); ipLen != net.IPv6len {
+		return fmt.Errorf("%w: %d bytes IP", errInvalidPeerField, ipLen)
+	}
+	signedIP := peer.SignedIP{
+		UnsignedIP: peer.UnsignedIP{
+			IPPort: ips.IPPort{
+				IP:   msg.IpAddr,
+				Port: uint16(msg.IpPort),
+			},
+			Timestamp: msg.MyVersionTime,
+		},
+		Signature: msg.Sig,
+	}
+	if err := signedIP.Verify(cert); err != nil {
+		return fmt.Errorf("%w (%v)", errUnverifiableSignedIP, err)
+	}
+	return nil
+}
+
+// checkPeerList returns why avalanchego would close the connection on the
+// peer list, if it would.
+// ref. "peer.handlePeerList"
+func checkPeerList(msg *p2p.PeerList) error {
+	for i, claimed := range msg.ClaimedIpPorts {
+		if _, err := x509.ParseCertificate(claimed.X509Certificate); err != nil {
+			return fmt.Errorf("%w: claimed IP %d certificate (%v)", errInvalidPeerField, i, err)
+		}
+		if ipLen := len(claimed.IpAddr); ipLen != net.IPv6len {
+			return fmt.Errorf("%w: claimed IP %d of %d bytes", errInvalidPeerField, i, ipLen)
+		}
+		if _, err := ids.ToID(claimed.TxId); err != nil {
+			return fmt.Errorf("%w: claimed IP %d tx ID (%v)", errInvalidPeerField, i, err)
+		}
+	}
+	return nil
+}
+
+// checkPong returns why avalanchego would close the connection on the pong,
+// if it would.
+// ref. "peer.handlePong"
+func checkPong(msg *p2p.Pong) error {
+	if msg.Uptime > 100 {
+		return fmt.Errorf("%w: uptime %d", errInvalidPeerField, msg.Uptime)
+	}
+	for _, subnetUptime := range msg.SubnetUptimes {
+		subnetID, err := ids.ToID(subnetUptime.SubnetId)
+		if err != nil {
+			return fmt.Errorf("%w: subnet ID (%v)", errInvalidPeerField, err)
+		}
+		if subnetUptime.Uptime > 100 {
+			return fmt.Errorf("%w: subnet %s uptime %d", errInvalidPeerField, subnetID, subnetUptime.Uptime)
+		}
+	}
+	return nil
+}
+
+// send writes the framed message to the connection.
+// ref. "peer.writeMessage"
+func (p *peerSession) send(msg message.OutboundMessage, err error) error {
+	if err != nil {
+		return err
+	}
+	return p.write(frameMsg(msg.Bytes()))
+}
+
+// write records and writes the framed message, which may be malformed
+// (e.g., from a script).
+func (p *peerSession) write(framed []byte) error {
+	_, _ = p.record(rpcpb.PeerDirection_PEER_DIRECTION_SENT, framed)
+	_, err := p.conn.Write(framed)
+	return err
+}
+
+// record adds the framed message to the transcript, and returns it parsed.
+func (p *peerSession) record(direction rpcpb.PeerDirection, framed []byte) (message.InboundMessage, error) {
+	var msg message.InboundMessage
+	payload, _, err := readFrame(bytes.NewReader(framed))
+	if err == nil {
+		msg, err = p.mc.Parse(payload, p.peerNodeID, func() {})
+	}
+	event := &rpcpb.PeerEvent{
+		OffsetMs:      uint64(time.Since(p.connected).Milliseconds()),
+		Direction:     direction,
+		SerializedMsg: framed,
+	}
+	if err != nil {
+		event.Error = err.Error()
+	} else {
+		event.Op = msg.Op().String()
+		if m, ok := msg.Message().(proto.Message); ok {
+			event.Parsed, err = anypb.New(m)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.events) < maxPeerEvents {
+		p.events = append(p.events, event)
+	}
+	return msg, err
+}
+
+// deviate records a deviation of the client peer in the current phase, and
+// returns it as an error.
+func (p *peerSession) deviate(fatal bool, format string, args ...any) error {
+	err := fmt.Errorf(format, args...)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.deviations = append(p.deviations, &rpcpb.PeerDeviation{
+		Phase:   p.phase,
+		Message: err.Error(),
+		Fatal:   fatal,
+	})
+	return err
+}
+
+// transcript returns the transcript of the exchange so far.
+func (p *peerSession) transcript(done bool) *rpcpb.PeerTranscriptResponse {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	resp := &rpcpb.PeerTranscriptResponse{
+		Phase:      p.phase,
+		Done:       done,
+		Events:     append([]*rpcpb.PeerEvent(nil), p.events...),
+		Deviations: append([]*rpcpb.PeerDeviation(nil), p.deviations...),
+		Success:    p.phase == rpcpb.PeerPhase_PEER_PHASE_COMPLETED && len(p.deviations) == 0,
+	}
+	if p.peerNodeID != ids.EmptyNodeID {
+		resp.PeerNodeId = p.peerNodeID.String()
+	}
+	var msgs []string
+	for _, d := range p.deviations {
+		msgs = append(msgs, d.Message)
+	}
+	// fatal deviations already tell why the exchange ended
+	fatal := len(p.deviations) > 0 && p.deviations[len(p.deviations)-1].Fatal
+	switch {
+	case resp.Success, fatal:
+	case p.phase != rpcpb.PeerPhase_PEER_PHASE_COMPLETED && p.err != nil:
+		msgs = append(msgs, fmt.Sprintf("exchange not completed in phase %s: %v", p.phase, p.err))
+	case p.phase != rpcpb.PeerPhase_PEER_PHASE_COMPLETED:
+		msgs = append(msgs, fmt.Sprintf("exchange not completed in phase %s", p.phase))
+	}
+	resp.Message = strings.Join(msgs, "; ")
+	return resp
+}
+
+func (p *peerSession) setPhase(phase rpcpb.PeerPhase) {
+	p.mu.Lock()
+	p.phase = phase
+	p.mu.Unlock()
+}
@@ -4,33 +4,60 @@
 package server
 
 import (
-	"bytes"
 	"context"
+	"crypto"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"net"
+	"strings"
+	"time"
 
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
 	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche/vertex"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/proposervm/block"
 	"go.uber.org/zap"
 )
 
 func (s *server) BuildVertex(ctx context.Context, req *rpcpb.BuildVertexRequest) (*rpcpb.BuildVertexResponse, error) {
-	zap.L().Info("received BuildVertex request")
+	era, err := resolveUpgradeEra(req.UpgradeEra)
+	if err != nil {
+		return &rpcpb.BuildVertexResponse{Message: err.Error()}, nil
+	}
 
-	chainID, err := ids.ToID(req.ChainId)
+	chainID, err := toID("chain_id", req.ChainId)
 	if err != nil {
 		return nil, err
 	}
 	parentIDs := make([]ids.ID, 0, len(req.ParentIds))
-	for _, b := range req.ParentIds {
-		parentID, err := ids.ToID(b)
+	for i, b := range req.ParentIds {
+		parentID, err := toID(fmt.Sprintf("parent_ids[%d]", i), b)
 		if err != nil {
 			return nil, err
 		}
 		parentIDs = append(parentIDs, parentID)
 	}
 
-	vtx, err := vertex.Build(chainID, req.Height, parentIDs, req.Txs)
+	var vtx vertex.StatelessVertex
+	switch era {
+	case rpcpb.UpgradeEra_UPGRADE_ERA_UNSPECIFIED, rpcpb.UpgradeEra_UPGRADE_ERA_BANFF:
+		vtx, err = vertex.Build(chainID, req.Height, parentIDs, req.Txs)
+	default:
+		// ref. "avm.VM.Linearize"
+		if len(req.Txs) > 0 {
+			return &rpcpb.BuildVertexResponse{
+				Message: fmt.Sprintf("vertices with transactions are not built after %s", rpcpb.UpgradeEra_UPGRADE_ERA_CORTINA),
+			}, nil
+		}
+		vtx, err = vertex.BuildStopVertex(chainID, req.Height, parentIDs)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -40,10 +67,297 @@ func (s *server) BuildVertex(ctx context.Context, req *rpcpb.BuildVertexRequest)
 		ExpectedBytes: expectedVtxBytes,
 		Success:       true,
 	}
-	if !bytes.Equal(req.VtxBytes, expectedVtxBytes) {
-		resp.Message = fmt.Sprintf("expected 0x%x", expectedVtxBytes)
+	if d := newDiff(expectedVtxBytes, req.VtxBytes, diff.AnnotatorPath(vertexFields)); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) ParseVertex(ctx context.Context, req *rpcpb.ParseVertexRequest) (*rpcpb.ParseVertexResponse, error) {
+	logger(ctx).Debug("received ParseVertex request", zap.Int("vtx-size", len(req.VtxBytes)))
+
+	resp := &rpcpb.ParseVertexResponse{
+		Success: true,
+	}
+	vtx, err := vertex.Parse(req.VtxBytes)
+	if err == nil {
+		chainID, vtxID := vtx.ChainID(), vtx.ID()
+		resp.ExpectedVertex = &rpcpb.Vertex{
+			CodecVersion: uint32(vtx.Version()),
+			ChainId:      chainID[:],
+			Height:       vtx.Height(),
+			Epoch:        vtx.Epoch(),
+			ParentIds:    make([][]byte, 0, len(vtx.ParentIDs())),
+			Txs:          vtx.Txs(),
+			Id:           vtxID[:],
+			StopVertex:   vtx.StopVertex(),
+		}
+		for _, parentID := range vtx.ParentIDs() {
+			parentID := parentID
+			resp.ExpectedVertex.ParentIds = append(resp.ExpectedVertex.ParentIds, parentID[:])
+		}
+		for _, tx := range vtx.Txs() {
+			resp.ExpectedTxHashes = append(resp.ExpectedTxHashes, hashing.ComputeHash256(tx))
+		}
+		resp.ExpectedParentsSortedUnique = utils.IsSortedAndUniqueSortable(vtx.ParentIDs())
+		resp.ExpectedTxsSortedUnique = utils.IsSortedAndUniqueByHash(vtx.Txs())
+		err = vtx.Verify()
+	}
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	}
+
+	switch {
+	case (err != nil) != req.Rejected:
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	case resp.ExpectedVertex == nil || req.Vertex == nil:
+		// no fields to compare, as the vertex failed to parse or the client
+		// only checks whether it is rejected
+	default:
+		resp.MismatchedFields = mismatchedMsgFields(resp.ExpectedVertex, req.Vertex)
+		if len(resp.MismatchedFields) > 0 {
+			resp.Message = fmt.Sprintf("vertex fields parsed differently: %s", strings.Join(resp.MismatchedFields, ", "))
+			resp.Success = false
+		}
+	}
+	return resp, nil
+}
+
+// vertexFields annotates a serialized vertex. Stop vertices (codec version 1)
+// have no epoch nor transactions.
+// ref. "vertex.innerStatelessVertex"
+func vertexFields(b []byte) []diff.Field {
+	fields := []diff.Field{}
+	pos := 0
+	add := func(name string, size int) bool {
+		if size < 0 || pos+size > len(b) {
+			return false
+		}
+		fields = append(fields, diff.Field{Name: name, Start: pos, End: pos + size})
+		pos += size
+		return true
+	}
+	readLen := func(name string) (int, bool) {
+		if !add(name, wrappers.IntLen) {
+			return 0, false
+		}
+		return int(binary.BigEndian.Uint32(b[pos-wrappers.IntLen:])), true
+	}
+
+	if !add("codec_version", wrappers.ShortLen) {
+		return fields
+	}
+	stopVertex := binary.BigEndian.Uint16(b) == 1
+	if !add("chain_id", hashing.HashLen) || !add("height", wrappers.LongLen) {
+		return fields
+	}
+	if !stopVertex && !add("epoch", wrappers.IntLen) {
+		return fields
+	}
+	n, ok := readLen("parent_ids length")
+	if !ok {
+		return fields
+	}
+	for i := 0; i < n; i++ {
+		if !add(fmt.Sprintf("parent_ids[%d]", i), hashing.HashLen) {
+			return fields
+		}
+	}
+	if stopVertex {
+		return fields
+	}
+	n, ok = readLen("txs length")
+	if !ok {
+		return fields
+	}
+	for i := 0; i < n; i++ {
+		txLen, ok := readLen(fmt.Sprintf("txs[%d] length", i))
+		if !ok || !add(fmt.Sprintf("txs[%d]", i), txLen) {
+			return fields
+		}
+	}
+	return fields
+}
+
+func (s *server) BuildBlock(ctx context.Context, req *rpcpb.BuildBlockRequest) (*rpcpb.BuildBlockResponse, error) {
+	parentID, err := toID("parent_id", req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	timestamp := time.Unix(req.Timestamp, 0)
+
+	var blk block.SignedBlock
+	if len(req.StakingCertPem) == 0 && len(req.StakingKeyPem) == 0 {
+		blk, err = block.BuildUnsigned(parentID, timestamp, req.PChainHeight, req.Block)
+	} else {
+		blk, err = buildSignedBlock(parentID, timestamp, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	expectedBlkBytes := blk.Bytes()
+	blkID := blk.ID()
+
+	resp := &rpcpb.BuildBlockResponse{
+		ExpectedBytes:   expectedBlkBytes,
+		ExpectedBlockId: blkID[:],
+		Success:         true,
+	}
+	if d := newDiff(expectedBlkBytes, req.BlockBytes, diff.AnnotatorPath(blockFields)); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func buildSignedBlock(parentID ids.ID, timestamp time.Time, req *rpcpb.BuildBlockRequest) (block.SignedBlock, error) {
+	tlsCert, err := staking.LoadTLSCertFromBytes(req.StakingKeyPem, req.StakingCertPem)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := tlsCert.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("staking key of type %T cannot sign", tlsCert.PrivateKey)
+	}
+	chainID, err := toID("chain_id", req.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	return block.Build(parentID, timestamp, req.PChainHeight, tlsCert.Leaf, req.Block, chainID, key)
+}
+
+// blockFields annotates a serialized proposervm block.
+// ref. "block.statelessBlock"
+func blockFields(b []byte) []diff.Field {
+	fields := []diff.Field{}
+	pos := 0
+	add := func(name string, size int) bool {
+		if size < 0 || pos+size > len(b) {
+			return false
+		}
+		fields = append(fields, diff.Field{Name: name, Start: pos, End: pos + size})
+		pos += size
+		return true
+	}
+	addBytes := func(name string) bool {
+		if !add(name+" length", wrappers.IntLen) {
+			return false
+		}
+		return add(name, int(binary.BigEndian.Uint32(b[pos-wrappers.IntLen:])))
+	}
+
+	if !add("codec_version", wrappers.ShortLen) ||
+		!add("type_id", wrappers.IntLen) ||
+		!add("parent_id", hashing.HashLen) ||
+		!add("timestamp", wrappers.LongLen) ||
+		!add("p_chain_height", wrappers.LongLen) ||
+		!addBytes("certificate") ||
+		!addBytes("block") {
+		return fields
+	}
+	addBytes("signature")
+	return fields
+}
+
+func (s *server) PackPrimitives(ctx context.Context, req *rpcpb.PackPrimitivesRequest) (*rpcpb.PackPrimitivesResponse, error) {
+	logger(ctx).Debug("received PackPrimitives request", zap.Int("ops", len(req.Ops)))
+
+	maxSize := int(req.MaxSize)
+	if maxSize == 0 {
+		maxSize = math.MaxInt
+	}
+	p := wrappers.Packer{MaxSize: maxSize}
+	fields := make([]diff.Field, 0, len(req.Ops))
+	erroredOp := -1
+	for i, op := range req.Ops {
+		start := p.Offset
+		if err := packOp(&p, op); err != nil {
+			return nil, invalidField(fmt.Sprintf("ops[%d]", i), err)
+		}
+		if p.Errored() {
+			erroredOp = i
+			break
+		}
+		fields = append(fields, diff.Field{Name: fmt.Sprintf("ops[%d]", i), Start: start, End: p.Offset})
+	}
+
+	resp := &rpcpb.PackPrimitivesResponse{
+		Success: true,
+	}
+	switch {
+	case erroredOp >= 0:
+		resp.ExpectedError = p.Err.Error()
+		resp.ExpectedErroredOp = uint32(erroredOp)
+		if !req.Errored {
+			resp.Message = fmt.Sprintf("expected packer error %q at ops[%d]", p.Err, erroredOp)
+			resp.Success = false
+		}
+	case req.Errored:
+		resp.ExpectedBytes = p.Bytes
+		resp.Message = "unexpected packer error"
 		resp.Success = false
+	default:
+		resp.ExpectedBytes = p.Bytes
+		path := diff.AnnotatorPath(func([]byte) []diff.Field { return fields })
+		if d := newDiff(p.Bytes, req.PackedBytes, path); d != nil {
+			resp.Diff = d
+			resp.Message = d.Summary
+			resp.Success = false
+		}
 	}
 
 	return resp, nil
 }
+
+// packOp packs the op, recording packing failures in the packer. It only
+// returns errors for ops whose values do not fit their primitive.
+func packOp(p *wrappers.Packer, op *rpcpb.PackerOp) error {
+	switch o := op.GetOp().(type) {
+	case *rpcpb.PackerOp_PackByte:
+		if o.PackByte > math.MaxUint8 {
+			return outOfRange("pack_byte", fmt.Errorf("%d overflows a byte", o.PackByte))
+		}
+		p.PackByte(byte(o.PackByte))
+	case *rpcpb.PackerOp_PackShort:
+		if o.PackShort > math.MaxUint16 {
+			return outOfRange("pack_short", fmt.Errorf("%d overflows a short", o.PackShort))
+		}
+		p.PackShort(uint16(o.PackShort))
+	case *rpcpb.PackerOp_PackInt:
+		p.PackInt(o.PackInt)
+	case *rpcpb.PackerOp_PackLong:
+		p.PackLong(o.PackLong)
+	case *rpcpb.PackerOp_PackBool:
+		p.PackBool(o.PackBool)
+	case *rpcpb.PackerOp_PackFixedBytes:
+		p.PackFixedBytes(o.PackFixedBytes)
+	case *rpcpb.PackerOp_PackBytes:
+		p.PackBytes(o.PackBytes)
+	case *rpcpb.PackerOp_PackStr:
+		p.PackStr(o.PackStr)
+	case *rpcpb.PackerOp_PackByteSlices:
+		// the 2D byte slice packing removed from "wrappers.Packer"
+		p.PackInt(uint32(len(o.PackByteSlices.GetSlices())))
+		for _, b := range o.PackByteSlices.GetSlices() {
+			p.PackBytes(b)
+		}
+	case *rpcpb.PackerOp_PackIp:
+		ip := net.IP(o.PackIp.GetIp())
+		if len(ip) != net.IPv4len && len(ip) != net.IPv6len {
+			return wrongLength("pack_ip.ip", fmt.Errorf("invalid IP length %d", len(ip)))
+		}
+		if o.PackIp.GetPort() > math.MaxUint16 {
+			return outOfRange("pack_ip.port", fmt.Errorf("port %d overflows a short", o.PackIp.GetPort()))
+		}
+		ips.PackIP(p, ips.IPPort{IP: ip, Port: uint16(o.PackIp.GetPort())})
+	default:
+		return invalidField("op", errMissingField)
+	}
+	return nil
+}
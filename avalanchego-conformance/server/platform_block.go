@@ -0,0 +1,226 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"go.uber.org/zap"
+)
+
+var (
+	errUnknownPlatformBlockType = errors.New("unknown platform block type")
+	errPlatformBlockTxs         = errors.New("unexpected number of txs for the block type")
+	errApricotBlockTimestamp    = errors.New("apricot blocks are not timestamped")
+)
+
+// platformBlockTypes is the type of each block type ID of the blocks codec:
+// apricot blocks are registered first, then the txs, then banff blocks.
+// ref. "blocks.RegisterApricotBlockTypes", "txs.RegisterUnsignedTxsTypes",
+// "blocks.RegisterBanffBlockTypes"
+var platformBlockTypes = map[uint32]rpcpb.PlatformBlockType{
+	0:  rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_PROPOSAL,
+	1:  rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_ABORT,
+	2:  rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_COMMIT,
+	3:  rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_STANDARD,
+	4:  rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_ATOMIC,
+	29: rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_PROPOSAL,
+	30: rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_ABORT,
+	31: rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_COMMIT,
+	32: rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_STANDARD,
+}
+
+func platformBlockFork(t rpcpb.PlatformBlockType) rpcpb.PlatformBlockFork {
+	switch {
+	case t == rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_UNSPECIFIED:
+		return rpcpb.PlatformBlockFork_PLATFORM_BLOCK_FORK_UNSPECIFIED
+	case t < rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_PROPOSAL:
+		return rpcpb.PlatformBlockFork_PLATFORM_BLOCK_FORK_APRICOT
+	default:
+		return rpcpb.PlatformBlockFork_PLATFORM_BLOCK_FORK_BANFF
+	}
+}
+
+func (s *server) BuildPlatformBlock(ctx context.Context, req *rpcpb.BuildPlatformBlockRequest) (*rpcpb.BuildPlatformBlockResponse, error) {
+	logger(ctx).Debug("received BuildPlatformBlock request",
+		zap.String("block-type", req.BlockType.String()),
+		zap.Uint64("height", req.Height),
+		zap.Int("txs", len(req.Txs)),
+	)
+
+	era, err := resolveUpgradeEra(req.UpgradeEra)
+	if err != nil {
+		return &rpcpb.BuildPlatformBlockResponse{Message: err.Error()}, nil
+	}
+	fork := platformBlockFork(req.BlockType)
+	// ref. "executor.verifier.apricotCommonBlock"
+	if fork == rpcpb.PlatformBlockFork_PLATFORM_BLOCK_FORK_APRICOT && era != rpcpb.UpgradeEra_UPGRADE_ERA_UNSPECIFIED {
+		return &rpcpb.BuildPlatformBlockResponse{
+			Message: fmt.Sprintf("apricot blocks are not built after %s", rpcpb.UpgradeEra_UPGRADE_ERA_BANFF),
+		}, nil
+	}
+
+	blk, err := buildPlatformBlock(req)
+	if err != nil {
+		return nil, err
+	}
+	expectedBlkBytes := blk.Bytes()
+	blkID := blk.ID()
+
+	resp := &rpcpb.BuildPlatformBlockResponse{
+		ExpectedBytes:   expectedBlkBytes,
+		ExpectedBlockId: blkID[:],
+		ExpectedTypeId:  binary.BigEndian.Uint32(expectedBlkBytes[wrappers.ShortLen:]),
+		ExpectedFork:    fork,
+		Success:         true,
+	}
+	if _, err := blocks.Parse(blocks.Codec, req.BlockBytes); err != nil {
+		resp.ParseError = err.Error()
+	} else {
+		resp.ParsedBlockType = platformBlockTypes[binary.BigEndian.Uint32(req.BlockBytes[wrappers.ShortLen:])]
+		resp.ParsedFork = platformBlockFork(resp.ParsedBlockType)
+	}
+
+	var mismatches []error
+	if d := newDiff(expectedBlkBytes, req.BlockBytes, diff.AnnotatorPath(platformBlockFields)); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+	if !bytes.Equal(blkID[:], req.BlockId) {
+		mismatches = append(mismatches, fmt.Errorf("expected block ID %s, got 0x%x", blkID, req.BlockId))
+	}
+	switch {
+	case resp.ParseError != "":
+		mismatches = append(mismatches, fmt.Errorf("block bytes do not parse: %s", resp.ParseError))
+	case resp.ParsedFork != fork:
+		mismatches = append(mismatches, fmt.Errorf("expected a %s encoding, got a %s one", fork, resp.ParsedFork))
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages(resp.Message, mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func buildPlatformBlock(req *rpcpb.BuildPlatformBlockRequest) (blocks.Block, error) {
+	parentID, err := toID("parent_id", req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	blkTxs := make([]*txs.Tx, 0, len(req.Txs))
+	for i, b := range req.Txs {
+		tx, err := txs.Parse(txs.Codec, b)
+		if err != nil {
+			return nil, invalidField(fmt.Sprintf("txs[%d]", i), err)
+		}
+		blkTxs = append(blkTxs, tx)
+	}
+
+	if req.BlockType <= rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_UNSPECIFIED ||
+		req.BlockType > rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_STANDARD {
+		return nil, invalidField("block_type", fmt.Errorf("%w %s", errUnknownPlatformBlockType, req.BlockType))
+	}
+	if platformBlockFork(req.BlockType) == rpcpb.PlatformBlockFork_PLATFORM_BLOCK_FORK_APRICOT && req.Timestamp != 0 {
+		return nil, invalidField("timestamp", errApricotBlockTimestamp)
+	}
+	wantTxs := len(blkTxs)
+	switch req.BlockType {
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_PROPOSAL,
+		rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_ATOMIC,
+		rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_PROPOSAL:
+		wantTxs = 1
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_ABORT,
+		rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_COMMIT,
+		rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_ABORT,
+		rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_COMMIT:
+		wantTxs = 0
+	}
+	if len(blkTxs) != wantTxs {
+		return nil, invalidField("txs", fmt.Errorf("%w %s: expected %d, got %d", errPlatformBlockTxs, req.BlockType, wantTxs, len(blkTxs)))
+	}
+
+	timestamp := time.Unix(int64(req.Timestamp), 0)
+	switch req.BlockType {
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_PROPOSAL:
+		return blocks.NewApricotProposalBlock(parentID, req.Height, blkTxs[0])
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_ABORT:
+		return blocks.NewApricotAbortBlock(parentID, req.Height)
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_COMMIT:
+		return blocks.NewApricotCommitBlock(parentID, req.Height)
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_STANDARD:
+		return blocks.NewApricotStandardBlock(parentID, req.Height, blkTxs)
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_ATOMIC:
+		return blocks.NewApricotAtomicBlock(parentID, req.Height, blkTxs[0])
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_PROPOSAL:
+		return blocks.NewBanffProposalBlock(timestamp, parentID, req.Height, blkTxs[0])
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_ABORT:
+		return blocks.NewBanffAbortBlock(timestamp, parentID, req.Height)
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_COMMIT:
+		return blocks.NewBanffCommitBlock(timestamp, parentID, req.Height)
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_STANDARD:
+		return blocks.NewBanffStandardBlock(timestamp, parentID, req.Height, blkTxs)
+	default:
+		return nil, fmt.Errorf("%w %s", errUnknownPlatformBlockType, req.BlockType)
+	}
+}
+
+// platformBlockFields annotates a serialized P-chain block.
+// ref. "blocks.BanffProposalBlock", "blocks.ApricotProposalBlock",
+// "blocks.ApricotStandardBlock"
+func platformBlockFields(b []byte) []diff.Field {
+	fields := []diff.Field{}
+	pos := 0
+	add := func(name string, size int) bool {
+		if size < 0 || pos+size > len(b) {
+			return false
+		}
+		fields = append(fields, diff.Field{Name: name, Start: pos, End: pos + size})
+		pos += size
+		return true
+	}
+
+	if !add("codec_version", wrappers.ShortLen) || !add("type_id", wrappers.IntLen) {
+		return fields
+	}
+	blockType, ok := platformBlockTypes[binary.BigEndian.Uint32(b[pos-wrappers.IntLen:])]
+	if !ok {
+		return fields
+	}
+	if platformBlockFork(blockType) == rpcpb.PlatformBlockFork_PLATFORM_BLOCK_FORK_BANFF &&
+		!add("timestamp", wrappers.LongLen) {
+		return fields
+	}
+	// The txs of banff proposal blocks are unused, and always empty.
+	if blockType == rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_PROPOSAL &&
+		(!add("transactions length", wrappers.IntLen) || binary.BigEndian.Uint32(b[pos-wrappers.IntLen:]) != 0) {
+		return fields
+	}
+	if !add("parent_id", hashing.HashLen) || !add("height", wrappers.LongLen) {
+		return fields
+	}
+	switch blockType {
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_STANDARD,
+		rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_STANDARD:
+		if add("txs length", wrappers.IntLen) {
+			add("txs", len(b)-pos)
+		}
+	case rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_PROPOSAL,
+		rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_APRICOT_ATOMIC,
+		rpcpb.PlatformBlockType_PLATFORM_BLOCK_TYPE_BANFF_PROPOSAL:
+		add("tx", len(b)-pos)
+	}
+	return fields
+}
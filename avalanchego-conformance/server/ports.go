@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+)
+
+// Ports are the addresses the server serves on, reported once it is ready
+// so that harnesses spawning it with ephemeral ports know where to connect.
+type Ports struct {
+	Pid        int    `json:"pid"`
+	Port       uint16 `json:"port,omitempty"`
+	GRPCSocket string `json:"grpc_socket,omitempty"`
+	GwPort     uint16 `json:"grpc_gateway_port,omitempty"`
+	// MetricsPort is the configured metrics port, which is never
+	// ephemeral.
+	MetricsPort uint16 `json:"metrics_port,omitempty"`
+}
+
+// addrPort returns the TCP port of the address, zero if not TCP.
+func addrPort(addr net.Addr) uint16 {
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+		return uint16(tcpAddr.Port)
+	}
+	return 0
+}
+
+func (s *server) ports() Ports {
+	p := Ports{
+		Pid:         os.Getpid(),
+		Port:        addrPort(s.ln.Addr()),
+		GRPCSocket:  s.cfg.GRPCSocket,
+		MetricsPort: s.cfg.MetricsPort,
+	}
+	if s.gwAddr != nil {
+		p.GwPort = addrPort(s.gwAddr)
+	}
+	return p
+}
+
+// reportPorts writes the ports as a JSON line to Config.PortsOutput, and
+// to Config.PortFile.
+func (s *server) reportPorts() error {
+	p := s.ports()
+	zap.L().Info("serving",
+		zap.Uint16("port", p.Port),
+		zap.String("grpc-socket", p.GRPCSocket),
+		zap.Uint16("grpc-gateway-port", p.GwPort),
+	)
+	return writePorts(s.cfg, p)
+}
+
+// writePorts writes v as a JSON line to the output and the port file of the
+// config. The file is replaced atomically, so that it can be polled without
+// reading a partial write.
+func writePorts(cfg Config, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	if cfg.PortsOutput != nil {
+		if _, err := cfg.PortsOutput.Write(b); err != nil {
+			return err
+		}
+	}
+	if cfg.PortFile == "" {
+		return nil
+	}
+	f, err := os.CreateTemp(filepath.Dir(cfg.PortFile), filepath.Base(cfg.PortFile)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), cfg.PortFile)
+}
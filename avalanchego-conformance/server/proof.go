@@ -0,0 +1,371 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/trace"
+	"github.com/ava-labs/avalanchego/x/merkledb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxMerkleOps = 100000
+
+	// ref. "sync.maxKeyValuesLimit"
+	maxKeyValuesLimit = 2048
+
+	// the change proofs served span a single batch
+	merkleHistoryLength = 2
+	merkleNodeCacheSize = 1024
+)
+
+func (s *server) MerkleRoot(ctx context.Context, req *rpcpb.MerkleRootRequest) (*rpcpb.MerkleRootResponse, error) {
+	logger(ctx).Debug("received MerkleRoot request", zap.Int("ops", len(req.Ops)))
+
+	db, err := merkleDBFromOps(ctx, req.Ops, "ops")
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	rootID, err := db.GetMerkleRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keyCount, err := merkleKeyCount(db)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.MerkleRootResponse{
+		ExpectedRootId: rootID[:],
+		KeyCount:       keyCount,
+		Success:        true,
+	}
+	if d := newDiff(rootID[:], req.RootId, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) RangeProof(ctx context.Context, req *rpcpb.RangeProofRequest) (*rpcpb.RangeProofResponse, error) {
+	logger(ctx).Debug("received RangeProof request",
+		zap.Int("ops", len(req.Ops)),
+		zap.Uint32("key-limit", req.KeyLimit),
+	)
+
+	db, err := merkleDBFromOps(ctx, req.Ops, "ops")
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	rootID, err := db.GetMerkleRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := db.GetRangeProof(ctx, req.StartKey, req.EndKey, merkleKeyLimit(req.KeyLimit))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	expected, err := merkledb.Codec.EncodeRangeProof(merkledb.Version, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.RangeProofResponse{
+		ExpectedSerializedProof: expected,
+		ExpectedRootId:          rootID[:],
+		Success:                 true,
+	}
+	received := &merkledb.RangeProof{}
+	_, err = merkledb.Codec.DecodeRangeProof(req.SerializedProof, received)
+	if err == nil {
+		err = received.Verify(ctx, req.StartKey, req.EndKey, rootID)
+	}
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	}
+
+	switch {
+	case (err != nil) != req.Rejected:
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	case req.Rejected:
+	default:
+		fields, err := merkleRangeProofFields(proof)
+		if err != nil {
+			return nil, err
+		}
+		path := diff.AnnotatorPath(func([]byte) []diff.Field { return fields })
+		if d := newDiff(expected, req.SerializedProof, path); d != nil {
+			resp.Diff = d
+			resp.Message = d.Summary
+			resp.Success = false
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) ChangeProof(ctx context.Context, req *rpcpb.ChangeProofRequest) (*rpcpb.ChangeProofResponse, error) {
+	logger(ctx).Debug("received ChangeProof request",
+		zap.Int("ops", len(req.Ops)),
+		zap.Int("change-ops", len(req.ChangeOps)),
+		zap.Uint32("key-limit", req.KeyLimit),
+	)
+
+	// the proof is served by a db written both batches, and verified by a
+	// db only written the first, as in state sync
+	db, err := merkleDBFromOps(ctx, req.Ops, "ops")
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	verifierDB, err := merkleDBFromOps(ctx, req.Ops, "ops")
+	if err != nil {
+		return nil, err
+	}
+	defer verifierDB.Close()
+	startRootID, err := db.GetMerkleRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeMerkleOps(db, req.ChangeOps, "change_ops"); err != nil {
+		return nil, err
+	}
+	endRootID, err := db.GetMerkleRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.ChangeProofResponse{
+		ExpectedStartRootId: startRootID[:],
+		ExpectedEndRootId:   endRootID[:],
+		Success:             true,
+	}
+	var proof *merkledb.ChangeProof
+	proof, err = db.GetChangeProof(ctx, startRootID, endRootID, req.StartKey, req.EndKey, merkleKeyLimit(req.KeyLimit))
+	switch {
+	case err == nil:
+		resp.ExpectedSerializedProof, err = merkledb.Codec.EncodeChangeProof(merkledb.Version, proof)
+		if err != nil {
+			return nil, err
+		}
+		received := &merkledb.ChangeProof{}
+		_, err = merkledb.Codec.DecodeChangeProof(req.SerializedProof, received)
+		if err == nil {
+			err = received.Verify(ctx, verifierDB, req.StartKey, req.EndKey, endRootID)
+		}
+	case startRootID == endRootID:
+		// no proof is served for a trie left unchanged, which clients
+		// reject as avalanchego does
+	default:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	}
+
+	switch {
+	case (err != nil) != req.Rejected:
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	case req.Rejected:
+	default:
+		fields, err := merkleChangeProofFields(proof)
+		if err != nil {
+			return nil, err
+		}
+		path := diff.AnnotatorPath(func([]byte) []diff.Field { return fields })
+		if d := newDiff(resp.ExpectedSerializedProof, req.SerializedProof, path); d != nil {
+			resp.Diff = d
+			resp.Message = d.Summary
+			resp.Success = false
+		}
+	}
+	return resp, nil
+}
+
+// merkleKeyLimit returns the maximum number of keys of a proof, bounded by
+// the sync server limit.
+func merkleKeyLimit(keyLimit uint32) int {
+	if keyLimit == 0 || keyLimit > maxKeyValuesLimit {
+		return maxKeyValuesLimit
+	}
+	return int(keyLimit)
+}
+
+// merkleDBFromOps returns an in-memory merkledb written the operations of
+// the field.
+func merkleDBFromOps(ctx context.Context, ops []*rpcpb.MerkleOp, field string) (*merkledb.Database, error) {
+	tracer, err := trace.New(trace.Config{})
+	if err != nil {
+		return nil, err
+	}
+	db, err := merkledb.New(ctx, memdb.New(), merkledb.Config{
+		HistoryLength: merkleHistoryLength,
+		NodeCacheSize: merkleNodeCacheSize,
+		Tracer:        tracer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := writeMerkleOps(db, ops, field); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// writeMerkleOps writes the operations of the field as a single batch, in
+// which the last operation on a key wins.
+func writeMerkleOps(db *merkledb.Database, ops []*rpcpb.MerkleOp, field string) error {
+	if len(ops) > maxMerkleOps {
+		return invalidField(field, fmt.Errorf("length %d > %d", len(ops), maxMerkleOps))
+	}
+	batch := db.NewBatch()
+	for i, op := range ops {
+		if !op.Delete {
+			if err := batch.Put(op.Key, op.Value); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(op.Value) > 0 {
+			return invalidField(fmt.Sprintf("%s[%d].value", field, i), errors.New("value of a deletion"))
+		}
+		if err := batch.Delete(op.Key); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed to apply %s: %w", field, err)
+	}
+	return nil
+}
+
+func merkleKeyCount(db database.Iteratee) (uint32, error) {
+	it := db.NewIterator()
+	defer it.Release()
+
+	count := uint32(0)
+	for it.Next() {
+		count++
+	}
+	return count, it.Error()
+}
+
+// merkleRangeProofFields annotates the serialized range proof by the sizes
+// of its parts, as serialized by the merkledb codec alone.
+// ref. "merkledb.codecImpl.EncodeRangeProof"
+func merkleRangeProofFields(proof *merkledb.RangeProof) ([]diff.Field, error) {
+	encode := func(p *merkledb.RangeProof) ([]byte, error) {
+		return merkledb.Codec.EncodeRangeProof(merkledb.Version, p)
+	}
+	empty, err := encode(&merkledb.RangeProof{})
+	if err != nil {
+		return nil, err
+	}
+	a := &merkleAnnotator{offset: len(empty) - 3}
+	a.fields = []diff.Field{{Name: "codec_version", Start: 0, End: a.offset}}
+	if err := a.proofPath("start_proof", empty, proof.StartProof, func(nodes []merkledb.ProofNode) ([]byte, error) {
+		return encode(&merkledb.RangeProof{StartProof: nodes})
+	}); err != nil {
+		return nil, err
+	}
+	if err := a.proofPath("end_proof", empty, proof.EndProof, func(nodes []merkledb.ProofNode) ([]byte, error) {
+		return encode(&merkledb.RangeProof{EndProof: nodes})
+	}); err != nil {
+		return nil, err
+	}
+	err = a.list("key_values", empty, len(proof.KeyValues), func(i, j int) ([]byte, error) {
+		return encode(&merkledb.RangeProof{KeyValues: proof.KeyValues[i:j]})
+	})
+	return a.fields, err
+}
+
+// merkleChangeProofFields annotates the serialized change proof by the
+// sizes of its parts, as serialized by the merkledb codec alone.
+// ref. "merkledb.codecImpl.EncodeChangeProof"
+func merkleChangeProofFields(proof *merkledb.ChangeProof) ([]diff.Field, error) {
+	encode := func(p *merkledb.ChangeProof) ([]byte, error) {
+		p.HadRootsInHistory = proof.HadRootsInHistory
+		return merkledb.Codec.EncodeChangeProof(merkledb.Version, p)
+	}
+	empty, err := encode(&merkledb.ChangeProof{})
+	if err != nil {
+		return nil, err
+	}
+	a := &merkleAnnotator{offset: len(empty) - 3}
+	a.fields = []diff.Field{
+		{Name: "codec_version", Start: 0, End: a.offset - 1},
+		{Name: "had_roots_in_history", Start: a.offset - 1, End: a.offset},
+	}
+	if err := a.proofPath("start_proof", empty, proof.StartProof, func(nodes []merkledb.ProofNode) ([]byte, error) {
+		return encode(&merkledb.ChangeProof{StartProof: nodes})
+	}); err != nil {
+		return nil, err
+	}
+	if err := a.proofPath("end_proof", empty, proof.EndProof, func(nodes []merkledb.ProofNode) ([]byte, error) {
+		return encode(&merkledb.ChangeProof{EndProof: nodes})
+	}); err != nil {
+		return nil, err
+	}
+	err = a.list("key_changes", empty, len(proof.KeyChanges), func(i, j int) ([]byte, error) {
+		return encode(&merkledb.ChangeProof{KeyChanges: proof.KeyChanges[i:j]})
+	})
+	return a.fields, err
+}
+
+// merkleAnnotator names the consecutive lists of a serialized proof, each
+// a length followed by its elements. The size of an element is the growth
+// of an otherwise empty proof holding it alone.
+type merkleAnnotator struct {
+	offset int
+	fields []diff.Field
+}
+
+func (a *merkleAnnotator) proofPath(name string, empty []byte, nodes []merkledb.ProofNode, encode func([]merkledb.ProofNode) ([]byte, error)) error {
+	return a.list(name, empty, len(nodes), func(i, j int) ([]byte, error) {
+		return encode(nodes[i:j])
+	})
+}
+
+// list annotates the list of n elements, of which encode serializes the
+// elements [i, j) alone.
+func (a *merkleAnnotator) list(name string, empty []byte, n int, encode func(i, j int) ([]byte, error)) error {
+	all, err := encode(0, n)
+	if err != nil {
+		return err
+	}
+	sizes := make([]int, n)
+	elemsLen := 0
+	for i := range sizes {
+		b, err := encode(i, i+1)
+		if err != nil {
+			return err
+		}
+		// the length of a single element is one byte, as when empty
+		sizes[i] = len(b) - len(empty)
+		elemsLen += sizes[i]
+	}
+	lengthEnd := a.offset + len(all) - len(empty) + 1 - elemsLen
+	a.fields = append(a.fields, diff.Field{Name: name + " length", Start: a.offset, End: lengthEnd})
+	a.offset = lengthEnd
+	for i, size := range sizes {
+		a.fields = append(a.fields, diff.Field{Name: fmt.Sprintf("%s[%d]", name, i), Start: a.offset, End: a.offset + size})
+		a.offset += size
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/summary"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ReportFileName is the file in Config.ReportDir every check is appended
+// to, as JSON lines.
+const ReportFileName = "report.jsonl"
+
+const expectedFieldPrefix = "expected_"
+
+// reportRecord is a line of the report file.
+type reportRecord struct {
+	Time      time.Time `json:"time"`
+	RequestID string    `json:"request_id"`
+	Service   string    `json:"service"`
+	Method    string    `json:"method"`
+	// SHA-256 of the deterministically marshaled request.
+	RequestHash string `json:"request_hash"`
+	// SHA-256 of the "expected_*" bytes fields of the response, in field
+	// order. Empty if the response has none.
+	ExpectedHash string `json:"expected_hash,omitempty"`
+	Success      bool   `json:"success"`
+	// Response message, which summarizes the diff on failures.
+	Message string `json:"message,omitempty"`
+}
+
+type methodKey struct {
+	service string
+	method  string
+}
+
+type methodReport struct {
+	passed uint64
+	failed uint64
+}
+
+// reporter records every conformance check, i.e., every response with a
+// "success" field.
+type reporter struct {
+	mu      sync.Mutex
+	methods map[methodKey]*methodReport
+	f       *os.File
+	enc     *json.Encoder
+}
+
+func newReporter(dir string) (*reporter, error) {
+	r := &reporter{methods: make(map[methodKey]*methodReport)}
+	if dir == "" {
+		return r, nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(filepath.Join(dir, ReportFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	r.f = f
+	r.enc = json.NewEncoder(f)
+	return r, nil
+}
+
+func (r *reporter) record(ctx context.Context, fullMethod string, req interface{}, resp interface{}) {
+	respMsg, ok := resp.(proto.Message)
+	if !ok {
+		return
+	}
+	fd := respMsg.ProtoReflect().Descriptor().Fields().ByName(successField)
+	if fd == nil || fd.Kind() != protoreflect.BoolKind {
+		return
+	}
+	msg, success := summary.Result(respMsg)
+	rec := reportRecord{
+		Time:         time.Now(),
+		RequestID:    requestID(ctx),
+		Service:      serviceName(fullMethod),
+		Method:       methodName(fullMethod),
+		ExpectedHash: expectedHash(respMsg),
+		Success:      success,
+		Message:      msg,
+	}
+	if reqMsg, ok := req.(proto.Message); ok {
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(reqMsg)
+		if err == nil {
+			h := sha256.Sum256(b)
+			rec.RequestHash = hex.EncodeToString(h[:])
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := methodKey{service: rec.Service, method: rec.Method}
+	m, ok := r.methods[k]
+	if !ok {
+		m = &methodReport{}
+		r.methods[k] = m
+	}
+	if success {
+		m.passed++
+	} else {
+		m.failed++
+	}
+	if r.enc != nil {
+		if err := r.enc.Encode(rec); err != nil {
+			zap.L().Warn("failed to write report", zap.Error(err))
+		}
+	}
+}
+
+// expectedHash returns the hex-encoded SHA-256 of the populated
+// "expected_*" bytes fields of the response.
+func expectedHash(resp proto.Message) string {
+	r := resp.ProtoReflect()
+	fields := r.Descriptor().Fields()
+	h := sha256.New()
+	found := false
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.BytesKind || fd.IsList() || !strings.HasPrefix(string(fd.Name()), expectedFieldPrefix) {
+			continue
+		}
+		if !r.Has(fd) {
+			continue
+		}
+		_, _ = h.Write(r.Get(fd).Bytes())
+		found = true
+	}
+	if !found {
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (r *reporter) close() error {
+	if r.f == nil {
+		return nil
+	}
+	return r.f.Close()
+}
+
+func (s *server) Report(ctx context.Context, req *rpcpb.ReportRequest) (*rpcpb.ReportResponse, error) {
+	s.reporter.mu.Lock()
+	defer s.reporter.mu.Unlock()
+
+	resp := &rpcpb.ReportResponse{}
+	if s.reporter.f != nil {
+		resp.ReportFile = s.reporter.f.Name()
+	}
+	for k, m := range s.reporter.methods {
+		resp.Methods = append(resp.Methods, &rpcpb.MethodReport{
+			Service: k.service,
+			Method:  k.method,
+			Passed:  m.passed,
+			Failed:  m.failed,
+		})
+		resp.Passed += m.passed
+		resp.Failed += m.failed
+	}
+	sort.Slice(resp.Methods, func(i, j int) bool {
+		if resp.Methods[i].Service != resp.Methods[j].Service {
+			return resp.Methods[i].Service < resp.Methods[j].Service
+		}
+		return resp.Methods[i].Method < resp.Methods[j].Method
+	})
+
+	if req.ResetReport {
+		s.reporter.methods = make(map[methodKey]*methodReport)
+	}
+	return resp, nil
+}
+
+func (s *server) reportUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err == nil {
+		s.reporter.record(ctx, info.FullMethod, req, resp)
+	}
+	return resp, err
+}
+
+// reportStreamInterceptor is the streaming variant of
+// reportUnaryInterceptor, recording every response sent on the stream
+// against the last request received.
+func (s *server) reportStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &reportServerStream{ServerStream: ss, r: s.reporter, fullMethod: info.FullMethod})
+}
+
+type reportServerStream struct {
+	grpc.ServerStream
+	r          *reporter
+	fullMethod string
+	lastReq    interface{}
+}
+
+func (ss *reportServerStream) RecvMsg(m interface{}) error {
+	if err := ss.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	ss.lastReq = m
+	return nil
+}
+
+func (ss *reportServerStream) SendMsg(m interface{}) error {
+	ss.r.record(ss.Context(), ss.fullMethod, ss.lastReq, m)
+	return ss.ServerStream.SendMsg(m)
+}
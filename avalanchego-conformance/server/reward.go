@@ -0,0 +1,238 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"go.uber.org/zap"
+)
+
+// ref. "executor.MaxValidatorWeightFactor"
+const maxValidatorWeightFactor = 5
+
+var (
+	// ref. "executor.ErrWeightTooSmall", "executor.ErrWeightTooLarge",
+	// "executor.ErrInsufficientDelegationFee", "executor.ErrStakeTooShort",
+	// "executor.ErrStakeTooLong", "executor.ErrStakeOverflow",
+	// "executor.ErrOverDelegated"
+	errWeightTooSmall            = errors.New("weight of this validator is too low")
+	errWeightTooLarge            = errors.New("weight of this validator is too large")
+	errInsufficientDelegationFee = errors.New("staker charges an insufficient delegation fee")
+	errStakeTooShort             = errors.New("staking period is too short")
+	errStakeTooLong              = errors.New("staking period is too long")
+	errStakeOverflow             = errors.New("validator stake exceeds limit")
+	errOverDelegated             = errors.New("validator would be over delegated")
+
+	errSupplyOverCap      = errors.New("current supply exceeds the supply cap")
+	errZeroSupply         = errors.New("current supply must be positive")
+	errZeroMintingPeriod  = errors.New("minting period must be positive")
+	errConsumptionRates   = errors.New("min consumption rate exceeds the max consumption rate")
+	errSharesOverflow     = fmt.Errorf("delegation shares exceed %d", reward.PercentDenominator)
+	errStakerKind         = errors.New("staker kind must be specified")
+	errDelegatedBelowSelf = errors.New("delegated weight is below the validator weight")
+)
+
+// stakingConfig returns the staking config of the network, the local
+// network's for networks other than mainnet and fuji.
+func stakingConfig(networkID uint32) *rpcpb.StakingConfig {
+	cfg := genesis.GetStakingConfig(networkID)
+	return &rpcpb.StakingConfig{
+		MinValidatorStake: cfg.MinValidatorStake,
+		MaxValidatorStake: cfg.MaxValidatorStake,
+		MinDelegatorStake: cfg.MinDelegatorStake,
+		MinDelegationFee:  cfg.MinDelegationFee,
+		MinStakeDuration:  uint64(cfg.MinStakeDuration),
+		MaxStakeDuration:  uint64(cfg.MaxStakeDuration),
+		RewardConfig:      toRPCRewardConfig(cfg.RewardConfig),
+	}
+}
+
+func toRPCRewardConfig(cfg reward.Config) *rpcpb.RewardConfig {
+	return &rpcpb.RewardConfig{
+		MaxConsumptionRate: cfg.MaxConsumptionRate,
+		MinConsumptionRate: cfg.MinConsumptionRate,
+		MintingPeriod:      uint64(cfg.MintingPeriod),
+		SupplyCap:          cfg.SupplyCap,
+	}
+}
+
+func (s *server) StakingReward(ctx context.Context, req *rpcpb.StakingRewardRequest) (*rpcpb.StakingRewardResponse, error) {
+	logger(ctx).Debug("received StakingReward request",
+		zap.Uint32("network-id", req.NetworkId),
+		zap.Uint64("staked-amount", req.StakedAmount),
+	)
+
+	cfg := genesis.GetStakingConfig(req.NetworkId).RewardConfig
+	if req.RewardConfig != nil {
+		cfg = reward.Config{
+			MaxConsumptionRate: req.RewardConfig.MaxConsumptionRate,
+			MinConsumptionRate: req.RewardConfig.MinConsumptionRate,
+			MintingPeriod:      time.Duration(req.RewardConfig.MintingPeriod),
+			SupplyCap:          req.RewardConfig.SupplyCap,
+		}
+	}
+	switch {
+	case cfg.MinConsumptionRate > cfg.MaxConsumptionRate:
+		return nil, invalidField("reward_config.min_consumption_rate", errConsumptionRates)
+	case cfg.MintingPeriod == 0:
+		return nil, invalidField("reward_config.minting_period", errZeroMintingPeriod)
+	case req.CurrentSupply == 0:
+		return nil, invalidField("current_supply", errZeroSupply)
+	case req.CurrentSupply > cfg.SupplyCap:
+		return nil, invalidField("current_supply", errSupplyOverCap)
+	}
+
+	calculator := reward.NewCalculator(cfg)
+	expected := calculator.Calculate(time.Duration(req.StakedDuration), req.StakedAmount, req.CurrentSupply)
+
+	resp := &rpcpb.StakingRewardResponse{
+		ExpectedReward:       expected,
+		ExpectedRewardConfig: toRPCRewardConfig(cfg),
+		Success:              true,
+	}
+	if expected != req.Reward {
+		resp.Message = fmt.Sprintf("expected reward %d, got %d", expected, req.Reward)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+func (s *server) DelegationReward(ctx context.Context, req *rpcpb.DelegationRewardRequest) (*rpcpb.DelegationRewardResponse, error) {
+	logger(ctx).Debug("received DelegationReward request",
+		zap.Uint64("potential-reward", req.PotentialReward),
+		zap.Uint32("delegation-shares", req.DelegationShares),
+	)
+
+	if req.DelegationShares > reward.PercentDenominator {
+		return nil, invalidField("delegation_shares", errSharesOverflow)
+	}
+	delegatorReward, delegateeReward := splitDelegationReward(req.PotentialReward, req.DelegationShares)
+
+	resp := &rpcpb.DelegationRewardResponse{
+		ExpectedDelegatorReward: delegatorReward,
+		ExpectedDelegateeReward: delegateeReward,
+		Success:                 true,
+	}
+	var mismatches []error
+	if delegatorReward != req.DelegatorReward {
+		mismatches = append(mismatches, fmt.Errorf("expected delegator reward %d, got %d", delegatorReward, req.DelegatorReward))
+	}
+	if delegateeReward != req.DelegateeReward {
+		mismatches = append(mismatches, fmt.Errorf("expected delegatee reward %d, got %d", delegateeReward, req.DelegateeReward))
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages(resp.Message, mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// splitDelegationReward returns the rewards of the delegator and of its
+// validator, which gets the delegation shares of the potential reward.
+// ref. "executor.ProposalTxExecutor.RewardValidatorTx"
+func splitDelegationReward(potentialReward uint64, validatorShares uint32) (uint64, uint64) {
+	delegatorShares := reward.PercentDenominator - uint64(validatorShares)
+	delegatorReward := delegatorShares * (potentialReward / reward.PercentDenominator)
+	// rounding is delayed as long as possible for small numbers
+	if optimisticReward, err := math.Mul64(delegatorShares, potentialReward); err == nil {
+		delegatorReward = optimisticReward / reward.PercentDenominator
+	}
+	return delegatorReward, potentialReward - delegatorReward
+}
+
+func (s *server) StakingLimits(ctx context.Context, req *rpcpb.StakingLimitsRequest) (*rpcpb.StakingLimitsResponse, error) {
+	logger(ctx).Debug("received StakingLimits request",
+		zap.Uint32("network-id", req.NetworkId),
+		zap.String("kind", req.Kind.String()),
+		zap.Uint64("weight", req.Weight),
+	)
+
+	cfg := req.StakingConfig
+	if cfg == nil {
+		cfg = stakingConfig(req.NetworkId)
+	}
+	resp := &rpcpb.StakingLimitsResponse{
+		ExpectedStakingConfig: cfg,
+		Success:               true,
+	}
+
+	var err error
+	switch req.Kind {
+	case rpcpb.StakerKind_STAKER_KIND_VALIDATOR:
+		err = verifyValidatorLimits(cfg, req)
+	case rpcpb.StakerKind_STAKER_KIND_DELEGATOR:
+		delegatedWeight := req.DelegatedWeight
+		if delegatedWeight == 0 {
+			delegatedWeight = req.ValidatorWeight
+		}
+		if delegatedWeight < req.ValidatorWeight {
+			return nil, invalidField("delegated_weight", errDelegatedBelowSelf)
+		}
+		resp.ExpectedMaxDelegatedWeight, err = verifyDelegatorLimits(cfg, req, delegatedWeight)
+	default:
+		return nil, invalidField("kind", errStakerKind)
+	}
+	if err != nil {
+		resp.ExpectedError = err.Error()
+	}
+	if (err != nil) != req.Rejected {
+		resp.Message = rejectionMismatch(err, req.Rejected)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// ref. "executor.verifyAddValidatorTx"
+func verifyValidatorLimits(cfg *rpcpb.StakingConfig, req *rpcpb.StakingLimitsRequest) error {
+	switch {
+	case req.Weight < cfg.MinValidatorStake:
+		return errWeightTooSmall
+	case req.Weight > cfg.MaxValidatorStake:
+		return errWeightTooLarge
+	case req.DelegationShares < cfg.MinDelegationFee:
+		return errInsufficientDelegationFee
+	case req.Duration < cfg.MinStakeDuration:
+		return errStakeTooShort
+	case req.Duration > cfg.MaxStakeDuration:
+		return errStakeTooLong
+	}
+	return nil
+}
+
+// verifyDelegatorLimits returns the maximum weight of the validator with
+// all its delegators, past the activation of apricot phase 3.
+// ref. "executor.verifyAddDelegatorTx", "executor.canDelegate"
+func verifyDelegatorLimits(cfg *rpcpb.StakingConfig, req *rpcpb.StakingLimitsRequest, delegatedWeight uint64) (uint64, error) {
+	switch {
+	case req.Duration < cfg.MinStakeDuration:
+		return 0, errStakeTooShort
+	case req.Duration > cfg.MaxStakeDuration:
+		return 0, errStakeTooLong
+	case req.Weight < cfg.MinDelegatorStake:
+		return 0, errWeightTooSmall
+	}
+
+	maximumWeight, err := math.Mul64(maxValidatorWeightFactor, req.ValidatorWeight)
+	if err != nil {
+		return 0, errStakeOverflow
+	}
+	maximumWeight = math.Min(maximumWeight, cfg.MaxValidatorStake)
+
+	newWeight, err := math.Add64(delegatedWeight, req.Weight)
+	if err != nil {
+		return maximumWeight, err
+	}
+	if newWeight > maximumWeight {
+		return maximumWeight, errOverDelegated
+	}
+	return maximumWeight, nil
+}
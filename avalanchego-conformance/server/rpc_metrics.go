@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const successField = protoreflect.Name("success")
+
+type rpcMetrics struct {
+	requests *prometheus.CounterVec
+	checks   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	inFlight *prometheus.GaugeVec
+}
+
+func newRPCMetrics(registerer prometheus.Registerer) (*rpcMetrics, error) {
+	m := &rpcMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "conformance",
+			Name:      "rpc_requests",
+			Help:      "Number of handled RPCs per method and gRPC status code",
+		}, []string{"service", "method", "code"}),
+		checks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "conformance",
+			Name:      "rpc_checks",
+			Help:      "Number of conformance checks per method and result (success or failure)",
+		}, []string{"service", "method", "result"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "conformance",
+			Name:      "rpc_duration_seconds",
+			Help:      "Time spent handling RPCs per method",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 10),
+		}, []string{"service", "method"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "conformance",
+			Name:      "rpc_in_flight",
+			Help:      "Number of RPCs (or streams) being handled per method",
+		}, []string{"service", "method"}),
+	}
+	for _, c := range []prometheus.Collector{m.requests, m.checks, m.duration, m.inFlight} {
+		if err := registerer.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// methodName returns the method name (e.g., "AppGossip") of a full method
+// name (e.g., "/rpcpb.MessageService/AppGossip").
+func methodName(fullMethod string) string {
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[i+1:]
+	}
+	return fullMethod
+}
+
+// observeCheck counts the check result of a response with a "success"
+// field, so that failure hot spots show up per method.
+func (m *rpcMetrics) observeCheck(svc string, method string, resp interface{}) {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return
+	}
+	r := msg.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName(successField)
+	if fd == nil || fd.Kind() != protoreflect.BoolKind {
+		return
+	}
+	result := "failure"
+	if r.Get(fd).Bool() {
+		result = "success"
+	}
+	m.checks.WithLabelValues(svc, method, result).Inc()
+}
+
+func (s *server) metricsUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	svc, method := serviceName(info.FullMethod), methodName(info.FullMethod)
+	inFlight := s.rpcMetrics.inFlight.WithLabelValues(svc, method)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	s.rpcMetrics.duration.WithLabelValues(svc, method).Observe(time.Since(start).Seconds())
+	s.rpcMetrics.requests.WithLabelValues(svc, method, status.Code(err).String()).Inc()
+	if err == nil {
+		s.rpcMetrics.observeCheck(svc, method, resp)
+	}
+	return resp, err
+}
+
+// metricsStreamInterceptor is the streaming variant of
+// metricsUnaryInterceptor, where every response sent on the stream counts
+// as a check.
+func (s *server) metricsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	svc, method := serviceName(info.FullMethod), methodName(info.FullMethod)
+	inFlight := s.rpcMetrics.inFlight.WithLabelValues(svc, method)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	start := time.Now()
+	err := handler(srv, &metricsServerStream{ServerStream: ss, m: s.rpcMetrics, svc: svc, method: method})
+	s.rpcMetrics.duration.WithLabelValues(svc, method).Observe(time.Since(start).Seconds())
+	s.rpcMetrics.requests.WithLabelValues(svc, method, status.Code(err).String()).Inc()
+	return err
+}
+
+type metricsServerStream struct {
+	grpc.ServerStream
+	m      *rpcMetrics
+	svc    string
+	method string
+}
+
+func (ss *metricsServerStream) SendMsg(m interface{}) error {
+	ss.m.observeCheck(ss.svc, ss.method, m)
+	return ss.ServerStream.SendMsg(m)
+}
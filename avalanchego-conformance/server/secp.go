@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultSecpCacheSize is the size of the secp256k1 public key recovery
+// cache, as used by avalanchego for tx verification.
+const DefaultSecpCacheSize = 256
+
+type secpMetrics struct {
+	cacheHits   prometheus.Counter
+	cacheMisses prometheus.Counter
+}
+
+func newSecpMetrics(registerer prometheus.Registerer) (*secpMetrics, error) {
+	m := &secpMetrics{
+		cacheHits: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "conformance",
+			Name:      "secp256k1_recover_cache_hits",
+			Help:      "Number of secp256k1 public key recoveries served from the cache",
+		}),
+		cacheMisses: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "conformance",
+			Name:      "secp256k1_recover_cache_misses",
+			Help:      "Number of secp256k1 public key recoveries not in the cache",
+		}),
+	}
+	for _, c := range []prometheus.Collector{m.cacheHits, m.cacheMisses} {
+		if err := registerer.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// recoverHashPublicKey recovers the public key with the shared factory,
+// recording whether the recovery cache was hit.
+func (s *server) recoverHashPublicKey(hash []byte, sig []byte) (*secp256k1.PublicKey, error) {
+	// ref. "secp256k1.Factory.RecoverHashPublicKey"
+	cacheBytes := make([]byte, len(hash)+len(sig))
+	copy(cacheBytes, hash)
+	copy(cacheBytes[len(hash):], sig)
+	if _, ok := s.secpFactory.Cache.Get(hashing.ComputeHash256Array(cacheBytes)); ok {
+		s.secpMetrics.cacheHits.Inc()
+	} else {
+		s.secpMetrics.cacheMisses.Inc()
+	}
+	return s.secpFactory.RecoverHashPublicKey(hash, sig)
+}
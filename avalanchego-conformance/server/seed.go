@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	dsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	secp256k1SeedDomain = "avalanche-conformance/secp256k1"
+	blsSeedDomain       = "avalanche-conformance/bls"
+	stakingSeedDomain   = "avalanche-conformance/staking"
+)
+
+var (
+	errEmptySeed = errors.New("seed must not be empty")
+
+	// ref. https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-pairing-friendly-curves-11#section-4.2.1
+	bls12381Order, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+	// ref. RFC 5280, section 4.1.2.5
+	noWellDefinedExpiration = time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)
+)
+
+// seedKey returns the private key of the index among the keys of the seed,
+// as a 32-byte big-endian scalar in [1, n-1].
+func seedKey(domain string, index uint32, seed []byte, n *big.Int) []byte {
+	h := sha256.New()
+	h.Write([]byte(domain))
+	_ = binary.Write(h, binary.BigEndian, index)
+	h.Write(seed)
+
+	m := new(big.Int).SetBytes(h.Sum(nil))
+	m.Mod(m, new(big.Int).Sub(n, big.NewInt(1)))
+	m.Add(m, big.NewInt(1))
+	return m.FillBytes(make([]byte, 32))
+}
+
+func (s *server) Secp256K1KeyFromSeed(ctx context.Context, req *rpcpb.Secp256K1KeyFromSeedRequest) (*rpcpb.Secp256K1KeyFromSeedResponse, error) {
+	logger(ctx).Debug("received Secp256K1KeyFromSeed request", zap.Uint32("index", req.Index), zap.Uint32("network-id", req.NetworkId))
+
+	if len(req.Seed) == 0 {
+		return nil, invalidField("seed", errEmptySeed)
+	}
+	privKey, err := s.secpFactory.ToPrivateKey(seedKey(secp256k1SeedDomain, req.Index, req.Seed, dsecp256k1.S256().N))
+	if err != nil {
+		return nil, err
+	}
+	cb58Key, err := encodePrivateKey(privKey)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := dsecp256k1.ParsePubKey(privKey.PublicKey().Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.Secp256K1KeyFromSeedResponse{
+		PrivateKey:            privKey.Bytes(),
+		PrivateKeyHex:         hex.EncodeToString(privKey.Bytes()),
+		PrivateKeyCb58:        cb58Key,
+		PublicKey:             pubKey.SerializeCompressed(),
+		UncompressedPublicKey: pubKey.SerializeUncompressed(),
+		ShortAddress:          encodeShortAddr(privKey),
+		EthAddress:            encodeEthAddr(privKey),
+	}
+	hrp := constants.GetHRP(req.NetworkId)
+	for _, f := range []struct {
+		chainAlias string
+		addr       *string
+	}{
+		{"X", &resp.XAddress},
+		{"P", &resp.PAddress},
+		{"C", &resp.CAddress},
+	} {
+		*f.addr, err = encodeAddr(privKey, f.chainAlias, hrp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (s *server) BlsKeyFromSeed(ctx context.Context, req *rpcpb.BlsKeyFromSeedRequest) (*rpcpb.BlsKeyFromSeedResponse, error) {
+	logger(ctx).Debug("received BlsKeyFromSeed request", zap.Uint32("index", req.Index))
+
+	if len(req.Seed) == 0 {
+		return nil, invalidField("seed", errEmptySeed)
+	}
+	sk, err := bls.SecretKeyFromBytes(seedKey(blsSeedDomain, req.Index, req.Seed, bls12381Order))
+	if err != nil {
+		return nil, err
+	}
+	pubkey := bls.PublicFromSecretKey(sk)
+	pop := signer.NewProofOfPossession(sk)
+	return &rpcpb.BlsKeyFromSeedResponse{
+		SecretKey:             bls.SecretKeyToBytes(sk),
+		PublicKey:             pop.PublicKey[:],
+		UncompressedPublicKey: pubkey.Serialize(),
+		ProofOfPossession:     pop.ProofOfPossession[:],
+	}, nil
+}
+
+func (s *server) StakingKeyFromSeed(ctx context.Context, req *rpcpb.StakingKeyFromSeedRequest) (*rpcpb.StakingKeyFromSeedResponse, error) {
+	logger(ctx).Debug("received StakingKeyFromSeed request", zap.Uint32("index", req.Index))
+
+	if len(req.Seed) == 0 {
+		return nil, invalidField("seed", errEmptySeed)
+	}
+	ecdhKey, err := ecdh.P256().NewPrivateKey(seedKey(stakingSeedDomain, req.Index, req.Seed, elliptic.P256().Params().N))
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(ecdhKey)
+	if err != nil {
+		return nil, err
+	}
+	parsedKey, err := x509.ParsePKCS8PrivateKey(keyDER)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := parsedKey.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("unexpected staking key type %T", parsedKey)
+	}
+
+	// ref. "staking.NewCertAndKeyBytes"
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(0),
+		NotBefore:             time.Date(2000, time.January, 0, 0, 0, 0, 0, time.UTC),
+		NotAfter:              noWellDefinedExpiration,
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageDataEncipherment,
+		BasicConstraintsValid: true,
+	}
+	certDER, err := x509.CreateCertificate(zeroReader{}, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return nil, err
+	}
+	nodeID := ids.NodeIDFromCert(cert)
+	return &rpcpb.StakingKeyFromSeedResponse{
+		PrivateKey:     keyDER,
+		PrivateKeyPem:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}),
+		Certificate:    certDER,
+		CertificatePem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		NodeId:         nodeID[:],
+		NodeIdString:   nodeID.String(),
+	}, nil
+}
+
+// zeroReader is an entropy source of zeros, with which ECDSA signatures
+// only depend on the key and the signed message.
+type zeroReader struct{}
+
+func (zeroReader) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = 0
+	}
+	return len(b), nil
+}
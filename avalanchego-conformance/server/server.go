@@ -6,9 +6,12 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
 	"os"
 	"sync"
 	"time"
@@ -17,14 +20,123 @@ import (
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	avmtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type Config struct {
-	Port        uint16
-	GwPort      uint16
+	// Port is the gRPC server port, an ephemeral one if zero.
+	Port uint16
+	// GwPort is the gateway port, zero to disable the gateway.
+	GwPort uint16
+	// DialTimeout bounds the gateway dial to the gRPC server. Zero means
+	// DefaultDialTimeout.
 	DialTimeout time.Duration
+	// DrainTimeout bounds how long in-flight requests are waited for on
+	// shutdown before being dropped. Zero means DefaultDrainTimeout.
+	DrainTimeout time.Duration
+	// ShutdownToken enables the Shutdown RPC, for requests carrying it.
+	ShutdownToken string
+
+	// PortsOutput receives the served Ports as a JSON line once the server
+	// is ready, if non-nil. PortFile is also written with them if set, and
+	// removed on shutdown.
+	PortsOutput io.Writer
+	PortFile    string
+
+	// GRPCSocket is the path of a unix socket the gRPC server listens on
+	// instead of Port.
+	GRPCSocket string
+
+	// FakeTime fixes the server clock used by timestamp-sensitive checks.
+	// Zero means the wall clock is used.
+	FakeTime time.Time
+
+	// SecpCacheSize is the size of the secp256k1 public key recovery cache.
+	// Zero means DefaultSecpCacheSize.
+	SecpCacheSize int
+
+	// MetricsPort serves prometheus metrics at "/metrics" if non-zero.
+	MetricsPort uint16
+	// ServiceQuotas maps a service name (e.g., "PackerService") to the
+	// maximum total request bytes it accepts per run. Zero means unlimited.
+	ServiceQuotas map[string]uint64
+
+	// EnabledServices lists the services (e.g., "KeyService") to register.
+	// Empty means all services. PingService is always registered.
+	EnabledServices []string
+	// DisabledServices lists the services not to register.
+	DisabledServices []string
+	// DisableReflection disables the gRPC server reflection service, which
+	// lets tools such as grpcurl list and invoke the served methods
+	// without the proto files.
+	DisableReflection bool
+
+	// TLSCertFile and TLSKeyFile serve the gRPC server and the gateway
+	// over TLS if set. TLSCAFile additionally requires clients to present
+	// a certificate signed by the CA (mutual TLS).
+	TLSCertFile string
+	TLSKeyFile  string
+	TLSCAFile   string
+
+	// SlowRequestThreshold logs unary requests taking at least as long as
+	// warnings, to find pathological inputs. Zero disables it.
+	SlowRequestThreshold time.Duration
+
+	// ReportDir is the directory every conformance check is appended to,
+	// in ReportFileName. Empty means checks are only aggregated in memory.
+	ReportDir string
+
+	// AuthToken requires requests to carry it as a bearer token in the
+	// "authorization" metadata (or the gateway "Authorization" header).
+	// Empty means no authentication.
+	AuthToken string
+
+	// MaxMessageTimeout is the maximum deadline of the messages checked
+	// and parsed by MessageService. Zero means DefaultMaxMessageTimeout.
+	MaxMessageTimeout time.Duration
+	// LenientInput zero-pads (or truncates) IDs of the wrong length,
+	// truncates out-of-range ports and defaults unset output owners, as
+	// clients written against older servers may rely on, instead of
+	// rejecting them with INVALID_ARGUMENT.
+	LenientInput bool
+
+	// MaxRecvMsgSize and MaxSendMsgSize bound the size of the messages the
+	// server receives and sends. Zero means DefaultMaxMsgSize.
+	MaxRecvMsgSize int
+	MaxSendMsgSize int
+	// MaxConcurrentStreams bounds the concurrent streams (i.e., in-flight
+	// requests) of each connection. Zero means unlimited.
+	MaxConcurrentStreams uint32
+	// KeepaliveTime is the idle time after which the server pings clients,
+	// and KeepaliveTimeout how long it waits for the ping ack before
+	// closing the connection. Zero means the gRPC defaults (2h and 20s).
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+	// KeepaliveMinTime is the minimum interval clients may ping the server
+	// at, and KeepalivePermitWithoutStream allows pings without in-flight
+	// requests. Connections of clients pinging more often are closed.
+	// Zero means the gRPC default (5m).
+	KeepaliveMinTime             time.Duration
+	KeepalivePermitWithoutStream bool
+
+	// LogRequests logs every request and its outcome at the info level.
+	LogRequests bool
+	// UnaryInterceptors and StreamInterceptors are chained after the
+	// built-in authentication, e.g., for embedding code to add rate
+	// limiting, tracing or custom authorization. They run in order, and
+	// before usage accounting.
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	StreamInterceptors []grpc.StreamServerInterceptor
 }
 
 type Server interface {
@@ -37,87 +149,372 @@ type server struct {
 	rootCtx   context.Context
 	closeOnce sync.Once
 	closed    chan struct{}
+	ready     chan struct{}
+
+	requestIDs uint64
+
+	shutdownOnce sync.Once
+	shutdownc    chan struct{}
 
 	ln               net.Listener
 	gRPCServer       *grpc.Server
+	gwConn           *grpc.ClientConn
+	gwServer         *http.Server
+	gwAddr           net.Addr
 	gRPCRegisterOnce sync.Once
+	services         []*grpc.ServiceDesc
+	unaryMethods     map[protoreflect.FullName]unaryMethod
+	health           *health.Server
+	tlsConfig        *tls.Config
+
+	// dispatchInterceptor wraps requests dispatched by type, which do
+	// not go through the gRPC server.
+	dispatchInterceptor grpc.UnaryServerInterceptor
 
 	mu *sync.RWMutex
 
+	clock mockable.Clock
+
+	metricsRegistry *prometheus.Registry
+	metricsServer   *http.Server
+	usageMetrics    *usageMetrics
+	rpcMetrics      *rpcMetrics
+	usage           map[string]*serviceUsage
+	reporter        *reporter
+	fuzz            fuzzSessions
+	peers           peerSessions
+
 	secpFactory *secp256k1.Factory
+	secpMetrics *secpMetrics
+
+	avmParser avmtxs.Parser
+
+	msgCreators msgCreators
+
+	// instance is the index of the server in instances, the servers of a
+	// multi-instance process. instances is nil for a single server.
+	instance  uint32
+	instances []*server
 
 	rpcpb.UnimplementedPingServiceServer
 	rpcpb.UnimplementedKeyServiceServer
 	rpcpb.UnimplementedPackerServiceServer
 	rpcpb.UnimplementedMessageServiceServer
+	rpcpb.UnimplementedJsonServiceServer
+	rpcpb.UnimplementedConfigServiceServer
+	rpcpb.UnimplementedGenesisServiceServer
+	rpcpb.UnimplementedValidatorsServiceServer
+	rpcpb.UnimplementedStressServiceServer
+	rpcpb.UnimplementedWatchServiceServer
+	rpcpb.UnimplementedTxServiceServer
+	rpcpb.UnimplementedCorethServiceServer
+	rpcpb.UnimplementedCodecServiceServer
+	rpcpb.UnimplementedWarpServiceServer
+	rpcpb.UnimplementedSortingServiceServer
+	rpcpb.UnimplementedCertServiceServer
+	rpcpb.UnimplementedAddressServiceServer
+	rpcpb.UnimplementedFormattingServiceServer
+	rpcpb.UnimplementedIdServiceServer
+	rpcpb.UnimplementedHashServiceServer
+	rpcpb.UnimplementedReportServiceServer
+	rpcpb.UnimplementedVectorServiceServer
+	rpcpb.UnimplementedFuzzServiceServer
+	rpcpb.UnimplementedNetworkServiceServer
+	rpcpb.UnimplementedBenchServiceServer
+	rpcpb.UnimplementedProofServiceServer
+	rpcpb.UnimplementedJsonApiServiceServer
+	rpcpb.UnimplementedRewardServiceServer
 }
 
 var (
-	ErrInvalidPort = errors.New("invalid port")
-	ErrClosed      = errors.New("server closed")
+	ErrInvalidPort    = errors.New("invalid port")
+	ErrClosed         = errors.New("server closed")
+	ErrUnknownService = errors.New("unknown service")
 )
 
-func New(cfg Config) (Server, error) {
-	if cfg.Port == 0 || cfg.GwPort == 0 {
+// services are the services registered by the server.
+var services = []*grpc.ServiceDesc{
+	&rpcpb.PingService_ServiceDesc,
+	&rpcpb.KeyService_ServiceDesc,
+	&rpcpb.PackerService_ServiceDesc,
+	&rpcpb.MessageService_ServiceDesc,
+	&rpcpb.JsonService_ServiceDesc,
+	&rpcpb.ConfigService_ServiceDesc,
+	&rpcpb.GenesisService_ServiceDesc,
+	&rpcpb.ValidatorsService_ServiceDesc,
+	&rpcpb.StressService_ServiceDesc,
+	&rpcpb.WatchService_ServiceDesc,
+	&rpcpb.TxService_ServiceDesc,
+	&rpcpb.CorethService_ServiceDesc,
+	&rpcpb.CodecService_ServiceDesc,
+	&rpcpb.WarpService_ServiceDesc,
+	&rpcpb.SortingService_ServiceDesc,
+	&rpcpb.CertService_ServiceDesc,
+	&rpcpb.AddressService_ServiceDesc,
+	&rpcpb.FormattingService_ServiceDesc,
+	&rpcpb.IdService_ServiceDesc,
+	&rpcpb.HashService_ServiceDesc,
+	&rpcpb.ReportService_ServiceDesc,
+	&rpcpb.VectorService_ServiceDesc,
+	&rpcpb.FuzzService_ServiceDesc,
+	&rpcpb.NetworkService_ServiceDesc,
+	&rpcpb.BenchService_ServiceDesc,
+	&rpcpb.ProofService_ServiceDesc,
+	&rpcpb.JsonApiService_ServiceDesc,
+	&rpcpb.RewardService_ServiceDesc,
+}
+
+// enabledServices returns the services to register given the config.
+func enabledServices(cfg Config) ([]*grpc.ServiceDesc, error) {
+	known := make(map[string]bool, len(services))
+	for _, desc := range services {
+		known[serviceName(desc.ServiceName)] = true
+	}
+	enabled := make(map[string]bool, len(cfg.EnabledServices))
+	for _, svc := range cfg.EnabledServices {
+		if !known[svc] {
+			return nil, fmt.Errorf("%w %q", ErrUnknownService, svc)
+		}
+		enabled[svc] = true
+	}
+	disabled := make(map[string]bool, len(cfg.DisabledServices))
+	for _, svc := range cfg.DisabledServices {
+		if !known[svc] {
+			return nil, fmt.Errorf("%w %q", ErrUnknownService, svc)
+		}
+		disabled[svc] = true
+	}
+
+	descs := make([]*grpc.ServiceDesc, 0, len(services))
+	for _, desc := range services {
+		svc := serviceName(desc.ServiceName)
+		if desc != &rpcpb.PingService_ServiceDesc {
+			if len(enabled) > 0 && !enabled[svc] {
+				continue
+			}
+			if disabled[svc] {
+				continue
+			}
+		}
+		descs = append(descs, desc)
+	}
+	return descs, nil
+}
+
+func New(cfg Config) (_ Server, err error) {
+	if cfg.GRPCSocket == "" && cfg.Port != 0 && cfg.Port == cfg.GwPort {
 		return nil, ErrInvalidPort
 	}
+	if cfg.SecpCacheSize == 0 {
+		cfg.SecpCacheSize = DefaultSecpCacheSize
+	}
+	if cfg.DialTimeout == 0 {
+		cfg.DialTimeout = DefaultDialTimeout
+	}
+	if cfg.DrainTimeout == 0 {
+		cfg.DrainTimeout = DefaultDrainTimeout
+	}
+	descs, err := enabledServices(cfg)
+	if err != nil {
+		return nil, err
+	}
+	unaryMethods, err := unaryMethodsByInput(descs)
+	if err != nil {
+		return nil, err
+	}
+	avmParser, err := newAVMParser()
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	reporter, err := newReporter(cfg.ReportDir)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = reporter.close()
+		}
+	}()
 
-	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
+	ln, err := newListener(cfg)
 	if err != nil {
 		return nil, err
 	}
-	return &server{
+	defer func() {
+		if err != nil {
+			_ = ln.Close()
+		}
+	}()
+	srv := &server{
 		cfg: cfg,
 
 		closed: make(chan struct{}),
+		ready:  make(chan struct{}),
+
+		shutdownc: make(chan struct{}),
 
-		ln:         ln,
-		gRPCServer: grpc.NewServer(),
+		ln:           ln,
+		services:     descs,
+		unaryMethods: unaryMethods,
+		health:       health.NewServer(),
+		tlsConfig:    tlsConfig,
+
+		metricsRegistry: prometheus.NewRegistry(),
+		usage:           make(map[string]*serviceUsage),
+		reporter:        reporter,
 
 		secpFactory: &secp256k1.Factory{
 			Cache: cache.LRU[ids.ID, *secp256k1.PublicKey]{
-				Size: 256,
+				Size: cfg.SecpCacheSize,
 			},
 		},
 
+		avmParser: avmParser,
+
 		mu: new(sync.RWMutex),
-	}, nil
+	}
+	if !cfg.FakeTime.IsZero() {
+		srv.clock.Set(cfg.FakeTime)
+	}
+	srv.setServing(false)
+	srv.usageMetrics, err = newUsageMetrics(srv.metricsRegistry)
+	if err != nil {
+		return nil, err
+	}
+	srv.secpMetrics, err = newSecpMetrics(srv.metricsRegistry)
+	if err != nil {
+		return nil, err
+	}
+	srv.rpcMetrics, err = newRPCMetrics(srv.metricsRegistry)
+	if err != nil {
+		return nil, err
+	}
+	unary, stream := srv.interceptors()
+	srv.dispatchInterceptor = chainUnaryInterceptors(unary)
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	opts = append(opts, cfg.transportOptions()...)
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	srv.gRPCServer = grpc.NewServer(opts...)
+	return srv, nil
 }
 
 func (s *server) Run(rootCtx context.Context) (err error) {
 	s.rootCtx = rootCtx
+	// runs last on every return, including failed gateway setup
+	defer func() {
+		if s.metricsServer != nil {
+			_ = s.metricsServer.Close()
+		}
+		if cerr := s.reporter.close(); cerr != nil {
+			zap.L().Warn("failed to close report", zap.Error(cerr))
+		}
+		s.closeOnce.Do(func() {
+			close(s.closed)
+		})
+	}()
 	s.gRPCRegisterOnce.Do(func() {
-		rpcpb.RegisterPingServiceServer(s.gRPCServer, s)
-		rpcpb.RegisterKeyServiceServer(s.gRPCServer, s)
-		rpcpb.RegisterPackerServiceServer(s.gRPCServer, s)
-		rpcpb.RegisterMessageServiceServer(s.gRPCServer, s)
+		for _, desc := range s.services {
+			zap.L().Info("registering service", zap.String("service", desc.ServiceName))
+			s.gRPCServer.RegisterService(desc, s)
+		}
+		grpc_health_v1.RegisterHealthServer(s.gRPCServer, s.health)
+		if !s.cfg.DisableReflection {
+			reflection.Register(s.gRPCServer)
+		}
 	})
 
 	gRPCErrc := make(chan error)
 	go func() {
-		zap.L().Info("serving gRPC server", zap.Uint16("port", s.cfg.Port))
+		zap.L().Info("serving gRPC server", zap.Stringer("address", s.ln.Addr()), zap.Bool("tls", s.tlsConfig != nil))
 		gRPCErrc <- s.gRPCServer.Serve(s.ln)
 	}()
 
+	var gwErrc chan error
+	if s.cfg.GwPort != 0 {
+		gwErrc, err = s.serveGateway(rootCtx)
+		if err != nil {
+			s.gRPCServer.Stop()
+			<-gRPCErrc
+			return err
+		}
+		defer s.gwConn.Close()
+	}
+	s.setServing(true)
+	if rerr := s.reportPorts(); rerr != nil {
+		zap.L().Warn("failed to report ports", zap.Error(rerr))
+	}
+	if s.cfg.PortFile != "" {
+		defer os.Remove(s.cfg.PortFile)
+	}
+	close(s.ready)
+
+	if s.cfg.MetricsPort != 0 {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(s.metricsRegistry, promhttp.HandlerOpts{}))
+		s.metricsServer = &http.Server{
+			Addr:              fmt.Sprintf(":%d", s.cfg.MetricsPort),
+			Handler:           mux,
+			ReadHeaderTimeout: s.cfg.DialTimeout,
+		}
+		go func() {
+			zap.L().Info("serving metrics", zap.Uint16("port", s.cfg.MetricsPort))
+			if err := s.metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				zap.L().Warn("metrics server failed", zap.Error(err))
+			}
+		}()
+	}
+
 	select {
 	case <-rootCtx.Done():
 		zap.L().Warn("root context is done")
 
-		s.gRPCServer.Stop()
-		zap.L().Warn("closed gRPC server")
-		<-gRPCErrc
+	case <-s.shutdownc:
+		zap.L().Warn("shutdown requested")
 
 	case err = <-gRPCErrc:
 		zap.L().Warn("gRPC server failed", zap.Error(err))
+		gRPCErrc = nil
+
+	case err = <-gwErrc:
+		zap.L().Warn("gRPC gateway server failed", zap.Error(err))
+		gwErrc = nil
 	}
 
-	s.closeOnce.Do(func() {
-		close(s.closed)
-	})
+	// fail readiness checks first, so that orchestrators stop routing
+	// requests while the server drains
+	s.health.Shutdown()
+
+	// drain in-flight REST requests before their gRPC backend goes away,
+	// sharing the drain timeout with the gRPC server
+	drainCtx, cancel := context.WithTimeout(context.Background(), s.cfg.DrainTimeout)
+	defer cancel()
+	if s.gwServer != nil {
+		if serr := s.gwServer.Shutdown(drainCtx); serr != nil {
+			zap.L().Warn("failed to shut down gRPC gateway server", zap.Error(serr))
+		}
+		if gwErrc != nil {
+			<-gwErrc
+		}
+		zap.L().Warn("closed gRPC gateway server")
+	}
+
+	s.gracefulStop(drainCtx)
+	zap.L().Warn("closed gRPC server")
+	if gRPCErrc != nil {
+		<-gRPCErrc
+	}
 	return err
 }
 
 func (s *server) PingService(ctx context.Context, req *rpcpb.PingServiceRequest) (*rpcpb.PingServiceResponse, error) {
-	zap.L().Debug("received PingService request")
 	return &rpcpb.PingServiceResponse{Pid: int32(os.Getpid())}, nil
 }
@@ -0,0 +1,50 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultDrainTimeout is the default Config.DrainTimeout.
+const DefaultDrainTimeout = 30 * time.Second
+
+// Shutdown makes Run return once in-flight requests are drained. The
+// response is sent before the server stops accepting requests.
+func (s *server) Shutdown(ctx context.Context, req *rpcpb.ShutdownRequest) (*rpcpb.ShutdownResponse, error) {
+	if s.cfg.ShutdownToken == "" {
+		return nil, status.Error(codes.Unimplemented, "shutdown token not configured")
+	}
+	if subtle.ConstantTimeCompare([]byte(req.Token), []byte(s.cfg.ShutdownToken)) != 1 {
+		return nil, status.Error(codes.PermissionDenied, "invalid shutdown token")
+	}
+	s.shutdownOnce.Do(func() {
+		close(s.shutdownc)
+	})
+	return &rpcpb.ShutdownResponse{}, nil
+}
+
+// gracefulStop stops the gRPC server once in-flight requests complete, or
+// drops the remaining ones (e.g., long-lived streams) when ctx is done.
+func (s *server) gracefulStop(ctx context.Context) {
+	stopped := make(chan struct{})
+	go func() {
+		s.gRPCServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		zap.L().Warn("drain timeout expired; dropping in-flight requests")
+		s.gRPCServer.Stop()
+		<-stopped
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"go.uber.org/zap"
+)
+
+var (
+	errNotSorted        = errors.New("not in canonical order")
+	errDuplicateElement = errors.New("duplicate element")
+)
+
+func (s *server) SortTransferableOutputs(ctx context.Context, req *rpcpb.SortTransferableOutputsRequest) (*rpcpb.SortTransferableOutputsResponse, error) {
+	logger(ctx).Debug("received SortTransferableOutputs request", zap.Int("outputs", len(req.Outputs)))
+
+	c, version, err := s.txChainCodec(req.Chain)
+	if err != nil {
+		return nil, err
+	}
+	outs, err := s.transferableOutputs(req.Outputs)
+	if err != nil {
+		return nil, invalidField("outputs", err)
+	}
+	// outputs of the same asset are ordered by their bytes, type ID included
+	outBytes := make([][]byte, 0, len(outs))
+	for i, out := range outs {
+		b, err := c.Marshal(version, &out.Out)
+		if err != nil {
+			return nil, fmt.Errorf("outputs[%d]: %w", i, err)
+		}
+		outBytes = append(outBytes, b)
+	}
+
+	o := canonicalOrderOf(len(outs), func(i, j int) bool {
+		iAssetID, jAssetID := outs[i].AssetID(), outs[j].AssetID()
+		if cmp := bytes.Compare(iAssetID[:], jAssetID[:]); cmp != 0 {
+			return cmp < 0
+		}
+		return bytes.Compare(outBytes[i], outBytes[j]) < 0
+	})
+	msg, success := o.result(false)
+	return &rpcpb.SortTransferableOutputsResponse{
+		ExpectedOrder: o.order,
+		Mismatches:    o.mismatches,
+		Unique:        o.unique,
+		Message:       msg,
+		Success:       success,
+	}, nil
+}
+
+func (s *server) SortTransferableInputs(ctx context.Context, req *rpcpb.SortTransferableInputsRequest) (*rpcpb.SortTransferableInputsResponse, error) {
+	logger(ctx).Debug("received SortTransferableInputs request", zap.Int("inputs", len(req.Inputs)))
+
+	ins, err := transferableInputs(req.Inputs)
+	if err != nil {
+		return nil, invalidField("inputs", err)
+	}
+
+	o := canonicalOrderOf(len(ins), func(i, j int) bool {
+		return ins[i].Less(ins[j])
+	})
+	msg, success := o.result(true)
+	return &rpcpb.SortTransferableInputsResponse{
+		ExpectedOrder: o.order,
+		Mismatches:    o.mismatches,
+		Unique:        o.unique,
+		Message:       msg,
+		Success:       success,
+	}, nil
+}
+
+func (s *server) SortIds(ctx context.Context, req *rpcpb.SortIdsRequest) (*rpcpb.SortIdsResponse, error) {
+	logger(ctx).Debug("received SortIds request", zap.Int("ids", len(req.Ids)))
+
+	converted := make([]ids.ID, 0, len(req.Ids))
+	for i, b := range req.Ids {
+		id, err := toID(fmt.Sprintf("ids[%d]", i), b)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, id)
+	}
+
+	o := canonicalOrderOf(len(converted), func(i, j int) bool {
+		return converted[i].Less(converted[j])
+	})
+	msg, success := o.result(true)
+	return &rpcpb.SortIdsResponse{
+		ExpectedOrder: o.order,
+		Mismatches:    o.mismatches,
+		Unique:        o.unique,
+		Message:       msg,
+		Success:       success,
+	}, nil
+}
+
+func (s *server) SortAddresses(ctx context.Context, req *rpcpb.SortAddressesRequest) (*rpcpb.SortAddressesResponse, error) {
+	logger(ctx).Debug("received SortAddresses request", zap.Int("addresses", len(req.Addresses)))
+
+	addrs := make([]ids.ShortID, 0, len(req.Addresses))
+	for i, b := range req.Addresses {
+		addr, err := toShortID(fmt.Sprintf("addresses[%d]", i), b)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+
+	o := canonicalOrderOf(len(addrs), func(i, j int) bool {
+		return addrs[i].Less(addrs[j])
+	})
+	msg, success := o.result(true)
+	return &rpcpb.SortAddressesResponse{
+		ExpectedOrder: o.order,
+		Mismatches:    o.mismatches,
+		Unique:        o.unique,
+		Message:       msg,
+		Success:       success,
+	}, nil
+}
+
+// canonicalOrder is the canonical ordering of the received elements.
+type canonicalOrder struct {
+	// indices of the received elements in canonical order
+	order      []uint32
+	mismatches []*rpcpb.SortMismatch
+	unique     bool
+}
+
+// canonicalOrderOf sorts the indices of n received elements. The sort is
+// stable, so that equal elements are never reported as mismatches.
+func canonicalOrderOf(n int, less func(i, j int) bool) canonicalOrder {
+	o := canonicalOrder{
+		order:  make([]uint32, n),
+		unique: true,
+	}
+	for i := range o.order {
+		o.order[i] = uint32(i)
+	}
+	sort.SliceStable(o.order, func(i, j int) bool {
+		return less(int(o.order[i]), int(o.order[j]))
+	})
+	for pos, idx := range o.order {
+		if int(idx) != pos {
+			o.mismatches = append(o.mismatches, &rpcpb.SortMismatch{
+				Position:      uint32(pos),
+				ExpectedIndex: idx,
+			})
+		}
+		// sorted elements are equal when neither is less than the other
+		if pos > 0 && !less(int(o.order[pos-1]), int(idx)) {
+			o.unique = false
+		}
+	}
+	return o
+}
+
+// result returns the message and success of the received ordering.
+func (o canonicalOrder) result(requireUnique bool) (string, bool) {
+	var errs []error
+	if len(o.mismatches) > 0 {
+		first := o.mismatches[0]
+		errs = append(errs, fmt.Errorf("%w: %d of %d elements misplaced, first at position %d (expected element %d)",
+			errNotSorted, len(o.mismatches), len(o.order), first.Position, first.ExpectedIndex))
+	}
+	if requireUnique && !o.unique {
+		errs = append(errs, errDuplicateElement)
+	}
+	return joinMessages("", errs), len(errs) == 0
+}
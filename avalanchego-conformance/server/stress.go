@@ -0,0 +1,192 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxStressRatePerSec  = 100000
+	maxStressConcurrency = 1024
+	maxStressQueueSize   = 100000
+	// maxStressQueueBytes bounds the payloads of the queued checks, queue
+	// size times payload size.
+	maxStressQueueBytes = 1 << 30
+)
+
+func (s *server) Stress(req *rpcpb.StressRequest, stream rpcpb.StressService_StressServer) error {
+	logger(stream.Context()).Info("received Stress request",
+		zap.Uint32("rate-per-sec", req.RatePerSec),
+		zap.Uint64("duration-ms", req.DurationMs),
+	)
+
+	if req.RatePerSec == 0 {
+		return invalidField("rate_per_sec", errMissingField)
+	}
+	if req.RatePerSec > maxStressRatePerSec {
+		return invalidField("rate_per_sec", fmt.Errorf("%d > %d", req.RatePerSec, maxStressRatePerSec))
+	}
+	if req.DurationMs == 0 {
+		return invalidField("duration_ms", errMissingField)
+	}
+	if req.PayloadSize > constants.DefaultMaxMessageSize {
+		return invalidField("payload_size", fmt.Errorf("%d > %d", req.PayloadSize, constants.DefaultMaxMessageSize))
+	}
+	concurrency := int(req.Concurrency)
+	if concurrency == 0 {
+		concurrency = runtime.NumCPU()
+	}
+	if concurrency > maxStressConcurrency {
+		return invalidField("concurrency", fmt.Errorf("%d > %d", concurrency, maxStressConcurrency))
+	}
+	queueSize := int(req.QueueSize)
+	if queueSize == 0 {
+		queueSize = int(req.RatePerSec)
+	}
+	if queueSize > maxStressQueueSize {
+		return invalidField("queue_size", fmt.Errorf("%d > %d", queueSize, maxStressQueueSize))
+	}
+	if total := uint64(queueSize) * uint64(req.PayloadSize); total > maxStressQueueBytes {
+		return status.Errorf(codes.InvalidArgument, "queue_size times payload_size %d > %d", total, maxStressQueueBytes)
+	}
+	reportInterval := time.Duration(req.ReportIntervalMs) * time.Millisecond
+	if reportInterval == 0 {
+		reportInterval = time.Second
+	}
+	genInterval := time.Second / time.Duration(req.RatePerSec)
+	if genInterval == 0 {
+		genInterval = time.Nanosecond
+	}
+
+	ctx, cancel := context.WithTimeout(stream.Context(), time.Duration(req.DurationMs)*time.Millisecond)
+	defer cancel()
+
+	var (
+		generated, completed, failed, dropped uint64
+
+		failureOnce sync.Once
+		failure     string
+	)
+	queue := make(chan *rpcpb.AppGossipRequest, queueSize)
+
+	workersWg := sync.WaitGroup{}
+	for i := 0; i < concurrency; i++ {
+		workersWg.Add(1)
+		go func() {
+			defer workersWg.Done()
+			for check := range queue {
+				if err := s.runStressCheck(ctx, check); err != nil {
+					failureOnce.Do(func() { failure = err.Error() })
+					atomic.AddUint64(&failed, 1)
+				}
+				atomic.AddUint64(&completed, 1)
+			}
+		}()
+	}
+
+	// Checks are generated in their own goroutine, so that a client slow to
+	// receive progress reports does not throttle generation.
+	genDone := make(chan struct{})
+	go func() {
+		defer close(genDone)
+		defer close(queue)
+
+		ticker := time.NewTicker(genInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			check := &rpcpb.AppGossipRequest{
+				ChainId:  utils.RandomBytes(32),
+				AppBytes: utils.RandomBytes(int(req.PayloadSize)),
+			}
+			atomic.AddUint64(&generated, 1)
+			select {
+			case queue <- check:
+			default:
+				atomic.AddUint64(&dropped, 1)
+			}
+		}
+	}()
+
+	start := time.Now()
+	progress := func(done bool) *rpcpb.StressProgress {
+		elapsed := time.Since(start)
+		p := &rpcpb.StressProgress{
+			ElapsedMs:  uint64(elapsed.Milliseconds()),
+			Generated:  atomic.LoadUint64(&generated),
+			Completed:  atomic.LoadUint64(&completed),
+			Failed:     atomic.LoadUint64(&failed),
+			Dropped:    atomic.LoadUint64(&dropped),
+			QueueDepth: uint32(len(queue)),
+			Done:       done,
+			Success:    true,
+		}
+		if elapsed > 0 {
+			p.AchievedRatePerSec = float64(p.Completed) / elapsed.Seconds()
+		}
+		if p.Failed > 0 {
+			p.Message = fmt.Sprintf("%d checks failed, first failure: %s", p.Failed, failure)
+			p.Success = false
+		}
+		return p
+	}
+
+	reportTicker := time.NewTicker(reportInterval)
+	defer reportTicker.Stop()
+	for generating := true; generating; {
+		select {
+		case <-reportTicker.C:
+			if err := stream.Send(progress(false)); err != nil {
+				cancel()
+				<-genDone
+				workersWg.Wait()
+				return err
+			}
+		case <-genDone:
+			generating = false
+		}
+	}
+
+	workersWg.Wait()
+	if err := stream.Context().Err(); err != nil {
+		return err
+	}
+	return stream.Send(progress(true))
+}
+
+// runStressCheck checks that the AppGossip message encoding of the check
+// is deterministic.
+func (s *server) runStressCheck(ctx context.Context, check *rpcpb.AppGossipRequest) error {
+	resp, err := s.AppGossip(ctx, check)
+	if err != nil {
+		return err
+	}
+	check.SerializedMsg = resp.ExpectedSerializedMsg
+	resp, err = s.AppGossip(ctx, check)
+	if err != nil {
+		return err
+	}
+	if !resp.Success {
+		return errors.New(resp.Message)
+	}
+	return nil
+}
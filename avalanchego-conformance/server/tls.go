@@ -0,0 +1,77 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	ErrInvalidTLSConfig = errors.New("invalid TLS config")
+
+	errUnexpectedSelfCert = errors.New("unexpected server certificate on self dial")
+)
+
+// newTLSConfig returns the TLS config of the gRPC server and the gateway,
+// nil if TLS is not configured. With TLSCAFile set, clients must present a
+// certificate signed by the CA (mutual TLS).
+func newTLSConfig(cfg Config) (*tls.Config, error) {
+	if cfg.TLSCertFile == "" && cfg.TLSKeyFile == "" {
+		if cfg.TLSCAFile != "" {
+			return nil, fmt.Errorf("%w: CA set without a certificate and key", ErrInvalidTLSConfig)
+		}
+		return nil, nil
+	}
+	if cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+		return nil, fmt.Errorf("%w: both a certificate and a key are required", ErrInvalidTLSConfig)
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.TLSCAFile != "" {
+		pem, err := os.ReadFile(cfg.TLSCAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%w: no certificates in %q", ErrInvalidTLSConfig, cfg.TLSCAFile)
+		}
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsCfg, nil
+}
+
+// selfDialCredentials returns the credentials the gateway dials the gRPC
+// server with. The server certificate is pinned rather than verified
+// against a hostname, since the gateway dials localhost. With mutual TLS,
+// the gateway presents the server certificate, which must then be signed by
+// the CA and allow client authentication.
+func selfDialCredentials(tlsCfg *tls.Config) credentials.TransportCredentials {
+	leaf := tlsCfg.Certificates[0].Certificate[0]
+	return credentials.NewTLS(&tls.Config{
+		Certificates:       tlsCfg.Certificates,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: true, // the certificate is pinned below
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], leaf) {
+				return errUnexpectedSelfCert
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// DefaultMaxMsgSize is the default maximum size of the messages the server
+// receives and sends, above the 4 MiB gRPC default so that large Ancestors
+// containers and app payloads fit.
+const DefaultMaxMsgSize = 64 * 1024 * 1024
+
+// maxRecvMsgSize returns the maximum size of the messages the server
+// receives.
+func (cfg Config) maxRecvMsgSize() int {
+	if cfg.MaxRecvMsgSize == 0 {
+		return DefaultMaxMsgSize
+	}
+	return cfg.MaxRecvMsgSize
+}
+
+// maxSendMsgSize returns the maximum size of the messages the server sends.
+func (cfg Config) maxSendMsgSize() int {
+	if cfg.MaxSendMsgSize == 0 {
+		return DefaultMaxMsgSize
+	}
+	return cfg.MaxSendMsgSize
+}
+
+// transportOptions returns the server options of the connection tuning
+// config.
+func (cfg Config) transportOptions() []grpc.ServerOption {
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(cfg.maxRecvMsgSize()),
+		grpc.MaxSendMsgSize(cfg.maxSendMsgSize()),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    cfg.KeepaliveTime,
+			Timeout: cfg.KeepaliveTimeout,
+		}),
+	}
+	if cfg.MaxConcurrentStreams != 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(cfg.MaxConcurrentStreams))
+	}
+	if cfg.KeepaliveMinTime != 0 || cfg.KeepalivePermitWithoutStream {
+		opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             cfg.KeepaliveMinTime,
+			PermitWithoutStream: cfg.KeepalivePermitWithoutStream,
+		}))
+	}
+	return opts
+}
+
+// callOptions returns the call options of the gateway connection, so that
+// the gateway proxies messages of the sizes the server accepts.
+func (cfg Config) callOptions() []grpc.CallOption {
+	return []grpc.CallOption{
+		grpc.MaxCallRecvMsgSize(cfg.maxSendMsgSize()),
+		grpc.MaxCallSendMsgSize(cfg.maxRecvMsgSize()),
+	}
+}
@@ -0,0 +1,649 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"go.uber.org/zap"
+)
+
+var (
+	errMissingField       = errors.New("missing field")
+	errNotChainCreationTx = errors.New("not a CreateSubnetTx or CreateChainTx")
+	errInvalidVMName      = errors.New("invalid VM name")
+)
+
+func (s *server) AddPermissionlessValidatorTx(ctx context.Context, req *rpcpb.AddPermissionlessValidatorTxRequest) (*rpcpb.AddPermissionlessValidatorTxResponse, error) {
+	baseTx, err := s.platformBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	vdr, err := stakerValidator(req.Validator)
+	if err != nil {
+		return nil, invalidField("validator", err)
+	}
+	subnetID, err := toID("subnet_id", req.SubnetId)
+	if err != nil {
+		return nil, err
+	}
+	txSigner, err := proofOfPossession(req.Signer)
+	if err != nil {
+		return nil, invalidField("signer", err)
+	}
+	stakeOuts, err := s.transferableOutputs(req.StakeOuts)
+	if err != nil {
+		return nil, invalidField("stake_outs", err)
+	}
+	validatorRewardsOwner, err := s.outputOwners(req.ValidatorRewardsOwner)
+	if err != nil {
+		return nil, invalidField("validator_rewards_owner", err)
+	}
+	delegatorRewardsOwner, err := s.outputOwners(req.DelegatorRewardsOwner)
+	if err != nil {
+		return nil, invalidField("delegator_rewards_owner", err)
+	}
+
+	utx := &txs.AddPermissionlessValidatorTx{
+		BaseTx:                baseTx,
+		Validator:             vdr,
+		Subnet:                subnetID,
+		Signer:                txSigner,
+		StakeOuts:             stakeOuts,
+		ValidatorRewardsOwner: validatorRewardsOwner,
+		DelegatorRewardsOwner: delegatorRewardsOwner,
+		DelegationShares:      req.DelegationShares,
+	}
+	expected, txID, err := initializeTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.AddPermissionlessValidatorTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) AddValidatorTx(ctx context.Context, req *rpcpb.AddValidatorTxRequest) (*rpcpb.AddValidatorTxResponse, error) {
+	baseTx, err := s.platformBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	vdr, err := stakerValidator(req.Validator)
+	if err != nil {
+		return nil, invalidField("validator", err)
+	}
+	stakeOuts, err := s.transferableOutputs(req.StakeOuts)
+	if err != nil {
+		return nil, invalidField("stake_outs", err)
+	}
+	rewardsOwner, err := s.outputOwners(req.RewardsOwner)
+	if err != nil {
+		return nil, invalidField("rewards_owner", err)
+	}
+
+	utx := &txs.AddValidatorTx{
+		BaseTx:           baseTx,
+		Validator:        vdr,
+		StakeOuts:        stakeOuts,
+		RewardsOwner:     rewardsOwner,
+		DelegationShares: req.DelegationShares,
+	}
+	expected, txID, err := initializeTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.AddValidatorTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) AddDelegatorTx(ctx context.Context, req *rpcpb.AddDelegatorTxRequest) (*rpcpb.AddDelegatorTxResponse, error) {
+	baseTx, err := s.platformBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	vdr, err := stakerValidator(req.Validator)
+	if err != nil {
+		return nil, invalidField("validator", err)
+	}
+	stakeOuts, err := s.transferableOutputs(req.StakeOuts)
+	if err != nil {
+		return nil, invalidField("stake_outs", err)
+	}
+	rewardsOwner, err := s.outputOwners(req.RewardsOwner)
+	if err != nil {
+		return nil, invalidField("rewards_owner", err)
+	}
+
+	utx := &txs.AddDelegatorTx{
+		BaseTx:                 baseTx,
+		Validator:              vdr,
+		StakeOuts:              stakeOuts,
+		DelegationRewardsOwner: rewardsOwner,
+	}
+	expected, txID, err := initializeTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.AddDelegatorTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) AddSubnetValidatorTx(ctx context.Context, req *rpcpb.AddSubnetValidatorTxRequest) (*rpcpb.AddSubnetValidatorTxResponse, error) {
+	baseTx, err := s.platformBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	vdr, err := stakerValidator(req.Validator)
+	if err != nil {
+		return nil, invalidField("validator", err)
+	}
+	subnetID, err := toID("subnet_id", req.SubnetId)
+	if err != nil {
+		return nil, err
+	}
+
+	utx := &txs.AddSubnetValidatorTx{
+		BaseTx: baseTx,
+		SubnetValidator: txs.SubnetValidator{
+			Validator: vdr,
+			Subnet:    subnetID,
+		},
+		SubnetAuth: &secp256k1fx.Input{SigIndices: req.SubnetAuthSigIndices},
+	}
+	expected, txID, err := initializeTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.AddSubnetValidatorTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) CreateSubnetTx(ctx context.Context, req *rpcpb.CreateSubnetTxRequest) (*rpcpb.CreateSubnetTxResponse, error) {
+	baseTx, err := s.platformBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	owner, err := s.outputOwners(req.Owner)
+	if err != nil {
+		return nil, invalidField("owner", err)
+	}
+
+	utx := &txs.CreateSubnetTx{
+		BaseTx: baseTx,
+		Owner:  owner,
+	}
+	expected, txID, err := initializeTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.CreateSubnetTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) CreateChainTx(ctx context.Context, req *rpcpb.CreateChainTxRequest) (*rpcpb.CreateChainTxResponse, error) {
+	baseTx, err := s.platformBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	subnetID, err := toID("subnet_id", req.SubnetId)
+	if err != nil {
+		return nil, err
+	}
+	vmID, err := toID("vm_id", req.VmId)
+	if err != nil {
+		return nil, err
+	}
+	fxIDs := make([]ids.ID, 0, len(req.FxIds))
+	for i, b := range req.FxIds {
+		fxID, err := toID(fmt.Sprintf("fx_ids[%d]", i), b)
+		if err != nil {
+			return nil, err
+		}
+		fxIDs = append(fxIDs, fxID)
+	}
+
+	utx := &txs.CreateChainTx{
+		BaseTx:      baseTx,
+		SubnetID:    subnetID,
+		ChainName:   req.ChainName,
+		VMID:        vmID,
+		FxIDs:       fxIDs,
+		GenesisData: req.GenesisData,
+		SubnetAuth:  &secp256k1fx.Input{SigIndices: req.SubnetAuthSigIndices},
+	}
+	expected, txID, err := initializeTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.CreateChainTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) ChainIds(ctx context.Context, req *rpcpb.ChainIdsRequest) (*rpcpb.ChainIdsResponse, error) {
+	logger(ctx).Debug("received ChainIds request", zap.String("vm-name", req.VmName))
+
+	tx, err := txs.Parse(txs.Codec, req.SignedTxBytes)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.ChainIdsResponse{
+		Success: true,
+	}
+	txID := tx.ID()
+	var vmID ids.ID
+	switch utx := tx.Unsigned.(type) {
+	case *txs.CreateSubnetTx:
+		resp.ExpectedSubnetId = txID[:]
+	case *txs.CreateChainTx:
+		resp.ExpectedSubnetId = utx.SubnetID[:]
+		resp.ExpectedBlockchainId = txID[:]
+		resp.ExpectedChainAlias = txID.String()
+		vmID = utx.VMID
+	default:
+		return nil, fmt.Errorf("%w: %T", errNotChainCreationTx, utx)
+	}
+	if req.VmName != "" {
+		if vmID, err = vmIDFromName(req.VmName); err != nil {
+			return nil, err
+		}
+	}
+	if vmID != ids.Empty {
+		resp.ExpectedVmId = vmID[:]
+	}
+
+	var mismatches []error
+	if !bytes.Equal(resp.ExpectedSubnetId, req.SubnetId) {
+		mismatches = append(mismatches, fmt.Errorf("expected subnet ID 0x%x, got 0x%x", resp.ExpectedSubnetId, req.SubnetId))
+	}
+	if !bytes.Equal(resp.ExpectedBlockchainId, req.BlockchainId) {
+		mismatches = append(mismatches, fmt.Errorf("expected blockchain ID 0x%x, got 0x%x", resp.ExpectedBlockchainId, req.BlockchainId))
+	}
+	if resp.ExpectedChainAlias != req.ChainAlias {
+		mismatches = append(mismatches, fmt.Errorf("expected chain alias %q, got %q", resp.ExpectedChainAlias, req.ChainAlias))
+	}
+	if !bytes.Equal(resp.ExpectedVmId, req.VmId) {
+		mismatches = append(mismatches, fmt.Errorf("expected VM ID 0x%x, got 0x%x", resp.ExpectedVmId, req.VmId))
+	}
+	if len(mismatches) > 0 {
+		resp.Message = joinMessages("", mismatches)
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// vmIDFromName returns the ID of the VM alias, zero-padded to 32 bytes as
+// "constants.AVMID" and "constants.EVMID" are.
+func vmIDFromName(name string) (ids.ID, error) {
+	if len(name) > len(ids.Empty) {
+		return ids.Empty, fmt.Errorf("%w: VM name %q is over %d bytes", errInvalidVMName, name, len(ids.Empty))
+	}
+	var vmID ids.ID
+	copy(vmID[:], name)
+	return vmID, nil
+}
+
+func (s *server) ImportTx(ctx context.Context, req *rpcpb.ImportTxRequest) (*rpcpb.ImportTxResponse, error) {
+	baseTx, err := s.platformBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	sourceChain, err := toID("source_chain", req.SourceChain)
+	if err != nil {
+		return nil, err
+	}
+	importedInputs, err := transferableInputs(req.ImportedInputs)
+	if err != nil {
+		return nil, invalidField("imported_inputs", err)
+	}
+
+	utx := &txs.ImportTx{
+		BaseTx:         baseTx,
+		SourceChain:    sourceChain,
+		ImportedInputs: importedInputs,
+	}
+	expected, txID, err := initializeTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.ImportTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) ExportTx(ctx context.Context, req *rpcpb.ExportTxRequest) (*rpcpb.ExportTxResponse, error) {
+	baseTx, err := s.platformBaseTx(req.BaseTx)
+	if err != nil {
+		return nil, invalidField("base_tx", err)
+	}
+	destinationChain, err := toID("destination_chain", req.DestinationChain)
+	if err != nil {
+		return nil, err
+	}
+	exportedOutputs, err := s.transferableOutputs(req.ExportedOutputs)
+	if err != nil {
+		return nil, invalidField("exported_outputs", err)
+	}
+
+	utx := &txs.ExportTx{
+		BaseTx:           baseTx,
+		DestinationChain: destinationChain,
+		ExportedOutputs:  exportedOutputs,
+	}
+	expected, txID, err := initializeTx(utx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.ExportTxResponse{
+		ExpectedUnsignedTxBytes: expected,
+		ExpectedTxId:            txID[:],
+		Success:                 true,
+	}
+	if d := newDiff(expected, req.UnsignedTxBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) ProofOfPossessionVerify(ctx context.Context, req *rpcpb.ProofOfPossessionVerifyRequest) (*rpcpb.ProofOfPossessionVerifyResponse, error) {
+	if req.ProofOfPossession == nil {
+		return nil, invalidField("proof_of_possession", errMissingField)
+	}
+	txSigner, err := proofOfPossession(req.ProofOfPossession)
+	if err != nil {
+		return nil, invalidField("proof_of_possession", err)
+	}
+	pop := txSigner.(*signer.ProofOfPossession)
+	b, err := txs.Codec.Marshal(txs.Version, pop)
+	if err != nil {
+		return nil, err
+	}
+	expected := b[wrappers.ShortLen:]
+
+	resp := &rpcpb.ProofOfPossessionVerifyResponse{
+		ExpectedBytes: expected,
+		Success:       true,
+	}
+	if d := newDiff(expected, req.ProofOfPossessionBytes, diff.AnnotatorPath(proofOfPossessionFields)); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+	if err := pop.Verify(); err != nil {
+		resp.Message = joinMessages(resp.Message, []error{err})
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+// proofOfPossessionFields annotates a serialized proof of possession, without
+// the codec version.
+// ref. "signer.ProofOfPossession"
+func proofOfPossessionFields([]byte) []diff.Field {
+	return []diff.Field{
+		{Name: "public_key", Start: 0, End: bls.PublicKeyLen},
+		{Name: "proof_of_possession", Start: bls.PublicKeyLen, End: bls.PublicKeyLen + bls.SignatureLen},
+	}
+}
+
+// initializeTx returns the unsigned bytes of the tx, and the ID of the tx
+// without credentials.
+// ref. "txs.Tx.Initialize"
+func initializeTx(utx txs.UnsignedTx) ([]byte, ids.ID, error) {
+	tx := &txs.Tx{Unsigned: utx}
+	if err := tx.Initialize(txs.Codec); err != nil {
+		return nil, ids.Empty, err
+	}
+	return utx.Bytes(), tx.ID(), nil
+}
+
+func (s *server) platformBaseTx(tx *rpcpb.BaseTx) (txs.BaseTx, error) {
+	if tx == nil {
+		return txs.BaseTx{}, errMissingField
+	}
+	blockchainID, err := toID("blockchain_id", tx.BlockchainId)
+	if err != nil {
+		return txs.BaseTx{}, err
+	}
+	outs, err := s.transferableOutputs(tx.Outputs)
+	if err != nil {
+		return txs.BaseTx{}, invalidField("outputs", err)
+	}
+	ins, err := transferableInputs(tx.Inputs)
+	if err != nil {
+		return txs.BaseTx{}, invalidField("inputs", err)
+	}
+	return txs.BaseTx{
+		BaseTx: avax.BaseTx{
+			NetworkID:    tx.NetworkId,
+			BlockchainID: blockchainID,
+			Outs:         outs,
+			Ins:          ins,
+			Memo:         tx.Memo,
+		},
+	}, nil
+}
+
+// outputOwners rejects unset owners, which are never empty in avalanchego,
+// unless the config is lenient, in which case they default to no owners.
+func (s *server) outputOwners(owners *rpcpb.OutputOwners) (*secp256k1fx.OutputOwners, error) {
+	if owners == nil {
+		if !s.cfg.LenientInput {
+			return nil, errMissingField
+		}
+		return &secp256k1fx.OutputOwners{}, nil
+	}
+	addrs := make([]ids.ShortID, 0, len(owners.Addresses))
+	for i, b := range owners.Addresses {
+		addr, err := toShortID(fmt.Sprintf("addresses[%d]", i), b)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return &secp256k1fx.OutputOwners{
+		Locktime:  owners.Locktime,
+		Threshold: owners.Threshold,
+		Addrs:     addrs,
+	}, nil
+}
+
+// transferableOutputs converts the outputs in order, as the codec does not
+// sort them.
+func (s *server) transferableOutputs(outs []*rpcpb.TransferableOutput) ([]*avax.TransferableOutput, error) {
+	converted := make([]*avax.TransferableOutput, 0, len(outs))
+	for i, out := range outs {
+		transferableOut, err := s.transferableOutput(out)
+		if err != nil {
+			return nil, invalidField(fmt.Sprintf("[%d]", i), err)
+		}
+		converted = append(converted, transferableOut)
+	}
+	return converted, nil
+}
+
+func (s *server) transferableOutput(out *rpcpb.TransferableOutput) (*avax.TransferableOutput, error) {
+	assetID, err := toID("asset_id", out.AssetId)
+	if err != nil {
+		return nil, err
+	}
+	owners, err := s.outputOwners(out.Owners)
+	if err != nil {
+		return nil, invalidField("owners", err)
+	}
+	var transferOut avax.TransferableOut = &secp256k1fx.TransferOutput{
+		Amt:          out.Amount,
+		OutputOwners: *owners,
+	}
+	if out.StakeableLocktime != 0 {
+		transferOut = &stakeable.LockOut{
+			Locktime:        out.StakeableLocktime,
+			TransferableOut: transferOut,
+		}
+	}
+	return &avax.TransferableOutput{
+		Asset: avax.Asset{ID: assetID},
+		Out:   transferOut,
+	}, nil
+}
+
+func transferableInputs(ins []*rpcpb.TransferableInput) ([]*avax.TransferableInput, error) {
+	converted := make([]*avax.TransferableInput, 0, len(ins))
+	for i, in := range ins {
+		txID, err := toID(fmt.Sprintf("[%d].tx_id", i), in.TxId)
+		if err != nil {
+			return nil, err
+		}
+		assetID, err := toID(fmt.Sprintf("[%d].asset_id", i), in.AssetId)
+		if err != nil {
+			return nil, err
+		}
+		var transferIn avax.TransferableIn = &secp256k1fx.TransferInput{
+			Amt: in.Amount,
+			Input: secp256k1fx.Input{
+				SigIndices: in.SigIndices,
+			},
+		}
+		if in.StakeableLocktime != 0 {
+			transferIn = &stakeable.LockIn{
+				Locktime:       in.StakeableLocktime,
+				TransferableIn: transferIn,
+			}
+		}
+		converted = append(converted, &avax.TransferableInput{
+			UTXOID: avax.UTXOID{
+				TxID:        txID,
+				OutputIndex: in.OutputIndex,
+			},
+			Asset: avax.Asset{ID: assetID},
+			In:    transferIn,
+		})
+	}
+	return converted, nil
+}
+
+func stakerValidator(vdr *rpcpb.StakerValidator) (txs.Validator, error) {
+	if vdr == nil {
+		return txs.Validator{}, errMissingField
+	}
+	nodeID, err := toNodeID("node_id", vdr.NodeId)
+	if err != nil {
+		return txs.Validator{}, err
+	}
+	return txs.Validator{
+		NodeID: nodeID,
+		Start:  vdr.StartTime,
+		End:    vdr.EndTime,
+		Wght:   vdr.Weight,
+	}, nil
+}
+
+// proofOfPossession returns the empty signer if the proof is unset.
+func proofOfPossession(pop *rpcpb.ProofOfPossession) (signer.Signer, error) {
+	if pop == nil {
+		return &signer.Empty{}, nil
+	}
+	if len(pop.PublicKey) != bls.PublicKeyLen {
+		return nil, wrongLength("public_key", fmt.Errorf("expected %d-byte BLS public key, got %d bytes", bls.PublicKeyLen, len(pop.PublicKey)))
+	}
+	if len(pop.Signature) != bls.SignatureLen {
+		return nil, wrongLength("signature", fmt.Errorf("expected %d-byte BLS signature, got %d bytes", bls.SignatureLen, len(pop.Signature)))
+	}
+	s := &signer.ProofOfPossession{}
+	copy(s.PublicKey[:], pop.PublicKey)
+	copy(s.ProofOfPossession[:], pop.Signature)
+	return s, nil
+}
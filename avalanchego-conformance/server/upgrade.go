@@ -0,0 +1,32 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+)
+
+var errUnsupportedUpgradeEra = errors.New("upgrade era not supported by the avalanchego version of the server")
+
+// latestUpgradeEra is the latest upgrade of the avalanchego version the
+// server is built with.
+const latestUpgradeEra = rpcpb.UpgradeEra_UPGRADE_ERA_CORTINA
+
+// resolveUpgradeEra resolves UPGRADE_ERA_LATEST, and returns an error if the
+// avalanchego version of the server does not implement the upgrade.
+func resolveUpgradeEra(era rpcpb.UpgradeEra) (rpcpb.UpgradeEra, error) {
+	switch era {
+	case rpcpb.UpgradeEra_UPGRADE_ERA_LATEST:
+		return latestUpgradeEra, nil
+	case rpcpb.UpgradeEra_UPGRADE_ERA_UNSPECIFIED,
+		rpcpb.UpgradeEra_UPGRADE_ERA_BANFF,
+		rpcpb.UpgradeEra_UPGRADE_ERA_CORTINA:
+		return era, nil
+	default:
+		return era, fmt.Errorf("%w: %s", errUnsupportedUpgradeEra, era)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type serviceUsage struct {
+	requests         uint64
+	requestBytes     uint64
+	rejectedRequests uint64
+}
+
+type usageMetrics struct {
+	requests         *prometheus.CounterVec
+	requestBytes     *prometheus.CounterVec
+	rejectedRequests *prometheus.CounterVec
+}
+
+func newUsageMetrics(registerer prometheus.Registerer) (*usageMetrics, error) {
+	m := &usageMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "conformance",
+			Name:      "service_requests",
+			Help:      "Number of accepted requests per service",
+		}, []string{"service"}),
+		requestBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "conformance",
+			Name:      "service_request_bytes",
+			Help:      "Total size of the accepted requests per service",
+		}, []string{"service"}),
+		rejectedRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "conformance",
+			Name:      "service_rejected_requests",
+			Help:      "Number of requests rejected for exceeding the service quota",
+		}, []string{"service"}),
+	}
+	for _, c := range []prometheus.Collector{m.requests, m.requestBytes, m.rejectedRequests} {
+		if err := registerer.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// serviceName returns the service name (e.g., "PackerService") of a full
+// gRPC method name (e.g., "/rpcpb.PackerService/BuildVertex").
+func serviceName(fullMethod string) string {
+	svc := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(svc, "/"); i >= 0 {
+		svc = svc[:i]
+	}
+	if i := strings.LastIndex(svc, "."); i >= 0 {
+		svc = svc[i+1:]
+	}
+	return svc
+}
+
+// accountRequest adds the request to the service usage, or returns a
+// ResourceExhausted error if it would exceed the service quota.
+func (s *server) accountRequest(svc string, req interface{}) error {
+	var size uint64
+	if msg, ok := req.(proto.Message); ok {
+		size = uint64(proto.Size(msg))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	usage, ok := s.usage[svc]
+	if !ok {
+		usage = &serviceUsage{}
+		s.usage[svc] = usage
+	}
+	if quota := s.cfg.ServiceQuotas[svc]; quota > 0 && usage.requestBytes+size > quota {
+		usage.rejectedRequests++
+		s.usageMetrics.rejectedRequests.WithLabelValues(svc).Inc()
+		zap.L().Warn("service quota exceeded",
+			zap.String("service", svc),
+			zap.Uint64("request-bytes", usage.requestBytes),
+			zap.Uint64("quota-bytes", quota),
+		)
+		return status.Errorf(codes.ResourceExhausted, "%s quota of %d bytes exceeded", svc, quota)
+	}
+	usage.requests++
+	usage.requestBytes += size
+	s.usageMetrics.requests.WithLabelValues(svc).Inc()
+	s.usageMetrics.requestBytes.WithLabelValues(svc).Add(float64(size))
+	return nil
+}
+
+func (s *server) usageUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := s.accountRequest(serviceName(info.FullMethod), req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// usageStreamInterceptor accounts for each message received on the stream.
+func (s *server) usageStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &usageServerStream{ServerStream: ss, s: s, svc: serviceName(info.FullMethod)})
+}
+
+type usageServerStream struct {
+	grpc.ServerStream
+	s   *server
+	svc string
+}
+
+func (ss *usageServerStream) RecvMsg(m interface{}) error {
+	if err := ss.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return ss.s.accountRequest(ss.svc, m)
+}
+
+func (s *server) ServiceUsage(ctx context.Context, req *rpcpb.ServiceUsageRequest) (*rpcpb.ServiceUsageResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := &rpcpb.ServiceUsageResponse{}
+	for svc, usage := range s.usage {
+		resp.Services = append(resp.Services, &rpcpb.ServiceUsage{
+			Service:          svc,
+			Requests:         usage.requests,
+			RequestBytes:     usage.requestBytes,
+			QuotaBytes:       s.cfg.ServiceQuotas[svc],
+			RejectedRequests: usage.rejectedRequests,
+		})
+	}
+	sort.Slice(resp.Services, func(i, j int) bool { return resp.Services[i].Service < resp.Services[j].Service })
+
+	if req.ResetUsage {
+		s.usage = make(map[string]*serviceUsage)
+	}
+	return resp, nil
+}
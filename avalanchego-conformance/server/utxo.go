@@ -0,0 +1,214 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/diff"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	avmtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"go.uber.org/zap"
+)
+
+var (
+	errOutputsNotSorted      = errors.New("outputs not sorted")
+	errInputsNotSortedUnique = errors.New("inputs not sorted and unique")
+)
+
+func (s *server) Utxo(ctx context.Context, req *rpcpb.UtxoRequest) (*rpcpb.UtxoResponse, error) {
+	logger(ctx).Debug("received Utxo request", zap.String("chain", req.Chain.String()))
+
+	c, version, err := s.txChainCodec(req.Chain)
+	if err != nil {
+		return nil, err
+	}
+	if req.UtxoId == nil {
+		return nil, invalidField("utxo_id", errMissingField)
+	}
+	if req.Output == nil {
+		return nil, invalidField("output", errMissingField)
+	}
+	txID, err := toID("utxo_id.tx_id", req.UtxoId.TxId)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.transferableOutput(req.Output)
+	if err != nil {
+		return nil, invalidField("output", err)
+	}
+
+	utxo := &avax.UTXO{
+		UTXOID: avax.UTXOID{
+			TxID:        txID,
+			OutputIndex: req.UtxoId.OutputIndex,
+		},
+		Asset: out.Asset,
+		Out:   out.Out,
+	}
+	expected, err := c.Marshal(version, utxo)
+	if err != nil {
+		return nil, err
+	}
+	inputID := utxo.InputID()
+
+	resp := &rpcpb.UtxoResponse{
+		ExpectedBytes:   expected,
+		ExpectedInputId: inputID[:],
+		Success:         true,
+	}
+	if d := newDiff(expected, req.UtxoBytes, diff.AnnotatorPath(utxoFields)); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) TransferableOutputs(ctx context.Context, req *rpcpb.TransferableOutputsRequest) (*rpcpb.TransferableOutputsResponse, error) {
+	logger(ctx).Debug("received TransferableOutputs request", zap.String("chain", req.Chain.String()))
+
+	c, version, err := s.txChainCodec(req.Chain)
+	if err != nil {
+		return nil, err
+	}
+	outs, err := s.transferableOutputs(req.Outputs)
+	if err != nil {
+		return nil, invalidField("outputs", err)
+	}
+	expected, err := c.Marshal(version, outs)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := listFields(c, version, "outputs", outs)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.TransferableOutputsResponse{
+		ExpectedBytes: expected,
+		Sorted:        avax.IsSortedTransferableOutputs(outs, c),
+		Success:       true,
+	}
+	path := diff.AnnotatorPath(func([]byte) []diff.Field { return fields })
+	if d := newDiff(expected, req.OutputsBytes, path); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+	if !resp.Sorted {
+		resp.Message = joinMessages(resp.Message, []error{errOutputsNotSorted})
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) TransferableInputs(ctx context.Context, req *rpcpb.TransferableInputsRequest) (*rpcpb.TransferableInputsResponse, error) {
+	logger(ctx).Debug("received TransferableInputs request", zap.String("chain", req.Chain.String()))
+
+	c, version, err := s.txChainCodec(req.Chain)
+	if err != nil {
+		return nil, err
+	}
+	ins, err := transferableInputs(req.Inputs)
+	if err != nil {
+		return nil, invalidField("inputs", err)
+	}
+	expected, err := c.Marshal(version, ins)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := listFields(c, version, "inputs", ins)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.TransferableInputsResponse{
+		ExpectedBytes: expected,
+		SortedUnique:  utils.IsSortedAndUniqueSortable(ins),
+		Success:       true,
+	}
+	path := diff.AnnotatorPath(func([]byte) []diff.Field { return fields })
+	if d := newDiff(expected, req.InputsBytes, path); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+	if !resp.SortedUnique {
+		resp.Message = joinMessages(resp.Message, []error{errInputsNotSortedUnique})
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+// txChainCodec returns the codec of the chain, and its current version.
+func (s *server) txChainCodec(chain rpcpb.TxChain) (codec.Manager, uint16, error) {
+	switch chain {
+	case rpcpb.TxChain_TX_CHAIN_PLATFORM:
+		return txs.Codec, txs.Version, nil
+	case rpcpb.TxChain_TX_CHAIN_AVM:
+		return s.avmParser.Codec(), avmtxs.CodecVersion, nil
+	case rpcpb.TxChain_TX_CHAIN_CORETH:
+		return corethCodec, corethCodecVersion, nil
+	default:
+		return nil, 0, fmt.Errorf("%w %s", errUnknownTxChain, chain)
+	}
+}
+
+// listFields annotates a serialized slice, whose elements follow the codec
+// version and the slice length.
+func listFields[T any](c codec.Manager, version uint16, name string, elems []T) ([]diff.Field, error) {
+	fields := make([]diff.Field, 0, len(elems)+2)
+	fields = append(fields,
+		diff.Field{Name: "codec_version", Start: 0, End: wrappers.ShortLen},
+		diff.Field{Name: "length", Start: wrappers.ShortLen, End: wrappers.ShortLen + wrappers.IntLen},
+	)
+	pos := wrappers.ShortLen + wrappers.IntLen
+	for i, elem := range elems {
+		size, err := c.Size(version, elem)
+		if err != nil {
+			return nil, err
+		}
+		// the size includes the codec version
+		size -= wrappers.ShortLen
+		fields = append(fields, diff.Field{Name: fmt.Sprintf("%s[%d]", name, i), Start: pos, End: pos + size})
+		pos += size
+	}
+	return fields, nil
+}
+
+// utxoFields annotates a serialized UTXO.
+// ref. "avax.UTXO"
+func utxoFields(b []byte) []diff.Field {
+	fields := []diff.Field{}
+	pos := 0
+	add := func(name string, size int) bool {
+		if size < 0 || pos+size > len(b) {
+			return false
+		}
+		fields = append(fields, diff.Field{Name: name, Start: pos, End: pos + size})
+		pos += size
+		return true
+	}
+
+	if !add("codec_version", wrappers.ShortLen) ||
+		!add("tx_id", hashing.HashLen) ||
+		!add("output_index", wrappers.IntLen) ||
+		!add("asset_id", hashing.HashLen) {
+		return fields
+	}
+	add("output", len(b)-pos)
+	return fields
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"go.uber.org/zap"
+)
+
+func (s *server) ValidatorSetAtHeight(ctx context.Context, req *rpcpb.ValidatorSetAtHeightRequest) (*rpcpb.ValidatorSetAtHeightResponse, error) {
+	logger(ctx).Debug("received ValidatorSetAtHeight request",
+		zap.Uint64("current-height", req.CurrentHeight),
+		zap.Uint64("height", req.Height),
+		zap.Int("diffs", len(req.Diffs)),
+	)
+
+	resp := &rpcpb.ValidatorSetAtHeightResponse{Success: true}
+
+	vdrSet, err := validatorSetAtHeight(req)
+	if err != nil {
+		resp.Message = err.Error()
+		resp.Success = false
+		return resp, nil
+	}
+
+	nodeIDs := make([]ids.NodeID, 0, len(vdrSet))
+	for nodeID := range vdrSet {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i].Less(nodeIDs[j]) })
+	for _, nodeID := range nodeIDs {
+		vdr := vdrSet[nodeID]
+		expected := &rpcpb.Validator{
+			NodeId: nodeID.Bytes(),
+			Weight: vdr.Weight,
+		}
+		if vdr.PublicKey != nil {
+			expected.PublicKey = bls.PublicKeyToBytes(vdr.PublicKey)
+		}
+		resp.ExpectedValidators = append(resp.ExpectedValidators, expected)
+	}
+
+	received := make(map[ids.NodeID]*rpcpb.Validator, len(req.Validators))
+	for _, vdr := range req.Validators {
+		nodeID, err := ids.ToNodeID(vdr.NodeId)
+		if err != nil {
+			resp.Message = fmt.Sprintf("invalid validator node ID 0x%x (%v)", vdr.NodeId, err)
+			resp.Success = false
+			return resp, nil
+		}
+		received[nodeID] = vdr
+	}
+
+	var msgs []string
+	for _, expected := range resp.ExpectedValidators {
+		nodeID, _ := ids.ToNodeID(expected.NodeId)
+		vdr, ok := received[nodeID]
+		if !ok {
+			msgs = append(msgs, fmt.Sprintf("missing validator %s", nodeID))
+			continue
+		}
+		delete(received, nodeID)
+		if vdr.Weight != expected.Weight {
+			msgs = append(msgs, fmt.Sprintf("validator %s: expected weight %d, got %d", nodeID, expected.Weight, vdr.Weight))
+		}
+		if !bytes.Equal(vdr.PublicKey, expected.PublicKey) {
+			msgs = append(msgs, fmt.Sprintf("validator %s: expected public key 0x%x, got 0x%x", nodeID, expected.PublicKey, vdr.PublicKey))
+		}
+	}
+	for _, vdr := range req.Validators {
+		nodeID, _ := ids.ToNodeID(vdr.NodeId)
+		if _, ok := received[nodeID]; ok {
+			msgs = append(msgs, fmt.Sprintf("unexpected validator %s", nodeID))
+		}
+	}
+	for i, msg := range msgs {
+		if i > 0 {
+			resp.Message += "; "
+		}
+		resp.Message += msg
+		resp.Success = false
+	}
+	return resp, nil
+}
+
+// validatorSetAtHeight reverts the diffs from the current validator set
+// down to the requested height.
+// ref. "platformvm.VM.GetValidatorSet"
+func validatorSetAtHeight(req *rpcpb.ValidatorSetAtHeightRequest) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	if req.CurrentHeight < req.Height {
+		return nil, fmt.Errorf("height %d is above current height %d", req.Height, req.CurrentHeight)
+	}
+
+	vdrSet := make(map[ids.NodeID]*validators.GetValidatorOutput, len(req.CurrentValidators))
+	for j, vdr := range req.CurrentValidators {
+		nodeID, err := toNodeID(fmt.Sprintf("current_validators[%d].node_id", j), vdr.NodeId)
+		if err != nil {
+			return nil, err
+		}
+		out := &validators.GetValidatorOutput{
+			NodeID: nodeID,
+			Weight: vdr.Weight,
+		}
+		if len(vdr.PublicKey) > 0 {
+			out.PublicKey, err = bls.PublicKeyFromBytes(vdr.PublicKey)
+			if err != nil {
+				return nil, invalidField(fmt.Sprintf("current_validators[%d].public_key", j), err)
+			}
+		}
+		vdrSet[nodeID] = out
+	}
+
+	diffs := make(map[uint64][]*rpcpb.ValidatorSetDiff)
+	for _, diff := range req.Diffs {
+		diffs[diff.Height] = append(diffs[diff.Height], diff)
+	}
+
+	for i := req.CurrentHeight; i > req.Height; i-- {
+		for _, diff := range diffs[i] {
+			for _, weightDiff := range diff.WeightDiffs {
+				nodeID, err := ids.ToNodeID(weightDiff.NodeId)
+				if err != nil {
+					return nil, invalidField("diffs", fmt.Errorf("invalid weight diff node ID 0x%x at height %d (%w)", weightDiff.NodeId, i, err))
+				}
+				vdr, ok := vdrSet[nodeID]
+				if !ok {
+					// This node isn't in the current validator set.
+					vdr = &validators.GetValidatorOutput{
+						NodeID: nodeID,
+					}
+					vdrSet[nodeID] = vdr
+				}
+
+				// The validator's weight was decreased at this block, so in
+				// the prior block it was higher, and vice versa.
+				op := math.Sub[uint64]
+				if weightDiff.Decrease {
+					op = math.Add64
+				}
+				vdr.Weight, err = op(vdr.Weight, weightDiff.Amount)
+				if err != nil {
+					return nil, fmt.Errorf("failed to apply weight diff of %s at height %d (%v)", nodeID, i, err)
+				}
+
+				if vdr.Weight == 0 {
+					// The validator's weight was 0 before this block so
+					// they weren't in the validator set.
+					delete(vdrSet, nodeID)
+				}
+			}
+		}
+
+		// Public key diffs are applied after all the weight diffs at this
+		// height, and only to validators in the set.
+		for _, diff := range diffs[i] {
+			for _, pkDiff := range diff.PublicKeyDiffs {
+				nodeID, err := ids.ToNodeID(pkDiff.NodeId)
+				if err != nil {
+					return nil, invalidField("diffs", fmt.Errorf("invalid public key diff node ID 0x%x at height %d (%w)", pkDiff.NodeId, i, err))
+				}
+				pk, err := bls.PublicKeyFromBytes(pkDiff.PublicKey)
+				if err != nil {
+					return nil, invalidField("diffs", fmt.Errorf("invalid public key diff of %s at height %d (%w)", nodeID, i, err))
+				}
+				if vdr, ok := vdrSet[nodeID]; ok {
+					// The validator's public key was removed at this block,
+					// so it was in the validator set before.
+					vdr.PublicKey = pk
+				}
+			}
+		}
+	}
+	return vdrSet, nil
+}
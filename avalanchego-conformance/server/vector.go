@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func (s *server) Generate(ctx context.Context, req *rpcpb.GenerateRequest) (*rpcpb.GenerateResponse, error) {
+	if req.Request == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	check, err := req.Request.UnmarshalNew()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal request (%v)", err)
+	}
+	logger(ctx).Debug("generating vector", zap.String("request", string(check.ProtoReflect().Descriptor().FullName())))
+
+	resp, err := s.dispatch(ctx, check)
+	if err != nil {
+		return nil, err
+	}
+	clearResult(resp)
+
+	anyResp, err := anypb.New(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GenerateResponse{Response: anyResp}, nil
+}
+
+// clearResult clears the "message", "success" and "diff" fields of a
+// response, which report the comparison against the (possibly unset)
+// serialized fields of the request, so that only the canonical fields
+// remain.
+func clearResult(resp proto.Message) {
+	r := resp.ProtoReflect()
+	fields := r.Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"message", successField, "diff"} {
+		if fd := fields.ByName(name); fd != nil {
+			r.Clear(fd)
+		}
+	}
+}
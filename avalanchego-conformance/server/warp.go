@@ -0,0 +1,332 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"go.uber.org/zap"
+)
+
+// The pinned avalanchego predates the network ID of warp messages and the
+// payload package, so both are mirrored below. Signatures and their
+// verification are unchanged, and reused from avalanchego.
+// ref. "warp.Codec", "payload.Codec"
+const (
+	warpCodecVersion    = 0
+	warpPayloadMaxSize  = 24 * 1024
+	warpPayloadSliceLen = 24 * 1024
+)
+
+var (
+	errDuplicateWarpValidator = errors.New("duplicate warp validator")
+
+	warpCodec        = newWarpCodec()
+	warpPayloadCodec = newWarpPayloadCodec()
+)
+
+func newWarpCodec() codec.Manager {
+	c := codec.NewManager(math.MaxInt)
+	lc := linearcodec.NewCustomMaxLength(math.MaxInt32)
+	errs := wrappers.Errs{}
+	errs.Add(
+		lc.RegisterType(&warp.BitSetSignature{}),
+		c.RegisterCodec(warpCodecVersion, lc),
+	)
+	if errs.Errored() {
+		panic(errs.Err)
+	}
+	return c
+}
+
+func newWarpPayloadCodec() codec.Manager {
+	c := codec.NewManager(warpPayloadMaxSize)
+	lc := linearcodec.NewCustomMaxLength(warpPayloadSliceLen)
+	errs := wrappers.Errs{}
+	errs.Add(
+		lc.RegisterType(&warpHashPayload{}),
+		lc.RegisterType(&warpAddressedCallPayload{}),
+		c.RegisterCodec(warpCodecVersion, lc),
+	)
+	if errs.Errored() {
+		panic(errs.Err)
+	}
+	return c
+}
+
+// ref. "warp.UnsignedMessage"
+type warpUnsignedMessage struct {
+	NetworkID     uint32 `serialize:"true"`
+	SourceChainID ids.ID `serialize:"true"`
+	Payload       []byte `serialize:"true"`
+}
+
+// ref. "warp.Message"
+type warpMessage struct {
+	UnsignedMessage warpUnsignedMessage `serialize:"true"`
+	Signature       warp.Signature      `serialize:"true"`
+}
+
+// ref. "payload.Hash"
+type warpHashPayload struct {
+	Hash ids.ID `serialize:"true"`
+}
+
+// ref. "payload.AddressedCall"
+type warpAddressedCallPayload struct {
+	SourceAddress []byte `serialize:"true"`
+	Payload       []byte `serialize:"true"`
+}
+
+func (s *server) WarpUnsignedMessage(ctx context.Context, req *rpcpb.WarpUnsignedMessageRequest) (*rpcpb.WarpUnsignedMessageResponse, error) {
+	msg, err := warpMessageOf(req.Message)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := warpCodec.Marshal(warpCodecVersion, msg)
+	if err != nil {
+		return nil, err
+	}
+	msgID := hashing.ComputeHash256Array(expected)
+
+	resp := &rpcpb.WarpUnsignedMessageResponse{
+		ExpectedBytes:     expected,
+		ExpectedMessageId: msgID[:],
+		Success:           true,
+	}
+	if d := newDiff(expected, req.MessageBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) WarpSignedMessage(ctx context.Context, req *rpcpb.WarpSignedMessageRequest) (*rpcpb.WarpSignedMessageResponse, error) {
+	unsignedMsg, err := warpMessageOf(req.Message)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Signature) != bls.SignatureLen {
+		return nil, fmt.Errorf("expected %d-byte signature, got %d", bls.SignatureLen, len(req.Signature))
+	}
+	signers := set.NewBits()
+	for _, i := range req.SignerIndices {
+		signers.Add(int(i))
+	}
+	sig := &warp.BitSetSignature{Signers: signers.Bytes()}
+	copy(sig.Signature[:], req.Signature)
+
+	msg := &warpMessage{
+		UnsignedMessage: *unsignedMsg,
+		Signature:       sig,
+	}
+	expected, err := warpCodec.Marshal(warpCodecVersion, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.WarpSignedMessageResponse{
+		ExpectedBytes:   expected,
+		ExpectedSigners: sig.Signers,
+		Success:         true,
+	}
+	if d := newDiff(expected, req.MessageBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) WarpAddressedCallPayload(ctx context.Context, req *rpcpb.WarpAddressedCallPayloadRequest) (*rpcpb.WarpAddressedCallPayloadResponse, error) {
+	var payload interface{} = &warpAddressedCallPayload{
+		SourceAddress: req.SourceAddress,
+		Payload:       req.Payload,
+	}
+	expected, err := warpPayloadCodec.Marshal(warpCodecVersion, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.WarpAddressedCallPayloadResponse{
+		ExpectedBytes: expected,
+		Success:       true,
+	}
+	if d := newDiff(expected, req.PayloadBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) WarpHashPayload(ctx context.Context, req *rpcpb.WarpHashPayloadRequest) (*rpcpb.WarpHashPayloadResponse, error) {
+	hash, err := toID("hash", req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	var payload interface{} = &warpHashPayload{Hash: hash}
+	expected, err := warpPayloadCodec.Marshal(warpCodecVersion, &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.WarpHashPayloadResponse{
+		ExpectedBytes: expected,
+		Success:       true,
+	}
+	if d := newDiff(expected, req.PayloadBytes, nil); d != nil {
+		resp.Diff = d
+		resp.Message = d.Summary
+		resp.Success = false
+	}
+
+	return resp, nil
+}
+
+func (s *server) WarpVerifySignature(ctx context.Context, req *rpcpb.WarpVerifySignatureRequest) (*rpcpb.WarpVerifySignatureResponse, error) {
+	logger(ctx).Debug("received WarpVerifySignature request", zap.Int("validators", len(req.Validators)))
+
+	msg, err := warpMessageOf(req.Message)
+	if err != nil {
+		return nil, err
+	}
+	unsignedBytes, err := warpCodec.Marshal(warpCodecVersion, msg)
+	if err != nil {
+		return nil, err
+	}
+	state, err := newWarpValidatorState(req.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	// ref. "warp.BitSetSignature.Verify"
+	vdrs, totalWeight, err := warp.GetCanonicalValidatorSet(ctx, state, 0, ids.Empty)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.WarpVerifySignatureResponse{
+		CanonicalPublicKeys: make([][]byte, 0, len(vdrs)),
+		TotalWeight:         totalWeight,
+	}
+	for _, vdr := range vdrs {
+		resp.CanonicalPublicKeys = append(resp.CanonicalPublicKeys, bls.PublicKeyToBytes(vdr.PublicKey))
+	}
+
+	signerIndices := set.BitsFromBytes(req.Signers)
+	if len(signerIndices.Bytes()) != len(req.Signers) {
+		resp.Message = warp.ErrInvalidBitSet.Error()
+		return resp, nil
+	}
+	resp.NumSigners = uint32(signerIndices.Len())
+	signers, err := warp.FilterValidators(signerIndices, vdrs)
+	if err != nil {
+		resp.Message = err.Error()
+		return resp, nil
+	}
+	// signers are a subset of the validators, whose weight did not overflow
+	resp.SignerWeight, _ = warp.SumWeight(signers)
+	if err := warp.VerifyWeight(resp.SignerWeight, totalWeight, req.QuorumNum, req.QuorumDen); err != nil {
+		resp.Message = err.Error()
+		return resp, nil
+	}
+	aggSig, err := bls.SignatureFromBytes(req.Signature)
+	if err != nil {
+		resp.Message = fmt.Sprintf("%s: %v", warp.ErrParseSignature, err)
+		return resp, nil
+	}
+	aggPubKey, err := warp.AggregatePublicKeys(signers)
+	if err != nil {
+		resp.Message = err.Error()
+		return resp, nil
+	}
+	if !bls.Verify(aggPubKey, aggSig, unsignedBytes) {
+		resp.Message = warp.ErrInvalidSignature.Error()
+		return resp, nil
+	}
+
+	resp.Success = true
+	return resp, nil
+}
+
+func warpMessageOf(msg *rpcpb.WarpUnsignedMessage) (*warpUnsignedMessage, error) {
+	if msg == nil {
+		return nil, invalidField("message", errMissingField)
+	}
+	sourceChainID, err := toID("message.source_chain_id", msg.SourceChainId)
+	if err != nil {
+		return nil, err
+	}
+	return &warpUnsignedMessage{
+		NetworkID:     msg.NetworkId,
+		SourceChainID: sourceChainID,
+		Payload:       msg.Payload,
+	}, nil
+}
+
+// warpValidatorState is the P-chain state of a single subnet, whose
+// validator set is the same at every height.
+type warpValidatorState struct {
+	vdrs map[ids.NodeID]*validators.GetValidatorOutput
+}
+
+func newWarpValidatorState(vdrs []*rpcpb.WarpValidator) (*warpValidatorState, error) {
+	state := &warpValidatorState{
+		vdrs: make(map[ids.NodeID]*validators.GetValidatorOutput, len(vdrs)),
+	}
+	for i, vdr := range vdrs {
+		nodeID, err := toNodeID(fmt.Sprintf("validators[%d].node_id", i), vdr.NodeId)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := state.vdrs[nodeID]; ok {
+			return nil, fmt.Errorf("%w %s", errDuplicateWarpValidator, nodeID)
+		}
+		out := &validators.GetValidatorOutput{
+			NodeID: nodeID,
+			Weight: vdr.Weight,
+		}
+		if len(vdr.PublicKey) > 0 {
+			out.PublicKey, err = bls.PublicKeyFromBytes(vdr.PublicKey)
+			if err != nil {
+				return nil, invalidField(fmt.Sprintf("validators[%d].public_key", i), err)
+			}
+		}
+		state.vdrs[nodeID] = out
+	}
+	return state, nil
+}
+
+func (*warpValidatorState) GetMinimumHeight(context.Context) (uint64, error) {
+	return 0, nil
+}
+
+func (*warpValidatorState) GetCurrentHeight(context.Context) (uint64, error) {
+	return 0, nil
+}
+
+func (*warpValidatorState) GetSubnetID(context.Context, ids.ID) (ids.ID, error) {
+	return ids.Empty, nil
+}
+
+func (s *warpValidatorState) GetValidatorSet(context.Context, uint64, ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	return s.vdrs, nil
+}
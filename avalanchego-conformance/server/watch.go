@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/pkg/summary"
+	"github.com/ava-labs/avalanche-rs/avalanchego-conformance/rpcpb"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	defaultWatchField = "serialized_msg"
+	// maxWatchVectors bounds the vectors registered on a stream, as
+	// maxBatchItems bounds the items of a batch.
+	maxWatchVectors = maxBatchItems
+	// maxWatchBytes bounds the size of the vectors registered on a stream.
+	maxWatchBytes = 1 << 30
+)
+
+// watchVectors are the vectors registered on a stream, by ID.
+type watchVectors struct {
+	vectors map[string]proto.Message
+	sizes   map[string]int
+	bytes   int
+}
+
+// set registers the vector, or replaces the registered one, if within the
+// bounds of the stream.
+func (w *watchVectors) set(id string, vector proto.Message) error {
+	old, ok := w.sizes[id]
+	if !ok && len(w.vectors) >= maxWatchVectors {
+		return fmt.Errorf("%d vectors registered, at most %d", len(w.vectors), maxWatchVectors)
+	}
+	size := proto.Size(vector)
+	if w.bytes-old+size > maxWatchBytes {
+		return fmt.Errorf("vectors of %d bytes exceed %d", w.bytes-old+size, maxWatchBytes)
+	}
+	w.vectors[id], w.sizes[id] = vector, size
+	w.bytes += size - old
+	return nil
+}
+
+func (s *server) Watch(stream rpcpb.WatchService_WatchServer) error {
+	vectors := &watchVectors{
+		vectors: make(map[string]proto.Message),
+		sizes:   make(map[string]int),
+	}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		result := &rpcpb.WatchResult{VectorId: req.VectorId}
+		if err := s.watchUpdate(vectors, req); err != nil {
+			result.Message = err.Error()
+		} else {
+			resp, err := s.dispatch(stream.Context(), vectors.vectors[req.VectorId])
+			if err != nil {
+				result.Message = err.Error()
+			} else {
+				result.Response, err = anypb.New(resp)
+				if err != nil {
+					return err
+				}
+				result.Message, result.Success = summary.Result(resp)
+			}
+		}
+		logger(stream.Context()).Debug("watched vector",
+			zap.String("vector-id", req.VectorId),
+			zap.Bool("success", result.Success),
+		)
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+	}
+}
+
+// watchUpdate applies the update to the registered vectors.
+func (s *server) watchUpdate(vectors *watchVectors, req *rpcpb.WatchRequest) error {
+	switch update := req.Update.(type) {
+	case *rpcpb.WatchRequest_Register:
+		vector, err := update.Register.UnmarshalNew()
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal vector %q (%v)", req.VectorId, err)
+		}
+		return vectors.set(req.VectorId, vector)
+
+	case *rpcpb.WatchRequest_Serialized:
+		vector, ok := vectors.vectors[req.VectorId]
+		if !ok {
+			return fmt.Errorf("vector %q is not registered", req.VectorId)
+		}
+		field := req.Field
+		if field == "" {
+			field = defaultWatchField
+		}
+		r := vector.ProtoReflect()
+		fd := r.Descriptor().Fields().ByName(protoreflect.Name(field))
+		if fd == nil || fd.Kind() != protoreflect.BytesKind || fd.IsList() {
+			return fmt.Errorf("%s has no bytes field %q", r.Descriptor().FullName(), field)
+		}
+		// the registered vector is only updated within the bounds
+		updated := proto.Clone(vector)
+		updated.ProtoReflect().Set(fd, protoreflect.ValueOfBytes(update.Serialized))
+		return vectors.set(req.VectorId, updated)
+
+	default:
+		return fmt.Errorf("no update for vector %q", req.VectorId)
+	}
+}
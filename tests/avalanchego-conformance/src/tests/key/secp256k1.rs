@@ -7,7 +7,7 @@ use std::{
 
 use avalanche_types::{hash, key};
 use avalanchego_conformance_sdk::{
-    ChainAddresses, Client, Secp256k1Info, Secp256k1InfoRequest,
+    AddressMatrixEntry, ChainAddresses, Client, Secp256k1Info, Secp256k1InfoRequest,
     Secp256k1RecoverHashPublicKeyRequest,
 };
 
@@ -101,12 +101,42 @@ async fn generate() {
                 short_address: ki.short_address.to_string(),
                 eth_address: ki.eth_address.clone(),
             }),
+            address_matrix: Vec::new(),
         })
         .await
         .expect("failed secp256k1_info");
     log::info!("secp256k1_info response: {} {}", resp.message, resp.success);
     assert!(resp.success);
 
+    let mut address_matrix = Vec::new();
+    for network_id in [1, 5, 9999] {
+        for chain_alias in ["X", "P", "C"] {
+            address_matrix.push(AddressMatrixEntry {
+                network_id,
+                hrp: String::new(),
+                chain_alias: chain_alias.to_string(),
+                address: pubkey.to_hrp_address(network_id, chain_alias).unwrap(),
+            });
+        }
+    }
+    let resp = cli
+        .secp256k1_info(Secp256k1InfoRequest {
+            secp256k1_info: Some(Secp256k1Info {
+                key_type: String::from("hot"),
+                private_key_cb58: ki.private_key_cb58.clone().unwrap(),
+                private_key_hex: ki.private_key_hex.clone().unwrap(),
+                chain_addresses: HashMap::new(),
+                short_address: ki.short_address.to_string(),
+                eth_address: ki.eth_address.clone(),
+            }),
+            address_matrix,
+        })
+        .await
+        .expect("failed secp256k1_info");
+    log::info!("secp256k1_info response: {} {}", resp.message, resp.success);
+    assert!(resp.success);
+    assert_eq!(resp.expected_address_matrix.len(), 9);
+
     let mut chain_addresses: HashMap<u32, ChainAddresses> = HashMap::new();
     chain_addresses.insert(
         9999,
@@ -125,6 +155,7 @@ async fn generate() {
                 short_address: ki.short_address.to_string(),
                 eth_address: ki.eth_address.clone(),
             }),
+            address_matrix: Vec::new(),
         })
         .await
         .expect("failed secp256k1_info");
@@ -155,6 +186,7 @@ async fn generate() {
                 short_address: ki.short_address.to_string(),
                 eth_address: ki.eth_address.clone(),
             }),
+            address_matrix: Vec::new(),
         })
         .await
         .expect("failed secp256k1_info");
@@ -202,6 +234,7 @@ async fn load() {
                         short_address: ki.short_address.to_string(),
                         eth_address: ki.eth_address.clone(),
                     }),
+                    address_matrix: Vec::new(),
                 })
                 .await
                 .expect("failed secp256k1_info");
@@ -226,6 +259,7 @@ async fn load() {
                         short_address: ki.short_address.to_string(),
                         eth_address: ki.eth_address.clone(),
                     }),
+                    address_matrix: Vec::new(),
                 })
                 .await
                 .expect("failed secp256k1_info");
@@ -256,6 +290,7 @@ async fn load() {
                         short_address: ki.short_address.to_string(),
                         eth_address: ki.eth_address.clone(),
                     }),
+                    address_matrix: Vec::new(),
                 })
                 .await
                 .expect("failed secp256k1_info");
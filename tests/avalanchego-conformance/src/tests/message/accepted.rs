@@ -90,6 +90,8 @@ async fn get_accepted() {
             deadline,
             container_ids: container_ids_bytes,
             serialized_msg,
+            max_timeout: 0,
+            engine_type: 0,
         })
         .await
         .expect("failed get_accepted");
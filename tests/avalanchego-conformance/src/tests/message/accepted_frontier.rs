@@ -76,6 +76,8 @@ async fn get_accepted_frontier() {
             request_id,
             deadline,
             serialized_msg,
+            max_timeout: 0,
+            engine_type: 0,
         })
         .await
         .expect("failed get_accepted_frontier");
@@ -46,6 +46,7 @@ async fn accepted_state_summary() {
             summary_ids: summary_ids_bytes,
             gzip_compressed: false,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed accepted_state_summary");
@@ -92,6 +93,7 @@ async fn accepted_state_summary_gzip_compress() {
             summary_ids: summary_ids_bytes,
             gzip_compressed: true,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed accepted_state_summary");
@@ -130,6 +132,8 @@ async fn get_accepted_state_summary() {
             heights,
             gzip_compressed: false,
             serialized_msg,
+            compression_type: 0,
+            max_timeout: 0,
         })
         .await
         .expect("failed get_accepted_state_summary");
@@ -169,6 +173,8 @@ async fn get_accepted_state_summary_gzip_compress() {
             heights,
             gzip_compressed: true,
             serialized_msg,
+            compression_type: 0,
+            max_timeout: 0,
         })
         .await
         .expect("failed get_accepted_state_summary");
@@ -39,6 +39,7 @@ async fn ancestors() {
             containers,
             gzip_compressed: false,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed ancestors");
@@ -81,6 +82,7 @@ async fn ancestors_gzip_compress() {
             containers,
             gzip_compressed: true,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed ancestors");
@@ -118,6 +120,8 @@ async fn get_ancestors() {
             deadline,
             container_id: container_id.as_ref().to_vec(),
             serialized_msg,
+            max_timeout: 0,
+            engine_type: 0,
         })
         .await
         .expect("failed get_ancestors");
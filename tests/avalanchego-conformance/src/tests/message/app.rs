@@ -32,6 +32,7 @@ async fn app_gossip() {
             app_bytes,
             gzip_compressed: false,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed app_gossip");
@@ -65,6 +66,7 @@ async fn app_gossip_gzip_compress() {
             app_bytes,
             gzip_compressed: true,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed app_gossip");
@@ -103,6 +105,8 @@ async fn app_request() {
             app_bytes,
             gzip_compressed: false,
             serialized_msg,
+            compression_type: 0,
+            max_timeout: 0,
         })
         .await
         .expect("failed app_request");
@@ -142,6 +146,8 @@ async fn app_request_gzip_compress() {
             app_bytes,
             gzip_compressed: true,
             serialized_msg,
+            compression_type: 0,
+            max_timeout: 0,
         })
         .await
         .expect("failed app_request");
@@ -177,6 +183,7 @@ async fn app_response() {
             app_bytes,
             gzip_compressed: false,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed app_response");
@@ -213,6 +220,7 @@ async fn app_response_gzip_compress() {
             app_bytes,
             gzip_compressed: true,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed app_response");
@@ -40,6 +40,7 @@ async fn chits() {
             request_id,
             container_ids: container_ids_bytes,
             serialized_msg,
+            accepted_container_ids: Vec::new(),
         })
         .await
         .expect("failed chits");
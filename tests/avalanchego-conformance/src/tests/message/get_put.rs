@@ -35,6 +35,8 @@ async fn get() {
             deadline,
             container_id: container_id.as_ref().to_vec(),
             serialized_msg,
+            max_timeout: 0,
+            engine_type: 0,
         })
         .await
         .expect("failed get");
@@ -70,6 +72,8 @@ async fn put() {
             container_bytes,
             gzip_compressed: false,
             serialized_msg,
+            compression_type: 0,
+            engine_type: 0,
         })
         .await
         .expect("failed put");
@@ -106,6 +110,8 @@ async fn put_gzip_compress() {
             container_bytes,
             gzip_compressed: true,
             serialized_msg,
+            compression_type: 0,
+            engine_type: 0,
         })
         .await
         .expect("failed put");
@@ -61,6 +61,7 @@ async fn peerlist() {
             peers: rpc_peers,
             gzip_compressed: false,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed peerlist");
@@ -127,6 +128,7 @@ async fn peerlist_gzip_compress() {
             peers: rpc_peers,
             gzip_compressed: true,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed peerlist");
@@ -32,6 +32,8 @@ async fn pull_query() {
             deadline,
             container_id: container_id.as_ref().to_vec(),
             serialized_msg,
+            max_timeout: 0,
+            engine_type: 0,
         })
         .await
         .expect("failed pull_query");
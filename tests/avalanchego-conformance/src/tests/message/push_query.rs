@@ -33,6 +33,9 @@ async fn push_query() {
             container_bytes,
             gzip_compressed: false,
             serialized_msg,
+            compression_type: 0,
+            max_timeout: 0,
+            engine_type: 0,
         })
         .await
         .expect("failed push_query");
@@ -72,6 +75,9 @@ async fn push_query_gzip_compress() {
             container_bytes,
             gzip_compressed: true,
             serialized_msg,
+            compression_type: 0,
+            max_timeout: 0,
+            engine_type: 0,
         })
         .await
         .expect("failed push_query");
@@ -35,6 +35,7 @@ async fn state_summary_frontier() {
             summary,
             gzip_compressed: false,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed state_summary_frontier");
@@ -71,6 +72,7 @@ async fn state_summary_frontier_gzip_compress() {
             summary,
             gzip_compressed: true,
             serialized_msg,
+            compression_type: 0,
         })
         .await
         .expect("failed state_summary_frontier");
@@ -105,6 +107,7 @@ async fn get_state_summary_frontier() {
             request_id,
             deadline,
             serialized_msg,
+            max_timeout: 0,
         })
         .await
         .expect("failed get_state_summary_frontier");
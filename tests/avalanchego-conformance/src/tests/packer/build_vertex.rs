@@ -51,6 +51,7 @@ async fn build_vertex() {
         parent_ids: parent_ids_copied,
         txs: txs_copied,
         vtx_bytes: Vec::new(),
+        upgrade_era: 0,
     };
     let packer = Packer::new(1024, 0);
     packer.pack_vertex(&mut vtx).unwrap();